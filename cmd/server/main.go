@@ -9,9 +9,11 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/rxtech-lab/invoice-management/internal/api"
+	"github.com/rxtech-lab/invoice-management/internal/api/handlers"
 	mcpserver "github.com/rxtech-lab/invoice-management/internal/mcp"
 	"github.com/rxtech-lab/invoice-management/internal/services"
 	"gorm.io/gorm"
@@ -38,15 +40,21 @@ func main() {
 
 	// Initialize services
 	tagService := services.NewTagService(db)
-	folderService := services.NewFolderService(db)
-	fileService := services.NewFileService(db)
-	uploadService := initUploadService()
+	folderService := initFolderService(db)
+	fileService := initFileService(db)
+	uploadService := initUploadService(db)
 	embeddingService := initEmbeddingService(db)
-	contentParserService := initContentParserService()
-	summaryService := initSummaryService()
-	searchService := services.NewSearchService(db, embeddingService)
-	agentService := initAgentService(tagService, fileService, folderService)
 	invoiceService := initInvoiceService()
+	contentParserService := initContentParserService(invoiceService != nil && invoiceService.IsEnabled())
+	summaryService := initSummaryService()
+	searchService := services.NewSearchService(db, embeddingService, initRerankService())
+	agentService := initAgentService(tagService, fileService, folderService, uploadService, searchService)
+	reembedService := services.NewReembedService(db, fileService, embeddingService)
+	autoTagService := initAutoTagService(db, embeddingService)
+	reassignService := services.NewReassignService(db, uploadService)
+	statsService := services.NewStatsService(db)
+	processingGate := services.NewProcessingGate(db)
+	parserJobService := services.NewParserJobService(db, processingTimeout())
 
 	// Initialize MCP server
 	mcpSrv := mcpserver.NewMCPServer(
@@ -74,6 +82,13 @@ func main() {
 		summaryService,
 		agentService,
 		invoiceService,
+		reembedService,
+		autoTagService,
+		reassignService,
+		statsService,
+		processingGate,
+		parserJobService,
+		initPagination(),
 		mcpSrv.GetServer(),
 	)
 
@@ -96,6 +111,12 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Recover files left in processing by an unclean shutdown
+	initProcessingSweeper(dbService.GetDB(), fileService, parserJobService, processingGate).Start(ctx)
+
+	// Permanently remove folders once they can no longer be restored
+	initFolderPurger(db, folderService, uploadService).Start(ctx)
+
 	go func() {
 		sigCh := make(chan os.Signal, 1)
 		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
@@ -132,11 +153,25 @@ func initDatabase() (services.DBService, error) {
 	return services.NewSqliteDBService(dbPath)
 }
 
-func initUploadService() services.UploadService {
+// initUploadService returns an upload service that resolves each user's bucket
+// from their storage config, using the S3_* bucket for everyone else
+func initUploadService(db *gorm.DB) services.UploadService {
+	secretKey := services.StorageSecretKey(os.Getenv("STORAGE_ENCRYPTION_KEY"))
+	if secretKey == nil {
+		log.Println("Warning: STORAGE_ENCRYPTION_KEY not configured, per-user storage config secrets can't be read")
+	} else if encrypted, err := services.EncryptStorageSecrets(db, secretKey); err != nil {
+		log.Printf("Warning: Failed to encrypt storage config secrets: %v", err)
+	} else if encrypted > 0 {
+		log.Printf("Encrypted %d plaintext storage config secrets", encrypted)
+	}
+	return services.NewUserUploadService(db, initDefaultUploadService(), secretKey)
+}
+
+func initDefaultUploadService() services.UploadService {
 	bucket := os.Getenv("S3_BUCKET")
 
 	if bucket == "" {
-		log.Println("Warning: S3_BUCKET not configured, file uploads will not work")
+		log.Println("Warning: S3_BUCKET not configured, file uploads will only work for users with their own storage config")
 		return nil
 	}
 
@@ -159,12 +194,114 @@ func initUploadService() services.UploadService {
 	return service
 }
 
+func initFolderService(db *gorm.DB) services.FolderService {
+	maxDepth := services.DefaultMaxFolderDepth
+	if maxDepthStr := os.Getenv("FOLDER_MAX_DEPTH"); maxDepthStr != "" {
+		if md, err := strconv.Atoi(maxDepthStr); err == nil && md > 0 {
+			maxDepth = md
+		}
+	}
+
+	return services.NewFolderService(db, services.FolderConfig{MaxDepth: maxDepth})
+}
+
+func initFileService(db *gorm.DB) services.FileService {
+	// Folders can hold any number of files unless FOLDER_MAX_FILES is set
+	maxFiles := 0
+	if maxFilesStr := os.Getenv("FOLDER_MAX_FILES"); maxFilesStr != "" {
+		if mf, err := strconv.Atoi(maxFilesStr); err == nil && mf >= 0 {
+			maxFiles = mf
+		}
+	}
+	if maxFiles > 0 {
+		log.Printf("Folders limited to %d files each", maxFiles)
+	}
+
+	return services.NewFileService(db, services.FileConfig{MaxFilesPerFolder: maxFiles})
+}
+
+// processingTimeout is how long a file may stay in processing, which is also
+// how long an async content parser has to call back
+func processingTimeout() time.Duration {
+	if timeoutStr := os.Getenv("PROCESSING_TIMEOUT_MINUTES"); timeoutStr != "" {
+		if minutes, err := strconv.Atoi(timeoutStr); err == nil && minutes > 0 {
+			return time.Duration(minutes) * time.Minute
+		}
+	}
+	return services.DefaultProcessingTimeout
+}
+
+func initProcessingSweeper(db *gorm.DB, fileService services.FileService, parserJobService services.ParserJobService, processingGate *services.ProcessingGate) *services.ProcessingSweeper {
+	config := services.ProcessingSweeperConfig{
+		Timeout:  processingTimeout(),
+		Interval: services.DefaultProcessingSweepInterval,
+	}
+	if intervalStr := os.Getenv("PROCESSING_SWEEP_INTERVAL_MINUTES"); intervalStr != "" {
+		if minutes, err := strconv.Atoi(intervalStr); err == nil && minutes > 0 {
+			config.Interval = time.Duration(minutes) * time.Minute
+		}
+	}
+
+	// One instance sweeps at a time; another takes over if it misses two sweeps
+	lease := services.NewJobLease(db, services.ProcessingSweeperLease, 2*config.Interval)
+
+	log.Printf("Processing sweeper initialized (timeout: %s, interval: %s)", config.Timeout, config.Interval)
+	return services.NewProcessingSweeper(fileService, parserJobService, processingGate, lease, config)
+}
+
+func initFolderPurger(db *gorm.DB, folderService services.FolderService, uploadService services.UploadService) *services.FolderPurger {
+	config := services.FolderPurgerConfig{
+		Retention: services.DefaultDeletedFolderRetention,
+		Interval:  services.DefaultFolderPurgeInterval,
+	}
+	if daysStr := os.Getenv("DELETED_FOLDER_RETENTION_DAYS"); daysStr != "" {
+		if days, err := strconv.Atoi(daysStr); err == nil && days > 0 {
+			config.Retention = time.Duration(days) * 24 * time.Hour
+		}
+	}
+
+	// One instance purges at a time; another takes over if it misses two purges
+	lease := services.NewJobLease(db, services.FolderPurgerLease, 2*config.Interval)
+
+	log.Printf("Folder purger initialized (retention: %s, interval: %s)", config.Retention, config.Interval)
+	return services.NewFolderPurger(folderService, uploadService, lease, config)
+}
+
+// initPagination reads page sizes from PAGE_SIZE_DEFAULT/PAGE_SIZE_MAX, which
+// apply to every list endpoint, and the per-endpoint overrides
+// <FILES|FOLDERS|TAGS|SEARCH>_PAGE_SIZE_DEFAULT/_MAX. Search keeps its own
+// defaults unless overridden explicitly.
+func initPagination() handlers.PaginationConfig {
+	list := handlers.PageSize{
+		Default: getEnvInt("PAGE_SIZE_DEFAULT", 0),
+		Max:     getEnvInt("PAGE_SIZE_MAX", 0),
+	}
+	pageSize := func(prefix string, fallback handlers.PageSize) handlers.PageSize {
+		return handlers.PageSize{
+			Default: getEnvInt(prefix+"_PAGE_SIZE_DEFAULT", fallback.Default),
+			Max:     getEnvInt(prefix+"_PAGE_SIZE_MAX", fallback.Max),
+		}
+	}
+
+	config := handlers.PaginationConfig{
+		Files:   pageSize("FILES", list),
+		Folders: pageSize("FOLDERS", list),
+		Tags:    pageSize("TAGS", list),
+		Search:  pageSize("SEARCH", handlers.PageSize{}),
+	}
+
+	log.Printf("Pagination initialized (files: %+v, folders: %+v, tags: %+v, search: %+v)",
+		config.Files, config.Folders, config.Tags, config.Search)
+	return config
+}
+
 func initEmbeddingService(db *gorm.DB) services.EmbeddingService {
-	gatewayURL := os.Getenv("AI_GATEWAY_URL")
-	apiKey := os.Getenv("AI_GATEWAY_API_KEY")
+	// Embeddings use the AI gateway unless a separate endpoint is configured
+	gatewayURL := getEnvOrDefault("EMBEDDING_URL", os.Getenv("AI_GATEWAY_URL"))
+	apiKey := getEnvOrDefault("EMBEDDING_API_KEY", os.Getenv("AI_GATEWAY_API_KEY"))
 
 	model := getEnvOrDefault("EMBEDDING_MODEL", "text-embedding-3-small")
-	dimensions := 1536
+	dimensions := services.DefaultEmbeddingDimensions(model)
 	if dimStr := os.Getenv("EMBEDDING_DIMENSIONS"); dimStr != "" {
 		if dim, err := strconv.Atoi(dimStr); err == nil {
 			dimensions = dim
@@ -172,26 +309,95 @@ func initEmbeddingService(db *gorm.DB) services.EmbeddingService {
 	}
 
 	config := services.EmbeddingConfig{
+		Provider:   getEnvOrDefault("EMBEDDING_PROVIDER", services.EmbeddingProviderOpenAI),
 		GatewayURL: gatewayURL,
 		APIKey:     apiKey,
 		Model:      model,
 		Dimensions: dimensions,
 	}
 
-	log.Printf("Embedding service initialized (model: %s, dimensions: %d)", model, dimensions)
-	return services.NewEmbeddingService(db, config)
+	provider, err := services.NewEmbeddingProvider(config)
+	if err != nil {
+		log.Fatalf("Failed to initialize embedding provider: %v", err)
+	}
+
+	log.Printf("Embedding service initialized (provider: %s, model: %s, dimensions: %d)", config.Provider, model, dimensions)
+	return services.NewEmbeddingServiceWithProvider(db, config, provider)
+}
+
+func initRerankService() services.RerankService {
+	// Reranking is opt-in: it costs an extra model call per search
+	config := services.RerankConfig{
+		URL:    os.Getenv("RERANK_URL"),
+		APIKey: getEnvOrDefault("RERANK_API_KEY", os.Getenv("AI_GATEWAY_API_KEY")),
+		Model:  os.Getenv("RERANK_MODEL"),
+		TopK:   getEnvInt("RERANK_TOP_K", services.DefaultRerankTopK),
+	}
+	if config.URL == "" {
+		return nil
+	}
+
+	log.Printf("Rerank service initialized (model: %s, top k: %d)", config.Model, config.TopK)
+	return services.NewRerankService(config)
 }
 
-func initContentParserService() services.ContentParserService {
+func initAutoTagService(db *gorm.DB, embeddingService services.EmbeddingService) services.AutoTagService {
+	// Similarity-based auto-tagging is opt-in
+	config := services.AutoTagConfig{
+		Enabled:   os.Getenv("AUTO_TAG_ENABLED") == "true",
+		Threshold: services.DefaultAutoTagThreshold,
+		MaxTags:   getEnvInt("AUTO_TAG_MAX_TAGS", services.DefaultAutoTagMaxTags),
+	}
+	if thresholdStr := os.Getenv("AUTO_TAG_THRESHOLD"); thresholdStr != "" {
+		if threshold, err := strconv.ParseFloat(thresholdStr, 64); err == nil && threshold > 0 && threshold <= 1 {
+			config.Threshold = threshold
+		}
+	}
+
+	autoTagService := services.NewAutoTagService(db, embeddingService, config)
+	if config.Enabled {
+		log.Printf("Auto-tagging enabled (threshold: %.2f, max tags: %d)", config.Threshold, config.MaxTags)
+	}
+	return autoTagService
+}
+
+// initContentParserService builds the content parser. Async mode is refused
+// while invoice processing is enabled: runs resumed by the parser's callback
+// have no user token to create invoices with.
+func initContentParserService(invoiceEnabled bool) services.ContentParserService {
 	endpoint := os.Getenv("CONTENT_PARSER_ENDPOINT")
 	apiKey := os.Getenv("ADMIN_API_KEY")
 
+	authHeader := getEnvOrDefault("CONTENT_PARSER_AUTH_HEADER", "X-Api-Key")
+	authScheme := os.Getenv("CONTENT_PARSER_AUTH_SCHEME")
+
+	// Documents parsed to fewer characters are flagged for review (0 disables)
+	minContentChars := services.DefaultMinContentChars
+	if minCharsStr := os.Getenv("CONTENT_PARSER_MIN_CONTENT_CHARS"); minCharsStr != "" {
+		if mc, err := strconv.Atoi(minCharsStr); err == nil && mc >= 0 {
+			minContentChars = mc
+		}
+	}
+
 	config := services.ContentParserConfig{
-		EndpointURL: endpoint,
-		APIKey:      apiKey,
+		EndpointURL:     endpoint,
+		APIKey:          apiKey,
+		AuthHeader:      authHeader,
+		AuthScheme:      authScheme,
+		MinContentChars: minContentChars,
+		// Async parsers post results to this URL instead of responding inline
+		CallbackURL: os.Getenv("CONTENT_PARSER_CALLBACK_URL"),
 	}
 
-	log.Printf("Content parser service initialized (endpoint: %s)", endpoint)
+	if config.CallbackURL != "" && invoiceEnabled {
+		log.Println("Warning: CONTENT_PARSER_CALLBACK_URL ignored while invoice processing is enabled; parsing inline")
+		config.CallbackURL = ""
+	}
+
+	log.Printf("Content parser service initialized (endpoint: %s, auth header: %s, min content chars: %d)", endpoint, authHeader, minContentChars)
+	if config.CallbackURL != "" {
+		log.Printf("Content parser async mode enabled (callback: %s)", config.CallbackURL)
+	}
 	return services.NewContentParserService(config)
 }
 
@@ -201,12 +407,14 @@ func initSummaryService() services.SummaryService {
 	model := getEnvOrDefault("SUMMARY_MODEL", "gpt-4o-mini")
 
 	config := services.SummaryConfig{
-		GatewayURL: gatewayURL,
-		APIKey:     apiKey,
-		Model:      model,
+		GatewayURL:      gatewayURL,
+		APIKey:          apiKey,
+		Model:           model,
+		FallbackLength:  getEnvInt("SUMMARY_FALLBACK_LENGTH", services.DefaultSummaryFallbackLength),
+		ExtractEntities: os.Getenv("SUMMARY_EXTRACT_ENTITIES") == "true",
 	}
 
-	log.Printf("Summary service initialized (model: %s)", model)
+	log.Printf("Summary service initialized (model: %s, extract entities: %v)", model, config.ExtractEntities)
 	return services.NewSummaryService(config)
 }
 
@@ -214,6 +422,8 @@ func initAgentService(
 	tagService services.TagService,
 	fileService services.FileService,
 	folderService services.FolderService,
+	uploadService services.UploadService,
+	searchService services.SearchService,
 ) services.AgentService {
 	// Check if agent is enabled (default: true)
 	enabled := os.Getenv("AGENT_ENABLED") != "false"
@@ -233,16 +443,48 @@ func initAgentService(
 		}
 	}
 
+	maxContentChars := 5000
+	if maxContentStr := os.Getenv("AGENT_MAX_CONTENT_CHARS"); maxContentStr != "" {
+		if mc, err := strconv.Atoi(maxContentStr); err == nil && mc > 0 {
+			maxContentChars = mc
+		}
+	}
+
+	// Files in a folder with at least this many tags skip the agent (0 disables)
+	skipOrganizedMinTags := 1
+	if minTagsStr := os.Getenv("AGENT_SKIP_ORGANIZED_MIN_TAGS"); minTagsStr != "" {
+		if mt, err := strconv.Atoi(minTagsStr); err == nil && mt >= 0 {
+			skipOrganizedMinTags = mt
+		}
+	}
+
 	config := services.AgentConfig{
-		GatewayURL: gatewayURL,
-		APIKey:     apiKey,
-		Model:      model,
-		MaxTurns:   maxTurns,
-		Enabled:    enabled,
+		GatewayURL:           gatewayURL,
+		APIKey:               apiKey,
+		Model:                model,
+		MaxTurns:             maxTurns,
+		Enabled:              enabled,
+		MaxContentChars:      maxContentChars,
+		SkipOrganizedMinTags: skipOrganizedMinTags,
+		EmbedBeforeAgent:     os.Getenv("AGENT_EMBED_FIRST") == "true",
+	}
+
+	log.Printf("AI Agent service initialized (model: %s, maxTurns: %d, maxContentChars: %d)", model, maxTurns, maxContentChars)
+	return services.NewAgentService(config, tagService, fileService, folderService, uploadService, searchService, initAgentActionPublisher())
+}
+
+// initAgentActionPublisher delivers the changes the agent makes to AGENT_WEBHOOK_URL
+func initAgentActionPublisher() services.AgentActionPublisher {
+	url := os.Getenv("AGENT_WEBHOOK_URL")
+	if url == "" {
+		return nil
 	}
 
-	log.Printf("AI Agent service initialized (model: %s, maxTurns: %d)", model, maxTurns)
-	return services.NewAgentService(config, tagService, fileService, folderService)
+	log.Printf("Agent action webhook enabled (url: %s)", url)
+	return services.NewWebhookActionPublisher(services.AgentWebhookConfig{
+		URL:    url,
+		Secret: os.Getenv("AGENT_WEBHOOK_SECRET"),
+	})
 }
 
 func initInvoiceService() services.InvoiceService {
@@ -273,6 +515,14 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvInt returns the positive integer value of an environment variable, or defaultValue
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
 func validateRequiredEnvVars() {
 	required := map[string]string{
 		"AI_GATEWAY_URL":          os.Getenv("AI_GATEWAY_URL"),
@@ -0,0 +1,298 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdminTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *AdminTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *AdminTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *AdminTestSuite) TestGetReembedStatusNotStarted() {
+	resp, err := s.setup.MakeAdminRequest("GET", "/api/admin/reembed", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *AdminTestSuite) TestStartReembed() {
+	db := s.setup.DBService.GetDB()
+	for _, title := range []string{"Invoice", "Contract"} {
+		fileID, err := s.setup.CreateTestFile(title, "files/test-user-123/"+title+".pdf", title+".pdf", nil)
+		s.Require().NoError(err)
+		s.Require().NoError(db.Model(&models.File{}).Where("id = ?", fileID).Updates(map[string]interface{}{
+			"processing_status": models.FileStatusCompleted,
+			"content":           "parsed " + title,
+		}).Error)
+	}
+
+	// Pending files are not re-embedded
+	_, err := s.setup.CreateTestFile("Pending", "files/test-user-123/pending.pdf", "pending.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeAdminRequest("POST", "/api/admin/reembed", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusAccepted, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("mock-embedding", result["model"])
+	s.Equal(float64(2), result["total"])
+
+	s.Require().Eventually(func() bool {
+		resp, err := s.setup.MakeAdminRequest("GET", "/api/admin/reembed", nil)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			return false
+		}
+		result, err = s.setup.ReadResponseBody(resp)
+		return err == nil && result["status"] == "completed"
+	}, 5*time.Second, 20*time.Millisecond)
+
+	s.Equal(float64(2), result["processed"])
+	s.Equal(float64(0), result["failed"])
+	s.NotNil(result["completed_at"])
+}
+
+func (s *AdminTestSuite) TestReembedRequiresAdmin() {
+	resp, err := s.setup.MakeRequest("POST", "/api/admin/reembed", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/admin/reembed", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+}
+
+func (s *AdminTestSuite) TestReassignOwnershipRequiresAdmin() {
+	resp, err := s.setup.MakeRequest("POST", "/api/admin/reassign", map[string]interface{}{
+		"from_user":     s.setup.TestUserID,
+		"to_user":       "new-owner",
+		"resource_type": "file",
+		"resource_ids":  []int{1},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+}
+
+func (s *AdminTestSuite) TestReassignFolder() {
+	const newOwner = "new-owner"
+	parentID, err := s.setup.CreateTestFolder("Team", nil)
+	s.Require().NoError(err)
+	folderID, err := s.setup.CreateTestFolder("Handoff", &parentID)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Drafts", &folderID)
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Plan", "files/test-user-123/plan.pdf", "plan.pdf", &childID)
+	s.Require().NoError(err)
+	tagID, err := s.setup.CreateTestTag("Roadmap")
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+		"tag_ids": []uint{tagID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeAdminRequest("POST", "/api/admin/reassign", map[string]interface{}{
+		"from_user":     s.setup.TestUserID,
+		"to_user":       newOwner,
+		"resource_type": "folder",
+		"resource_ids":  []uint{folderID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["folders"])
+	s.Equal(float64(1), result["files"])
+	s.Equal(float64(1), result["tags_created"])
+	s.Equal(float64(1), result["objects_moved"])
+
+	// The old owner no longer sees the folder
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d", folderID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	// The new owner gets it at their root, with the file and a copy of its tag
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/folders/%d", folderID), nil, newOwner)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	folder, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Nil(folder["parent_id"])
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil, newOwner)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("files/new-owner/plan.pdf", file["s3_key"])
+	tags := file["tags"].([]interface{})
+	s.Require().Len(tags, 1)
+	s.Equal("Roadmap", tags[0].(map[string]interface{})["name"])
+	s.NotEqual(float64(tagID), tags[0].(map[string]interface{})["id"])
+}
+
+func (s *AdminTestSuite) TestReassignFolderMovesRelations() {
+	const newOwner = "new-owner"
+	folderID, err := s.setup.CreateTestFolder("Handoff", nil)
+	s.Require().NoError(err)
+	invoiceID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", &folderID)
+	s.Require().NoError(err)
+	receiptID, err := s.setup.CreateTestFile("Receipt", "files/test-user-123/receipt.pdf", "receipt.pdf", &folderID)
+	s.Require().NoError(err)
+	outsideID, err := s.setup.CreateTestFile("Contract", "files/test-user-123/contract.pdf", "contract.pdf", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.FileService.AddFileRelation(s.setup.TestUserID, invoiceID, receiptID, "")
+	s.Require().NoError(err)
+	_, err = s.setup.FileService.AddFileRelation(s.setup.TestUserID, invoiceID, outsideID, "")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeAdminRequest("POST", "/api/admin/reassign", map[string]interface{}{
+		"from_user":     s.setup.TestUserID,
+		"to_user":       newOwner,
+		"resource_type": "folder",
+		"resource_ids":  []uint{folderID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	// The relation between moved files moves with them; the one to the file
+	// left behind is removed
+	relations, err := s.setup.FileService.ListFileRelations(newOwner, invoiceID)
+	s.Require().NoError(err)
+	s.Require().Len(relations, 1)
+	s.Equal(receiptID, relations[0].RelatedFileID)
+
+	var count int64
+	s.Require().NoError(s.setup.DBService.GetDB().Model(&models.FileRelation{}).Where("user_id = ?", s.setup.TestUserID).Count(&count).Error)
+	s.Zero(count)
+}
+
+func (s *AdminTestSuite) TestReassignFileNotOwned() {
+	resp, err := s.setup.MakeAdminRequest("POST", "/api/admin/reassign", map[string]interface{}{
+		"from_user":     s.setup.TestUserID,
+		"to_user":       "new-owner",
+		"resource_type": "file",
+		"resource_ids":  []int{99999},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *AdminTestSuite) TestGetProcessingStatus() {
+	db := s.setup.DBService.GetDB()
+	now := time.Now()
+	for i, duration := range []time.Duration{10 * time.Second, 30 * time.Second} {
+		fileID, err := s.setup.CreateTestFile(fmt.Sprintf("Done %d", i), fmt.Sprintf("files/test-user-123/done-%d.pdf", i), "done.pdf", nil)
+		s.Require().NoError(err)
+		s.Require().NoError(db.Model(&models.File{}).Where("id = ?", fileID).Updates(map[string]interface{}{
+			"processing_status":     models.FileStatusCompleted,
+			"processing_started_at": now.Add(-duration),
+			"processing_ended_at":   now,
+		}).Error)
+	}
+
+	// Finished before the window, so it only shows up in the counts
+	oldID, err := s.setup.CreateTestFile("Old", "files/test-user-123/old.pdf", "old.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(db.Model(&models.File{}).Where("id = ?", oldID).Updates(map[string]interface{}{
+		"processing_status":     models.FileStatusCompleted,
+		"processing_started_at": now.Add(-3 * time.Hour),
+		"processing_ended_at":   now.Add(-2 * time.Hour),
+	}).Error)
+
+	_, err = s.setup.CreateTestFile("Queued", "files/test-user-123/queued.pdf", "queued.pdf", nil)
+	s.Require().NoError(err)
+	busyID, err := s.setup.CreateTestFile("Busy", "files/test-user-123/busy.pdf", "busy.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, busyID, models.FileStatusProcessing, ""))
+
+	resp, err := s.setup.MakeAdminRequest("GET", "/api/admin/processing-status", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	counts := result["counts"].(map[string]interface{})
+	s.Equal(float64(1), counts["pending"])
+	s.Equal(float64(1), counts["processing"])
+	s.Equal(float64(3), counts["completed"])
+	s.Equal(float64(0), counts["failed"])
+	s.Equal(float64(1), result["processing"])
+	s.Equal(float64(60), result["window_minutes"])
+	s.Equal(float64(2), result["completed"])
+	s.InDelta(20, result["average_duration_seconds"], 0.5)
+}
+
+func (s *AdminTestSuite) TestGetProcessingStatusRequiresAdmin() {
+	resp, err := s.setup.MakeRequest("GET", "/api/admin/processing-status", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+
+	resp, err = s.setup.MakeAdminRequest("GET", "/api/admin/processing-status?window_minutes=0", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *AdminTestSuite) TestPauseAndResumeProcessing() {
+	resp, err := s.setup.MakeRequest("POST", "/api/admin/processing/pause", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+
+	resp, err = s.setup.MakeAdminRequest("POST", "/api/admin/processing/pause", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(true, result["paused"])
+
+	fileID, err := s.setup.CreateTestFile("Held", "files/test-user-123/held.pdf", "held.pdf", nil)
+	s.Require().NoError(err)
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/process", fileID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusAccepted, resp.StatusCode)
+
+	s.Eventually(func() bool {
+		resp, err := s.setup.MakeAdminRequest("GET", "/api/admin/processing-status", nil)
+		if err != nil {
+			return false
+		}
+		result, err := s.setup.ReadResponseBody(resp)
+		return err == nil && result["paused"] == true && result["waiting"] == float64(1)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	file, err := s.setup.FileService.GetFileByID(s.setup.TestUserID, fileID)
+	s.Require().NoError(err)
+	s.Equal(models.FileStatusProcessing, file.ProcessingStatus)
+
+	resp, err = s.setup.MakeAdminRequest("POST", "/api/admin/processing/resume", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(false, result["paused"])
+
+	s.Eventually(func() bool {
+		file, err := s.setup.FileService.GetFileByID(s.setup.TestUserID, fileID)
+		return err == nil && file.ProcessingStatus == models.FileStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestAdminSuite(t *testing.T) {
+	suite.Run(t, new(AdminTestSuite))
+}
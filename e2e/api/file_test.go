@@ -1,13 +1,19 @@
 package api
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -78,6 +84,109 @@ func (s *FileTestSuite) TestCreateFileEmptyBody() {
 	s.Equal(http.StatusBadRequest, resp.StatusCode)
 }
 
+func (s *FileTestSuite) TestCreateFileReportsAllFieldErrors() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             " ",
+		"s3_key":            "files/test-user-123/invalid.pdf",
+		"original_filename": "invalid.pdf",
+		"file_type":         "spreadsheet",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	errs := result["errors"].([]interface{})
+	s.Require().Len(errs, 2)
+	s.Equal("title", errs[0].(map[string]interface{})["field"])
+	s.Equal("file_type", errs[1].(map[string]interface{})["field"])
+	s.Contains(result["error"], "title is required")
+}
+
+func (s *FileTestSuite) TestUpdateFileInvalidFileType() {
+	fileID, err := s.setup.CreateTestFile("Valid", "files/test-user-123/valid.pdf", "valid.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{
+		"file_type": "spreadsheet",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["errors"], 1)
+}
+
+func (s *FileTestSuite) TestCreateFileDuplicateS3Key() {
+	_, err := s.setup.CreateTestFile("Original", "files/test-user-123/shared.pdf", "shared.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             "Copy",
+		"s3_key":            "files/test-user-123/shared.pdf",
+		"original_filename": "shared.pdf",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(result["error"], "s3_key already exists")
+}
+
+func (s *FileTestSuite) TestCreateFileWithContent() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             "Imported Notes",
+		"s3_key":            "files/test-user-123/imported.txt",
+		"original_filename": "imported.txt",
+		"mime_type":         "text/plain",
+		"content":           "quarterly budget review notes",
+		"summary":           "Budget notes",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("completed", result["processing_status"])
+	s.Equal("quarterly budget review notes", result["content"])
+	s.Equal("Budget notes", result["summary"])
+
+	file, err := s.setup.FileService.GetFileByID(s.setup.TestUserID, uint(result["id"].(float64)))
+	s.Require().NoError(err)
+	s.True(file.HasEmbedding)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/search?q=budget&type=fulltext", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	searchResult, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), searchResult["total"])
+}
+
+func (s *FileTestSuite) TestCreateFileWithContentSkipEmbedding() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":              "Imported Notes",
+		"s3_key":             "files/test-user-123/imported.txt",
+		"original_filename":  "imported.txt",
+		"content":            "some text",
+		"generate_embedding": false,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("completed", result["processing_status"])
+
+	file, err := s.setup.FileService.GetFileByID(s.setup.TestUserID, uint(result["id"].(float64)))
+	s.Require().NoError(err)
+	s.False(file.HasEmbedding)
+}
+
 func (s *FileTestSuite) TestListFiles() {
 	// Create some files
 	_, err := s.setup.CreateTestFile("File1", "files/test-user-123/file1.pdf", "file1.pdf", nil)
@@ -97,6 +206,321 @@ func (s *FileTestSuite) TestListFiles() {
 	s.Equal(float64(2), result["total"])
 }
 
+func (s *FileTestSuite) TestListFilesKeywordIncludesFolderName() {
+	folderID, err := s.setup.CreateTestFolder("Contracts", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Lease", "files/test-user-123/lease.pdf", "lease.pdf", &folderID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Notes", "files/test-user-123/notes.pdf", "notes.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/files?keyword=contracts&all_folders=true", nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["total"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files?keyword=contracts&all_folders=true&include_folder_name=true", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("Lease", data[0].(map[string]interface{})["title"])
+}
+
+func (s *FileTestSuite) TestListFilesGrouped() {
+	projects, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	apollo, err := s.setup.CreateTestFolder("Apollo", &projects)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Specs", &apollo)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Archive", nil)
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.setup.CreateTestFile(fmt.Sprintf("Plan %d", i), fmt.Sprintf("files/test-user-123/plan-%d.pdf", i), "plan.pdf", &apollo)
+		s.Require().NoError(err)
+	}
+	_, err = s.setup.CreateTestFile("Loose", "files/test-user-123/loose.pdf", "loose.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/files/grouped?max_depth=2&files_per_folder=2", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	root, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Nil(root["folder_id"])
+	s.Equal(float64(1), root["file_count"])
+	s.Len(root["files"], 1)
+	s.Equal(float64(2), root["child_count"])
+
+	// Top-level folders are ordered by name
+	children := root["children"].([]interface{})
+	s.Require().Len(children, 2)
+	s.Equal("Archive", children[0].(map[string]interface{})["name"])
+	s.Empty(children[0].(map[string]interface{})["files"])
+	projectsNode := children[1].(map[string]interface{})
+	s.Equal(float64(projects), projectsNode["folder_id"])
+
+	apolloNode := projectsNode["children"].([]interface{})[0].(map[string]interface{})
+	s.Equal("Apollo", apolloNode["name"])
+	s.Equal(float64(3), apolloNode["file_count"])
+	s.Len(apolloNode["files"], 2, "files are capped per folder")
+	// Specs is past max_depth: counted but not included
+	s.Equal(float64(1), apolloNode["child_count"])
+	s.Empty(apolloNode["children"])
+
+	// A subtree starts at its folder
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/grouped?root_folder_id=%d", apollo), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	root, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Apollo", root["name"])
+	s.Len(root["files"], 3)
+	s.Equal("Specs", root["children"].([]interface{})[0].(map[string]interface{})["name"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/grouped?root_folder_id=99999", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/grouped?max_depth=11", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestListFilesInvalidSort() {
+	resp, err := s.setup.MakeRequest("GET", "/api/files?sort_by=name&sort_order=up", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	errs := result["errors"].([]interface{})
+	s.Require().Len(errs, 2)
+	s.Equal("sort_by", errs[0].(map[string]interface{})["field"])
+	s.Equal("sort_order", errs[1].(map[string]interface{})["field"])
+	s.Contains(result["error"], "sort_by must be one of created_at, updated_at, title, size, word_count, char_count")
+}
+
+func (s *FileTestSuite) TestListFilesInvalidTagIDs() {
+	_, err := s.setup.CreateTestFile("Untagged", "files/test-user-123/untagged.pdf", "untagged.pdf", nil)
+	s.Require().NoError(err)
+
+	for _, path := range []string{
+		"/api/files?tag_ids=abc",
+		"/api/files?tag_ids=1,abc",
+		"/api/folders?tag_ids=abc",
+		"/api/search?q=untagged&tag_ids=abc",
+		"/api/files/stream?tag_ids=abc",
+	} {
+		resp, err := s.setup.MakeRequest("GET", path, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, path)
+
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		s.Contains(result["error"], `invalid ID "abc"`, path)
+	}
+
+	// A trailing comma is still accepted
+	tagID, err := s.setup.CreateTestTag("Finance")
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files?tag_ids=%d,", tagID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestListFilesByExtension() {
+	for _, name := range []string{"report.docx", "budget.XLSX", "scan.pdf"} {
+		_, err := s.setup.CreateTestFile(name, "files/test-user-123/"+name, name, nil)
+		s.Require().NoError(err)
+	}
+
+	for path, want := range map[string][]string{
+		"/api/files?ext=docx":                  {"report.docx"},
+		"/api/files?ext=docx,.xlsx":            {"report.docx", "budget.XLSX"},
+		"/api/files?ext=pdf&file_type=invoice": {},
+	} {
+		resp, err := s.setup.MakeRequest("GET", path, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode, path)
+
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		titles := []string{}
+		for _, file := range result["data"].([]interface{}) {
+			titles = append(titles, file.(map[string]interface{})["title"].(string))
+		}
+		s.ElementsMatch(want, titles, path)
+	}
+
+	for _, path := range []string{"/api/files?ext=%25", "/api/files/stream?ext=tar.gz"} {
+		resp, err := s.setup.MakeRequest("GET", path, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, path)
+	}
+}
+
+func (s *FileTestSuite) TestListFilesBySize() {
+	for name, size := range map[string]int{"small.mp3": 1 << 10, "large.mov": 200 << 20} {
+		resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+			"title":             name,
+			"s3_key":            "files/test-user-123/" + name,
+			"original_filename": name,
+			"size":              size,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files?min_size=%d", 100<<20), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("large.mov", data[0].(map[string]interface{})["title"])
+	s.Equal(float64(1), result["total"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files?max_size=1024", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data = result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("small.mp3", data[0].(map[string]interface{})["title"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files?min_size=10&max_size=5", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(result["error"], "max_size must not be less than min_size")
+}
+
+func (s *FileTestSuite) TestListFilesByCreatedAt() {
+	_, err := s.setup.CreateTestFile("Audit", "files/test-user-123/audit.pdf", "audit.pdf", nil)
+	s.Require().NoError(err)
+	hourAgo := url.QueryEscape(time.Now().Add(-time.Hour).Format(time.RFC3339))
+	inAnHour := url.QueryEscape(time.Now().Add(time.Hour).Format(time.RFC3339))
+
+	for query, want := range map[string]int{
+		"created_after=" + hourAgo:                                 1,
+		"created_after=" + hourAgo + "&created_before=" + inAnHour: 1,
+		"created_before=" + hourAgo:                                0,
+		"updated_after=" + inAnHour:                                0,
+		"created_after=" + hourAgo + "&keyword=other":              0,
+	} {
+		resp, err := s.setup.MakeRequest("GET", "/api/files?"+query, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode, query)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		s.Len(result["data"].([]interface{}), want, query)
+		s.Equal(float64(want), result["total"], query)
+	}
+
+	for _, query := range []string{"created_after=last-month", "created_after=" + inAnHour + "&created_before=" + hourAgo} {
+		resp, err := s.setup.MakeRequest("GET", "/api/files?"+query, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
+
+func (s *FileTestSuite) TestListFilesByWordCount() {
+	shortID, err := s.setup.CreateTestFile("Short", "files/test-user-123/short.pdf", "short.pdf", nil)
+	s.Require().NoError(err)
+	longID, err := s.setup.CreateTestFile("Long", "files/test-user-123/long.pdf", "long.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileContent(s.setup.TestUserID, shortID, "Grüße aus Berlin", "", false, models.FileTypeDocument))
+	s.Require().NoError(s.setup.FileService.UpdateFileContent(s.setup.TestUserID, longID, "one two three four five six", "", false, models.FileTypeDocument))
+
+	resp, err := s.setup.MakeRequest("GET", "/api/files?sort_by=word_count&sort_order=desc", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 2)
+	longest := data[0].(map[string]interface{})
+	s.Equal("Long", longest["title"])
+	s.Equal(float64(6), longest["word_count"])
+	s.Equal(float64(27), longest["char_count"])
+	shortest := data[1].(map[string]interface{})
+	s.Equal(float64(3), shortest["word_count"])
+	s.Equal(float64(16), shortest["char_count"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files?min_word_count=4", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data = result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("Long", data[0].(map[string]interface{})["title"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files?max_word_count=3", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data = result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("Short", data[0].(map[string]interface{})["title"])
+}
+
+func (s *FileTestSuite) TestProcessingErrorSummary() {
+	failures := []string{
+		"Failed to get download URL: dial tcp 10.0.0.1:443: i/o timeout",
+		"Failed to get download URL: access denied",
+		"Failed to get download URL: access denied",
+		"Failed to parse content: unsupported format",
+	}
+	for i, msg := range failures {
+		fileID, err := s.setup.CreateTestFile(fmt.Sprintf("Failed %d", i), fmt.Sprintf("files/test-user-123/failed-%d.pdf", i), "failed.pdf", nil)
+		s.Require().NoError(err)
+		s.Require().NoError(s.setup.FileService.FailFileProcessing(s.setup.TestUserID, fileID, "", msg))
+	}
+	_, err := s.setup.CreateTestFile("Healthy", "files/test-user-123/healthy.pdf", "healthy.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/files/errors/summary", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 2)
+	top := data[0].(map[string]interface{})
+	s.Equal("Failed to get download URL", top["message"])
+	s.Equal(float64(3), top["count"])
+
+	// A group's message filters the file list to its files
+	resp, err = s.setup.MakeRequest("GET", "/api/files?error_contains="+url.QueryEscape(top["message"].(string)), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(3), result["total"])
+
+	// LIKE wildcards in the filter match literally
+	for _, pattern := range []string{"%", "_"} {
+		resp, err = s.setup.MakeRequest("GET", "/api/files?error_contains="+url.QueryEscape(pattern), nil)
+		s.Require().NoError(err)
+		result, err = s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		s.Equal(float64(0), result["total"], pattern)
+	}
+}
+
 func (s *FileTestSuite) TestListFilesInFolder() {
 	// Create folder and files
 	folderID, err := s.setup.CreateTestFolder("Documents", nil)
@@ -117,6 +541,48 @@ func (s *FileTestSuite) TestListFilesInFolder() {
 	s.Len(data, 1)
 }
 
+func (s *FileTestSuite) TestStreamFiles() {
+	folderID, err := s.setup.CreateTestFolder("Documents", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("InFolder", "files/test-user-123/in-folder.pdf", "in-folder.pdf", &folderID)
+	s.Require().NoError(err)
+	for i := 0; i < 105; i++ {
+		_, err = s.setup.CreateTestFile(fmt.Sprintf("Root %d", i), fmt.Sprintf("files/test-user-123/root-%d.pdf", i), "root.pdf", nil)
+		s.Require().NoError(err)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", "/api/files/stream?all_folders=true", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	ids := s.readNDJSONFileIDs(resp)
+	s.Len(ids, 106)
+	for i := 1; i < len(ids); i++ {
+		s.Greater(ids[i], ids[i-1])
+	}
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/stream?folder_id=%d", folderID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Len(s.readNDJSONFileIDs(resp), 1)
+}
+
+func (s *FileTestSuite) readNDJSONFileIDs(resp *http.Response) []float64 {
+	defer resp.Body.Close()
+
+	var ids []float64
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var file map[string]interface{}
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &file))
+		ids = append(ids, file["id"].(float64))
+	}
+	s.Require().NoError(scanner.Err())
+	return ids
+}
+
 func (s *FileTestSuite) TestListFilesRootFolderDoesNotShowSubfolderFiles() {
 	// Create folder
 	folderID, err := s.setup.CreateTestFolder("Documents", nil)
@@ -162,98 +628,772 @@ func (s *FileTestSuite) TestListFilesWithKeyword() {
 	result, err := s.setup.ReadResponseBody(resp)
 	s.Require().NoError(err)
 
-	data := result["data"].([]interface{})
-	s.Len(data, 1)
+	data := result["data"].([]interface{})
+	s.Len(data, 1)
+}
+
+func (s *FileTestSuite) TestGetFile() {
+	fileID, err := s.setup.CreateTestFile("Test File", "files/test-user-123/test.pdf", "test.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal("Test File", result["title"])
+	s.Equal(float64(fileID), result["id"])
+}
+
+func (s *FileTestSuite) TestGetFileNotFound() {
+	resp, err := s.setup.MakeRequest("GET", "/api/files/99999", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestGetFileAssociations() {
+	parentID, err := s.setup.CreateTestFolder("Finance", nil)
+	s.Require().NoError(err)
+	folderID, err := s.setup.CreateTestFolder("2024", &parentID)
+	s.Require().NoError(err)
+	tagID, err := s.setup.CreateTestTag("Important")
+	s.Require().NoError(err)
+
+	fileID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", &folderID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+		"tag_ids": []uint{tagID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d/associations", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.NotContains(result, "content")
+	s.NotContains(result, "title")
+
+	tags := result["tags"].([]interface{})
+	s.Require().Len(tags, 1)
+	s.Equal("Important", tags[0].(map[string]interface{})["name"])
+
+	folder := result["folder"].(map[string]interface{})
+	s.Equal(float64(folderID), folder["id"])
+
+	path := result["folder_path"].([]interface{})
+	s.Require().Len(path, 2)
+	s.Equal("Finance", path[0].(map[string]interface{})["name"])
+	s.Equal("2024", path[1].(map[string]interface{})["name"])
+}
+
+func (s *FileTestSuite) TestGetFileAssociationsRootFile() {
+	fileID, err := s.setup.CreateTestFile("Loose", "files/test-user-123/loose.pdf", "loose.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d/associations", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Empty(result["tags"])
+	s.Nil(result["folder"])
+	s.Empty(result["folder_path"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/99999/associations", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestGetFileByPublicID() {
+	fileID, err := s.setup.CreateTestFile("Contract", "files/test-user-123/contract.pdf", "contract.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	publicID := file["public_id"].(string)
+	s.Len(publicID, 36)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/public/"+publicID, nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(fileID), result["id"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/public/"+publicID+"/download", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("contract.pdf", result["filename"])
+
+	// Public IDs don't grant access to other users' files
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", "/api/files/public/"+publicID, nil, "other-user")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/public/00000000-0000-0000-0000-000000000000", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestUpdateFile() {
+	fileID, err := s.setup.CreateTestFile("Original Title", "files/test-user-123/test.pdf", "test.pdf", nil)
+	s.Require().NoError(err)
+
+	update := map[string]interface{}{
+		"title":     "Updated Title",
+		"summary":   "File summary",
+		"file_type": "invoice",
+	}
+
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), update)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal("Updated Title", result["title"])
+	s.Equal("File summary", result["summary"])
+	s.Equal("invoice", result["file_type"])
+}
+
+func (s *FileTestSuite) TestUpdateFileSummaryClearsFallback() {
+	fileID, err := s.setup.CreateTestFile("Report", "files/test-user-123/report.pdf", "report.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileContent(s.setup.TestUserID, fileID, "Quarterly numbers", "Quarterly numbers", true, models.FileTypeDocument))
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(true, result["summary_is_fallback"])
+
+	// Renaming keeps the excerpt flagged; replacing the summary clears it
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{"title": "Q3 Report"})
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(true, result["summary_is_fallback"])
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{"summary": "Q3 revenue report"})
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(false, result["summary_is_fallback"])
+}
+
+func (s *FileTestSuite) TestFileProcessingHint() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             "ACME Invoice",
+		"s3_key":            "files/test-user-123/acme.pdf",
+		"original_filename": "acme.pdf",
+		"processing_hint":   "File ACME invoices under Clients/ACME",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("File ACME invoices under Clients/ACME", result["processing_hint"])
+	fileID := uint(result["id"].(float64))
+
+	// Other updates keep the hint
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{"title": "Renamed"})
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("File ACME invoices under Clients/ACME", result["processing_hint"])
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{"processing_hint": ""})
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Nil(result["processing_hint"])
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{
+		"processing_hint": strings.Repeat("a", 2001),
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestDeleteFile() {
+	fileID, err := s.setup.CreateTestFile("To Delete", "files/test-user-123/delete.pdf", "delete.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	// Verify it's deleted
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestCopyFile() {
+	folderID, err := s.setup.CreateTestFolder("Archive", nil)
+	s.Require().NoError(err)
+	tagID, err := s.setup.CreateTestTag("Finance")
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Report", "files/test-user-123/report.pdf", "report.pdf", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.FileService.AddTagsToFile(s.setup.TestUserID, fileID, []uint{tagID})
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/copy", fileID), map[string]interface{}{
+		"folder_id": folderID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	copied, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotEqual(float64(fileID), copied["id"])
+	s.Equal("Report", copied["title"])
+	s.Equal(float64(folderID), copied["folder_id"])
+	s.NotEqual("files/test-user-123/report.pdf", copied["s3_key"])
+	s.Len(copied["tags"], 1)
+
+	// The original stays where it was
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	original, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Nil(original["folder_id"])
+
+	for body, status := range map[string]int{
+		`{"folder_id": 0}`:     http.StatusBadRequest,
+		`{"folder_id": 99999}`: http.StatusBadRequest,
+	} {
+		var payload map[string]interface{}
+		s.Require().NoError(json.Unmarshal([]byte(body), &payload))
+		resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/copy", fileID), payload)
+		s.Require().NoError(err)
+		s.Equal(status, resp.StatusCode, body)
+	}
+
+	resp, err = s.setup.MakeRequest("POST", "/api/files/99999/copy", map[string]interface{}{})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestCopyProcessingFileProcessesCopy() {
+	fileID, err := s.setup.CreateTestFile("Scan", "files/test-user-123/scan.pdf", "scan.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, fileID, models.FileStatusProcessing, ""))
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/copy", fileID), map[string]interface{}{})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	copied, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	copyID := uint(copied["id"].(float64))
+
+	s.Eventually(func() bool {
+		file, err := s.setup.FileService.GetFileByID(s.setup.TestUserID, copyID)
+		return err == nil && file != nil && file.ProcessingStatus == models.FileStatusCompleted
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func (s *FileTestSuite) TestBatchDeleteFiles() {
+	tagID, err := s.setup.CreateTestTag("Finance")
+	s.Require().NoError(err)
+	var fileIDs []uint
+	for i := 0; i < 3; i++ {
+		fileID, err := s.setup.CreateTestFile(fmt.Sprintf("Delete %d", i), fmt.Sprintf("files/test-user-123/delete-%d.pdf", i), "delete.pdf", nil)
+		s.Require().NoError(err)
+		fileIDs = append(fileIDs, fileID)
+	}
+	_, err = s.setup.FileService.AddTagsToFile(s.setup.TestUserID, fileIDs[0], []uint{tagID})
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files/batch-delete", map[string]interface{}{
+		"file_ids": []uint{fileIDs[0], fileIDs[1], 99999},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["deleted_count"])
+	s.Equal([]interface{}{float64(99999)}, result["failed_ids"])
+
+	for i, fileID := range fileIDs {
+		resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+		s.Require().NoError(err)
+		if i < 2 {
+			s.Equal(http.StatusNotFound, resp.StatusCode)
+		} else {
+			s.Equal(http.StatusOK, resp.StatusCode)
+		}
+	}
+
+	// Another user's file is reported as failed and left in place
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/files/batch-delete", map[string]interface{}{
+		"file_ids": []uint{fileIDs[2]},
+	}, "other-user")
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["deleted_count"])
+	s.Equal([]interface{}{float64(fileIDs[2])}, result["failed_ids"])
+
+	resp, err = s.setup.MakeRequest("POST", "/api/files/batch-delete", map[string]interface{}{
+		"file_ids": []int{},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestFileRelations() {
+	invoiceID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", nil)
+	s.Require().NoError(err)
+	receiptID, err := s.setup.CreateTestFile("Receipt", "files/test-user-123/receipt.pdf", "receipt.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/relations", invoiceID), map[string]interface{}{
+		"related_file_id": receiptID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	relation, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(receiptID), relation["related_file_id"])
+	s.Equal("attachment", relation["relation_type"])
+
+	// Relating the same files again, or a file to itself, is rejected
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/relations", invoiceID), map[string]interface{}{
+		"related_file_id": receiptID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/relations", invoiceID), map[string]interface{}{
+		"related_file_id": invoiceID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// The related file is surfaced when getting the file
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	related := file["related_files"].([]interface{})
+	s.Require().Len(related, 1)
+	relatedFile := related[0].(map[string]interface{})["related_file"].(map[string]interface{})
+	s.Equal("Receipt", relatedFile["title"])
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/files/%d/relations/%d", invoiceID, receiptID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d/relations", invoiceID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Empty(result["data"])
+
+	// Both files are kept
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", receiptID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestFileRelationsHiddenOutsideShare() {
+	const memberID = "member-user-456"
+
+	sharedID, err := s.setup.CreateTestFolder("Shared", nil)
+	s.Require().NoError(err)
+	privateID, err := s.setup.CreateTestFolder("Private", nil)
+	s.Require().NoError(err)
+	invoiceID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", &sharedID)
+	s.Require().NoError(err)
+	receiptID, err := s.setup.CreateTestFile("Receipt", "files/test-user-123/receipt.pdf", "receipt.pdf", &sharedID)
+	s.Require().NoError(err)
+	contractID, err := s.setup.CreateTestFile("Contract", "files/test-user-123/contract.pdf", "contract.pdf", &privateID)
+	s.Require().NoError(err)
+
+	for _, relatedID := range []uint{receiptID, contractID} {
+		resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/relations", invoiceID), map[string]interface{}{
+			"related_file_id": relatedID,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", sharedID), map[string]interface{}{
+		"user_id": memberID,
+		"role":    "read",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	// Members only see related files they can read themselves
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files/%d", invoiceID), nil, memberID)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	related := file["related_files"].([]interface{})
+	s.Require().Len(related, 1)
+	s.Equal(float64(receiptID), related[0].(map[string]interface{})["related_file_id"])
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files/%d/relations", invoiceID), nil, memberID)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 1)
+
+	// The owner still sees both
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d/relations", invoiceID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 2)
+}
+
+func (s *FileTestSuite) TestDeleteFileCascadeRelations() {
+	invoiceID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", nil)
+	s.Require().NoError(err)
+	receiptID, err := s.setup.CreateTestFile("Receipt", "files/test-user-123/receipt.pdf", "receipt.pdf", nil)
+	s.Require().NoError(err)
+	contractID, err := s.setup.CreateTestFile("Contract", "files/test-user-123/contract.pdf", "contract.pdf", nil)
+	s.Require().NoError(err)
+	otherID, err := s.setup.CreateTestFile("Other Invoice", "files/test-user-123/other.pdf", "other.pdf", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.FileService.AddFileRelation(s.setup.TestUserID, invoiceID, receiptID, "")
+	s.Require().NoError(err)
+	_, err = s.setup.FileService.AddFileRelation(s.setup.TestUserID, invoiceID, contractID, "")
+	s.Require().NoError(err)
+	_, err = s.setup.FileService.AddFileRelation(s.setup.TestUserID, otherID, contractID, "")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/files/%d?cascade_relations=true", invoiceID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", receiptID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	// An attachment another file still relates to is kept
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", contractID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestListFileChanges() {
+	since := time.Now().Add(-time.Second).UTC().Format(time.RFC3339Nano)
+
+	var fileIDs []uint
+	for i := 0; i < 3; i++ {
+		fileID, err := s.setup.CreateTestFile(fmt.Sprintf("Change %d", i), fmt.Sprintf("files/test-user-123/change-%d.pdf", i), "change.pdf", nil)
+		s.Require().NoError(err)
+		fileIDs = append(fileIDs, fileID)
+	}
+
+	changes := func(query string) ([]interface{}, string, bool) {
+		resp, err := s.setup.MakeRequest("GET", "/api/files/changes?"+query, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return result["data"].([]interface{}), result["cursor"].(string), result["has_more"].(bool)
+	}
+	changeFileID := func(change interface{}) uint {
+		return uint(change.(map[string]interface{})["file"].(map[string]interface{})["id"].(float64))
+	}
+
+	data, cursor, hasMore := changes("limit=2&since=" + url.QueryEscape(since))
+	s.Require().Len(data, 2)
+	s.True(hasMore)
+	s.Equal(fileIDs[0], changeFileID(data[0]))
+	s.Equal(fileIDs[1], changeFileID(data[1]))
+
+	data, cursor, hasMore = changes("limit=2&cursor=" + cursor)
+	s.Require().Len(data, 1)
+	s.False(hasMore)
+	s.Equal(fileIDs[2], changeFileID(data[0]))
+
+	// Nothing new since the last cursor
+	data, _, _ = changes("cursor=" + cursor)
+	s.Empty(data)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/files/%d", fileIDs[0]), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	data, _, _ = changes("cursor=" + cursor)
+	s.Require().Len(data, 1)
+	s.Equal(fileIDs[0], changeFileID(data[0]))
+	s.Equal(true, data[0].(map[string]interface{})["deleted"])
+
+	// Other users' changes aren't included
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", "/api/files/changes?since="+url.QueryEscape(since), nil, "other-user")
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Empty(result["data"])
+}
+
+func (s *FileTestSuite) TestListFileChangesValidation() {
+	resp, err := s.setup.MakeRequest("GET", "/api/files/changes", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/changes?cursor=not-a-cursor", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files/changes?since=yesterday", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestClearFileEmbedding() {
+	fileID, err := s.setup.CreateTestFile("Report", "files/test-user-123/report.pdf", "report.pdf", nil)
+	s.Require().NoError(err)
+
+	process := func() string {
+		resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d/process-stream", fileID), nil)
+		s.Require().NoError(err)
+		body, err := io.ReadAll(resp.Body)
+		s.Require().NoError(err)
+		return string(body)
+	}
+	hasEmbedding := func() interface{} {
+		resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+		s.Require().NoError(err)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return result["has_embedding"]
+	}
+
+	process()
+	s.Equal(true, hasEmbedding())
+	s.Contains(process(), "Embedding unchanged, skipped")
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/embedding/clear", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(false, result["has_embedding"])
+	s.Equal("Report", result["title"])
+
+	// The next run embeds from scratch
+	s.NotContains(process(), "Embedding unchanged, skipped")
+	s.Equal(true, hasEmbedding())
+
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", fmt.Sprintf("/api/files/%d/embedding/clear", fileID), nil, "other-user")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestMoveFiles() {
+	// Create folder and files
+	folderID, err := s.setup.CreateTestFolder("Target", nil)
+	s.Require().NoError(err)
+	fileID1, err := s.setup.CreateTestFile("File1", "files/test-user-123/file1.pdf", "file1.pdf", nil)
+	s.Require().NoError(err)
+	fileID2, err := s.setup.CreateTestFile("File2", "files/test-user-123/file2.pdf", "file2.pdf", nil)
+	s.Require().NoError(err)
+
+	move := map[string]interface{}{
+		"file_ids":  []int{int(fileID1), int(fileID2)},
+		"folder_id": folderID,
+	}
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files/move", move)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal(float64(2), result["moved_count"])
+
+	// Verify files are in the folder
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID1), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	fileResult, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(folderID), fileResult["folder_id"])
 }
 
-func (s *FileTestSuite) TestGetFile() {
-	fileID, err := s.setup.CreateTestFile("Test File", "files/test-user-123/test.pdf", "test.pdf", nil)
+func (s *FileTestSuite) TestMoveFilesByFilter() {
+	archiveID, err := s.setup.CreateTestFolder("Archive", nil)
+	s.Require().NoError(err)
+
+	var invoiceIDs []uint
+	for i := 0; i < 2; i++ {
+		resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+			"title":             fmt.Sprintf("Invoice %d", i),
+			"s3_key":            fmt.Sprintf("files/test-user-123/invoice-%d.pdf", i),
+			"original_filename": "invoice.pdf",
+			"file_type":         "invoice",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		invoiceIDs = append(invoiceIDs, uint(result["id"].(float64)))
+	}
+	documentID, err := s.setup.CreateTestFile("Notes", "files/test-user-123/notes.pdf", "notes.pdf", nil)
 	s.Require().NoError(err)
 
-	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	resp, err := s.setup.MakeRequest("POST", "/api/files/move-by-filter", map[string]interface{}{
+		"filter":           map[string]interface{}{"file_types": []string{"invoice"}},
+		"target_folder_id": archiveID,
+	})
 	s.Require().NoError(err)
 	s.Equal(http.StatusOK, resp.StatusCode)
 
 	result, err := s.setup.ReadResponseBody(resp)
 	s.Require().NoError(err)
+	s.Equal(float64(2), result["moved_count"])
+	s.Equal(float64(0), result["unchanged_count"])
+
+	for _, id := range invoiceIDs {
+		resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", id), nil)
+		s.Require().NoError(err)
+		file, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		s.Equal(float64(archiveID), file["folder_id"])
+	}
 
-	s.Equal("Test File", result["title"])
-	s.Equal(float64(fileID), result["id"])
-}
-
-func (s *FileTestSuite) TestGetFileNotFound() {
-	resp, err := s.setup.MakeRequest("GET", "/api/files/99999", nil)
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", documentID), nil)
 	s.Require().NoError(err)
-	s.Equal(http.StatusNotFound, resp.StatusCode)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Nil(file["folder_id"], "non-matching file stays put")
 }
 
-func (s *FileTestSuite) TestUpdateFile() {
-	fileID, err := s.setup.CreateTestFile("Original Title", "files/test-user-123/test.pdf", "test.pdf", nil)
+func (s *FileTestSuite) TestReclassifyFiles() {
+	db := s.setup.DBService.GetDB()
+	folderID, err := s.setup.CreateTestFolder("Bills", nil)
 	s.Require().NoError(err)
 
-	update := map[string]interface{}{
-		"title":     "Updated Title",
-		"summary":   "File summary",
-		"file_type": "invoice",
+	setContent := func(fileID uint, fileType models.FileType, content string) {
+		s.Require().NoError(db.Model(&models.File{}).Where("id = ?", fileID).Updates(map[string]interface{}{
+			"file_type":             fileType,
+			"file_type_set_by_user": false,
+			"content":               content,
+		}).Error)
 	}
-
-	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), update)
+	invoiceText := "Invoice #1042\nBill to: Acme\nBalance due: $40.00"
+	inFolder, err := s.setup.CreateTestFile("March bill", "files/test-user-123/march.pdf", "march.pdf", &folderID)
 	s.Require().NoError(err)
-	s.Equal(http.StatusOK, resp.StatusCode)
+	setContent(inFolder, models.FileTypeDocument, invoiceText)
+	atRoot, err := s.setup.CreateTestFile("April bill", "files/test-user-123/april.pdf", "april.pdf", nil)
+	s.Require().NoError(err)
+	setContent(atRoot, models.FileTypeDocument, invoiceText)
 
+	// A filter narrows the files that are checked
+	resp, err := s.setup.MakeRequest("POST", "/api/files/reclassify", map[string]interface{}{
+		"filter": map[string]interface{}{"folder_id": folderID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
 	result, err := s.setup.ReadResponseBody(resp)
 	s.Require().NoError(err)
+	s.Equal(float64(1), result["changed_count"])
+	s.Equal([]interface{}{float64(inFolder)}, result["changed_file_ids"])
+	s.Equal(map[string]interface{}{"invoice": float64(1)}, result["changed_by_type"])
 
-	s.Equal("Updated Title", result["title"])
-	s.Equal("File summary", result["summary"])
-	s.Equal("invoice", result["file_type"])
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", inFolder), nil)
+	s.Require().NoError(err)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("invoice", file["file_type"])
+
+	// Without a filter every file is checked
+	resp, err = s.setup.MakeRequest("POST", "/api/files/reclassify", map[string]interface{}{})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), result["changed_count"])
+	s.Equal(float64(1), result["unchanged_count"])
+	s.Equal([]interface{}{float64(atRoot)}, result["changed_file_ids"])
+
+	// A type the user chose is left alone
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/files/%d", atRoot), map[string]interface{}{"file_type": "document"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	resp, err = s.setup.MakeRequest("POST", "/api/files/reclassify", map[string]interface{}{})
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["changed_count"])
+	s.Equal(float64(1), result["unchanged_count"])
+
+	resp, err = s.setup.MakeRequest("POST", "/api/files/reclassify", map[string]interface{}{
+		"filter": map[string]interface{}{"file_types": []string{"spreadsheet"}},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
 }
 
-func (s *FileTestSuite) TestDeleteFile() {
-	fileID, err := s.setup.CreateTestFile("To Delete", "files/test-user-123/delete.pdf", "delete.pdf", nil)
+func (s *FileTestSuite) TestMoveFilesByFilterValidation() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files/move-by-filter", map[string]interface{}{
+		"filter":           map[string]interface{}{"file_types": []string{"spreadsheet"}},
+		"target_folder_id": 0,
+	})
 	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
 
-	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/files/%d", fileID), nil)
+	result, err := s.setup.ReadResponseBody(resp)
 	s.Require().NoError(err)
-	s.Equal(http.StatusNoContent, resp.StatusCode)
+	s.Len(result["errors"], 2)
 
-	// Verify it's deleted
-	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	// Unknown target folder
+	resp, err = s.setup.MakeRequest("POST", "/api/files/move-by-filter", map[string]interface{}{
+		"filter":           map[string]interface{}{"all_folders": true},
+		"target_folder_id": 99999,
+	})
 	s.Require().NoError(err)
-	s.Equal(http.StatusNotFound, resp.StatusCode)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
 }
 
-func (s *FileTestSuite) TestMoveFiles() {
-	// Create folder and files
+func (s *FileTestSuite) TestMoveFilesReportsUnchanged() {
 	folderID, err := s.setup.CreateTestFolder("Target", nil)
 	s.Require().NoError(err)
-	fileID1, err := s.setup.CreateTestFile("File1", "files/test-user-123/file1.pdf", "file1.pdf", nil)
+	alreadyThere, err := s.setup.CreateTestFile("Already There", "files/test-user-123/there.pdf", "there.pdf", &folderID)
 	s.Require().NoError(err)
-	fileID2, err := s.setup.CreateTestFile("File2", "files/test-user-123/file2.pdf", "file2.pdf", nil)
+	atRoot, err := s.setup.CreateTestFile("At Root", "files/test-user-123/root.pdf", "root.pdf", nil)
 	s.Require().NoError(err)
 
-	move := map[string]interface{}{
-		"file_ids":  []int{int(fileID1), int(fileID2)},
+	resp, err := s.setup.MakeRequest("POST", "/api/files/move", map[string]interface{}{
+		"file_ids":  []uint{alreadyThere, atRoot},
 		"folder_id": folderID,
-	}
-
-	resp, err := s.setup.MakeRequest("POST", "/api/files/move", move)
+	})
 	s.Require().NoError(err)
 	s.Equal(http.StatusOK, resp.StatusCode)
 
 	result, err := s.setup.ReadResponseBody(resp)
 	s.Require().NoError(err)
 
-	s.Equal(float64(2), result["moved_count"])
-
-	// Verify files are in the folder
-	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID1), nil)
-	s.Require().NoError(err)
-	s.Equal(http.StatusOK, resp.StatusCode)
-
-	fileResult, err := s.setup.ReadResponseBody(resp)
-	s.Require().NoError(err)
-	s.Equal(float64(folderID), fileResult["folder_id"])
+	s.Equal(float64(1), result["moved_count"])
+	s.Equal(float64(1), result["unchanged_count"])
+	s.Equal([]interface{}{float64(alreadyThere)}, result["unchanged_ids"])
 }
 
 func (s *FileTestSuite) TestAddTagsToFile() {
@@ -274,8 +1414,41 @@ func (s *FileTestSuite) TestAddTagsToFile() {
 	result, err := s.setup.ReadResponseBody(resp)
 	s.Require().NoError(err)
 
-	tags := result["tags"].([]interface{})
+	file := result["file"].(map[string]interface{})
+	tags := file["tags"].([]interface{})
 	s.Len(tags, 1)
+	s.Equal([]interface{}{float64(tagID)}, result["added_tag_ids"])
+	s.Empty(result["already_present_tag_ids"])
+}
+
+func (s *FileTestSuite) TestAddTagsToFileIdempotent() {
+	fileID, err := s.setup.CreateTestFile("Tagged File", "files/test-user-123/tagged.pdf", "tagged.pdf", nil)
+	s.Require().NoError(err)
+	firstTagID, err := s.setup.CreateTestTag("First")
+	s.Require().NoError(err)
+	secondTagID, err := s.setup.CreateTestTag("Second")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+		"tag_ids": []int{int(firstTagID)},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+		"tag_ids": []int{int(firstTagID), int(secondTagID)},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal([]interface{}{float64(secondTagID)}, result["added_tag_ids"])
+	s.Equal([]interface{}{float64(firstTagID)}, result["already_present_tag_ids"])
+
+	file := result["file"].(map[string]interface{})
+	s.Len(file["tags"].([]interface{}), 2)
 }
 
 func (s *FileTestSuite) TestRemoveTagsFromFile() {
@@ -326,6 +1499,34 @@ func (s *FileTestSuite) TestGetFileDownloadURL() {
 	s.Equal("files/test-user-123/download.pdf", result["key"])
 }
 
+func (s *FileTestSuite) TestListFilesIDsOnly() {
+	folderID, err := s.setup.CreateTestFolder("Synced", nil)
+	s.Require().NoError(err)
+	firstID, err := s.setup.CreateTestFile("First", "files/test-user-123/first.pdf", "first.pdf", &folderID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Second", "files/test-user-123/second.pdf", "second.pdf", &folderID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files?folder_id=%d&ids_only=true&sort_by=title&sort_order=asc&limit=1", folderID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Empty(result["data"])
+	s.Equal([]interface{}{float64(firstID)}, result["ids"])
+	s.Equal(float64(2), result["total"])
+
+	// Without ids_only the full records are returned and ids is omitted
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files?folder_id=%d", folderID), nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 2)
+	s.NotContains(result, "ids")
+}
+
 func (s *FileTestSuite) TestProcessFile() {
 	fileID, err := s.setup.CreateTestFile("Process Test", "files/test-user-123/process.pdf", "process.pdf", nil)
 	s.Require().NoError(err)
@@ -342,6 +1543,312 @@ func (s *FileTestSuite) TestProcessFile() {
 	s.Equal("processing", result["status"])
 }
 
+func (s *FileTestSuite) TestProcessFileWait() {
+	fileID, err := s.setup.CreateTestFile("Wait Test", "files/test-user-123/wait.pdf", "wait.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/process?wait=true&wait_timeout=10", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(fileID), result["id"])
+	s.Equal("completed", result["processing_status"])
+	s.NotEmpty(result["summary"])
+	s.Equal(true, result["has_embedding"])
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/process?wait=true&wait_timeout=301", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestProcessFileCorrectsMimeType() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             "Scan",
+		"s3_key":            "files/test-user-123/scan.png",
+		"original_filename": "scan",
+		"mime_type":         "application/octet-stream",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("document", result["file_type"])
+	fileID := uint(result["id"].(float64))
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/process?wait=true&wait_timeout=10", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("image/png", result["mime_type"])
+	s.Equal("application/octet-stream", result["declared_mime_type"])
+	s.Equal("image/png", result["detected_mime_type"])
+	s.Equal("photo", result["file_type"])
+}
+
+func (s *FileTestSuite) TestProcessFileNeedsReview() {
+	fileID, err := s.setup.CreateTestFile("Blank Scan", "files/test-user-123/blank-scan.pdf", "blank-scan.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/process?wait=true&wait_timeout=10", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("needs_review", result["processing_status"])
+	s.Equal("content_too_short", result["processing_error_code"])
+	s.Equal("~ .", result["content"])
+	s.Empty(result["summary"])
+	s.Equal(false, result["has_embedding"])
+
+	// Files needing review can be retried
+	resp, err = s.setup.MakeRequest("POST", "/api/files/process/retry", map[string]interface{}{
+		"file_ids": []uint{fileID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), result["transitioned"])
+}
+
+func (s *FileTestSuite) TestProcessFileExtractsEntities() {
+	fileID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/ACME-2024-03-01.pdf", "ACME-2024-03-01.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/process?wait=true&wait_timeout=10", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	entities := result["entities"].(map[string]interface{})
+	s.Contains(entities["organizations"], "ACME")
+	s.Contains(entities["dates"], "2024-03-01")
+
+	listIDs := func(query string) []interface{} {
+		resp, err := s.setup.MakeRequest("GET", "/api/files?ids_only=true&"+query, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode, query)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return result["ids"].([]interface{})
+	}
+	s.Equal([]interface{}{float64(fileID)}, listIDs("entity=acme&entity_type=organizations&entity_date_from=2024-01-01"))
+	s.Empty(listIDs("entity=acme&entity_type=people"))
+	s.Empty(listIDs("entity_date_from=2025-01-01"))
+
+	resp, err = s.setup.MakeRequest("GET", "/api/files?entity_type=places&entity_date_to=2024", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["errors"], 2)
+}
+
+func (s *FileTestSuite) TestCancelFilesProcessing() {
+	processingID, err := s.setup.CreateTestFile("Processing", "files/test-user-123/processing.pdf", "processing.pdf", nil)
+	s.Require().NoError(err)
+	pendingID, err := s.setup.CreateTestFile("Pending", "files/test-user-123/pending.pdf", "pending.pdf", nil)
+	s.Require().NoError(err)
+
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Exec("UPDATE files SET processing_status = ? WHERE id = ?", "processing", processingID).Error)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files/process/cancel", map[string]interface{}{
+		"file_ids": []uint{processingID, pendingID, processingID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["requested"])
+	s.Equal(float64(1), result["transitioned"])
+	s.Equal(float64(1), result["skipped"])
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", processingID), nil)
+	s.Require().NoError(err)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("failed", file["processing_status"])
+	s.Equal("canceled", file["processing_error_code"])
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", pendingID), nil)
+	s.Require().NoError(err)
+	file, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("pending", file["processing_status"])
+}
+
+func (s *FileTestSuite) TestRetryFilesProcessingSkipsFilesNotFailed() {
+	fileID, err := s.setup.CreateTestFile("Pending", "files/test-user-123/retry.pdf", "retry.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files/process/retry", map[string]interface{}{
+		"file_ids": []uint{fileID, 99999},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["requested"])
+	s.Equal(float64(0), result["transitioned"])
+	s.Equal(float64(2), result["skipped"])
+}
+
+func (s *FileTestSuite) TestRetryFilesProcessingRequiresFileIDs() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files/process/retry", map[string]interface{}{
+		"file_ids": []uint{},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FileTestSuite) TestGetFileContentText() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":              "Scanned Letter",
+		"s3_key":             "files/test-user-123/letter.pdf",
+		"original_filename":  "letter.pdf",
+		"content":            "Dear customer,\nyour order has shipped.",
+		"generate_embedding": false,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	created, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%v/content.txt", created["id"]), nil)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("text/plain; charset=utf-8", resp.Header.Get("Content-Type"))
+	s.Equal("attachment; filename=letter.txt", resp.Header.Get("Content-Disposition"))
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.Equal("Dear customer,\nyour order has shipped.", string(body))
+}
+
+func (s *FileTestSuite) TestMaskedFields() {
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":              "Medical Record",
+		"s3_key":             "files/test-user-123/record.pdf",
+		"original_filename":  "record.pdf",
+		"content":            "Patient history and diagnosis.",
+		"generate_embedding": false,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	created, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	fileID := uint(created["id"].(float64))
+	s.Require().NoError(s.setup.DBService.GetDB().Model(&models.File{}).Where("id = ?", fileID).
+		Update("summary", "A patient record").Error)
+
+	maskedRequest := func(path, masked string) *http.Response {
+		req := httptest.NewRequest("GET", path, nil)
+		req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+		req.Header.Set("X-Test-Masked-Fields", masked)
+		resp, err := s.setup.App.Test(req, -1)
+		s.Require().NoError(err)
+		return resp
+	}
+
+	resp = maskedRequest(fmt.Sprintf("/api/files/%d", fileID), "content")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	file, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(file, "content")
+	s.Equal("A patient record", file["summary"])
+
+	resp = maskedRequest("/api/files", "content,summary")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	item := list["data"].([]interface{})[0].(map[string]interface{})
+	s.Equal("Medical Record", item["title"])
+	s.NotContains(item, "content")
+	s.NotContains(item, "summary")
+
+	resp = maskedRequest("/api/search?q=diagnosis&type=fulltext", "content")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	search, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	results := search["data"].([]interface{})
+	s.Require().Len(results, 1)
+	s.NotContains(results[0].(map[string]interface{}), "snippet")
+
+	resp = maskedRequest(fmt.Sprintf("/api/files/%d/content.txt", fileID), "content")
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+
+	// Unmasked tokens still see everything
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	file, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Patient history and diagnosis.", file["content"])
+}
+
+func (s *FileTestSuite) TestMaskedContentBlocksDownloads() {
+	folderID, err := s.setup.CreateTestFolder("Records", nil)
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Medical Record", "files/test-user-123/record.pdf", "record.pdf", &folderID)
+	s.Require().NoError(err)
+	file, err := s.setup.FileService.GetFileByID(s.setup.TestUserID, fileID)
+	s.Require().NoError(err)
+
+	request := func(method, path string, body interface{}, masked string) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			payload, err := json.Marshal(body)
+			s.Require().NoError(err)
+			reader = strings.NewReader(string(payload))
+		}
+		req := httptest.NewRequest(method, path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+		if masked != "" {
+			req.Header.Set("X-Test-Masked-Fields", masked)
+		}
+		resp, err := s.setup.App.Test(req, -1)
+		s.Require().NoError(err)
+		return resp
+	}
+
+	downloads := []struct {
+		method, path string
+		body         interface{}
+	}{
+		{"GET", fmt.Sprintf("/api/files/%d/download", fileID), nil},
+		{"GET", fmt.Sprintf("/api/files/public/%s/download", file.PublicID), nil},
+		{"POST", "/api/files/batch-download", map[string]interface{}{"file_ids": []uint{fileID}}},
+		{"GET", fmt.Sprintf("/api/folders/%d/download", folderID), nil},
+	}
+	for _, download := range downloads {
+		resp := request(download.method, download.path, download.body, "content")
+		s.Equal(http.StatusForbidden, resp.StatusCode, download.path)
+
+		// Masking only the summary still allows downloads
+		resp = request(download.method, download.path, download.body, "summary")
+		s.Equal(http.StatusOK, resp.StatusCode, download.path)
+	}
+}
+
+func (s *FileTestSuite) TestGetFileContentTextNotProcessed() {
+	fileID, err := s.setup.CreateTestFile("Pending", "files/test-user-123/pending.pdf", "pending.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d/content.txt", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
 func (s *FileTestSuite) TestUnlinkFileInvoice() {
 	// Create a file with invoice_id set
 	fileID, err := s.setup.CreateTestFile("Invoice Document", "files/test-user-123/invoice.pdf", "invoice.pdf", nil)
@@ -375,6 +1882,29 @@ func (s *FileTestSuite) TestUnlinkFileInvoice() {
 	s.Nil(result["invoice_id"])
 }
 
+func (s *FileTestSuite) TestUnlinkFileInvoiceRemoveRelations() {
+	fileID, err := s.setup.CreateTestFile("Invoice Document", "files/test-user-123/invoice.pdf", "invoice.pdf", nil)
+	s.Require().NoError(err)
+	receiptID, err := s.setup.CreateTestFile("Receipt", "files/test-user-123/receipt.pdf", "receipt.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileInvoiceID(s.setup.TestUserID, fileID, 777))
+	_, err = s.setup.FileService.AddFileRelation(s.setup.TestUserID, fileID, receiptID, "")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", "/api/files/invoice?invoice_id=777&remove_relations=true", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	relations, err := s.setup.FileService.ListFileRelations(s.setup.TestUserID, fileID)
+	s.Require().NoError(err)
+	s.Empty(relations)
+
+	// The attachment itself is kept
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", receiptID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
 func (s *FileTestSuite) TestUnlinkFileInvoiceNotFound() {
 	// Try to unlink invoice with non-existent invoice_id
 	resp, err := s.setup.MakeRequest("DELETE", "/api/files/invoice?invoice_id=99999", nil)
@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -97,6 +98,24 @@ func (s *FolderTestSuite) TestListFolders() {
 	s.Equal(float64(2), result["total"])
 }
 
+func (s *FolderTestSuite) TestListFoldersIDsOnly() {
+	alphaID, err := s.setup.CreateTestFolder("Alpha", nil)
+	s.Require().NoError(err)
+	betaID, err := s.setup.CreateTestFolder("Beta", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/folders?ids_only=true", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Empty(result["data"])
+	s.Equal([]interface{}{float64(alphaID), float64(betaID)}, result["ids"])
+	s.Equal(float64(2), result["total"])
+}
+
 func (s *FolderTestSuite) TestListFoldersWithParent() {
 	// Create parent and child folders
 	parentID, err := s.setup.CreateTestFolder("Parent", nil)
@@ -118,6 +137,51 @@ func (s *FolderTestSuite) TestListFoldersWithParent() {
 	s.Len(data, 2)
 }
 
+func (s *FolderTestSuite) TestFolderChildCount() {
+	parentID, err := s.setup.CreateTestFolder("Parent", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Leaf", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Child A", &parentID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Child B", &parentID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Grandchild", &childID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/folders", nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	counts := map[string]float64{}
+	for _, item := range result["data"].([]interface{}) {
+		folder := item.(map[string]interface{})
+		counts[folder["name"].(string)] = folder["child_count"].(float64)
+	}
+	s.Equal(map[string]float64{"Parent": 2, "Leaf": 0}, counts)
+
+	// Contents report the count for each subfolder
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/contents", parentID), nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	counts = map[string]float64{}
+	for _, item := range result["folders"].([]interface{}) {
+		folder := item.(map[string]interface{})
+		counts[folder["name"].(string)] = folder["child_count"].(float64)
+	}
+	s.Equal(map[string]float64{"Child A": 1, "Child B": 0}, counts)
+
+	// Getting the folder counts its children without loading them
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d", parentID), nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["child_count"])
+	s.NotContains(result, "children")
+}
+
 func (s *FolderTestSuite) TestGetFolder() {
 	folderID, err := s.setup.CreateTestFolder("Test Folder", nil)
 	s.Require().NoError(err)
@@ -173,6 +237,294 @@ func (s *FolderTestSuite) TestDeleteFolder() {
 	s.Equal(http.StatusNotFound, resp.StatusCode)
 }
 
+func (s *FolderTestSuite) TestRestoreFolder() {
+	rootID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("2024", &rootID)
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Report", "files/test-user-123/report.pdf", "report.pdf", &childID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/restore", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Projects", result["name"])
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d", childID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// Restoring a folder that isn't deleted is rejected
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/restore", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestRestoreFolderNotFound() {
+	resp, err := s.setup.MakeRequest("POST", "/api/folders/99999/restore", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestFolderDeletePreview() {
+	rootID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("2024", &rootID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Q1", &childID)
+	s.Require().NoError(err)
+
+	for i, folderID := range []uint{rootID, childID} {
+		resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+			"title":             fmt.Sprintf("File %d", i),
+			"s3_key":            fmt.Sprintf("files/test-user-123/preview-%d.pdf", i),
+			"original_filename": "preview.pdf",
+			"size":              1000,
+			"folder_id":         folderID,
+		})
+		s.Require().NoError(err)
+		s.Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/delete-preview", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal(float64(rootID), result["folder_id"])
+	s.Equal(float64(2), result["subfolder_count"])
+	s.Equal(float64(2), result["file_count"])
+	s.Equal(float64(2000), result["total_size"])
+
+	// Preview must not delete anything
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestFolderDeletePreviewNotFound() {
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/99999/delete-preview", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestGetFolderContents() {
+	rootID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("2023", &rootID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("2024", &rootID)
+	s.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err = s.setup.CreateTestFile(fmt.Sprintf("File %d", i), fmt.Sprintf("files/test-user-123/contents-%d.pdf", i), "contents.pdf", &rootID)
+		s.Require().NoError(err)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/contents", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["folders"], 2)
+	s.Len(result["files"], 3)
+	s.Equal(float64(2), result["total_folders"])
+	s.Equal(float64(3), result["total_files"])
+}
+
+func (s *FolderTestSuite) TestGetFolderContentsPagination() {
+	rootID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("2023", &rootID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("2024", &rootID)
+	s.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err = s.setup.CreateTestFile(fmt.Sprintf("File %d", i), fmt.Sprintf("files/test-user-123/contents-%d.pdf", i), "contents.pdf", &rootID)
+		s.Require().NoError(err)
+	}
+
+	// First page holds only folders
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/contents?limit=2", rootID), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["folders"], 2)
+	s.Len(result["files"], 0)
+	s.Equal(float64(3), result["total_files"])
+
+	// Second page continues with files
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/contents?limit=2&offset=2", rootID), nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["folders"], 0)
+	s.Len(result["files"], 2)
+
+	// A page spanning both
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/contents?limit=2&offset=1", rootID), nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["folders"], 1)
+	s.Len(result["files"], 1)
+}
+
+func (s *FolderTestSuite) TestGetFolderContentsNotFound() {
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/99999/contents", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestFolderDeletePreviewExcludeFolders() {
+	rootID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	archiveID, err := s.setup.CreateTestFolder("Archive", &rootID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Old", &archiveID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Current", "files/test-user-123/current.pdf", "current.pdf", &rootID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Archived", "files/test-user-123/archived.pdf", "archived.pdf", &archiveID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/delete-preview?exclude_folder_ids=%d", rootID, archiveID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["subfolder_count"])
+	s.Equal(float64(1), result["file_count"])
+}
+
+func (s *FolderTestSuite) TestDeleteFolderExcludeFolders() {
+	parentID, err := s.setup.CreateTestFolder("Work", nil)
+	s.Require().NoError(err)
+	rootID, err := s.setup.CreateTestFolder("Projects", &parentID)
+	s.Require().NoError(err)
+	archiveID, err := s.setup.CreateTestFolder("Archive", &rootID)
+	s.Require().NoError(err)
+	currentFileID, err := s.setup.CreateTestFile("Current", "files/test-user-123/current.pdf", "current.pdf", &rootID)
+	s.Require().NoError(err)
+	archivedFileID, err := s.setup.CreateTestFile("Archived", "files/test-user-123/archived.pdf", "archived.pdf", &archiveID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d?exclude_folder_ids=%d", rootID, archiveID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	// The excluded folder survives and moves up to the deleted folder's parent
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d", archiveID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(parentID), result["parent_id"])
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", archivedFileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", currentFileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestDeleteFolderExcludeSelf() {
+	folderID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d?exclude_folder_ids=%d", folderID, folderID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestDownloadFolderEmpty() {
+	folderID, err := s.setup.CreateTestFolder("Empty", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/download?recursive=true", folderID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("application/zip", resp.Header.Get("Content-Type"))
+}
+
+func (s *FolderTestSuite) TestDownloadFolderNotFound() {
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/99999/download", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestDownloadFolderTooLarge() {
+	rootID, err := s.setup.CreateTestFolder("Videos", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Raw", &rootID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             "Footage",
+		"s3_key":            "files/test-user-123/footage.mov",
+		"original_filename": "footage.mov",
+		"size":              3 << 30,
+		"folder_id":         childID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/download?recursive=true", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Excluding the subfolder brings the download under the cap
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/download?recursive=true&exclude_folder_ids=%d", rootID, childID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestDownloadFolderExcludeDirect() {
+	rootID, err := s.setup.CreateTestFolder("Videos", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Edited", &rootID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/files", map[string]interface{}{
+		"title":             "Footage",
+		"s3_key":            "files/test-user-123/footage.mov",
+		"original_filename": "footage.mov",
+		"size":              3 << 30,
+		"folder_id":         rootID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/download?recursive=true", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Only the subfolders' files are downloaded, leaving out the large direct file
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/download?recursive=true&exclude_direct=true", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
 func (s *FolderTestSuite) TestMoveFolder() {
 	// Create two parent folders and a child
 	parent1ID, err := s.setup.CreateTestFolder("Parent1", nil)
@@ -217,6 +569,140 @@ func (s *FolderTestSuite) TestMoveFolderToRoot() {
 	s.Nil(result["parent_id"])
 }
 
+func (s *FolderTestSuite) resolveFolderPath(path string) (int, map[string]interface{}) {
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/resolve?path="+url.QueryEscape(path), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return resp.StatusCode, result
+}
+
+func (s *FolderTestSuite) TestMoveFolderKeepAlias() {
+	projectsID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	archiveID, err := s.setup.CreateTestFolder("Archive", nil)
+	s.Require().NoError(err)
+	reportsID, err := s.setup.CreateTestFolder("Reports", &projectsID)
+	s.Require().NoError(err)
+	q1ID, err := s.setup.CreateTestFolder("Q1", &reportsID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/move", reportsID), map[string]interface{}{
+		"parent_id":  archiveID,
+		"keep_alias": true,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// The new path, the old path and subfolders under the old path resolve
+	status, result := s.resolveFolderPath("Archive/Reports")
+	s.Equal(http.StatusOK, status)
+	s.Equal(float64(reportsID), result["id"])
+
+	status, result = s.resolveFolderPath("/Projects/Reports/")
+	s.Equal(http.StatusOK, status)
+	s.Equal(float64(reportsID), result["id"])
+
+	status, result = s.resolveFolderPath("Projects/Reports/Q1")
+	s.Equal(http.StatusOK, status)
+	s.Equal(float64(q1ID), result["id"])
+
+	// A folder created at the old path takes precedence over the alias
+	newReportsID, err := s.setup.CreateTestFolder("Reports", &projectsID)
+	s.Require().NoError(err)
+	status, result = s.resolveFolderPath("Projects/Reports")
+	s.Equal(http.StatusOK, status)
+	s.Equal(float64(newReportsID), result["id"])
+
+	status, _ = s.resolveFolderPath("Projects/Missing")
+	s.Equal(http.StatusNotFound, status)
+}
+
+func (s *FolderTestSuite) TestMoveFolderWithoutKeepAlias() {
+	projectsID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	reportsID, err := s.setup.CreateTestFolder("Reports", &projectsID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/move", reportsID), map[string]interface{}{})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	status, _ := s.resolveFolderPath("Projects/Reports")
+	s.Equal(http.StatusNotFound, status)
+}
+
+func (s *FolderTestSuite) TestRenameFolderKeepAlias() {
+	folderID, err := s.setup.CreateTestFolder("Invoices", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/folders/%d", folderID), map[string]interface{}{
+		"name":       "Billing",
+		"keep_alias": true,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	status, result := s.resolveFolderPath("Invoices")
+	s.Equal(http.StatusOK, status)
+	s.Equal(float64(folderID), result["id"])
+	s.Equal("Billing", result["name"])
+
+	// Aliases can be listed and removed
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/aliases", folderID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	aliases := result["data"].([]interface{})
+	s.Require().Len(aliases, 1)
+	alias := aliases[0].(map[string]interface{})
+	s.Equal("Invoices", alias["path"])
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d/aliases/%d", folderID, int(alias["id"].(float64))), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+	resp.Body.Close()
+
+	status, _ = s.resolveFolderPath("Invoices")
+	s.Equal(http.StatusNotFound, status)
+}
+
+func (s *FolderTestSuite) TestAddFolderAlias() {
+	folderID, err := s.setup.CreateTestFolder("Receipts", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/aliases", folderID), map[string]interface{}{
+		"path": " /Old/Receipts ",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Old/Receipts", result["path"])
+
+	status, result := s.resolveFolderPath("Old/Receipts")
+	s.Equal(http.StatusOK, status)
+	s.Equal(float64(folderID), result["id"])
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/aliases", folderID), map[string]interface{}{
+		"path": "//",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = s.setup.MakeRequest("POST", "/api/folders/99999/aliases", map[string]interface{}{
+		"path": "Old/Receipts",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+}
+
 func (s *FolderTestSuite) TestGetFolderTree() {
 	// Create a folder structure
 	parent1ID, err := s.setup.CreateTestFolder("Parent1", nil)
@@ -248,6 +734,194 @@ func (s *FolderTestSuite) TestGetFolderTree() {
 	}
 }
 
+func (s *FolderTestSuite) TestListFolderDescendants() {
+	rootID, err := s.setup.CreateTestFolder("Root", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Child", &rootID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Grandchild", &childID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/descendants", rootID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 2)
+
+	first := data[0].(map[string]interface{})
+	s.Equal(float64(1), first["depth"])
+	s.Equal("Child", first["folder"].(map[string]interface{})["name"])
+	second := data[1].(map[string]interface{})
+	s.Equal(float64(2), second["depth"])
+	s.Equal("Grandchild", second["folder"].(map[string]interface{})["name"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/folders/99999/descendants", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestExpandFolderTree() {
+	projectsID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	archiveID, err := s.setup.CreateTestFolder("Archive", nil)
+	s.Require().NoError(err)
+	webID, err := s.setup.CreateTestFolder("Web", &projectsID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Apps", &projectsID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFolder("Frontend", &webID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/folders/tree/expand", map[string]interface{}{
+		"folder_ids": []uint{projectsID, archiveID, 9999, projectsID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal([]interface{}{float64(9999)}, result["not_found_ids"])
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 2)
+
+	projects := data[0].(map[string]interface{})
+	s.Equal(float64(projectsID), projects["parent_id"])
+	children := projects["children"].([]interface{})
+	s.Require().Len(children, 2)
+	s.Equal("Apps", children[0].(map[string]interface{})["name"])
+	s.Equal(float64(0), children[0].(map[string]interface{})["child_count"])
+	s.Equal("Web", children[1].(map[string]interface{})["name"])
+	s.Equal(float64(1), children[1].(map[string]interface{})["child_count"])
+
+	archive := data[1].(map[string]interface{})
+	s.Equal(float64(archiveID), archive["parent_id"])
+	s.Equal([]interface{}{}, archive["children"])
+
+	// Other users' folders can't be expanded
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/folders/tree/expand", map[string]interface{}{
+		"folder_ids": []uint{projectsID},
+	}, "other-user")
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Empty(result["data"])
+	s.Equal([]interface{}{float64(projectsID)}, result["not_found_ids"])
+
+	// Unless the folder is shared with them
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", projectsID), map[string]interface{}{
+		"user_id": "other-user",
+		"role":    "read",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/folders/tree/expand", map[string]interface{}{
+		"folder_ids": []uint{webID},
+	}, "other-user")
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data = result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	children = data[0].(map[string]interface{})["children"].([]interface{})
+	s.Require().Len(children, 1)
+	s.Equal("Frontend", children[0].(map[string]interface{})["name"])
+
+	// The root is expanded first, with a null parent_id
+	resp, err = s.setup.MakeRequest("POST", "/api/folders/tree/expand", map[string]interface{}{
+		"folder_ids":   []uint{webID},
+		"include_root": true,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data = result["data"].([]interface{})
+	s.Require().Len(data, 2)
+	root := data[0].(map[string]interface{})
+	s.Nil(root["parent_id"])
+	children = root["children"].([]interface{})
+	s.Require().Len(children, 2)
+	s.Equal("Archive", children[0].(map[string]interface{})["name"])
+	s.Equal("Projects", children[1].(map[string]interface{})["name"])
+	s.Equal(float64(2), children[1].(map[string]interface{})["child_count"])
+	s.Equal(float64(webID), data[1].(map[string]interface{})["parent_id"])
+
+	resp, err = s.setup.MakeRequest("POST", "/api/folders/tree/expand", map[string]interface{}{
+		"folder_ids":   []int{},
+		"include_root": true,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/folders/tree/expand", map[string]interface{}{
+		"folder_ids": []int{},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestGetFolderTreeWithCountsSortedByFiles() {
+	archiveID, err := s.setup.CreateTestFolder("Archive", nil)
+	s.Require().NoError(err)
+	projectsID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	activeID, err := s.setup.CreateTestFolder("Active", &projectsID)
+	s.Require().NoError(err)
+
+	files := map[string]uint{"old.pdf": archiveID, "plan.pdf": projectsID, "spec.pdf": activeID, "notes.pdf": activeID}
+	for name, folderID := range files {
+		_, err := s.setup.CreateTestFile(name, "files/test-user-123/"+name, name, &folderID)
+		s.Require().NoError(err)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/tree?with_counts=true&sort=files_desc", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBodyArray(resp)
+	s.Require().NoError(err)
+	s.Require().Len(result, 2)
+
+	projects := result[0].(map[string]interface{})
+	s.Equal("Projects", projects["name"])
+	s.Equal(float64(1), projects["file_count"])
+	s.Equal(float64(3), projects["total_file_count"])
+
+	active := projects["children"].([]interface{})[0].(map[string]interface{})
+	s.Equal(float64(2), active["file_count"])
+	s.Equal(float64(2), active["total_file_count"])
+
+	archive := result[1].(map[string]interface{})
+	s.Equal("Archive", archive["name"])
+	s.Equal(float64(1), archive["total_file_count"])
+
+	// Counts are omitted unless requested
+	resp, err = s.setup.MakeRequest("GET", "/api/folders/tree?sort=files_asc", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBodyArray(resp)
+	s.Require().NoError(err)
+	s.Equal("Archive", result[0].(map[string]interface{})["name"])
+	s.NotContains(result[0].(map[string]interface{}), "total_file_count")
+}
+
+func (s *FolderTestSuite) TestGetFolderTreeInvalidSort() {
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/tree?sort=size", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	errs := result["errors"].([]interface{})
+	s.Require().Len(errs, 1)
+	s.Equal("sort", errs[0].(map[string]interface{})["field"])
+	s.Contains(result["error"], "sort must be one of name, files_desc, files_asc")
+}
+
 func (s *FolderTestSuite) TestAddTagsToFolder() {
 	// Create folder and tag
 	folderID, err := s.setup.CreateTestFolder("Tagged Folder", nil)
@@ -306,6 +980,373 @@ func (s *FolderTestSuite) TestRemoveTagsFromFolder() {
 	// If tags is nil, that's also acceptable (means no tags)
 }
 
+func (s *FolderTestSuite) TestListFolderTags() {
+	parentID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Archive", &parentID)
+	s.Require().NoError(err)
+	urgentID, err := s.setup.CreateTestTag("Urgent")
+	s.Require().NoError(err)
+	draftID, err := s.setup.CreateTestTag("Draft")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestTag("Unused")
+	s.Require().NoError(err)
+
+	tagFile := func(title string, folderID uint, tagIDs ...uint) {
+		fileID, err := s.setup.CreateTestFile(title, "files/"+title, title+".pdf", &folderID)
+		s.Require().NoError(err)
+		resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+			"tag_ids": tagIDs,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+	}
+	tagFile("plan", parentID, urgentID, draftID)
+	tagFile("budget", parentID, urgentID)
+	tagFile("old-plan", childID, draftID)
+	tagFile("old-budget", childID, draftID)
+
+	listTags := func(recursive bool) map[string]int {
+		resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/tags?recursive=%t", parentID, recursive), nil)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+
+		counts := map[string]int{}
+		for _, item := range result["data"].([]interface{}) {
+			entry := item.(map[string]interface{})
+			counts[entry["tag"].(map[string]interface{})["name"].(string)] = int(entry["file_count"].(float64))
+		}
+		return counts
+	}
+
+	s.Equal(map[string]int{"Urgent": 2, "Draft": 1}, listTags(false))
+	s.Equal(map[string]int{"Urgent": 2, "Draft": 3}, listTags(true))
+
+	resp, err := s.setup.MakeRequest("GET", "/api/folders/99999/tags", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) listFolderFileTitles(folderID uint, includeLinked bool) []string {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/files?folder_id=%d&include_linked=%t", folderID, includeLinked), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	var titles []string
+	for _, f := range result["data"].([]interface{}) {
+		titles = append(titles, f.(map[string]interface{})["title"].(string))
+	}
+	return titles
+}
+
+func (s *FolderTestSuite) TestFileLinks() {
+	legalID, err := s.setup.CreateTestFolder("Legal", nil)
+	s.Require().NoError(err)
+	clientID, err := s.setup.CreateTestFolder("Client ACME", nil)
+	s.Require().NoError(err)
+
+	fileID, err := s.setup.CreateTestFile("Contract", "files/test-user-123/contract.pdf", "contract.pdf", &legalID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/links", clientID), map[string]interface{}{
+		"file_ids": []uint{fileID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	// Linking again is a no-op
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/links", clientID), map[string]interface{}{
+		"file_ids": []uint{fileID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	s.Empty(s.listFolderFileTitles(clientID, false))
+	s.Equal([]string{"Contract"}, s.listFolderFileTitles(clientID, true))
+	s.Equal([]string{"Contract"}, s.listFolderFileTitles(legalID, true))
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d/links", clientID), map[string]interface{}{
+		"file_ids": []uint{fileID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	s.Empty(s.listFolderFileTitles(clientID, true))
+
+	// Unlinking keeps the file
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestFileLinksRemovedWithLinkFolder() {
+	legalID, err := s.setup.CreateTestFolder("Legal", nil)
+	s.Require().NoError(err)
+	clientID, err := s.setup.CreateTestFolder("Client ACME", nil)
+	s.Require().NoError(err)
+
+	fileID, err := s.setup.CreateTestFile("Contract", "files/test-user-123/contract.pdf", "contract.pdf", &legalID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/links", clientID), map[string]interface{}{
+		"file_ids": []uint{fileID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	// Deleting the folder holding the link must not delete the file
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d", clientID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestFileLinksFolderNotFound() {
+	resp, err := s.setup.MakeRequest("POST", "/api/folders/99999/links", map[string]interface{}{
+		"file_ids": []uint{1},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestShareFolder() {
+	const memberID = "member-user-456"
+
+	sharedID, err := s.setup.CreateTestFolder("Shared", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Child", &sharedID)
+	s.Require().NoError(err)
+	privateID, err := s.setup.CreateTestFolder("Private", nil)
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", &childID)
+	s.Require().NoError(err)
+
+	// Not shared yet
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/folders/%d", sharedID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", sharedID), map[string]interface{}{
+		"user_id": memberID,
+		"role":    "read",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/folders/%d/members", sharedID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	members := result["data"].([]interface{})
+	s.Require().Len(members, 1)
+	s.Equal(memberID, members[0].(map[string]interface{})["user_id"])
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", "/api/folders/shared", nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	shared := result["data"].([]interface{})
+	s.Require().Len(shared, 1)
+	s.Equal("read", shared[0].(map[string]interface{})["role"])
+
+	// A share covers the whole subtree, but nothing else
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/folders/%d/contents", childID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["files"], 1)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/folders/%d", privateID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	// Read-only members can't add to the folder
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/folders", map[string]interface{}{
+		"name":      "Member Folder",
+		"parent_id": childID,
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", sharedID), map[string]interface{}{
+		"user_id": memberID,
+		"role":    "write",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// Writers create content owned by the folder's owner
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/folders", map[string]interface{}{
+		"name":      "Member Folder",
+		"parent_id": childID,
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(s.setup.TestUserID, result["user_id"])
+
+	resp, err = s.setup.MakeAuthenticatedRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{
+		"title": "Renamed Invoice",
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folders/%d/members/%s", sharedID, memberID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestSharedFolderListingStaysInShare() {
+	const memberID = "member-user-456"
+
+	sharedID, err := s.setup.CreateTestFolder("Shared", nil)
+	s.Require().NoError(err)
+	privateID, err := s.setup.CreateTestFolder("Private", nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Shared Doc", "files/test-user-123/shared.pdf", "shared.pdf", &sharedID)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestFile("Private Doc", "files/test-user-123/private.pdf", "private.pdf", &privateID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", sharedID), map[string]interface{}{
+		"user_id": memberID,
+		"role":    "read",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files?folder_id=%d", sharedID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 1)
+
+	for _, query := range []string{"all_folders=true", "include_linked=true"} {
+		resp, err := s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/files?folder_id=%d&%s", sharedID, query), nil, memberID)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, query)
+	}
+
+	// The owner can still combine them
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files?folder_id=%d&all_folders=true", sharedID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 2)
+}
+
+func (s *FolderTestSuite) TestSharedFolderWriteScope() {
+	const memberID = "member-user-456"
+
+	sharedID, err := s.setup.CreateTestFolder("Shared", nil)
+	s.Require().NoError(err)
+	childID, err := s.setup.CreateTestFolder("Child", &sharedID)
+	s.Require().NoError(err)
+	privateID, err := s.setup.CreateTestFolder("Private", nil)
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/invoice.pdf", "invoice.pdf", &childID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", sharedID), map[string]interface{}{
+		"user_id": memberID,
+		"role":    "write",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	// Writers can move files within the share, but not out of it
+	resp, err = s.setup.MakeAuthenticatedRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{
+		"folder_id": privateID,
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("PUT", fmt.Sprintf("/api/files/%d", fileID), map[string]interface{}{
+		"folder_id": sharedID,
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(sharedID), result["folder_id"])
+
+	// Deleting and moving in bulk stay owner-only
+	resp, err = s.setup.MakeAuthenticatedRequest("DELETE", fmt.Sprintf("/api/files/%d", fileID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/files/move", map[string]interface{}{
+		"file_ids":  []uint{fileID},
+		"folder_id": childID,
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("PUT", fmt.Sprintf("/api/folders/%d", childID), map[string]interface{}{
+		"name": "Renamed",
+	}, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = s.setup.MakeAuthenticatedRequest("DELETE", fmt.Sprintf("/api/folders/%d", childID), nil, memberID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *FolderTestSuite) TestShareFolderValidation() {
+	folderID, err := s.setup.CreateTestFolder("Shared", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", folderID), map[string]interface{}{
+		"user_id": "member-user-456",
+		"role":    "admin",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/members", folderID), map[string]interface{}{
+		"user_id": s.setup.TestUserID,
+		"role":    "read",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Only the owner can share
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", fmt.Sprintf("/api/folders/%d/members", folderID), map[string]interface{}{
+		"user_id": "member-user-789",
+		"role":    "read",
+	}, "member-user-456")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
 func TestFolderSuite(t *testing.T) {
 	suite.Run(t, new(FolderTestSuite))
 }
@@ -1,13 +1,18 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -100,3 +105,156 @@ func NewTestSetupWithAuthToken(t *testing.T) *TestSetup {
 	// Note: The test auth middleware is updated to also set raw auth token
 	return setup
 }
+
+// TestProcessStreamEncryptedFile verifies password-protected files fail before
+// parsing with a distinct error code
+func TestProcessStreamEncryptedFile(t *testing.T) {
+	setup := NewTestSetup(t)
+	defer setup.Cleanup()
+
+	// The mock parser treats URLs containing "encrypted" as password-protected
+	fileID, err := setup.CreateTestFile("locked.pdf", "files/test-user-123/encrypted.pdf", "locked.pdf", nil)
+	require.NoError(t, err)
+
+	resp, err := setup.MakeRequest("GET", "/api/files/"+uintToStringHelper(fileID)+"/process-stream", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"code":"file_encrypted"`)
+
+	resp, err = setup.MakeRequest("GET", "/api/files/"+uintToStringHelper(fileID), nil)
+	require.NoError(t, err)
+	result, err := setup.ReadResponseBody(resp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "failed", result["processing_status"])
+	assert.Equal(t, "file_encrypted", result["processing_error_code"])
+	assert.Equal(t, "file is password-protected", result["processing_error"])
+}
+
+// TestProcessStreamNDJSON verifies format=ndjson emits the same events as one
+// JSON object per line without SSE framing
+func TestProcessStreamNDJSON(t *testing.T) {
+	setup := NewTestSetup(t)
+	defer setup.Cleanup()
+
+	fileID, err := setup.CreateTestFile("locked.pdf", "files/test-user-123/encrypted.pdf", "locked.pdf", nil)
+	require.NoError(t, err)
+
+	resp, err := setup.MakeRequest("GET", "/api/files/"+uintToStringHelper(fileID)+"/process-stream?format=ndjson", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	var events []map[string]interface{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event), "line: %s", scanner.Text())
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.NotEmpty(t, events)
+	assert.Equal(t, "connected", events[0]["type"])
+	assert.Equal(t, "done", events[len(events)-1]["type"])
+}
+
+func TestProcessStreamInvalidFormat(t *testing.T) {
+	setup := NewTestSetup(t)
+	defer setup.Cleanup()
+
+	fileID, err := setup.CreateTestFile("test.pdf", "test-key", "test.pdf", nil)
+	require.NoError(t, err)
+
+	resp, err := setup.MakeRequest("GET", "/api/files/"+uintToStringHelper(fileID)+"/process-stream?format=xml", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// The file is left untouched
+	resp, err = setup.MakeRequest("GET", "/api/files/"+uintToStringHelper(fileID), nil)
+	require.NoError(t, err)
+	result, err := setup.ReadResponseBody(resp)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", result["processing_status"])
+}
+
+// TestParserCallback verifies an async parser's callback resumes processing
+// without user authentication and that each job token works once
+func TestParserCallback(t *testing.T) {
+	setup := NewTestSetup(t)
+	defer setup.Cleanup()
+
+	fileID, err := setup.CreateTestFile("report.pdf", "files/test-user-123/report.pdf", "report.pdf", nil)
+	require.NoError(t, err)
+	require.NoError(t, setup.FileService.UpdateFileProcessingStatus(setup.TestUserID, fileID, models.FileStatusProcessing, ""))
+	job, err := setup.ParserJobService.CreateJob(setup.TestUserID, fileID, "", "")
+	require.NoError(t, err)
+
+	postCallback := func(body map[string]interface{}) *http.Response {
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+		// The parser has no user credentials; the job token authenticates it
+		req := httptest.NewRequest("POST", "/api/internal/parser-callback", bytes.NewReader(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := setup.App.Test(req, -1)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := postCallback(map[string]interface{}{
+		"job_token": job.Token,
+		"content":   "Quarterly report\n\nRevenue grew across every region this quarter.",
+	})
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	result, err := setup.ReadResponseBody(resp)
+	require.NoError(t, err)
+	assert.Equal(t, float64(fileID), result["file_id"])
+	assert.Equal(t, true, result["resumed"])
+
+	assert.Eventually(t, func() bool {
+		file, err := setup.FileService.GetFileByID(setup.TestUserID, fileID)
+		return err == nil && file.ProcessingStatus == models.FileStatusCompleted
+	}, 5*time.Second, 20*time.Millisecond)
+	file, err := setup.FileService.GetFileByID(setup.TestUserID, fileID)
+	require.NoError(t, err)
+	assert.Contains(t, file.Content, "Revenue grew")
+	assert.NotEmpty(t, file.Summary)
+
+	// A token can only be used once
+	resp = postCallback(map[string]interface{}{"job_token": job.Token, "content": "again"})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = postCallback(map[string]interface{}{})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestParserCallbackError verifies a parser error fails the file
+func TestParserCallbackError(t *testing.T) {
+	setup := NewTestSetup(t)
+	defer setup.Cleanup()
+
+	fileID, err := setup.CreateTestFile("video.mp4", "files/test-user-123/video.mp4", "video.mp4", nil)
+	require.NoError(t, err)
+	require.NoError(t, setup.FileService.UpdateFileProcessingStatus(setup.TestUserID, fileID, models.FileStatusProcessing, ""))
+	job, err := setup.ParserJobService.CreateJob(setup.TestUserID, fileID, "", "")
+	require.NoError(t, err)
+
+	jsonBody, err := json.Marshal(map[string]interface{}{"job_token": job.Token, "error": "transcription timed out"})
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/api/internal/parser-callback", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := setup.App.Test(req, -1)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	result, err := setup.ReadResponseBody(resp)
+	require.NoError(t, err)
+	assert.Equal(t, false, result["resumed"])
+
+	file, err := setup.FileService.GetFileByID(setup.TestUserID, fileID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusFailed, file.ProcessingStatus)
+	assert.Equal(t, "Failed to parse content: transcription timed out", file.ProcessingError)
+}
@@ -1,9 +1,14 @@
 package api
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
+	"github.com/rxtech-lab/invoice-management/internal/api/handlers"
+	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -91,6 +96,23 @@ func (s *SearchTestSuite) TestSearchFilesDefaultType() {
 	s.Equal("fulltext", result["search_type"])
 }
 
+func (s *SearchTestSuite) TestSearchMetrics() {
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=metrics-probe&type=hybrid", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// Metrics are public for scrapers
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	s.Require().NoError(err)
+	resp, err = s.setup.App.Test(req)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.Contains(string(body), `file_management_search_duration_seconds_count{mode="hybrid"}`)
+}
+
 func (s *SearchTestSuite) TestSearchFilesMissingQuery() {
 	resp, err := s.setup.MakeRequest("GET", "/api/search", nil)
 	s.Require().NoError(err)
@@ -120,6 +142,24 @@ func (s *SearchTestSuite) TestSearchFilesWithPagination() {
 	// Results might be empty due to processing status filter, but pagination params are passed
 }
 
+func (s *SearchTestSuite) TestSearchFilesClampsLimit() {
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=Document&limit=100000&offset=3", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(handlers.DefaultMaxSearchPageSize), result["limit"])
+	s.Equal(float64(3), result["offset"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/search?q=Document", nil)
+	s.Require().NoError(err)
+
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(handlers.DefaultSearchPageSize), result["limit"])
+}
+
 func (s *SearchTestSuite) TestSearchFilesInvalidType() {
 	// Invalid search type falls back to default behavior (fulltext)
 	resp, err := s.setup.MakeRequest("GET", "/api/search?q=test&type=invalid", nil)
@@ -128,6 +168,162 @@ func (s *SearchTestSuite) TestSearchFilesInvalidType() {
 	s.Equal(http.StatusOK, resp.StatusCode)
 }
 
+func (s *SearchTestSuite) TestSearchFilesCache() {
+	fileID, err := s.setup.CreateTestFile("Invoice 2024", "files/test-user-123/invoice2024.pdf", "invoice2024.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, fileID, models.FileStatusCompleted, ""))
+
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=Invoice&type=fulltext", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("MISS", resp.Header.Get("X-Search-Cache"))
+
+	resp, err = s.setup.MakeRequest("GET", "/api/search?q=Invoice&type=fulltext", nil)
+	s.Require().NoError(err)
+	s.Equal("HIT", resp.Header.Get("X-Search-Cache"))
+
+	// Different filters are cached separately
+	resp, err = s.setup.MakeRequest("GET", "/api/search?q=Invoice&type=fulltext&limit=5", nil)
+	s.Require().NoError(err)
+	s.Equal("MISS", resp.Header.Get("X-Search-Cache"))
+
+	// Changing files invalidates cached results
+	fileID, err = s.setup.CreateTestFile("Invoice 2023", "files/test-user-123/invoice2023.pdf", "invoice2023.pdf", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, fileID, models.FileStatusCompleted, ""))
+
+	resp, err = s.setup.MakeRequest("GET", "/api/search?q=Invoice&type=fulltext", nil)
+	s.Require().NoError(err)
+	s.Equal("MISS", resp.Header.Get("X-Search-Cache"))
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["total"])
+}
+
+func (s *SearchTestSuite) TestSearchFilesCSV() {
+	projectsID, err := s.setup.CreateTestFolder("Projects", nil)
+	s.Require().NoError(err)
+	clientID, err := s.setup.CreateTestFolder("Acme, Inc", &projectsID)
+	s.Require().NoError(err)
+	nestedID, err := s.setup.CreateTestFile("Invoice Acme", "files/test-user-123/acme.pdf", "acme.pdf", &clientID)
+	s.Require().NoError(err)
+	rootID, err := s.setup.CreateTestFile("Invoice Root", "files/test-user-123/root.pdf", "root.pdf", nil)
+	s.Require().NoError(err)
+	formulaID, err := s.setup.CreateTestFile("=HYPERLINK(\"http://x\") Invoice", "files/test-user-123/formula.pdf", "formula.pdf", nil)
+	s.Require().NoError(err)
+	for _, id := range []uint{nestedID, rootID, formulaID} {
+		s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, id, models.FileStatusCompleted, ""))
+	}
+
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=Invoice&type=fulltext&format=csv", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("text/csv", resp.Header.Get("Content-Type"))
+
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, 4)
+	s.Equal([]string{"id", "title", "file_type", "folder_path", "score", "snippet"}, records[0])
+
+	paths := map[string]string{}
+	for _, record := range records[1:] {
+		paths[record[1]] = record[3]
+	}
+	// Text a spreadsheet would evaluate is quoted
+	s.Equal(map[string]string{
+		"Invoice Acme":                      "Projects/Acme, Inc",
+		"Invoice Root":                      "",
+		"'=HYPERLINK(\"http://x\") Invoice": "",
+	}, paths)
+}
+
+func (s *SearchTestSuite) TestSearchFilesTitleOnly() {
+	titleID, err := s.setup.CreateTestFile("Invoice ACME", "files/test-user-123/acme.pdf", "acme.pdf", nil)
+	s.Require().NoError(err)
+	contentID, err := s.setup.CreateTestFile("Scan 0042", "files/test-user-123/scan.pdf", "scan.pdf", nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.setup.FileService.UpdateFileContent(s.setup.TestUserID, contentID, "Invoice number 42", "", false, models.FileTypeInvoice))
+	for _, id := range []uint{titleID, contentID} {
+		s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, id, models.FileStatusCompleted, ""))
+	}
+
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=invoice&type=fulltext", nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["total"])
+
+	// type is ignored on the title-only path
+	resp, err = s.setup.MakeRequest("GET", "/api/search?q=invoice&type=hybrid&title_only=true", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal("fulltext", result["search_type"])
+	s.Equal(float64(1), result["total"])
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	file := data[0].(map[string]interface{})["file"].(map[string]interface{})
+	s.Equal("Invoice ACME", file["title"])
+}
+
+func (s *SearchTestSuite) TestSearchFilesTagBoost() {
+	titleID, err := s.setup.CreateTestFile("Report", "files/test-user-123/report.pdf", "report.pdf", nil)
+	s.Require().NoError(err)
+	boostedID, err := s.setup.CreateTestFile("Scan", "files/test-user-123/scan.pdf", "scan.pdf", nil)
+	s.Require().NoError(err)
+	tagID, err := s.setup.CreateTestTag("Important")
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.setup.FileService.UpdateFileContent(s.setup.TestUserID, boostedID, "quarterly report", "", false, models.FileTypeDocument))
+	_, err = s.setup.FileService.AddTagsToFile(s.setup.TestUserID, boostedID, []uint{tagID})
+	s.Require().NoError(err)
+	for _, id := range []uint{titleID, boostedID} {
+		s.Require().NoError(s.setup.FileService.UpdateFileProcessingStatus(s.setup.TestUserID, id, models.FileStatusCompleted, ""))
+	}
+
+	firstResultID := func(url string) float64 {
+		resp, err := s.setup.MakeRequest("GET", url, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		data := result["data"].([]interface{})
+		s.Require().Len(data, 2)
+		return data[0].(map[string]interface{})["file"].(map[string]interface{})["id"].(float64)
+	}
+
+	// Title matches outrank content matches by default
+	s.Equal(float64(titleID), firstResultID("/api/search?q=report&type=fulltext"))
+
+	// A strong boost lifts the tagged file to the top
+	s.Equal(float64(boostedID), firstResultID(fmt.Sprintf("/api/search?q=report&type=fulltext&boost_tag_ids=%d:10", tagID)))
+}
+
+func (s *SearchTestSuite) TestSearchFilesDatabaseErrorNotLeaked() {
+	_, err := s.setup.CreateTestFile("Annual Report", "files/test-user-123/report.pdf", "report.pdf", nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.setup.DBService.GetDB().Migrator().DropTable("files"))
+
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=report", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusInternalServerError, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Internal server error", result["error"])
+}
+
+func (s *SearchTestSuite) TestSearchFilesInvalidTagBoost() {
+	resp, err := s.setup.MakeRequest("GET", "/api/search?q=report&boost_tag_ids=1:-2", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestSearchSuite(t *testing.T) {
 	suite.Run(t, new(SearchTestSuite))
 }
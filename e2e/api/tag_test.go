@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/rxtech-lab/invoice-management/internal/api/handlers"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -41,6 +42,118 @@ func (s *TagTestSuite) TestCreateTag() {
 	s.NotNil(result["id"])
 }
 
+func (s *TagTestSuite) TestCreateTagFieldErrors() {
+	resp, err := s.setup.MakeRequest("POST", "/api/tags", map[string]interface{}{
+		"name":  "",
+		"color": "red",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	errs := result["errors"].([]interface{})
+	s.Require().Len(errs, 2)
+	s.Equal("name", errs[0].(map[string]interface{})["field"])
+	s.Equal("color", errs[1].(map[string]interface{})["field"])
+}
+
+func (s *TagTestSuite) TestCreateTagSimilarExistingTags() {
+	_, err := s.setup.CreateTestTag("Invoices")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestTag("Travel")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/tags", map[string]interface{}{"name": "invoice"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("invoice", result["name"])
+
+	similar := result["similar_existing_tags"].([]interface{})
+	s.Require().Len(similar, 1)
+	s.Equal("Invoices", similar[0].(map[string]interface{})["name"])
+}
+
+func (s *TagTestSuite) TestCreateTagNoSimilarExistingTags() {
+	_, err := s.setup.CreateTestTag("Travel")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/tags", map[string]interface{}{"name": "Receipts"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(result, "similar_existing_tags")
+}
+
+func (s *TagTestSuite) TestCreateTagsBulk() {
+	existingID, err := s.setup.CreateTestTag("Invoices")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/tags/bulk", map[string]interface{}{
+		"tags": []map[string]interface{}{
+			{"name": "Travel", "color": "#00FF00"},
+			{"name": "invoices"},
+			{"name": "Receipts", "description": "Purchase receipts"},
+			{"name": "travel"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	created := result["created"].([]interface{})
+	s.Require().Len(created, 2)
+	travel := created[0].(map[string]interface{})
+	s.Equal("Travel", travel["name"])
+	s.Equal("#00FF00", travel["color"])
+	s.Equal("Receipts", created[1].(map[string]interface{})["name"])
+
+	skipped := result["skipped"].([]interface{})
+	s.Require().Len(skipped, 2)
+	s.Equal(float64(existingID), skipped[0].(map[string]interface{})["id"])
+	s.Equal(travel["id"], skipped[1].(map[string]interface{})["id"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/tags", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(3), result["total"])
+}
+
+func (s *TagTestSuite) TestCreateTagsBulkFieldErrors() {
+	resp, err := s.setup.MakeRequest("POST", "/api/tags/bulk", map[string]interface{}{
+		"tags": []map[string]interface{}{
+			{"name": "Travel"},
+			{"name": "", "color": "green"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	errs := result["errors"].([]interface{})
+	s.Require().Len(errs, 2)
+	s.Equal("tags[1].name", errs[0].(map[string]interface{})["field"])
+	s.Equal("tags[1].color", errs[1].(map[string]interface{})["field"])
+
+	// Nothing is created when any tag is invalid
+	resp, err = s.setup.MakeRequest("GET", "/api/tags", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["total"])
+}
+
 func (s *TagTestSuite) TestCreateTagMinimal() {
 	tag := map[string]interface{}{
 		"name": "Simple Tag",
@@ -83,6 +196,24 @@ func (s *TagTestSuite) TestListTags() {
 	s.Equal(float64(2), result["total"])
 }
 
+func (s *TagTestSuite) TestListTagsClampsLimit() {
+	resp, err := s.setup.MakeRequest("GET", "/api/tags", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(handlers.DefaultListPageSize), result["limit"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/tags?limit=100000", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(handlers.DefaultMaxListPageSize), result["limit"])
+}
+
 func (s *TagTestSuite) TestListTagsWithKeyword() {
 	// Create tags
 	_, err := s.setup.CreateTestTag("Invoice Tag")
@@ -175,6 +306,275 @@ func (s *TagTestSuite) TestDeleteTagNotFound() {
 	s.Equal(http.StatusNotFound, resp.StatusCode)
 }
 
+func (s *TagTestSuite) TestBulkDeleteTags() {
+	unusedID, err := s.setup.CreateTestTag("unused")
+	s.Require().NoError(err)
+	fileTagID, err := s.setup.CreateTestTag("on-file")
+	s.Require().NoError(err)
+	folderTagID, err := s.setup.CreateTestTag("on-folder")
+	s.Require().NoError(err)
+
+	fileID, err := s.setup.CreateTestFile("Bill", "files/bill.pdf", "bill.pdf", nil)
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+		"tag_ids": []uint{fileTagID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	folderID, err := s.setup.CreateTestFolder("Tagged", nil)
+	s.Require().NoError(err)
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/folders/%d/tags", folderID), map[string]interface{}{
+		"tag_ids": []uint{folderTagID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// Without force, tags in use are skipped and reported
+	resp, err = s.setup.MakeRequest("POST", "/api/tags/bulk-delete", map[string]interface{}{
+		"tag_ids": []uint{unusedID, fileTagID, folderTagID, 99999},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	results := result["results"].([]interface{})
+	s.Require().Len(results, 4)
+	statuses := make([]string, len(results))
+	for i, r := range results {
+		statuses[i] = r.(map[string]interface{})["status"].(string)
+	}
+	s.Equal([]string{"deleted", "skipped_in_use", "skipped_in_use", "not_found"}, statuses)
+	s.Equal(float64(1), results[1].(map[string]interface{})["file_count"])
+	s.Equal(float64(1), results[2].(map[string]interface{})["folder_count"])
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/tags/%d", unusedID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/tags/%d", fileTagID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// With force, tags in use are deleted and detached
+	resp, err = s.setup.MakeRequest("POST", "/api/tags/bulk-delete", map[string]interface{}{
+		"tag_ids": []uint{fileTagID, folderTagID},
+		"force":   true,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	for _, r := range result["results"].([]interface{}) {
+		s.Equal("deleted", r.(map[string]interface{})["status"])
+	}
+
+	var attached int64
+	s.Require().NoError(s.setup.DBService.GetDB().Table("file_tags").Where("file_id = ?", fileID).Count(&attached).Error)
+	s.Zero(attached)
+}
+
+func (s *TagTestSuite) TestBulkDeleteTagsValidation() {
+	resp, err := s.setup.MakeRequest("POST", "/api/tags/bulk-delete", map[string]interface{}{
+		"tag_ids": []int{},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/tags/bulk-delete", map[string]interface{}{
+		"tag_ids": []int{0},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *TagTestSuite) TestAddTagAlias() {
+	tagID, err := s.setup.CreateTestTag("meetings")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/tags/%d/aliases", tagID), map[string]interface{}{
+		"alias": "meeting-notes",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	aliases := result["aliases"].([]interface{})
+	s.Len(aliases, 1)
+	s.Equal("meeting-notes", aliases[0].(map[string]interface{})["alias"])
+}
+
+func (s *TagTestSuite) TestAddDuplicateTagAlias() {
+	tagID, err := s.setup.CreateTestTag("meetings")
+	s.Require().NoError(err)
+
+	body := map[string]interface{}{"alias": "minutes"}
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/tags/%d/aliases", tagID), body)
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/tags/%d/aliases", tagID), map[string]interface{}{"alias": "Minutes"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *TagTestSuite) TestAddTagAliasNotFound() {
+	resp, err := s.setup.MakeRequest("POST", "/api/tags/99999/aliases", map[string]interface{}{"alias": "x"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *TagTestSuite) TestListTagsMatchesAlias() {
+	tagID, err := s.setup.CreateTestTag("meetings")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestTag("invoices")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/tags/%d/aliases", tagID), map[string]interface{}{
+		"alias": "standup",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/tags?keyword=standup", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	data := result["data"].([]interface{})
+	s.Len(data, 1)
+	s.Equal("meetings", data[0].(map[string]interface{})["name"])
+}
+
+func (s *TagTestSuite) TestRemoveTagAlias() {
+	tagID, err := s.setup.CreateTestTag("meetings")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/tags/%d/aliases", tagID), map[string]interface{}{
+		"alias": "standup",
+	})
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	aliasID := int(result["aliases"].([]interface{})[0].(map[string]interface{})["id"].(float64))
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/tags/%d/aliases/%d", tagID, aliasID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/tags?keyword=standup", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["total"])
+}
+
+func (s *TagTestSuite) TestFoldingRuleMovesTaggedFile() {
+	tagID, err := s.setup.CreateTestTag("invoices")
+	s.Require().NoError(err)
+	folderID, err := s.setup.CreateTestFolder("Invoices", nil)
+	s.Require().NoError(err)
+	fileID, err := s.setup.CreateTestFile("Bill", "files/bill.pdf", "bill.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/folding-rules", map[string]interface{}{
+		"tag_id":           tagID,
+		"target_folder_id": folderID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/files/%d/tags", fileID), map[string]interface{}{
+		"tag_ids": []uint{tagID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(folderID), result["moved_to_folder_id"])
+	s.Equal(float64(folderID), result["file"].(map[string]interface{})["folder_id"])
+}
+
+func (s *TagTestSuite) TestFoldingRuleCRUD() {
+	tagID, err := s.setup.CreateTestTag("invoices")
+	s.Require().NoError(err)
+	firstFolderID, err := s.setup.CreateTestFolder("Invoices", nil)
+	s.Require().NoError(err)
+	secondFolderID, err := s.setup.CreateTestFolder("Bills", nil)
+	s.Require().NoError(err)
+
+	body := map[string]interface{}{"tag_id": tagID, "target_folder_id": firstFolderID}
+	resp, err := s.setup.MakeRequest("POST", "/api/folding-rules", body)
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	ruleID := int(result["id"].(float64))
+
+	// A second rule for the same tag would conflict
+	resp, err = s.setup.MakeRequest("POST", "/api/folding-rules", map[string]interface{}{
+		"tag_id":           tagID,
+		"target_folder_id": secondFolderID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/folding-rules/%d", ruleID), map[string]interface{}{
+		"target_folder_id": secondFolderID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(secondFolderID), result["target_folder_id"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/folding-rules", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"].([]interface{}), 1)
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folding-rules/%d", ruleID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/folding-rules/%d", ruleID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *TagTestSuite) TestCreateFoldingRuleUnknownFolder() {
+	tagID, err := s.setup.CreateTestTag("invoices")
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/folding-rules", map[string]interface{}{
+		"tag_id":           tagID,
+		"target_folder_id": 99999,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *TagTestSuite) TestGetTagDatabaseErrorNotLeaked() {
+	tagID, err := s.setup.CreateTestTag("Invoices")
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.setup.DBService.GetDB().Migrator().DropTable("tags"))
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/tags/%d", tagID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusInternalServerError, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Internal server error", result["error"])
+}
+
 func TestTagSuite(t *testing.T) {
 	suite.Run(t, new(TagTestSuite))
 }
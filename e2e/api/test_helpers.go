@@ -7,10 +7,12 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rxtech-lab/invoice-management/internal/api"
+	"github.com/rxtech-lab/invoice-management/internal/api/handlers"
 	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
 	"github.com/rxtech-lab/invoice-management/internal/services"
 	"github.com/rxtech-lab/invoice-management/internal/utils"
@@ -29,6 +31,7 @@ type TestSetup struct {
 	ContentParserService services.ContentParserService
 	SearchService        services.SearchService
 	InvoiceService       *services.MockInvoiceService
+	ParserJobService     services.ParserJobService
 	APIServer            *api.APIServer
 	App                  *fiber.App
 	TestUserID           string
@@ -44,15 +47,21 @@ func NewTestSetup(t *testing.T) *TestSetup {
 
 	// Create services
 	tagService := services.NewTagService(db)
-	folderService := services.NewFolderService(db)
-	fileService := services.NewFileService(db)
-	uploadService := services.NewMockUploadService()
+	folderService := services.NewFolderService(db, services.FolderConfig{})
+	fileService := services.NewFileService(db, services.FileConfig{})
+	uploadService := services.NewUserUploadService(db, services.NewMockUploadService(), nil)
 	embeddingService := services.NewMockEmbeddingService()
 	contentParserService := services.NewMockContentParserService()
 	summaryService := services.NewMockSummaryService()
-	searchService := services.NewSearchService(db, embeddingService)
+	searchService := services.NewSearchService(db, embeddingService, nil)
 	agentService := services.NewMockAgentService()
 	invoiceService := services.NewMockInvoiceService(true)
+	reembedService := services.NewReembedService(db, fileService, embeddingService)
+	autoTagService := services.NewAutoTagService(db, embeddingService, services.AutoTagConfig{})
+	reassignService := services.NewReassignService(db, uploadService)
+	statsService := services.NewStatsService(db)
+	processingGate := services.NewProcessingGate(db)
+	parserJobService := services.NewParserJobService(db, 0)
 
 	// Create API server
 	apiServer := api.NewAPIServer(
@@ -67,6 +76,13 @@ func NewTestSetup(t *testing.T) *TestSetup {
 		summaryService,
 		agentService,
 		invoiceService,
+		reembedService,
+		autoTagService,
+		reassignService,
+		statsService,
+		processingGate,
+		parserJobService,
+		handlers.PaginationConfig{},
 		nil, // No MCP server for tests
 	)
 
@@ -87,6 +103,7 @@ func NewTestSetup(t *testing.T) *TestSetup {
 		ContentParserService: contentParserService,
 		SearchService:        searchService,
 		InvoiceService:       invoiceService,
+		ParserJobService:     parserJobService,
 		APIServer:            apiServer,
 		App:                  apiServer.GetFiberApp(),
 		TestUserID:           "test-user-123",
@@ -152,6 +169,25 @@ func (s *TestSetup) MakeAuthenticatedRequest(method, path string, body interface
 	return resp, nil
 }
 
+// MakeAdminRequest makes an HTTP request as the test user with the admin role
+func (s *TestSetup) MakeAdminRequest(method, path string, body interface{}) (*http.Response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		jsonBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(jsonBytes)
+	}
+
+	req := httptest.NewRequest(method, path, reqBody)
+	req.Header.Set("Content-Type", "application/json")
+	s.addAuthHeader(req)
+	req.Header.Set("X-Test-User-Roles", "admin")
+
+	return s.App.Test(req, -1)
+}
+
 // addAuthHeader adds authentication header for testing
 func (s *TestSetup) addAuthHeader(req *http.Request) {
 	s.addAuthHeaderWithUserID(req, s.TestUserID)
@@ -284,6 +320,12 @@ func SetupTestAuthMiddleware(app *fiber.App) {
 			user := &utils.AuthenticatedUser{
 				Sub: userID,
 			}
+			if roles := c.Get("X-Test-User-Roles"); roles != "" {
+				user.Roles = strings.Split(roles, ",")
+			}
+			if masked := c.Get("X-Test-Masked-Fields"); masked != "" {
+				user.MaskedFields = strings.Split(masked, ",")
+			}
 			c.Locals(middleware.AuthenticatedUserContextKey, user)
 
 			// Also set raw auth token if provided (for invoice processing tests)
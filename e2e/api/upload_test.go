@@ -2,9 +2,11 @@ package api
 
 import (
 	"bytes"
+	"fmt"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -104,6 +106,136 @@ func (s *UploadTestSuite) TestGetPresignedURLMissingFilename() {
 	s.Equal(http.StatusBadRequest, resp.StatusCode)
 }
 
+func (s *UploadTestSuite) TestGetPresignedURLUsesFolderPrefix() {
+	resp, err := s.setup.MakeRequest("POST", "/api/folders", map[string]interface{}{
+		"name":      "ACME",
+		"s3_prefix": "/clients/acme/",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+	folder, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("clients/acme", folder["s3_prefix"])
+
+	req := httptest.NewRequest("GET", fmt.Sprintf("/api/upload/presigned?filename=invoice.pdf&folder_id=%d", int(folder["id"].(float64))), nil)
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	resp, err = s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(result["key"].(string), "files/test-user-123/clients/acme/"), result["key"])
+	s.True(strings.HasSuffix(result["key"].(string), ".pdf"), result["key"])
+
+	req = httptest.NewRequest("GET", "/api/upload/presigned?filename=invoice.pdf&folder_id=99999", nil)
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	resp, err = s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *UploadTestSuite) TestPresignUploadBatch() {
+	folderID, err := s.setup.CreateTestFolder("ACME", nil)
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/folders/%d", folderID), map[string]interface{}{
+		"s3_prefix": "clients/acme",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/upload/presign-batch", map[string]interface{}{
+		"folder_id": folderID,
+		"files": []map[string]interface{}{
+			{"filename": "a.pdf", "content_type": "application/pdf"},
+			{"filename": "b.png"},
+			{"filename": "c.txt", "content_type": "text/plain"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 3)
+
+	keys := map[string]bool{}
+	for i, want := range []struct{ filename, contentType, ext string }{
+		{"a.pdf", "application/pdf", ".pdf"},
+		{"b.png", "application/octet-stream", ".png"},
+		{"c.txt", "text/plain", ".txt"},
+	} {
+		upload := data[i].(map[string]interface{})
+		key := upload["key"].(string)
+		s.Equal(want.filename, upload["filename"])
+		s.Equal(want.contentType, upload["content_type"])
+		s.True(strings.HasPrefix(key, "files/test-user-123/clients/acme/"), key)
+		s.True(strings.HasSuffix(key, want.ext), key)
+		s.Contains(upload["upload_url"], key)
+		keys[key] = true
+	}
+	s.Len(keys, 3, "each upload gets its own key")
+}
+
+func (s *UploadTestSuite) TestPresignUploadBatchValidation() {
+	resp, err := s.setup.MakeRequest("POST", "/api/upload/presign-batch", map[string]interface{}{
+		"files": []map[string]interface{}{},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/upload/presign-batch", map[string]interface{}{
+		"files": []map[string]interface{}{{"filename": "a.pdf"}, {"filename": " "}},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/upload/presign-batch", map[string]interface{}{
+		"folder_id": 99999,
+		"files":     []map[string]interface{}{{"filename": "a.pdf"}},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *UploadTestSuite) TestMoveFileIntoPrefixedFolder() {
+	folderID, err := s.setup.CreateTestFolder("ACME", nil)
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/folders/%d", folderID), map[string]interface{}{
+		"s3_prefix": "clients/acme",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	fileID, err := s.setup.CreateTestFile("Invoice", "files/test-user-123/abc.pdf", "invoice.pdf", nil)
+	s.Require().NoError(err)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/files/move", map[string]interface{}{
+		"file_ids":  []uint{fileID},
+		"folder_id": folderID,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/files/%d", fileID), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("files/test-user-123/clients/acme/abc.pdf", result["s3_key"])
+}
+
+func (s *UploadTestSuite) TestFolderInvalidS3Prefix() {
+	for _, prefix := range []string{"../escape", "clients//acme", "space here"} {
+		resp, err := s.setup.MakeRequest("POST", "/api/folders", map[string]interface{}{
+			"name":      "Bad",
+			"s3_prefix": prefix,
+		})
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, prefix)
+	}
+}
+
 func TestUploadSuite(t *testing.T) {
 	suite.Run(t, new(UploadTestSuite))
 }
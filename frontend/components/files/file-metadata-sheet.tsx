@@ -128,7 +128,13 @@ export function FileMetadataSheet({
       try {
         const result = await addTagsToFileAction(file.id, [tag.id]);
         if (result.success) {
-          toast.success("Tag added");
+          if (result.data?.moved_to_folder_id) {
+            toast.success("Tag added and file moved by a folding rule");
+          } else if (result.data?.added_tag_ids.length === 0) {
+            toast.success("Tag already applied");
+          } else {
+            toast.success("Tag added");
+          }
           router.refresh();
         } else {
           toast.error(result.error || "Failed to add tag");
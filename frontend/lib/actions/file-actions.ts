@@ -11,6 +11,7 @@ import type {
   FileDownloadURLResponse,
   ProcessResponse,
   MoveResponse,
+  FileTagAdditionResult,
 } from "@/lib/api/types";
 
 interface ActionResult<T> {
@@ -141,11 +142,11 @@ export async function getFileDownloadAction(
 export async function addTagsToFileAction(
   id: number,
   tagIds: number[],
-): Promise<ActionResult<FileItem>> {
+): Promise<ActionResult<FileTagAdditionResult>> {
   try {
-    const file = await api.addTagsToFile(id, tagIds);
+    const result = await api.addTagsToFile(id, tagIds);
     revalidatePath("/files");
-    return { success: true, data: file };
+    return { success: true, data: result };
   } catch (error) {
     return {
       success: false,
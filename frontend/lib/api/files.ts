@@ -9,6 +9,7 @@ import type {
   ProcessResponse,
   MoveResponse,
   TagIdsRequest,
+  FileTagAdditionResult,
 } from "./types";
 
 export async function listFiles(
@@ -91,9 +92,9 @@ export async function getFileDownloadURL(
 export async function addTagsToFile(
   id: number,
   tagIds: number[],
-): Promise<FileItem> {
+): Promise<FileTagAdditionResult> {
   const data: TagIdsRequest = { tag_ids: tagIds };
-  return apiClient<FileItem>(`/api/files/${id}/tags`, {
+  return apiClient<FileTagAdditionResult>(`/api/files/${id}/tags`, {
     method: "POST",
     body: JSON.stringify(data),
   });
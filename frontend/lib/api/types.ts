@@ -114,6 +114,13 @@ export interface TagIdsRequest {
   tag_ids: number[];
 }
 
+export interface FileTagAdditionResult {
+  file: FileItem;
+  added_tag_ids: number[];
+  already_present_tag_ids: number[];
+  moved_to_folder_id?: number;
+}
+
 // Search
 export interface SearchResult {
   file: FileItem;
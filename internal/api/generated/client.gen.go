@@ -89,6 +89,29 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetProcessingStatus request
+	GetProcessingStatus(ctx context.Context, params *GetProcessingStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseProcessing request
+	PauseProcessing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeProcessing request
+	ResumeProcessing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReassignOwnershipWithBody request with any body
+	ReassignOwnershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReassignOwnership(ctx context.Context, body ReassignOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReembedStatus request
+	GetReembedStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartReembed request
+	StartReembed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAgentCapabilities request
+	GetAgentCapabilities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAgentStatus request
 	GetAgentStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -100,11 +123,25 @@ type ClientInterface interface {
 
 	CreateFile(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// BatchDeleteFilesWithBody request with any body
+	BatchDeleteFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchDeleteFiles(ctx context.Context, body BatchDeleteFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// BatchDownloadFilesWithBody request with any body
 	BatchDownloadFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	BatchDownloadFiles(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListFileChanges request
+	ListFileChanges(ctx context.Context, params *ListFileChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProcessingErrorSummary request
+	GetProcessingErrorSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFilesGrouped request
+	ListFilesGrouped(ctx context.Context, params *ListFilesGroupedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UnlinkFileInvoice request
 	UnlinkFileInvoice(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -113,8 +150,37 @@ type ClientInterface interface {
 
 	MoveFiles(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// MoveFilesByFilterWithBody request with any body
+	MoveFilesByFilterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveFilesByFilter(ctx context.Context, body MoveFilesByFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelFilesProcessingWithBody request with any body
+	CancelFilesProcessingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelFilesProcessing(ctx context.Context, body CancelFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryFilesProcessingWithBody request with any body
+	RetryFilesProcessingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RetryFilesProcessing(ctx context.Context, body RetryFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFileByPublicID request
+	GetFileByPublicID(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFileDownloadURLByPublicID request
+	GetFileDownloadURLByPublicID(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReclassifyFilesWithBody request with any body
+	ReclassifyFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReclassifyFiles(ctx context.Context, body ReclassifyFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamFiles request
+	StreamFiles(ctx context.Context, params *StreamFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteFile request
-	DeleteFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteFile(ctx context.Context, id FileId, params *DeleteFileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetFile request
 	GetFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -125,16 +191,41 @@ type ClientInterface interface {
 	UpdateFile(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StreamAgentProgress request
-	StreamAgentProgress(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	StreamAgentProgress(ctx context.Context, id FileId, params *StreamAgentProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFileAssociations request
+	GetFileAssociations(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFileContentText request
+	GetFileContentText(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CopyFileWithBody request with any body
+	CopyFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CopyFile(ctx context.Context, id FileId, body CopyFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetFileDownloadURL request
 	GetFileDownloadURL(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ClearFileEmbedding request
+	ClearFileEmbedding(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// OrganizeFile request
 	OrganizeFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ProcessFile request
-	ProcessFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ProcessFile(ctx context.Context, id FileId, params *ProcessFileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFileRelations request
+	ListFileRelations(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddFileRelationWithBody request with any body
+	AddFileRelationWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddFileRelation(ctx context.Context, id FileId, body AddFileRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveFileRelation request
+	RemoveFileRelation(ctx context.Context, id FileId, relatedFileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RemoveTagsFromFileWithBody request with any body
 	RemoveTagsFromFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -154,11 +245,22 @@ type ClientInterface interface {
 
 	CreateFolder(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ResolveFolderPath request
+	ResolveFolderPath(ctx context.Context, params *ResolveFolderPathParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSharedFolders request
+	ListSharedFolders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFolderTree request
 	GetFolderTree(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExpandFolderTreeWithBody request with any body
+	ExpandFolderTreeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExpandFolderTree(ctx context.Context, body ExpandFolderTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteFolder request
-	DeleteFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteFolder(ctx context.Context, id FolderId, params *DeleteFolderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetFolder request
 	GetFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -168,21 +270,92 @@ type ClientInterface interface {
 
 	UpdateFolder(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListFolderAliases request
+	ListFolderAliases(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddFolderAliasWithBody request with any body
+	AddFolderAliasWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddFolderAlias(ctx context.Context, id FolderId, body AddFolderAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveFolderAlias request
+	RemoveFolderAlias(ctx context.Context, id FolderId, aliasId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFolderContents request
+	GetFolderContents(ctx context.Context, id FolderId, params *GetFolderContentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFolderDeletePreview request
+	GetFolderDeletePreview(ctx context.Context, id FolderId, params *GetFolderDeletePreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFolderDescendants request
+	ListFolderDescendants(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadFolder request
+	DownloadFolder(ctx context.Context, id FolderId, params *DownloadFolderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveFileLinksWithBody request with any body
+	RemoveFileLinksWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RemoveFileLinks(ctx context.Context, id FolderId, body RemoveFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddFileLinksWithBody request with any body
+	AddFileLinksWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddFileLinks(ctx context.Context, id FolderId, body AddFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFolderMembers request
+	ListFolderMembers(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ShareFolderWithBody request with any body
+	ShareFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ShareFolder(ctx context.Context, id FolderId, body ShareFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnshareFolder request
+	UnshareFolder(ctx context.Context, id FolderId, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MoveFolderWithBody request with any body
 	MoveFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	MoveFolder(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RestoreFolder request
+	RestoreFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RemoveTagsFromFolderWithBody request with any body
 	RemoveTagsFromFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	RemoveTagsFromFolder(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListFolderTags request
+	ListFolderTags(ctx context.Context, id FolderId, params *ListFolderTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddTagsToFolderWithBody request with any body
 	AddTagsToFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	AddTagsToFolder(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListFoldingRules request
+	ListFoldingRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateFoldingRuleWithBody request with any body
+	CreateFoldingRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateFoldingRule(ctx context.Context, body CreateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteFoldingRule request
+	DeleteFoldingRule(ctx context.Context, id FoldingRuleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateFoldingRuleWithBody request with any body
+	UpdateFoldingRuleWithBody(ctx context.Context, id FoldingRuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateFoldingRule(ctx context.Context, id FoldingRuleId, body UpdateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ParserCallbackWithBody request with any body
+	ParserCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ParserCallback(ctx context.Context, body ParserCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchFiles request
 	SearchFiles(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -194,6 +367,16 @@ type ClientInterface interface {
 
 	CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateTagsWithBody request with any body
+	CreateTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTags(ctx context.Context, body CreateTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTagsWithBody request with any body
+	DeleteTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteTags(ctx context.Context, body DeleteTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteTag request
 	DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -205,8 +388,21 @@ type ClientInterface interface {
 
 	UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AddTagAliasWithBody request with any body
+	AddTagAliasWithBody(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddTagAlias(ctx context.Context, id TagId, body AddTagAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveTagAlias request
+	RemoveTagAlias(ctx context.Context, id TagId, aliasId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UploadFileWithBody request with any body
-	UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UploadFileWithBody(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PresignUploadBatchWithBody request with any body
+	PresignUploadBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PresignUploadBatch(ctx context.Context, body PresignUploadBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetPresignedURL request
 	GetPresignedURL(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -215,8 +411,8 @@ type ClientInterface interface {
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetAgentStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAgentStatusRequest(c.Server)
+func (c *Client) GetProcessingStatus(ctx context.Context, params *GetProcessingStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProcessingStatusRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +423,8 @@ func (c *Client) GetAgentStatus(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListFiles(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListFilesRequest(c.Server, params)
+func (c *Client) PauseProcessing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseProcessingRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -239,8 +435,8 @@ func (c *Client) ListFiles(ctx context.Context, params *ListFilesParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateFileRequestWithBody(c.Server, contentType, body)
+func (c *Client) ResumeProcessing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeProcessingRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -251,8 +447,8 @@ func (c *Client) CreateFileWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateFile(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateFileRequest(c.Server, body)
+func (c *Client) ReassignOwnershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignOwnershipRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +459,8 @@ func (c *Client) CreateFile(ctx context.Context, body CreateFileJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) BatchDownloadFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBatchDownloadFilesRequestWithBody(c.Server, contentType, body)
+func (c *Client) ReassignOwnership(ctx context.Context, body ReassignOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignOwnershipRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +471,8 @@ func (c *Client) BatchDownloadFilesWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) BatchDownloadFiles(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBatchDownloadFilesRequest(c.Server, body)
+func (c *Client) GetReembedStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReembedStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +483,8 @@ func (c *Client) BatchDownloadFiles(ctx context.Context, body BatchDownloadFiles
 	return c.Client.Do(req)
 }
 
-func (c *Client) UnlinkFileInvoice(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUnlinkFileInvoiceRequest(c.Server, params)
+func (c *Client) StartReembed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartReembedRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -299,8 +495,8 @@ func (c *Client) UnlinkFileInvoice(ctx context.Context, params *UnlinkFileInvoic
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveFilesRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAgentCapabilities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentCapabilitiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +507,8 @@ func (c *Client) MoveFilesWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveFiles(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveFilesRequest(c.Server, body)
+func (c *Client) GetAgentStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -323,8 +519,8 @@ func (c *Client) MoveFiles(ctx context.Context, body MoveFilesJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteFileRequest(c.Server, id)
+func (c *Client) ListFiles(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFilesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -335,8 +531,8 @@ func (c *Client) DeleteFile(ctx context.Context, id FileId, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFileRequest(c.Server, id)
+func (c *Client) CreateFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFileRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -347,8 +543,8 @@ func (c *Client) GetFile(ctx context.Context, id FileId, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFileRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateFile(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFileRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -359,8 +555,8 @@ func (c *Client) UpdateFileWithBody(ctx context.Context, id FileId, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFile(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFileRequest(c.Server, id, body)
+func (c *Client) BatchDeleteFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchDeleteFilesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -371,8 +567,8 @@ func (c *Client) UpdateFile(ctx context.Context, id FileId, body UpdateFileJSONR
 	return c.Client.Do(req)
 }
 
-func (c *Client) StreamAgentProgress(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStreamAgentProgressRequest(c.Server, id)
+func (c *Client) BatchDeleteFiles(ctx context.Context, body BatchDeleteFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchDeleteFilesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -383,8 +579,8 @@ func (c *Client) StreamAgentProgress(ctx context.Context, id FileId, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFileDownloadURL(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFileDownloadURLRequest(c.Server, id)
+func (c *Client) BatchDownloadFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchDownloadFilesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -395,8 +591,8 @@ func (c *Client) GetFileDownloadURL(ctx context.Context, id FileId, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) OrganizeFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewOrganizeFileRequest(c.Server, id)
+func (c *Client) BatchDownloadFiles(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchDownloadFilesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -407,8 +603,8 @@ func (c *Client) OrganizeFile(ctx context.Context, id FileId, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) ProcessFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessFileRequest(c.Server, id)
+func (c *Client) ListFileChanges(ctx context.Context, params *ListFileChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFileChangesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -419,8 +615,8 @@ func (c *Client) ProcessFile(ctx context.Context, id FileId, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveTagsFromFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTagsFromFileRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetProcessingErrorSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProcessingErrorSummaryRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -431,8 +627,8 @@ func (c *Client) RemoveTagsFromFileWithBody(ctx context.Context, id FileId, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveTagsFromFile(ctx context.Context, id FileId, body RemoveTagsFromFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTagsFromFileRequest(c.Server, id, body)
+func (c *Client) ListFilesGrouped(ctx context.Context, params *ListFilesGroupedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFilesGroupedRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -443,8 +639,8 @@ func (c *Client) RemoveTagsFromFile(ctx context.Context, id FileId, body RemoveT
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTagsToFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTagsToFileRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) UnlinkFileInvoice(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlinkFileInvoiceRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -455,8 +651,8 @@ func (c *Client) AddTagsToFileWithBody(ctx context.Context, id FileId, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTagsToFile(ctx context.Context, id FileId, body AddTagsToFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTagsToFileRequest(c.Server, id, body)
+func (c *Client) MoveFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveFilesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -467,8 +663,8 @@ func (c *Client) AddTagsToFile(ctx context.Context, id FileId, body AddTagsToFil
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListFolders(ctx context.Context, params *ListFoldersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListFoldersRequest(c.Server, params)
+func (c *Client) MoveFiles(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveFilesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -479,8 +675,8 @@ func (c *Client) ListFolders(ctx context.Context, params *ListFoldersParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateFolderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateFolderRequestWithBody(c.Server, contentType, body)
+func (c *Client) MoveFilesByFilterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveFilesByFilterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -491,8 +687,8 @@ func (c *Client) CreateFolderWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateFolder(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateFolderRequest(c.Server, body)
+func (c *Client) MoveFilesByFilter(ctx context.Context, body MoveFilesByFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveFilesByFilterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +699,8 @@ func (c *Client) CreateFolder(ctx context.Context, body CreateFolderJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFolderTree(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFolderTreeRequest(c.Server, params)
+func (c *Client) CancelFilesProcessingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelFilesProcessingRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -515,8 +711,8 @@ func (c *Client) GetFolderTree(ctx context.Context, params *GetFolderTreeParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteFolderRequest(c.Server, id)
+func (c *Client) CancelFilesProcessing(ctx context.Context, body CancelFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelFilesProcessingRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -527,8 +723,8 @@ func (c *Client) DeleteFolder(ctx context.Context, id FolderId, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFolderRequest(c.Server, id)
+func (c *Client) RetryFilesProcessingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryFilesProcessingRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +735,8 @@ func (c *Client) GetFolder(ctx context.Context, id FolderId, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFolderRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) RetryFilesProcessing(ctx context.Context, body RetryFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryFilesProcessingRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -551,8 +747,8 @@ func (c *Client) UpdateFolderWithBody(ctx context.Context, id FolderId, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFolder(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFolderRequest(c.Server, id, body)
+func (c *Client) GetFileByPublicID(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileByPublicIDRequest(c.Server, publicId)
 	if err != nil {
 		return nil, err
 	}
@@ -563,8 +759,8 @@ func (c *Client) UpdateFolder(ctx context.Context, id FolderId, body UpdateFolde
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveFolderRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetFileDownloadURLByPublicID(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileDownloadURLByPublicIDRequest(c.Server, publicId)
 	if err != nil {
 		return nil, err
 	}
@@ -575,8 +771,8 @@ func (c *Client) MoveFolderWithBody(ctx context.Context, id FolderId, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveFolder(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveFolderRequest(c.Server, id, body)
+func (c *Client) ReclassifyFilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReclassifyFilesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -587,8 +783,8 @@ func (c *Client) MoveFolder(ctx context.Context, id FolderId, body MoveFolderJSO
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveTagsFromFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTagsFromFolderRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ReclassifyFiles(ctx context.Context, body ReclassifyFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReclassifyFilesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -599,8 +795,8 @@ func (c *Client) RemoveTagsFromFolderWithBody(ctx context.Context, id FolderId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveTagsFromFolder(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTagsFromFolderRequest(c.Server, id, body)
+func (c *Client) StreamFiles(ctx context.Context, params *StreamFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamFilesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -611,8 +807,8 @@ func (c *Client) RemoveTagsFromFolder(ctx context.Context, id FolderId, body Rem
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTagsToFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTagsToFolderRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) DeleteFile(ctx context.Context, id FileId, params *DeleteFileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFileRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -623,8 +819,8 @@ func (c *Client) AddTagsToFolderWithBody(ctx context.Context, id FolderId, conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTagsToFolder(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTagsToFolderRequest(c.Server, id, body)
+func (c *Client) GetFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -635,8 +831,8 @@ func (c *Client) AddTagsToFolder(ctx context.Context, id FolderId, body AddTagsT
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchFiles(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchFilesRequest(c.Server, params)
+func (c *Client) UpdateFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFileRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -647,8 +843,8 @@ func (c *Client) SearchFiles(ctx context.Context, params *SearchFilesParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTags(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTagsRequest(c.Server, params)
+func (c *Client) UpdateFile(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFileRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -659,8 +855,8 @@ func (c *Client) ListTags(ctx context.Context, params *ListTagsParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
+func (c *Client) StreamAgentProgress(ctx context.Context, id FileId, params *StreamAgentProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamAgentProgressRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -671,8 +867,8 @@ func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequest(c.Server, body)
+func (c *Client) GetFileAssociations(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileAssociationsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -683,8 +879,8 @@ func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTagRequest(c.Server, id)
+func (c *Client) GetFileContentText(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileContentTextRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -695,8 +891,8 @@ func (c *Client) DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTagRequest(c.Server, id)
+func (c *Client) CopyFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyFileRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -707,8 +903,8 @@ func (c *Client) GetTag(ctx context.Context, id TagId, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTagWithBody(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTagRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CopyFile(ctx context.Context, id FileId, body CopyFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyFileRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -719,8 +915,8 @@ func (c *Client) UpdateTagWithBody(ctx context.Context, id TagId, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTagRequest(c.Server, id, body)
+func (c *Client) GetFileDownloadURL(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileDownloadURLRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -731,8 +927,8 @@ func (c *Client) UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadFileRequestWithBody(c.Server, contentType, body)
+func (c *Client) ClearFileEmbedding(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClearFileEmbeddingRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -743,8 +939,8 @@ func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPresignedURL(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPresignedURLRequest(c.Server, params)
+func (c *Client) OrganizeFile(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrganizeFileRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -755,8 +951,8 @@ func (c *Client) GetPresignedURL(ctx context.Context, params *GetPresignedURLPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
+func (c *Client) ProcessFile(ctx context.Context, id FileId, params *ProcessFileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessFileRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -767,891 +963,872 @@ func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetAgentStatusRequest generates requests for GetAgentStatus
-func NewGetAgentStatusRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListFileRelations(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFileRelationsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/agent/status")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddFileRelationWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddFileRelationRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AddFileRelation(ctx context.Context, id FileId, body AddFileRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddFileRelationRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListFilesRequest generates requests for ListFiles
-func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request, error) {
-	var err error
+func (c *Client) RemoveFileRelation(ctx context.Context, id FileId, relatedFileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveFileRelationRequest(c.Server, id, relatedFileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveTagsFromFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagsFromFileRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/files")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) RemoveTagsFromFile(ctx context.Context, id FileId, body RemoveTagsFromFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagsFromFileRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddTagsToFileWithBody(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagsToFileRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Keyword != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FolderId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "folder_id", runtime.ParamLocationQuery, *params.FolderId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AllFolders != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all_folders", runtime.ParamLocationQuery, *params.AllFolders); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FileType != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "file_type", runtime.ParamLocationQuery, *params.FileType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.TagIds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Status != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.SortBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_by", runtime.ParamLocationQuery, *params.SortBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.SortOrder != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_order", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AddTagsToFile(ctx context.Context, id FileId, body AddTagsToFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagsToFileRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
-}
-
-// NewCreateFileRequest calls the generic CreateFile builder with application/json body
-func NewCreateFileRequest(server string, body CreateFileJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateFileRequestWithBody(server, "application/json", bodyReader)
+	return c.Client.Do(req)
 }
 
-// NewCreateFileRequestWithBody generates requests for CreateFile with any type of body
-func NewCreateFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListFolders(ctx context.Context, params *ListFoldersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFoldersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateFolderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFolderRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewBatchDownloadFilesRequest calls the generic BatchDownloadFiles builder with application/json body
-func NewBatchDownloadFilesRequest(server string, body BatchDownloadFilesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateFolder(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFolderRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewBatchDownloadFilesRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewBatchDownloadFilesRequestWithBody generates requests for BatchDownloadFiles with any type of body
-func NewBatchDownloadFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ResolveFolderPath(ctx context.Context, params *ResolveFolderPathParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResolveFolderPathRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/batch-download")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListSharedFolders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSharedFoldersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUnlinkFileInvoiceRequest generates requests for UnlinkFileInvoice
-func NewUnlinkFileInvoiceRequest(server string, params *UnlinkFileInvoiceParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetFolderTree(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFolderTreeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/invoice")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ExpandFolderTreeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExpandFolderTreeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "invoice_id", runtime.ParamLocationQuery, params.InvoiceId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMoveFilesRequest calls the generic MoveFiles builder with application/json body
-func NewMoveFilesRequest(server string, body MoveFilesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ExpandFolderTree(ctx context.Context, body ExpandFolderTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExpandFolderTreeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMoveFilesRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewMoveFilesRequestWithBody generates requests for MoveFiles with any type of body
-func NewMoveFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteFolder(ctx context.Context, id FolderId, params *DeleteFolderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFolderRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/move")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFolderRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteFileRequest generates requests for DeleteFile
-func NewDeleteFileRequest(server string, id FileId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) UpdateFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFolderRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateFolder(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFolderRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListFolderAliases(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFolderAliasesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFileRequest generates requests for GetFile
-func NewGetFileRequest(server string, id FileId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) AddFolderAliasWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddFolderAliasRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddFolderAlias(ctx context.Context, id FolderId, body AddFolderAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddFolderAliasRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemoveFolderAlias(ctx context.Context, id FolderId, aliasId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveFolderAliasRequest(c.Server, id, aliasId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateFileRequest calls the generic UpdateFile builder with application/json body
-func NewUpdateFileRequest(server string, id FileId, body UpdateFileJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetFolderContents(ctx context.Context, id FolderId, params *GetFolderContentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFolderContentsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateFileRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateFileRequestWithBody generates requests for UpdateFile with any type of body
-func NewUpdateFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetFolderDeletePreview(ctx context.Context, id FolderId, params *GetFolderDeletePreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFolderDeletePreviewRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListFolderDescendants(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFolderDescendantsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DownloadFolder(ctx context.Context, id FolderId, params *DownloadFolderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadFolderRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewStreamAgentProgressRequest generates requests for StreamAgentProgress
-func NewStreamAgentProgressRequest(server string, id FileId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) RemoveFileLinksWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveFileLinksRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveFileLinks(ctx context.Context, id FolderId, body RemoveFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveFileLinksRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s/agent-stream", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddFileLinksWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddFileLinksRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFileDownloadURLRequest generates requests for GetFileDownloadURL
-func NewGetFileDownloadURLRequest(server string, id FileId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) AddFileLinks(ctx context.Context, id FolderId, body AddFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddFileLinksRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListFolderMembers(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFolderMembersRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s/download", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ShareFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShareFolderRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewOrganizeFileRequest generates requests for OrganizeFile
-func NewOrganizeFileRequest(server string, id FileId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) ShareFolder(ctx context.Context, id FolderId, body ShareFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShareFolderRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/files/%s/organize", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UnshareFolder(ctx context.Context, id FolderId, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnshareFolderRequest(c.Server, id, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) MoveFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveFolderRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) MoveFolder(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveFolderRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewProcessFileRequest generates requests for ProcessFile
-func NewProcessFileRequest(server string, id FileId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) RestoreFolder(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreFolderRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveTagsFromFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagsFromFolderRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s/process", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemoveTagsFromFolder(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagsFromFolderRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) ListFolderTags(ctx context.Context, id FolderId, params *ListFolderTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFolderTagsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRemoveTagsFromFileRequest calls the generic RemoveTagsFromFile builder with application/json body
-func NewRemoveTagsFromFileRequest(server string, id FileId, body RemoveTagsFromFileJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AddTagsToFolderWithBody(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagsToFolderRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRemoveTagsFromFileRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRemoveTagsFromFileRequestWithBody generates requests for RemoveTagsFromFile with any type of body
-func NewRemoveTagsFromFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) AddTagsToFolder(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagsToFolderRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListFoldingRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFoldingRulesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s/tags", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateFoldingRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFoldingRuleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+func (c *Client) CreateFoldingRule(ctx context.Context, body CreateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFoldingRuleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddTagsToFileRequest calls the generic AddTagsToFile builder with application/json body
-func NewAddTagsToFileRequest(server string, id FileId, body AddTagsToFileJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteFoldingRule(ctx context.Context, id FoldingRuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFoldingRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddTagsToFileRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddTagsToFileRequestWithBody generates requests for AddTagsToFile with any type of body
-func NewAddTagsToFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) UpdateFoldingRuleWithBody(ctx context.Context, id FoldingRuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFoldingRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateFoldingRule(ctx context.Context, id FoldingRuleId, body UpdateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFoldingRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/files/%s/tags", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ParserCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewParserCallbackRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) ParserCallback(ctx context.Context, body ParserCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewParserCallbackRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) SearchFiles(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchFilesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ListTags(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListFoldersRequest generates requests for ListFolders
-func NewListFoldersRequest(server string, params *ListFoldersParams) (*http.Request, error) {
-	var err error
+func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/folders")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) CreateTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTags(ctx context.Context, body CreateTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) DeleteTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Keyword != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.TagIds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) DeleteTags(ctx context.Context, body DeleteTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) GetTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdateTagWithBody(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateFolderRequest calls the generic CreateFolder builder with application/json body
-func NewCreateFolderRequest(server string, body CreateFolderJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AddTagAliasWithBody(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagAliasRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateFolderRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateFolderRequestWithBody generates requests for CreateFolder with any type of body
-func NewCreateFolderRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) AddTagAlias(ctx context.Context, id TagId, body AddTagAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagAliasRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveTagAlias(ctx context.Context, id TagId, aliasId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagAliasRequest(c.Server, id, aliasId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/folders")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UploadFileWithBody(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadFileRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PresignUploadBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPresignUploadBatchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PresignUploadBatch(ctx context.Context, body PresignUploadBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPresignUploadBatchRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) GetPresignedURL(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPresignedURLRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetFolderTreeRequest generates requests for GetFolderTree
-func NewGetFolderTreeRequest(server string, params *GetFolderTreeParams) (*http.Request, error) {
+// NewGetProcessingStatusRequest generates requests for GetProcessingStatus
+func NewGetProcessingStatusRequest(server string, params *GetProcessingStatusParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1659,7 +1836,7 @@ func NewGetFolderTreeRequest(server string, params *GetFolderTreeParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/tree")
+	operationPath := fmt.Sprintf("/api/admin/processing-status")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1672,9 +1849,9 @@ func NewGetFolderTreeRequest(server string, params *GetFolderTreeParams) (*http.
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.ParentId != nil {
+		if params.WindowMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "window_minutes", runtime.ParamLocationQuery, *params.WindowMinutes); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1699,23 +1876,16 @@ func NewGetFolderTreeRequest(server string, params *GetFolderTreeParams) (*http.
 	return req, nil
 }
 
-// NewDeleteFolderRequest generates requests for DeleteFolder
-func NewDeleteFolderRequest(server string, id FolderId) (*http.Request, error) {
+// NewPauseProcessingRequest generates requests for PauseProcessing
+func NewPauseProcessingRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/admin/processing/pause")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1725,7 +1895,7 @@ func NewDeleteFolderRequest(server string, id FolderId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1733,23 +1903,16 @@ func NewDeleteFolderRequest(server string, id FolderId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetFolderRequest generates requests for GetFolder
-func NewGetFolderRequest(server string, id FolderId) (*http.Request, error) {
+// NewResumeProcessingRequest generates requests for ResumeProcessing
+func NewResumeProcessingRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/admin/processing/resume")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1759,7 +1922,7 @@ func NewGetFolderRequest(server string, id FolderId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1767,34 +1930,27 @@ func NewGetFolderRequest(server string, id FolderId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateFolderRequest calls the generic UpdateFolder builder with application/json body
-func NewUpdateFolderRequest(server string, id FolderId, body UpdateFolderJSONRequestBody) (*http.Request, error) {
+// NewReassignOwnershipRequest calls the generic ReassignOwnership builder with application/json body
+func NewReassignOwnershipRequest(server string, body ReassignOwnershipJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateFolderRequestWithBody(server, id, "application/json", bodyReader)
+	return NewReassignOwnershipRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateFolderRequestWithBody generates requests for UpdateFolder with any type of body
-func NewUpdateFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+// NewReassignOwnershipRequestWithBody generates requests for ReassignOwnership with any type of body
+func NewReassignOwnershipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/admin/reassign")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1804,7 +1960,7 @@ func NewUpdateFolderRequestWithBody(server string, id FolderId, contentType stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1814,34 +1970,43 @@ func NewUpdateFolderRequestWithBody(server string, id FolderId, contentType stri
 	return req, nil
 }
 
-// NewMoveFolderRequest calls the generic MoveFolder builder with application/json body
-func NewMoveFolderRequest(server string, id FolderId, body MoveFolderJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetReembedStatusRequest generates requests for GetReembedStatus
+func NewGetReembedStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMoveFolderRequestWithBody(server, id, "application/json", bodyReader)
-}
 
-// NewMoveFolderRequestWithBody generates requests for MoveFolder with any type of body
-func NewMoveFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/api/admin/reembed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewStartReembedRequest generates requests for StartReembed
+func NewStartReembedRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/%s/move", pathParam0)
+	operationPath := fmt.Sprintf("/api/admin/reembed")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1851,44 +2016,24 @@ func NewMoveFolderRequestWithBody(server string, id FolderId, contentType string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewRemoveTagsFromFolderRequest calls the generic RemoveTagsFromFolder builder with application/json body
-func NewRemoveTagsFromFolderRequest(server string, id FolderId, body RemoveTagsFromFolderJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRemoveTagsFromFolderRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewRemoveTagsFromFolderRequestWithBody generates requests for RemoveTagsFromFolder with any type of body
-func NewRemoveTagsFromFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAgentCapabilitiesRequest generates requests for GetAgentCapabilities
+func NewGetAgentCapabilitiesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/%s/tags", pathParam0)
+	operationPath := fmt.Sprintf("/api/agent/capabilities")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1898,44 +2043,24 @@ func NewRemoveTagsFromFolderRequestWithBody(server string, id FolderId, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAddTagsToFolderRequest calls the generic AddTagsToFolder builder with application/json body
-func NewAddTagsToFolderRequest(server string, id FolderId, body AddTagsToFolderJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddTagsToFolderRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewAddTagsToFolderRequestWithBody generates requests for AddTagsToFolder with any type of body
-func NewAddTagsToFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAgentStatusRequest generates requests for GetAgentStatus
+func NewGetAgentStatusRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/folders/%s/tags", pathParam0)
+	operationPath := fmt.Sprintf("/api/agent/status")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1945,18 +2070,16 @@ func NewAddTagsToFolderRequestWithBody(server string, id FolderId, contentType s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewSearchFilesRequest generates requests for SearchFiles
-func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Request, error) {
+// NewListFilesRequest generates requests for ListFiles
+func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1964,7 +2087,7 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/search")
+	operationPath := fmt.Sprintf("/api/files")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1977,21 +2100,25 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.Type != nil {
+		if params.IncludeFolderName != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_folder_name", runtime.ParamLocationQuery, *params.IncludeFolderName); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2021,9 +2148,9 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 
 		}
 
-		if params.FileType != nil {
+		if params.AllFolders != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "file_type", runtime.ParamLocationQuery, *params.FileType); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all_folders", runtime.ParamLocationQuery, *params.AllFolders); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2037,9 +2164,9 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 
 		}
 
-		if params.TagIds != nil {
+		if params.IncludeLinked != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_linked", runtime.ParamLocationQuery, *params.IncludeLinked); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2053,9 +2180,9 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 
 		}
 
-		if params.Limit != nil {
+		if params.FileType != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "file_type", runtime.ParamLocationQuery, *params.FileType); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2069,9 +2196,9 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 
 		}
 
-		if params.Offset != nil {
+		if params.Ext != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ext", runtime.ParamLocationQuery, *params.Ext); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2085,42 +2212,41 @@ func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Requ
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+		if params.TagIds != nil {
 
-// NewListTagsRequest generates requests for ListTags
-func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/api/tags")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Status != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
 
-		if params.Keyword != nil {
+		if params.ErrorContains != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error_contains", runtime.ParamLocationQuery, *params.ErrorContains); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2134,9 +2260,9 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 
 		}
 
-		if params.Limit != nil {
+		if params.MinWordCount != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_word_count", runtime.ParamLocationQuery, *params.MinWordCount); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2150,9 +2276,9 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 
 		}
 
-		if params.Offset != nil {
+		if params.MaxWordCount != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_word_count", runtime.ParamLocationQuery, *params.MaxWordCount); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2166,84 +2292,250 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+		if params.MinSize != nil {
 
-// NewCreateTagRequest calls the generic CreateTag builder with application/json body
-func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_size", runtime.ParamLocationQuery, *params.MinSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
-func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.MaxSize != nil {
 
-	operationPath := fmt.Sprintf("/api/tags")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_size", runtime.ParamLocationQuery, *params.MaxSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+		if params.CreatedAfter != nil {
 
-	req.Header.Add("Content-Type", contentType)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_after", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewDeleteTagRequest generates requests for DeleteTag
-func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
-	var err error
+		if params.CreatedBefore != nil {
 
-	var pathParam0 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_before", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.UpdatedAfter != nil {
 
-	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updated_after", runtime.ParamLocationQuery, *params.UpdatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		}
+
+		if params.UpdatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updated_before", runtime.ParamLocationQuery, *params.UpdatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Entity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity", runtime.ParamLocationQuery, *params.Entity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_type", runtime.ParamLocationQuery, *params.EntityType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityDateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_date_from", runtime.ParamLocationQuery, *params.EntityDateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityDateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_date_to", runtime.ParamLocationQuery, *params.EntityDateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_by", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_order", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IdsOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ids_only", runtime.ParamLocationQuery, *params.IdsOnly); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2251,23 +2543,27 @@ func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetTagRequest generates requests for GetTag
-func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// NewCreateFileRequest calls the generic CreateFile builder with application/json body
+func NewCreateFileRequest(server string, body CreateFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateFileRequestWithBody generates requests for CreateFile with any type of body
+func NewCreateFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/files")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2277,42 +2573,37 @@ func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
-func NewUpdateTagRequest(server string, id TagId, body UpdateTagJSONRequestBody) (*http.Request, error) {
+// NewBatchDeleteFilesRequest calls the generic BatchDeleteFiles builder with application/json body
+func NewBatchDeleteFilesRequest(server string, body BatchDeleteFilesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTagRequestWithBody(server, id, "application/json", bodyReader)
+	return NewBatchDeleteFilesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
-func NewUpdateTagRequestWithBody(server string, id TagId, contentType string, body io.Reader) (*http.Request, error) {
+// NewBatchDeleteFilesRequestWithBody generates requests for BatchDeleteFiles with any type of body
+func NewBatchDeleteFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/files/batch-delete")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2322,7 +2613,7 @@ func NewUpdateTagRequestWithBody(server string, id TagId, contentType string, bo
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2332,8 +2623,19 @@ func NewUpdateTagRequestWithBody(server string, id TagId, contentType string, bo
 	return req, nil
 }
 
-// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
-func NewUploadFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewBatchDownloadFilesRequest calls the generic BatchDownloadFiles builder with application/json body
+func NewBatchDownloadFilesRequest(server string, body BatchDownloadFilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchDownloadFilesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBatchDownloadFilesRequestWithBody generates requests for BatchDownloadFiles with any type of body
+func NewBatchDownloadFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2341,7 +2643,7 @@ func NewUploadFileRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/upload")
+	operationPath := fmt.Sprintf("/api/files/batch-download")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2361,8 +2663,8 @@ func NewUploadFileRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewGetPresignedURLRequest generates requests for GetPresignedURL
-func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*http.Request, error) {
+// NewListFileChangesRequest generates requests for ListFileChanges
+func NewListFileChangesRequest(server string, params *ListFileChangesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2370,7 +2672,7 @@ func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/upload/presigned")
+	operationPath := fmt.Sprintf("/api/files/changes")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2383,21 +2685,41 @@ func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*h
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filename", runtime.ParamLocationQuery, params.Filename); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.ContentType != nil {
+		if params.Cursor != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "content_type", runtime.ParamLocationQuery, *params.ContentType); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2422,8 +2744,8 @@ func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*h
 	return req, nil
 }
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
+// NewGetProcessingErrorSummaryRequest generates requests for GetProcessingErrorSummary
+func NewGetProcessingErrorSummaryRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2431,7 +2753,7 @@ func NewHealthCheckRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
+	operationPath := fmt.Sprintf("/api/files/errors/summary")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2449,1407 +2771,9392 @@ func NewHealthCheckRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewListFilesGroupedRequest generates requests for ListFilesGrouped
+func NewListFilesGroupedRequest(server string, params *ListFilesGroupedParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/api/files/grouped")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAgentStatusWithResponse request
-	GetAgentStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentStatusResponse, error)
-
-	// ListFilesWithResponse request
-	ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error)
 
-	// CreateFileWithBodyWithResponse request with any body
-	CreateFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFileResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateFileWithResponse(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFileResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// BatchDownloadFilesWithBodyWithResponse request with any body
-	BatchDownloadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error)
+		if params.RootFolderId != nil {
 
-	BatchDownloadFilesWithResponse(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "root_folder_id", runtime.ParamLocationQuery, *params.RootFolderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UnlinkFileInvoiceWithResponse request
-	UnlinkFileInvoiceWithResponse(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*UnlinkFileInvoiceResponse, error)
+		}
 
-	// MoveFilesWithBodyWithResponse request with any body
-	MoveFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error)
+		if params.MaxDepth != nil {
 
-	MoveFilesWithResponse(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_depth", runtime.ParamLocationQuery, *params.MaxDepth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteFileWithResponse request
-	DeleteFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error)
+		}
 
-	// GetFileWithResponse request
-	GetFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileResponse, error)
+		if params.FilesPerFolder != nil {
 
-	// UpdateFileWithBodyWithResponse request with any body
-	UpdateFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "files_per_folder", runtime.ParamLocationQuery, *params.FilesPerFolder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	UpdateFileWithResponse(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error)
+		}
 
-	// StreamAgentProgressWithResponse request
-	StreamAgentProgressWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*StreamAgentProgressResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetFileDownloadURLWithResponse request
-	GetFileDownloadURLWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// OrganizeFileWithResponse request
-	OrganizeFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*OrganizeFileResponse, error)
+	return req, nil
+}
 
-	// ProcessFileWithResponse request
-	ProcessFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*ProcessFileResponse, error)
+// NewUnlinkFileInvoiceRequest generates requests for UnlinkFileInvoice
+func NewUnlinkFileInvoiceRequest(server string, params *UnlinkFileInvoiceParams) (*http.Request, error) {
+	var err error
 
-	// RemoveTagsFromFileWithBodyWithResponse request with any body
-	RemoveTagsFromFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	RemoveTagsFromFileWithResponse(ctx context.Context, id FileId, body RemoveTagsFromFileJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error)
+	operationPath := fmt.Sprintf("/api/files/invoice")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AddTagsToFileWithBodyWithResponse request with any body
-	AddTagsToFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AddTagsToFileWithResponse(ctx context.Context, id FileId, body AddTagsToFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// ListFoldersWithResponse request
-	ListFoldersWithResponse(ctx context.Context, params *ListFoldersParams, reqEditors ...RequestEditorFn) (*ListFoldersResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "invoice_id", runtime.ParamLocationQuery, params.InvoiceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// CreateFolderWithBodyWithResponse request with any body
-	CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+		if params.RemoveRelations != nil {
 
-	CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "remove_relations", runtime.ParamLocationQuery, *params.RemoveRelations); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetFolderTreeWithResponse request
-	GetFolderTreeWithResponse(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*GetFolderTreeResponse, error)
+		}
 
-	// DeleteFolderWithResponse request
-	DeleteFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*DeleteFolderResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetFolderWithResponse request
-	GetFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*GetFolderResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateFolderWithBodyWithResponse request with any body
-	UpdateFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error)
+	return req, nil
+}
 
-	UpdateFolderWithResponse(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error)
+// NewMoveFilesRequest calls the generic MoveFiles builder with application/json body
+func NewMoveFilesRequest(server string, body MoveFilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveFilesRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// MoveFolderWithBodyWithResponse request with any body
-	MoveFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error)
+// NewMoveFilesRequestWithBody generates requests for MoveFiles with any type of body
+func NewMoveFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	MoveFolderWithResponse(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// RemoveTagsFromFolderWithBodyWithResponse request with any body
-	RemoveTagsFromFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error)
+	operationPath := fmt.Sprintf("/api/files/move")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	RemoveTagsFromFolderWithResponse(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddTagsToFolderWithBodyWithResponse request with any body
-	AddTagsToFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	AddTagsToFolderWithResponse(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// SearchFilesWithResponse request
-	SearchFilesWithResponse(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*SearchFilesResponse, error)
+	return req, nil
+}
 
-	// ListTagsWithResponse request
-	ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
-
-	// CreateTagWithBodyWithResponse request with any body
-	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
-
-	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+// NewMoveFilesByFilterRequest calls the generic MoveFilesByFilter builder with application/json body
+func NewMoveFilesByFilterRequest(server string, body MoveFilesByFilterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveFilesByFilterRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// DeleteTagWithResponse request
-	DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+// NewMoveFilesByFilterRequestWithBody generates requests for MoveFilesByFilter with any type of body
+func NewMoveFilesByFilterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetTagWithResponse request
-	GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateTagWithBodyWithResponse request with any body
-	UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+	operationPath := fmt.Sprintf("/api/files/move-by-filter")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UploadFileWithBodyWithResponse request with any body
-	UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetPresignedURLWithResponse request
-	GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// HealthCheckWithResponse request
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+	return req, nil
 }
 
-type GetAgentStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AgentStatusResponse
+// NewCancelFilesProcessingRequest calls the generic CancelFilesProcessing builder with application/json body
+func NewCancelFilesProcessingRequest(server string, body CancelFilesProcessingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelFilesProcessingRequestWithBody(server, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAgentStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCancelFilesProcessingRequestWithBody generates requests for CancelFilesProcessing with any type of body
+func NewCancelFilesProcessingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAgentStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/files/process/cancel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type ListFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FileListResponse
-	JSON401      *Unauthorized
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewRetryFilesProcessingRequest calls the generic RetryFilesProcessing builder with application/json body
+func NewRetryFilesProcessingRequest(server string, body RetryFilesProcessingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewRetryFilesProcessingRequestWithBody(server, "application/json", bodyReader)
 }
 
-type CreateFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *File
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+// NewRetryFilesProcessingRequestWithBody generates requests for RetryFilesProcessing with any type of body
+func NewRetryFilesProcessingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/files/process/retry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type BatchDownloadFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r BatchDownloadFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r BatchDownloadFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetFileByPublicIDRequest generates requests for GetFileByPublicID
+func NewGetFileByPublicIDRequest(server string, publicId FilePublicId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "public_id", runtime.ParamLocationPath, publicId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UnlinkFileInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UnlinkFileInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/files/public/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UnlinkFileInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type MoveFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message    string `json:"message"`
-		MovedCount int    `json:"moved_count"`
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *BadRequest
-	JSON401 *Unauthorized
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r MoveFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetFileDownloadURLByPublicIDRequest generates requests for GetFileDownloadURLByPublicID
+func NewGetFileDownloadURLByPublicIDRequest(server string, publicId FilePublicId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "public_id", runtime.ParamLocationPath, publicId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MoveFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	operationPath := fmt.Sprintf("/api/files/public/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type GetFileResponse struct {
-	Body         []byte
+// NewReclassifyFilesRequest calls the generic ReclassifyFiles builder with application/json body
+func NewReclassifyFilesRequest(server string, body ReclassifyFilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReclassifyFilesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReclassifyFilesRequestWithBody generates requests for ReclassifyFiles with any type of body
+func NewReclassifyFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/reclassify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStreamFilesRequest generates requests for StreamFiles
+func NewStreamFilesRequest(server string, params *StreamFilesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FolderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "folder_id", runtime.ParamLocationQuery, *params.FolderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AllFolders != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all_folders", runtime.ParamLocationQuery, *params.AllFolders); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeLinked != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_linked", runtime.ParamLocationQuery, *params.IncludeLinked); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FileType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "file_type", runtime.ParamLocationQuery, *params.FileType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Ext != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ext", runtime.ParamLocationQuery, *params.Ext); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Entity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity", runtime.ParamLocationQuery, *params.Entity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_type", runtime.ParamLocationQuery, *params.EntityType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityDateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_date_from", runtime.ParamLocationQuery, *params.EntityDateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityDateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_date_to", runtime.ParamLocationQuery, *params.EntityDateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteFileRequest generates requests for DeleteFile
+func NewDeleteFileRequest(server string, id FileId, params *DeleteFileParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CascadeRelations != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cascade_relations", runtime.ParamLocationQuery, *params.CascadeRelations); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFileRequest generates requests for GetFile
+func NewGetFileRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFileRequest calls the generic UpdateFile builder with application/json body
+func NewUpdateFileRequest(server string, id FileId, body UpdateFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFileRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateFileRequestWithBody generates requests for UpdateFile with any type of body
+func NewUpdateFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStreamAgentProgressRequest generates requests for StreamAgentProgress
+func NewStreamAgentProgressRequest(server string, id FileId, params *StreamAgentProgressParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/agent-stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFileAssociationsRequest generates requests for GetFileAssociations
+func NewGetFileAssociationsRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/associations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFileContentTextRequest generates requests for GetFileContentText
+func NewGetFileContentTextRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/content.txt", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCopyFileRequest calls the generic CopyFile builder with application/json body
+func NewCopyFileRequest(server string, id FileId, body CopyFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCopyFileRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCopyFileRequestWithBody generates requests for CopyFile with any type of body
+func NewCopyFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/copy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFileDownloadURLRequest generates requests for GetFileDownloadURL
+func NewGetFileDownloadURLRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewClearFileEmbeddingRequest generates requests for ClearFileEmbedding
+func NewClearFileEmbeddingRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/embedding/clear", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOrganizeFileRequest generates requests for OrganizeFile
+func NewOrganizeFileRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/organize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessFileRequest generates requests for ProcessFile
+func NewProcessFileRequest(server string, id FileId, params *ProcessFileParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/process", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WaitTimeout != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait_timeout", runtime.ParamLocationQuery, *params.WaitTimeout); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SummaryModel != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "summary_model", runtime.ParamLocationQuery, *params.SummaryModel); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AgentModel != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "agent_model", runtime.ParamLocationQuery, *params.AgentModel); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListFileRelationsRequest generates requests for ListFileRelations
+func NewListFileRelationsRequest(server string, id FileId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/relations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddFileRelationRequest calls the generic AddFileRelation builder with application/json body
+func NewAddFileRelationRequest(server string, id FileId, body AddFileRelationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddFileRelationRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddFileRelationRequestWithBody generates requests for AddFileRelation with any type of body
+func NewAddFileRelationRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/relations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveFileRelationRequest generates requests for RemoveFileRelation
+func NewRemoveFileRelationRequest(server string, id FileId, relatedFileId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "related_file_id", runtime.ParamLocationPath, relatedFileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/relations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveTagsFromFileRequest calls the generic RemoveTagsFromFile builder with application/json body
+func NewRemoveTagsFromFileRequest(server string, id FileId, body RemoveTagsFromFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveTagsFromFileRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveTagsFromFileRequestWithBody generates requests for RemoveTagsFromFile with any type of body
+func NewRemoveTagsFromFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddTagsToFileRequest calls the generic AddTagsToFile builder with application/json body
+func NewAddTagsToFileRequest(server string, id FileId, body AddTagsToFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTagsToFileRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTagsToFileRequestWithBody generates requests for AddTagsToFile with any type of body
+func NewAddTagsToFileRequestWithBody(server string, id FileId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/files/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFoldersRequest generates requests for ListFolders
+func NewListFoldersRequest(server string, params *ListFoldersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IdsOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ids_only", runtime.ParamLocationQuery, *params.IdsOnly); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateFolderRequest calls the generic CreateFolder builder with application/json body
+func NewCreateFolderRequest(server string, body CreateFolderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFolderRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateFolderRequestWithBody generates requests for CreateFolder with any type of body
+func NewCreateFolderRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResolveFolderPathRequest generates requests for ResolveFolderPath
+func NewResolveFolderPathRequest(server string, params *ResolveFolderPathParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/resolve")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSharedFoldersRequest generates requests for ListSharedFolders
+func NewListSharedFoldersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/shared")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFolderTreeRequest generates requests for GetFolderTree
+func NewGetFolderTreeRequest(server string, params *GetFolderTreeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/tree")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ParentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WithCounts != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "with_counts", runtime.ParamLocationQuery, *params.WithCounts); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExpandFolderTreeRequest calls the generic ExpandFolderTree builder with application/json body
+func NewExpandFolderTreeRequest(server string, body ExpandFolderTreeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExpandFolderTreeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExpandFolderTreeRequestWithBody generates requests for ExpandFolderTree with any type of body
+func NewExpandFolderTreeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/tree/expand")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFolderRequest generates requests for DeleteFolder
+func NewDeleteFolderRequest(server string, id FolderId, params *DeleteFolderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFolderIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_folder_ids", runtime.ParamLocationQuery, *params.ExcludeFolderIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFolderRequest generates requests for GetFolder
+func NewGetFolderRequest(server string, id FolderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFolderRequest calls the generic UpdateFolder builder with application/json body
+func NewUpdateFolderRequest(server string, id FolderId, body UpdateFolderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFolderRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateFolderRequestWithBody generates requests for UpdateFolder with any type of body
+func NewUpdateFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFolderAliasesRequest generates requests for ListFolderAliases
+func NewListFolderAliasesRequest(server string, id FolderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/aliases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddFolderAliasRequest calls the generic AddFolderAlias builder with application/json body
+func NewAddFolderAliasRequest(server string, id FolderId, body AddFolderAliasJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddFolderAliasRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddFolderAliasRequestWithBody generates requests for AddFolderAlias with any type of body
+func NewAddFolderAliasRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/aliases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveFolderAliasRequest generates requests for RemoveFolderAlias
+func NewRemoveFolderAliasRequest(server string, id FolderId, aliasId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "alias_id", runtime.ParamLocationPath, aliasId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/aliases/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFolderContentsRequest generates requests for GetFolderContents
+func NewGetFolderContentsRequest(server string, id FolderId, params *GetFolderContentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/contents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFolderDeletePreviewRequest generates requests for GetFolderDeletePreview
+func NewGetFolderDeletePreviewRequest(server string, id FolderId, params *GetFolderDeletePreviewParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/delete-preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFolderIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_folder_ids", runtime.ParamLocationQuery, *params.ExcludeFolderIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListFolderDescendantsRequest generates requests for ListFolderDescendants
+func NewListFolderDescendantsRequest(server string, id FolderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/descendants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadFolderRequest generates requests for DownloadFolder
+func NewDownloadFolderRequest(server string, id FolderId, params *DownloadFolderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Recursive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recursive", runtime.ParamLocationQuery, *params.Recursive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeDirect != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_direct", runtime.ParamLocationQuery, *params.ExcludeDirect); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeFolderIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_folder_ids", runtime.ParamLocationQuery, *params.ExcludeFolderIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveFileLinksRequest calls the generic RemoveFileLinks builder with application/json body
+func NewRemoveFileLinksRequest(server string, id FolderId, body RemoveFileLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveFileLinksRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveFileLinksRequestWithBody generates requests for RemoveFileLinks with any type of body
+func NewRemoveFileLinksRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddFileLinksRequest calls the generic AddFileLinks builder with application/json body
+func NewAddFileLinksRequest(server string, id FolderId, body AddFileLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddFileLinksRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddFileLinksRequestWithBody generates requests for AddFileLinks with any type of body
+func NewAddFileLinksRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFolderMembersRequest generates requests for ListFolderMembers
+func NewListFolderMembersRequest(server string, id FolderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewShareFolderRequest calls the generic ShareFolder builder with application/json body
+func NewShareFolderRequest(server string, id FolderId, body ShareFolderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewShareFolderRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewShareFolderRequestWithBody generates requests for ShareFolder with any type of body
+func NewShareFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnshareFolderRequest generates requests for UnshareFolder
+func NewUnshareFolderRequest(server string, id FolderId, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMoveFolderRequest calls the generic MoveFolder builder with application/json body
+func NewMoveFolderRequest(server string, id FolderId, body MoveFolderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveFolderRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewMoveFolderRequestWithBody generates requests for MoveFolder with any type of body
+func NewMoveFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreFolderRequest generates requests for RestoreFolder
+func NewRestoreFolderRequest(server string, id FolderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveTagsFromFolderRequest calls the generic RemoveTagsFromFolder builder with application/json body
+func NewRemoveTagsFromFolderRequest(server string, id FolderId, body RemoveTagsFromFolderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveTagsFromFolderRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveTagsFromFolderRequestWithBody generates requests for RemoveTagsFromFolder with any type of body
+func NewRemoveTagsFromFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFolderTagsRequest generates requests for ListFolderTags
+func NewListFolderTagsRequest(server string, id FolderId, params *ListFolderTagsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Recursive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recursive", runtime.ParamLocationQuery, *params.Recursive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTagsToFolderRequest calls the generic AddTagsToFolder builder with application/json body
+func NewAddTagsToFolderRequest(server string, id FolderId, body AddTagsToFolderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTagsToFolderRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTagsToFolderRequestWithBody generates requests for AddTagsToFolder with any type of body
+func NewAddTagsToFolderRequestWithBody(server string, id FolderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folders/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFoldingRulesRequest generates requests for ListFoldingRules
+func NewListFoldingRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folding-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateFoldingRuleRequest calls the generic CreateFoldingRule builder with application/json body
+func NewCreateFoldingRuleRequest(server string, body CreateFoldingRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFoldingRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateFoldingRuleRequestWithBody generates requests for CreateFoldingRule with any type of body
+func NewCreateFoldingRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folding-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFoldingRuleRequest generates requests for DeleteFoldingRule
+func NewDeleteFoldingRuleRequest(server string, id FoldingRuleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folding-rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFoldingRuleRequest calls the generic UpdateFoldingRule builder with application/json body
+func NewUpdateFoldingRuleRequest(server string, id FoldingRuleId, body UpdateFoldingRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFoldingRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateFoldingRuleRequestWithBody generates requests for UpdateFoldingRule with any type of body
+func NewUpdateFoldingRuleRequestWithBody(server string, id FoldingRuleId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/folding-rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewParserCallbackRequest calls the generic ParserCallback builder with application/json body
+func NewParserCallbackRequest(server string, body ParserCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewParserCallbackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewParserCallbackRequestWithBody generates requests for ParserCallback with any type of body
+func NewParserCallbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/internal/parser-callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchFilesRequest generates requests for SearchFiles
+func NewSearchFilesRequest(server string, params *SearchFilesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FolderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "folder_id", runtime.ParamLocationQuery, *params.FolderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ScopeFolderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope_folder_id", runtime.ParamLocationQuery, *params.ScopeFolderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FileType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "file_type", runtime.ParamLocationQuery, *params.FileType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BoostTagIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "boost_tag_ids", runtime.ParamLocationQuery, *params.BoostTagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TitleOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title_only", runtime.ParamLocationQuery, *params.TitleOnly); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeFolderName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_folder_name", runtime.ParamLocationQuery, *params.IncludeFolderName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SnippetLength != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "snippet_length", runtime.ParamLocationQuery, *params.SnippetLength); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RecencyHalfLifeDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recency_half_life_days", runtime.ParamLocationQuery, *params.RecencyHalfLifeDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Rerank != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rerank", runtime.ParamLocationQuery, *params.Rerank); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeRawScores != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_raw_scores", runtime.ParamLocationQuery, *params.IncludeRawScores); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTagsRequest generates requests for ListTags
+func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateTagsRequest calls the generic CreateTags builder with application/json body
+func NewCreateTagsRequest(server string, body CreateTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTagsRequestWithBody generates requests for CreateTags with any type of body
+func NewCreateTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTagsRequest calls the generic DeleteTags builder with application/json body
+func NewDeleteTagsRequest(server string, body DeleteTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteTagsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteTagsRequestWithBody generates requests for DeleteTags with any type of body
+func NewDeleteTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/bulk-delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTagRequest generates requests for GetTag
+func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
+func NewUpdateTagRequest(server string, id TagId, body UpdateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTagRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
+func NewUpdateTagRequestWithBody(server string, id TagId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddTagAliasRequest calls the generic AddTagAlias builder with application/json body
+func NewAddTagAliasRequest(server string, id TagId, body AddTagAliasJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTagAliasRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTagAliasRequestWithBody generates requests for AddTagAlias with any type of body
+func NewAddTagAliasRequestWithBody(server string, id TagId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s/aliases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveTagAliasRequest generates requests for RemoveTagAlias
+func NewRemoveTagAliasRequest(server string, id TagId, aliasId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "alias_id", runtime.ParamLocationPath, aliasId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s/aliases/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
+func NewUploadFileRequestWithBody(server string, params *UploadFileParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.FolderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "folder_id", runtime.ParamLocationQuery, *params.FolderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPresignUploadBatchRequest calls the generic PresignUploadBatch builder with application/json body
+func NewPresignUploadBatchRequest(server string, body PresignUploadBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPresignUploadBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPresignUploadBatchRequestWithBody generates requests for PresignUploadBatch with any type of body
+func NewPresignUploadBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload/presign-batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPresignedURLRequest generates requests for GetPresignedURL
+func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload/presigned")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filename", runtime.ParamLocationQuery, params.Filename); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.ContentType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "content_type", runtime.ParamLocationQuery, *params.ContentType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FolderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "folder_id", runtime.ParamLocationQuery, *params.FolderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetProcessingStatusWithResponse request
+	GetProcessingStatusWithResponse(ctx context.Context, params *GetProcessingStatusParams, reqEditors ...RequestEditorFn) (*GetProcessingStatusResponse, error)
+
+	// PauseProcessingWithResponse request
+	PauseProcessingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PauseProcessingResponse, error)
+
+	// ResumeProcessingWithResponse request
+	ResumeProcessingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResumeProcessingResponse, error)
+
+	// ReassignOwnershipWithBodyWithResponse request with any body
+	ReassignOwnershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignOwnershipResponse, error)
+
+	ReassignOwnershipWithResponse(ctx context.Context, body ReassignOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignOwnershipResponse, error)
+
+	// GetReembedStatusWithResponse request
+	GetReembedStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReembedStatusResponse, error)
+
+	// StartReembedWithResponse request
+	StartReembedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StartReembedResponse, error)
+
+	// GetAgentCapabilitiesWithResponse request
+	GetAgentCapabilitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentCapabilitiesResponse, error)
+
+	// GetAgentStatusWithResponse request
+	GetAgentStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentStatusResponse, error)
+
+	// ListFilesWithResponse request
+	ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error)
+
+	// CreateFileWithBodyWithResponse request with any body
+	CreateFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFileResponse, error)
+
+	CreateFileWithResponse(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFileResponse, error)
+
+	// BatchDeleteFilesWithBodyWithResponse request with any body
+	BatchDeleteFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchDeleteFilesResponse, error)
+
+	BatchDeleteFilesWithResponse(ctx context.Context, body BatchDeleteFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchDeleteFilesResponse, error)
+
+	// BatchDownloadFilesWithBodyWithResponse request with any body
+	BatchDownloadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error)
+
+	BatchDownloadFilesWithResponse(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error)
+
+	// ListFileChangesWithResponse request
+	ListFileChangesWithResponse(ctx context.Context, params *ListFileChangesParams, reqEditors ...RequestEditorFn) (*ListFileChangesResponse, error)
+
+	// GetProcessingErrorSummaryWithResponse request
+	GetProcessingErrorSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProcessingErrorSummaryResponse, error)
+
+	// ListFilesGroupedWithResponse request
+	ListFilesGroupedWithResponse(ctx context.Context, params *ListFilesGroupedParams, reqEditors ...RequestEditorFn) (*ListFilesGroupedResponse, error)
+
+	// UnlinkFileInvoiceWithResponse request
+	UnlinkFileInvoiceWithResponse(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*UnlinkFileInvoiceResponse, error)
+
+	// MoveFilesWithBodyWithResponse request with any body
+	MoveFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error)
+
+	MoveFilesWithResponse(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error)
+
+	// MoveFilesByFilterWithBodyWithResponse request with any body
+	MoveFilesByFilterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFilesByFilterResponse, error)
+
+	MoveFilesByFilterWithResponse(ctx context.Context, body MoveFilesByFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFilesByFilterResponse, error)
+
+	// CancelFilesProcessingWithBodyWithResponse request with any body
+	CancelFilesProcessingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelFilesProcessingResponse, error)
+
+	CancelFilesProcessingWithResponse(ctx context.Context, body CancelFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelFilesProcessingResponse, error)
+
+	// RetryFilesProcessingWithBodyWithResponse request with any body
+	RetryFilesProcessingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetryFilesProcessingResponse, error)
+
+	RetryFilesProcessingWithResponse(ctx context.Context, body RetryFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*RetryFilesProcessingResponse, error)
+
+	// GetFileByPublicIDWithResponse request
+	GetFileByPublicIDWithResponse(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*GetFileByPublicIDResponse, error)
+
+	// GetFileDownloadURLByPublicIDWithResponse request
+	GetFileDownloadURLByPublicIDWithResponse(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*GetFileDownloadURLByPublicIDResponse, error)
+
+	// ReclassifyFilesWithBodyWithResponse request with any body
+	ReclassifyFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReclassifyFilesResponse, error)
+
+	ReclassifyFilesWithResponse(ctx context.Context, body ReclassifyFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReclassifyFilesResponse, error)
+
+	// StreamFilesWithResponse request
+	StreamFilesWithResponse(ctx context.Context, params *StreamFilesParams, reqEditors ...RequestEditorFn) (*StreamFilesResponse, error)
+
+	// DeleteFileWithResponse request
+	DeleteFileWithResponse(ctx context.Context, id FileId, params *DeleteFileParams, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error)
+
+	// GetFileWithResponse request
+	GetFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileResponse, error)
+
+	// UpdateFileWithBodyWithResponse request with any body
+	UpdateFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error)
+
+	UpdateFileWithResponse(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error)
+
+	// StreamAgentProgressWithResponse request
+	StreamAgentProgressWithResponse(ctx context.Context, id FileId, params *StreamAgentProgressParams, reqEditors ...RequestEditorFn) (*StreamAgentProgressResponse, error)
+
+	// GetFileAssociationsWithResponse request
+	GetFileAssociationsWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileAssociationsResponse, error)
+
+	// GetFileContentTextWithResponse request
+	GetFileContentTextWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileContentTextResponse, error)
+
+	// CopyFileWithBodyWithResponse request with any body
+	CopyFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyFileResponse, error)
+
+	CopyFileWithResponse(ctx context.Context, id FileId, body CopyFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyFileResponse, error)
+
+	// GetFileDownloadURLWithResponse request
+	GetFileDownloadURLWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error)
+
+	// ClearFileEmbeddingWithResponse request
+	ClearFileEmbeddingWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*ClearFileEmbeddingResponse, error)
+
+	// OrganizeFileWithResponse request
+	OrganizeFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*OrganizeFileResponse, error)
+
+	// ProcessFileWithResponse request
+	ProcessFileWithResponse(ctx context.Context, id FileId, params *ProcessFileParams, reqEditors ...RequestEditorFn) (*ProcessFileResponse, error)
+
+	// ListFileRelationsWithResponse request
+	ListFileRelationsWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*ListFileRelationsResponse, error)
+
+	// AddFileRelationWithBodyWithResponse request with any body
+	AddFileRelationWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddFileRelationResponse, error)
+
+	AddFileRelationWithResponse(ctx context.Context, id FileId, body AddFileRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*AddFileRelationResponse, error)
+
+	// RemoveFileRelationWithResponse request
+	RemoveFileRelationWithResponse(ctx context.Context, id FileId, relatedFileId int, reqEditors ...RequestEditorFn) (*RemoveFileRelationResponse, error)
+
+	// RemoveTagsFromFileWithBodyWithResponse request with any body
+	RemoveTagsFromFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error)
+
+	RemoveTagsFromFileWithResponse(ctx context.Context, id FileId, body RemoveTagsFromFileJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error)
+
+	// AddTagsToFileWithBodyWithResponse request with any body
+	AddTagsToFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error)
+
+	AddTagsToFileWithResponse(ctx context.Context, id FileId, body AddTagsToFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error)
+
+	// ListFoldersWithResponse request
+	ListFoldersWithResponse(ctx context.Context, params *ListFoldersParams, reqEditors ...RequestEditorFn) (*ListFoldersResponse, error)
+
+	// CreateFolderWithBodyWithResponse request with any body
+	CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+
+	CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+
+	// ResolveFolderPathWithResponse request
+	ResolveFolderPathWithResponse(ctx context.Context, params *ResolveFolderPathParams, reqEditors ...RequestEditorFn) (*ResolveFolderPathResponse, error)
+
+	// ListSharedFoldersWithResponse request
+	ListSharedFoldersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSharedFoldersResponse, error)
+
+	// GetFolderTreeWithResponse request
+	GetFolderTreeWithResponse(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*GetFolderTreeResponse, error)
+
+	// ExpandFolderTreeWithBodyWithResponse request with any body
+	ExpandFolderTreeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExpandFolderTreeResponse, error)
+
+	ExpandFolderTreeWithResponse(ctx context.Context, body ExpandFolderTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*ExpandFolderTreeResponse, error)
+
+	// DeleteFolderWithResponse request
+	DeleteFolderWithResponse(ctx context.Context, id FolderId, params *DeleteFolderParams, reqEditors ...RequestEditorFn) (*DeleteFolderResponse, error)
+
+	// GetFolderWithResponse request
+	GetFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*GetFolderResponse, error)
+
+	// UpdateFolderWithBodyWithResponse request with any body
+	UpdateFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error)
+
+	UpdateFolderWithResponse(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error)
+
+	// ListFolderAliasesWithResponse request
+	ListFolderAliasesWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*ListFolderAliasesResponse, error)
+
+	// AddFolderAliasWithBodyWithResponse request with any body
+	AddFolderAliasWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddFolderAliasResponse, error)
+
+	AddFolderAliasWithResponse(ctx context.Context, id FolderId, body AddFolderAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*AddFolderAliasResponse, error)
+
+	// RemoveFolderAliasWithResponse request
+	RemoveFolderAliasWithResponse(ctx context.Context, id FolderId, aliasId int, reqEditors ...RequestEditorFn) (*RemoveFolderAliasResponse, error)
+
+	// GetFolderContentsWithResponse request
+	GetFolderContentsWithResponse(ctx context.Context, id FolderId, params *GetFolderContentsParams, reqEditors ...RequestEditorFn) (*GetFolderContentsResponse, error)
+
+	// GetFolderDeletePreviewWithResponse request
+	GetFolderDeletePreviewWithResponse(ctx context.Context, id FolderId, params *GetFolderDeletePreviewParams, reqEditors ...RequestEditorFn) (*GetFolderDeletePreviewResponse, error)
+
+	// ListFolderDescendantsWithResponse request
+	ListFolderDescendantsWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*ListFolderDescendantsResponse, error)
+
+	// DownloadFolderWithResponse request
+	DownloadFolderWithResponse(ctx context.Context, id FolderId, params *DownloadFolderParams, reqEditors ...RequestEditorFn) (*DownloadFolderResponse, error)
+
+	// RemoveFileLinksWithBodyWithResponse request with any body
+	RemoveFileLinksWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveFileLinksResponse, error)
+
+	RemoveFileLinksWithResponse(ctx context.Context, id FolderId, body RemoveFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveFileLinksResponse, error)
+
+	// AddFileLinksWithBodyWithResponse request with any body
+	AddFileLinksWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddFileLinksResponse, error)
+
+	AddFileLinksWithResponse(ctx context.Context, id FolderId, body AddFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*AddFileLinksResponse, error)
+
+	// ListFolderMembersWithResponse request
+	ListFolderMembersWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*ListFolderMembersResponse, error)
+
+	// ShareFolderWithBodyWithResponse request with any body
+	ShareFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareFolderResponse, error)
+
+	ShareFolderWithResponse(ctx context.Context, id FolderId, body ShareFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareFolderResponse, error)
+
+	// UnshareFolderWithResponse request
+	UnshareFolderWithResponse(ctx context.Context, id FolderId, userId string, reqEditors ...RequestEditorFn) (*UnshareFolderResponse, error)
+
+	// MoveFolderWithBodyWithResponse request with any body
+	MoveFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error)
+
+	MoveFolderWithResponse(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error)
+
+	// RestoreFolderWithResponse request
+	RestoreFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*RestoreFolderResponse, error)
+
+	// RemoveTagsFromFolderWithBodyWithResponse request with any body
+	RemoveTagsFromFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error)
+
+	RemoveTagsFromFolderWithResponse(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error)
+
+	// ListFolderTagsWithResponse request
+	ListFolderTagsWithResponse(ctx context.Context, id FolderId, params *ListFolderTagsParams, reqEditors ...RequestEditorFn) (*ListFolderTagsResponse, error)
+
+	// AddTagsToFolderWithBodyWithResponse request with any body
+	AddTagsToFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error)
+
+	AddTagsToFolderWithResponse(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error)
+
+	// ListFoldingRulesWithResponse request
+	ListFoldingRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListFoldingRulesResponse, error)
+
+	// CreateFoldingRuleWithBodyWithResponse request with any body
+	CreateFoldingRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFoldingRuleResponse, error)
+
+	CreateFoldingRuleWithResponse(ctx context.Context, body CreateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFoldingRuleResponse, error)
+
+	// DeleteFoldingRuleWithResponse request
+	DeleteFoldingRuleWithResponse(ctx context.Context, id FoldingRuleId, reqEditors ...RequestEditorFn) (*DeleteFoldingRuleResponse, error)
+
+	// UpdateFoldingRuleWithBodyWithResponse request with any body
+	UpdateFoldingRuleWithBodyWithResponse(ctx context.Context, id FoldingRuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFoldingRuleResponse, error)
+
+	UpdateFoldingRuleWithResponse(ctx context.Context, id FoldingRuleId, body UpdateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFoldingRuleResponse, error)
+
+	// ParserCallbackWithBodyWithResponse request with any body
+	ParserCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ParserCallbackResponse, error)
+
+	ParserCallbackWithResponse(ctx context.Context, body ParserCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*ParserCallbackResponse, error)
+
+	// SearchFilesWithResponse request
+	SearchFilesWithResponse(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*SearchFilesResponse, error)
+
+	// ListTagsWithResponse request
+	ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	// CreateTagsWithBodyWithResponse request with any body
+	CreateTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagsResponse, error)
+
+	CreateTagsWithResponse(ctx context.Context, body CreateTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagsResponse, error)
+
+	// DeleteTagsWithBodyWithResponse request with any body
+	DeleteTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTagsResponse, error)
+
+	DeleteTagsWithResponse(ctx context.Context, body DeleteTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTagsResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// GetTagWithResponse request
+	GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+
+	// UpdateTagWithBodyWithResponse request with any body
+	UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	// AddTagAliasWithBodyWithResponse request with any body
+	AddTagAliasWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagAliasResponse, error)
+
+	AddTagAliasWithResponse(ctx context.Context, id TagId, body AddTagAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagAliasResponse, error)
+
+	// RemoveTagAliasWithResponse request
+	RemoveTagAliasWithResponse(ctx context.Context, id TagId, aliasId int, reqEditors ...RequestEditorFn) (*RemoveTagAliasResponse, error)
+
+	// UploadFileWithBodyWithResponse request with any body
+	UploadFileWithBodyWithResponse(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error)
+
+	// PresignUploadBatchWithBodyWithResponse request with any body
+	PresignUploadBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PresignUploadBatchResponse, error)
+
+	PresignUploadBatchWithResponse(ctx context.Context, body PresignUploadBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PresignUploadBatchResponse, error)
+
+	// GetPresignedURLWithResponse request
+	GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error)
+
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+}
+
+type GetProcessingStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessingOverview
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProcessingStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProcessingStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PauseProcessingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessingPauseState
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PauseProcessingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseProcessingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumeProcessingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessingPauseState
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeProcessingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeProcessingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReassignOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReassignResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ReassignOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReassignOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReembedStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReembedJob
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReembedStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReembedStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartReembedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ReembedJob
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StartReembedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartReembedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentCapabilitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AgentCapabilities
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentCapabilitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentCapabilitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AgentStatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *File
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchDeleteFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchDeleteFilesResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchDeleteFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchDeleteFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchDownloadFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchDownloadFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchDownloadFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFileChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileChangesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFileChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFileChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProcessingErrorSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessingErrorSummaryResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProcessingErrorSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProcessingErrorSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFilesGroupedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileGroup
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFilesGroupedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFilesGroupedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlinkFileInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlinkFileInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlinkFileInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message    string `json:"message"`
+		MovedCount int    `json:"moved_count"`
+
+		// UnchangedCount Files that were already in the target folder
+		UnchangedCount int   `json:"unchanged_count"`
+		UnchangedIds   []int `json:"unchanged_ids"`
+	}
+	JSON400 *BadRequest
+	JSON401 *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveFilesByFilterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message    string `json:"message"`
+		MovedCount int    `json:"moved_count"`
+
+		// UnchangedCount Matching files that were already in the target folder
+		UnchangedCount int `json:"unchanged_count"`
+	}
+	JSON400 *BadRequest
+	JSON401 *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveFilesByFilterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveFilesByFilterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelFilesProcessingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusTransitionResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelFilesProcessingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelFilesProcessingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryFilesProcessingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusTransitionResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryFilesProcessingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryFilesProcessingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileByPublicIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *File
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileByPublicIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileByPublicIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileDownloadURLByPublicIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileDownloadResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileDownloadURLByPublicIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileDownloadURLByPublicIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReclassifyFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReclassifyFilesResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ReclassifyFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReclassifyFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *File
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *File
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamAgentProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamAgentProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamAgentProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileAssociationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileAssociations
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileAssociationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileAssociationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileContentTextResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileContentTextResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileContentTextResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CopyFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *File
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r CopyFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CopyFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileDownloadURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileDownloadResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileDownloadURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileDownloadURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClearFileEmbeddingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *File
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ClearFileEmbeddingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClearFileEmbeddingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrganizeFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizeFileResult
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrganizeFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrganizeFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *File
+	JSON202      *struct {
+		Message string `json:"message"`
+
+		// Status `needs_review` means the file parsed but the result looks unusable, e.g. a
+		// document with almost no text; re-upload it or retry processing.
+		Status ProcessingStatus `json:"status"`
+	}
+	JSON400 *BadRequest
+	JSON401 *Unauthorized
+	JSON408 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFileRelationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileRelationListResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFileRelationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFileRelationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddFileRelationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FileRelation
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AddFileRelationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddFileRelationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveFileRelationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveFileRelationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveFileRelationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTagsFromFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *File
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTagsFromFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTagsFromFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTagsToFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileTagAdditionResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTagsToFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTagsToFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFoldersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFoldersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFoldersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResolveFolderPathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ResolveFolderPathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResolveFolderPathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSharedFoldersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedFolderListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSharedFoldersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSharedFoldersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFolderTreeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FolderTree
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFolderTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFolderTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExpandFolderTreeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExpandFolderTreeResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ExpandFolderTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExpandFolderTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFolderAliasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderAliasListResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFolderAliasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFolderAliasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddFolderAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FolderAlias
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AddFolderAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddFolderAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveFolderAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveFolderAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveFolderAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFolderContentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderContents
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFolderContentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFolderContentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFolderDeletePreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderDeletePreview
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFolderDeletePreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFolderDeletePreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFolderDescendantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderDescendantListResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFolderDescendantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFolderDescendantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveFileLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveFileLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveFileLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddFileLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AddFileLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddFileLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFolderMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderMemberListResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFolderMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFolderMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShareFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderMember
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ShareFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShareFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnshareFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UnshareFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnshareFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTagsFromFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTagsFromFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTagsFromFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFolderTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FolderTagsResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFolderTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFolderTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTagsToFolderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Folder
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTagsToFolderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTagsToFolderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFoldingRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FoldingRuleListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFoldingRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFoldingRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFoldingRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FoldingRule
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFoldingRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFoldingRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFoldingRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFoldingRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFoldingRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFoldingRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FoldingRule
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFoldingRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFoldingRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ParserCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ParserCallbackResult
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ParserCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ParserCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedTag
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BulkCreateTagsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkDeleteTagsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tag
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tag
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTagAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tag
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTagAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTagAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTagAliasResponse struct {
+	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *File
 	JSON401      *Unauthorized
 	JSON404      *NotFound
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r RemoveTagAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTagAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PresignUploadBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PresignBatchResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r PresignUploadBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PresignUploadBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPresignedURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PresignedURLResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPresignedURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPresignedURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetProcessingStatusWithResponse request returning *GetProcessingStatusResponse
+func (c *ClientWithResponses) GetProcessingStatusWithResponse(ctx context.Context, params *GetProcessingStatusParams, reqEditors ...RequestEditorFn) (*GetProcessingStatusResponse, error) {
+	rsp, err := c.GetProcessingStatus(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProcessingStatusResponse(rsp)
+}
+
+// PauseProcessingWithResponse request returning *PauseProcessingResponse
+func (c *ClientWithResponses) PauseProcessingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PauseProcessingResponse, error) {
+	rsp, err := c.PauseProcessing(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseProcessingResponse(rsp)
+}
+
+// ResumeProcessingWithResponse request returning *ResumeProcessingResponse
+func (c *ClientWithResponses) ResumeProcessingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResumeProcessingResponse, error) {
+	rsp, err := c.ResumeProcessing(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeProcessingResponse(rsp)
+}
+
+// ReassignOwnershipWithBodyWithResponse request with arbitrary body returning *ReassignOwnershipResponse
+func (c *ClientWithResponses) ReassignOwnershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignOwnershipResponse, error) {
+	rsp, err := c.ReassignOwnershipWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignOwnershipResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReassignOwnershipWithResponse(ctx context.Context, body ReassignOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignOwnershipResponse, error) {
+	rsp, err := c.ReassignOwnership(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignOwnershipResponse(rsp)
+}
+
+// GetReembedStatusWithResponse request returning *GetReembedStatusResponse
+func (c *ClientWithResponses) GetReembedStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReembedStatusResponse, error) {
+	rsp, err := c.GetReembedStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReembedStatusResponse(rsp)
+}
+
+// StartReembedWithResponse request returning *StartReembedResponse
+func (c *ClientWithResponses) StartReembedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StartReembedResponse, error) {
+	rsp, err := c.StartReembed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartReembedResponse(rsp)
+}
+
+// GetAgentCapabilitiesWithResponse request returning *GetAgentCapabilitiesResponse
+func (c *ClientWithResponses) GetAgentCapabilitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentCapabilitiesResponse, error) {
+	rsp, err := c.GetAgentCapabilities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentCapabilitiesResponse(rsp)
+}
+
+// GetAgentStatusWithResponse request returning *GetAgentStatusResponse
+func (c *ClientWithResponses) GetAgentStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentStatusResponse, error) {
+	rsp, err := c.GetAgentStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentStatusResponse(rsp)
+}
+
+// ListFilesWithResponse request returning *ListFilesResponse
+func (c *ClientWithResponses) ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error) {
+	rsp, err := c.ListFiles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFilesResponse(rsp)
+}
+
+// CreateFileWithBodyWithResponse request with arbitrary body returning *CreateFileResponse
+func (c *ClientWithResponses) CreateFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFileResponse, error) {
+	rsp, err := c.CreateFileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFileWithResponse(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFileResponse, error) {
+	rsp, err := c.CreateFile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFileResponse(rsp)
+}
+
+// BatchDeleteFilesWithBodyWithResponse request with arbitrary body returning *BatchDeleteFilesResponse
+func (c *ClientWithResponses) BatchDeleteFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchDeleteFilesResponse, error) {
+	rsp, err := c.BatchDeleteFilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchDeleteFilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchDeleteFilesWithResponse(ctx context.Context, body BatchDeleteFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchDeleteFilesResponse, error) {
+	rsp, err := c.BatchDeleteFiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchDeleteFilesResponse(rsp)
+}
+
+// BatchDownloadFilesWithBodyWithResponse request with arbitrary body returning *BatchDownloadFilesResponse
+func (c *ClientWithResponses) BatchDownloadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error) {
+	rsp, err := c.BatchDownloadFilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchDownloadFilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchDownloadFilesWithResponse(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error) {
+	rsp, err := c.BatchDownloadFiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchDownloadFilesResponse(rsp)
+}
+
+// ListFileChangesWithResponse request returning *ListFileChangesResponse
+func (c *ClientWithResponses) ListFileChangesWithResponse(ctx context.Context, params *ListFileChangesParams, reqEditors ...RequestEditorFn) (*ListFileChangesResponse, error) {
+	rsp, err := c.ListFileChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFileChangesResponse(rsp)
+}
+
+// GetProcessingErrorSummaryWithResponse request returning *GetProcessingErrorSummaryResponse
+func (c *ClientWithResponses) GetProcessingErrorSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProcessingErrorSummaryResponse, error) {
+	rsp, err := c.GetProcessingErrorSummary(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProcessingErrorSummaryResponse(rsp)
+}
+
+// ListFilesGroupedWithResponse request returning *ListFilesGroupedResponse
+func (c *ClientWithResponses) ListFilesGroupedWithResponse(ctx context.Context, params *ListFilesGroupedParams, reqEditors ...RequestEditorFn) (*ListFilesGroupedResponse, error) {
+	rsp, err := c.ListFilesGrouped(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFilesGroupedResponse(rsp)
+}
+
+// UnlinkFileInvoiceWithResponse request returning *UnlinkFileInvoiceResponse
+func (c *ClientWithResponses) UnlinkFileInvoiceWithResponse(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*UnlinkFileInvoiceResponse, error) {
+	rsp, err := c.UnlinkFileInvoice(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlinkFileInvoiceResponse(rsp)
+}
+
+// MoveFilesWithBodyWithResponse request with arbitrary body returning *MoveFilesResponse
+func (c *ClientWithResponses) MoveFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error) {
+	rsp, err := c.MoveFilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveFilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveFilesWithResponse(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error) {
+	rsp, err := c.MoveFiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveFilesResponse(rsp)
+}
+
+// MoveFilesByFilterWithBodyWithResponse request with arbitrary body returning *MoveFilesByFilterResponse
+func (c *ClientWithResponses) MoveFilesByFilterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFilesByFilterResponse, error) {
+	rsp, err := c.MoveFilesByFilterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveFilesByFilterResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveFilesByFilterWithResponse(ctx context.Context, body MoveFilesByFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFilesByFilterResponse, error) {
+	rsp, err := c.MoveFilesByFilter(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveFilesByFilterResponse(rsp)
+}
+
+// CancelFilesProcessingWithBodyWithResponse request with arbitrary body returning *CancelFilesProcessingResponse
+func (c *ClientWithResponses) CancelFilesProcessingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelFilesProcessingResponse, error) {
+	rsp, err := c.CancelFilesProcessingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelFilesProcessingResponse(rsp)
+}
+
+func (c *ClientWithResponses) CancelFilesProcessingWithResponse(ctx context.Context, body CancelFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelFilesProcessingResponse, error) {
+	rsp, err := c.CancelFilesProcessing(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelFilesProcessingResponse(rsp)
+}
+
+// RetryFilesProcessingWithBodyWithResponse request with arbitrary body returning *RetryFilesProcessingResponse
+func (c *ClientWithResponses) RetryFilesProcessingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetryFilesProcessingResponse, error) {
+	rsp, err := c.RetryFilesProcessingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryFilesProcessingResponse(rsp)
+}
+
+func (c *ClientWithResponses) RetryFilesProcessingWithResponse(ctx context.Context, body RetryFilesProcessingJSONRequestBody, reqEditors ...RequestEditorFn) (*RetryFilesProcessingResponse, error) {
+	rsp, err := c.RetryFilesProcessing(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryFilesProcessingResponse(rsp)
+}
+
+// GetFileByPublicIDWithResponse request returning *GetFileByPublicIDResponse
+func (c *ClientWithResponses) GetFileByPublicIDWithResponse(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*GetFileByPublicIDResponse, error) {
+	rsp, err := c.GetFileByPublicID(ctx, publicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileByPublicIDResponse(rsp)
+}
+
+// GetFileDownloadURLByPublicIDWithResponse request returning *GetFileDownloadURLByPublicIDResponse
+func (c *ClientWithResponses) GetFileDownloadURLByPublicIDWithResponse(ctx context.Context, publicId FilePublicId, reqEditors ...RequestEditorFn) (*GetFileDownloadURLByPublicIDResponse, error) {
+	rsp, err := c.GetFileDownloadURLByPublicID(ctx, publicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileDownloadURLByPublicIDResponse(rsp)
+}
+
+// ReclassifyFilesWithBodyWithResponse request with arbitrary body returning *ReclassifyFilesResponse
+func (c *ClientWithResponses) ReclassifyFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReclassifyFilesResponse, error) {
+	rsp, err := c.ReclassifyFilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReclassifyFilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReclassifyFilesWithResponse(ctx context.Context, body ReclassifyFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReclassifyFilesResponse, error) {
+	rsp, err := c.ReclassifyFiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReclassifyFilesResponse(rsp)
+}
+
+// StreamFilesWithResponse request returning *StreamFilesResponse
+func (c *ClientWithResponses) StreamFilesWithResponse(ctx context.Context, params *StreamFilesParams, reqEditors ...RequestEditorFn) (*StreamFilesResponse, error) {
+	rsp, err := c.StreamFiles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamFilesResponse(rsp)
+}
+
+// DeleteFileWithResponse request returning *DeleteFileResponse
+func (c *ClientWithResponses) DeleteFileWithResponse(ctx context.Context, id FileId, params *DeleteFileParams, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error) {
+	rsp, err := c.DeleteFile(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFileResponse(rsp)
+}
+
+// GetFileWithResponse request returning *GetFileResponse
+func (c *ClientWithResponses) GetFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileResponse, error) {
+	rsp, err := c.GetFile(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileResponse(rsp)
+}
+
+// UpdateFileWithBodyWithResponse request with arbitrary body returning *UpdateFileResponse
+func (c *ClientWithResponses) UpdateFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error) {
+	rsp, err := c.UpdateFileWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFileWithResponse(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error) {
+	rsp, err := c.UpdateFile(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFileResponse(rsp)
+}
+
+// StreamAgentProgressWithResponse request returning *StreamAgentProgressResponse
+func (c *ClientWithResponses) StreamAgentProgressWithResponse(ctx context.Context, id FileId, params *StreamAgentProgressParams, reqEditors ...RequestEditorFn) (*StreamAgentProgressResponse, error) {
+	rsp, err := c.StreamAgentProgress(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamAgentProgressResponse(rsp)
+}
+
+// GetFileAssociationsWithResponse request returning *GetFileAssociationsResponse
+func (c *ClientWithResponses) GetFileAssociationsWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileAssociationsResponse, error) {
+	rsp, err := c.GetFileAssociations(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileAssociationsResponse(rsp)
+}
+
+// GetFileContentTextWithResponse request returning *GetFileContentTextResponse
+func (c *ClientWithResponses) GetFileContentTextWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileContentTextResponse, error) {
+	rsp, err := c.GetFileContentText(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileContentTextResponse(rsp)
+}
+
+// CopyFileWithBodyWithResponse request with arbitrary body returning *CopyFileResponse
+func (c *ClientWithResponses) CopyFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyFileResponse, error) {
+	rsp, err := c.CopyFileWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) CopyFileWithResponse(ctx context.Context, id FileId, body CopyFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyFileResponse, error) {
+	rsp, err := c.CopyFile(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyFileResponse(rsp)
+}
+
+// GetFileDownloadURLWithResponse request returning *GetFileDownloadURLResponse
+func (c *ClientWithResponses) GetFileDownloadURLWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error) {
+	rsp, err := c.GetFileDownloadURL(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileDownloadURLResponse(rsp)
+}
+
+// ClearFileEmbeddingWithResponse request returning *ClearFileEmbeddingResponse
+func (c *ClientWithResponses) ClearFileEmbeddingWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*ClearFileEmbeddingResponse, error) {
+	rsp, err := c.ClearFileEmbedding(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClearFileEmbeddingResponse(rsp)
+}
+
+// OrganizeFileWithResponse request returning *OrganizeFileResponse
+func (c *ClientWithResponses) OrganizeFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*OrganizeFileResponse, error) {
+	rsp, err := c.OrganizeFile(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizeFileResponse(rsp)
+}
+
+// ProcessFileWithResponse request returning *ProcessFileResponse
+func (c *ClientWithResponses) ProcessFileWithResponse(ctx context.Context, id FileId, params *ProcessFileParams, reqEditors ...RequestEditorFn) (*ProcessFileResponse, error) {
+	rsp, err := c.ProcessFile(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessFileResponse(rsp)
+}
+
+// ListFileRelationsWithResponse request returning *ListFileRelationsResponse
+func (c *ClientWithResponses) ListFileRelationsWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*ListFileRelationsResponse, error) {
+	rsp, err := c.ListFileRelations(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFileRelationsResponse(rsp)
+}
+
+// AddFileRelationWithBodyWithResponse request with arbitrary body returning *AddFileRelationResponse
+func (c *ClientWithResponses) AddFileRelationWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddFileRelationResponse, error) {
+	rsp, err := c.AddFileRelationWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddFileRelationResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddFileRelationWithResponse(ctx context.Context, id FileId, body AddFileRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*AddFileRelationResponse, error) {
+	rsp, err := c.AddFileRelation(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddFileRelationResponse(rsp)
+}
+
+// RemoveFileRelationWithResponse request returning *RemoveFileRelationResponse
+func (c *ClientWithResponses) RemoveFileRelationWithResponse(ctx context.Context, id FileId, relatedFileId int, reqEditors ...RequestEditorFn) (*RemoveFileRelationResponse, error) {
+	rsp, err := c.RemoveFileRelation(ctx, id, relatedFileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveFileRelationResponse(rsp)
+}
+
+// RemoveTagsFromFileWithBodyWithResponse request with arbitrary body returning *RemoveTagsFromFileResponse
+func (c *ClientWithResponses) RemoveTagsFromFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error) {
+	rsp, err := c.RemoveTagsFromFileWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagsFromFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveTagsFromFileWithResponse(ctx context.Context, id FileId, body RemoveTagsFromFileJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error) {
+	rsp, err := c.RemoveTagsFromFile(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagsFromFileResponse(rsp)
+}
+
+// AddTagsToFileWithBodyWithResponse request with arbitrary body returning *AddTagsToFileResponse
+func (c *ClientWithResponses) AddTagsToFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error) {
+	rsp, err := c.AddTagsToFileWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagsToFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTagsToFileWithResponse(ctx context.Context, id FileId, body AddTagsToFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error) {
+	rsp, err := c.AddTagsToFile(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagsToFileResponse(rsp)
+}
+
+// ListFoldersWithResponse request returning *ListFoldersResponse
+func (c *ClientWithResponses) ListFoldersWithResponse(ctx context.Context, params *ListFoldersParams, reqEditors ...RequestEditorFn) (*ListFoldersResponse, error) {
+	rsp, err := c.ListFolders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFoldersResponse(rsp)
+}
+
+// CreateFolderWithBodyWithResponse request with arbitrary body returning *CreateFolderResponse
+func (c *ClientWithResponses) CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
+	rsp, err := c.CreateFolderWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
+	rsp, err := c.CreateFolder(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFolderResponse(rsp)
+}
+
+// ResolveFolderPathWithResponse request returning *ResolveFolderPathResponse
+func (c *ClientWithResponses) ResolveFolderPathWithResponse(ctx context.Context, params *ResolveFolderPathParams, reqEditors ...RequestEditorFn) (*ResolveFolderPathResponse, error) {
+	rsp, err := c.ResolveFolderPath(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResolveFolderPathResponse(rsp)
+}
+
+// ListSharedFoldersWithResponse request returning *ListSharedFoldersResponse
+func (c *ClientWithResponses) ListSharedFoldersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSharedFoldersResponse, error) {
+	rsp, err := c.ListSharedFolders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSharedFoldersResponse(rsp)
+}
+
+// GetFolderTreeWithResponse request returning *GetFolderTreeResponse
+func (c *ClientWithResponses) GetFolderTreeWithResponse(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*GetFolderTreeResponse, error) {
+	rsp, err := c.GetFolderTree(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFolderTreeResponse(rsp)
+}
+
+// ExpandFolderTreeWithBodyWithResponse request with arbitrary body returning *ExpandFolderTreeResponse
+func (c *ClientWithResponses) ExpandFolderTreeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExpandFolderTreeResponse, error) {
+	rsp, err := c.ExpandFolderTreeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExpandFolderTreeResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExpandFolderTreeWithResponse(ctx context.Context, body ExpandFolderTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*ExpandFolderTreeResponse, error) {
+	rsp, err := c.ExpandFolderTree(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExpandFolderTreeResponse(rsp)
+}
+
+// DeleteFolderWithResponse request returning *DeleteFolderResponse
+func (c *ClientWithResponses) DeleteFolderWithResponse(ctx context.Context, id FolderId, params *DeleteFolderParams, reqEditors ...RequestEditorFn) (*DeleteFolderResponse, error) {
+	rsp, err := c.DeleteFolder(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFolderResponse(rsp)
+}
+
+// GetFolderWithResponse request returning *GetFolderResponse
+func (c *ClientWithResponses) GetFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*GetFolderResponse, error) {
+	rsp, err := c.GetFolder(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFolderResponse(rsp)
+}
+
+// UpdateFolderWithBodyWithResponse request with arbitrary body returning *UpdateFolderResponse
+func (c *ClientWithResponses) UpdateFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error) {
+	rsp, err := c.UpdateFolderWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFolderWithResponse(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error) {
+	rsp, err := c.UpdateFolder(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFolderResponse(rsp)
+}
+
+// ListFolderAliasesWithResponse request returning *ListFolderAliasesResponse
+func (c *ClientWithResponses) ListFolderAliasesWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*ListFolderAliasesResponse, error) {
+	rsp, err := c.ListFolderAliases(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFolderAliasesResponse(rsp)
+}
+
+// AddFolderAliasWithBodyWithResponse request with arbitrary body returning *AddFolderAliasResponse
+func (c *ClientWithResponses) AddFolderAliasWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddFolderAliasResponse, error) {
+	rsp, err := c.AddFolderAliasWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddFolderAliasResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddFolderAliasWithResponse(ctx context.Context, id FolderId, body AddFolderAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*AddFolderAliasResponse, error) {
+	rsp, err := c.AddFolderAlias(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddFolderAliasResponse(rsp)
+}
+
+// RemoveFolderAliasWithResponse request returning *RemoveFolderAliasResponse
+func (c *ClientWithResponses) RemoveFolderAliasWithResponse(ctx context.Context, id FolderId, aliasId int, reqEditors ...RequestEditorFn) (*RemoveFolderAliasResponse, error) {
+	rsp, err := c.RemoveFolderAlias(ctx, id, aliasId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveFolderAliasResponse(rsp)
+}
+
+// GetFolderContentsWithResponse request returning *GetFolderContentsResponse
+func (c *ClientWithResponses) GetFolderContentsWithResponse(ctx context.Context, id FolderId, params *GetFolderContentsParams, reqEditors ...RequestEditorFn) (*GetFolderContentsResponse, error) {
+	rsp, err := c.GetFolderContents(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFolderContentsResponse(rsp)
+}
+
+// GetFolderDeletePreviewWithResponse request returning *GetFolderDeletePreviewResponse
+func (c *ClientWithResponses) GetFolderDeletePreviewWithResponse(ctx context.Context, id FolderId, params *GetFolderDeletePreviewParams, reqEditors ...RequestEditorFn) (*GetFolderDeletePreviewResponse, error) {
+	rsp, err := c.GetFolderDeletePreview(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFolderDeletePreviewResponse(rsp)
+}
+
+// ListFolderDescendantsWithResponse request returning *ListFolderDescendantsResponse
+func (c *ClientWithResponses) ListFolderDescendantsWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*ListFolderDescendantsResponse, error) {
+	rsp, err := c.ListFolderDescendants(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFolderDescendantsResponse(rsp)
+}
+
+// DownloadFolderWithResponse request returning *DownloadFolderResponse
+func (c *ClientWithResponses) DownloadFolderWithResponse(ctx context.Context, id FolderId, params *DownloadFolderParams, reqEditors ...RequestEditorFn) (*DownloadFolderResponse, error) {
+	rsp, err := c.DownloadFolder(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadFolderResponse(rsp)
+}
+
+// RemoveFileLinksWithBodyWithResponse request with arbitrary body returning *RemoveFileLinksResponse
+func (c *ClientWithResponses) RemoveFileLinksWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveFileLinksResponse, error) {
+	rsp, err := c.RemoveFileLinksWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveFileLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveFileLinksWithResponse(ctx context.Context, id FolderId, body RemoveFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveFileLinksResponse, error) {
+	rsp, err := c.RemoveFileLinks(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveFileLinksResponse(rsp)
+}
+
+// AddFileLinksWithBodyWithResponse request with arbitrary body returning *AddFileLinksResponse
+func (c *ClientWithResponses) AddFileLinksWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddFileLinksResponse, error) {
+	rsp, err := c.AddFileLinksWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddFileLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddFileLinksWithResponse(ctx context.Context, id FolderId, body AddFileLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*AddFileLinksResponse, error) {
+	rsp, err := c.AddFileLinks(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddFileLinksResponse(rsp)
+}
+
+// ListFolderMembersWithResponse request returning *ListFolderMembersResponse
+func (c *ClientWithResponses) ListFolderMembersWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*ListFolderMembersResponse, error) {
+	rsp, err := c.ListFolderMembers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFolderMembersResponse(rsp)
+}
+
+// ShareFolderWithBodyWithResponse request with arbitrary body returning *ShareFolderResponse
+func (c *ClientWithResponses) ShareFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareFolderResponse, error) {
+	rsp, err := c.ShareFolderWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShareFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) ShareFolderWithResponse(ctx context.Context, id FolderId, body ShareFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareFolderResponse, error) {
+	rsp, err := c.ShareFolder(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShareFolderResponse(rsp)
+}
+
+// UnshareFolderWithResponse request returning *UnshareFolderResponse
+func (c *ClientWithResponses) UnshareFolderWithResponse(ctx context.Context, id FolderId, userId string, reqEditors ...RequestEditorFn) (*UnshareFolderResponse, error) {
+	rsp, err := c.UnshareFolder(ctx, id, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnshareFolderResponse(rsp)
+}
+
+// MoveFolderWithBodyWithResponse request with arbitrary body returning *MoveFolderResponse
+func (c *ClientWithResponses) MoveFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error) {
+	rsp, err := c.MoveFolderWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveFolderWithResponse(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error) {
+	rsp, err := c.MoveFolder(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveFolderResponse(rsp)
+}
+
+// RestoreFolderWithResponse request returning *RestoreFolderResponse
+func (c *ClientWithResponses) RestoreFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*RestoreFolderResponse, error) {
+	rsp, err := c.RestoreFolder(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreFolderResponse(rsp)
+}
+
+// RemoveTagsFromFolderWithBodyWithResponse request with arbitrary body returning *RemoveTagsFromFolderResponse
+func (c *ClientWithResponses) RemoveTagsFromFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error) {
+	rsp, err := c.RemoveTagsFromFolderWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagsFromFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveTagsFromFolderWithResponse(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error) {
+	rsp, err := c.RemoveTagsFromFolder(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagsFromFolderResponse(rsp)
+}
+
+// ListFolderTagsWithResponse request returning *ListFolderTagsResponse
+func (c *ClientWithResponses) ListFolderTagsWithResponse(ctx context.Context, id FolderId, params *ListFolderTagsParams, reqEditors ...RequestEditorFn) (*ListFolderTagsResponse, error) {
+	rsp, err := c.ListFolderTags(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFolderTagsResponse(rsp)
+}
+
+// AddTagsToFolderWithBodyWithResponse request with arbitrary body returning *AddTagsToFolderResponse
+func (c *ClientWithResponses) AddTagsToFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error) {
+	rsp, err := c.AddTagsToFolderWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagsToFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTagsToFolderWithResponse(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error) {
+	rsp, err := c.AddTagsToFolder(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagsToFolderResponse(rsp)
+}
+
+// ListFoldingRulesWithResponse request returning *ListFoldingRulesResponse
+func (c *ClientWithResponses) ListFoldingRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListFoldingRulesResponse, error) {
+	rsp, err := c.ListFoldingRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFoldingRulesResponse(rsp)
+}
+
+// CreateFoldingRuleWithBodyWithResponse request with arbitrary body returning *CreateFoldingRuleResponse
+func (c *ClientWithResponses) CreateFoldingRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFoldingRuleResponse, error) {
+	rsp, err := c.CreateFoldingRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFoldingRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFoldingRuleWithResponse(ctx context.Context, body CreateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFoldingRuleResponse, error) {
+	rsp, err := c.CreateFoldingRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFoldingRuleResponse(rsp)
+}
+
+// DeleteFoldingRuleWithResponse request returning *DeleteFoldingRuleResponse
+func (c *ClientWithResponses) DeleteFoldingRuleWithResponse(ctx context.Context, id FoldingRuleId, reqEditors ...RequestEditorFn) (*DeleteFoldingRuleResponse, error) {
+	rsp, err := c.DeleteFoldingRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFoldingRuleResponse(rsp)
+}
+
+// UpdateFoldingRuleWithBodyWithResponse request with arbitrary body returning *UpdateFoldingRuleResponse
+func (c *ClientWithResponses) UpdateFoldingRuleWithBodyWithResponse(ctx context.Context, id FoldingRuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFoldingRuleResponse, error) {
+	rsp, err := c.UpdateFoldingRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFoldingRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFoldingRuleWithResponse(ctx context.Context, id FoldingRuleId, body UpdateFoldingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFoldingRuleResponse, error) {
+	rsp, err := c.UpdateFoldingRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFoldingRuleResponse(rsp)
+}
+
+// ParserCallbackWithBodyWithResponse request with arbitrary body returning *ParserCallbackResponse
+func (c *ClientWithResponses) ParserCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ParserCallbackResponse, error) {
+	rsp, err := c.ParserCallbackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseParserCallbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) ParserCallbackWithResponse(ctx context.Context, body ParserCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*ParserCallbackResponse, error) {
+	rsp, err := c.ParserCallback(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseParserCallbackResponse(rsp)
+}
+
+// SearchFilesWithResponse request returning *SearchFilesResponse
+func (c *ClientWithResponses) SearchFilesWithResponse(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*SearchFilesResponse, error) {
+	rsp, err := c.SearchFiles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchFilesResponse(rsp)
+}
+
+// ListTagsWithResponse request returning *ListTagsResponse
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTags(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResponse(rsp)
+}
+
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+// CreateTagsWithBodyWithResponse request with arbitrary body returning *CreateTagsResponse
+func (c *ClientWithResponses) CreateTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagsResponse, error) {
+	rsp, err := c.CreateTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagsWithResponse(ctx context.Context, body CreateTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagsResponse, error) {
+	rsp, err := c.CreateTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagsResponse(rsp)
+}
+
+// DeleteTagsWithBodyWithResponse request with arbitrary body returning *DeleteTagsResponse
+func (c *ClientWithResponses) DeleteTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTagsResponse, error) {
+	rsp, err := c.DeleteTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteTagsWithResponse(ctx context.Context, body DeleteTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTagsResponse, error) {
+	rsp, err := c.DeleteTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagsResponse(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResponse(rsp)
+}
+
+// GetTagWithResponse request returning *GetTagResponse
+func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
+	rsp, err := c.GetTag(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagResponse(rsp)
+}
+
+// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
+func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTagWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTag(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+// AddTagAliasWithBodyWithResponse request with arbitrary body returning *AddTagAliasResponse
+func (c *ClientWithResponses) AddTagAliasWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagAliasResponse, error) {
+	rsp, err := c.AddTagAliasWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagAliasResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTagAliasWithResponse(ctx context.Context, id TagId, body AddTagAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagAliasResponse, error) {
+	rsp, err := c.AddTagAlias(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagAliasResponse(rsp)
+}
+
+// RemoveTagAliasWithResponse request returning *RemoveTagAliasResponse
+func (c *ClientWithResponses) RemoveTagAliasWithResponse(ctx context.Context, id TagId, aliasId int, reqEditors ...RequestEditorFn) (*RemoveTagAliasResponse, error) {
+	rsp, err := c.RemoveTagAlias(ctx, id, aliasId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagAliasResponse(rsp)
+}
+
+// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
+func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error) {
+	rsp, err := c.UploadFileWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadFileResponse(rsp)
+}
+
+// PresignUploadBatchWithBodyWithResponse request with arbitrary body returning *PresignUploadBatchResponse
+func (c *ClientWithResponses) PresignUploadBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PresignUploadBatchResponse, error) {
+	rsp, err := c.PresignUploadBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePresignUploadBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PresignUploadBatchWithResponse(ctx context.Context, body PresignUploadBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PresignUploadBatchResponse, error) {
+	rsp, err := c.PresignUploadBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePresignUploadBatchResponse(rsp)
+}
+
+// GetPresignedURLWithResponse request returning *GetPresignedURLResponse
+func (c *ClientWithResponses) GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error) {
+	rsp, err := c.GetPresignedURL(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPresignedURLResponse(rsp)
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// ParseGetProcessingStatusResponse parses an HTTP response from a GetProcessingStatusWithResponse call
+func ParseGetProcessingStatusResponse(rsp *http.Response) (*GetProcessingStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProcessingStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessingOverview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePauseProcessingResponse parses an HTTP response from a PauseProcessingWithResponse call
+func ParsePauseProcessingResponse(rsp *http.Response) (*PauseProcessingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseProcessingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessingPauseState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumeProcessingResponse parses an HTTP response from a ResumeProcessingWithResponse call
+func ParseResumeProcessingResponse(rsp *http.Response) (*ResumeProcessingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumeProcessingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessingPauseState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReassignOwnershipResponse parses an HTTP response from a ReassignOwnershipWithResponse call
+func ParseReassignOwnershipResponse(rsp *http.Response) (*ReassignOwnershipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReassignOwnershipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReassignResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReembedStatusResponse parses an HTTP response from a GetReembedStatusWithResponse call
+func ParseGetReembedStatusResponse(rsp *http.Response) (*GetReembedStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReembedStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReembedJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartReembedResponse parses an HTTP response from a StartReembedWithResponse call
+func ParseStartReembedResponse(rsp *http.Response) (*StartReembedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartReembedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReembedJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentCapabilitiesResponse parses an HTTP response from a GetAgentCapabilitiesWithResponse call
+func ParseGetAgentCapabilitiesResponse(rsp *http.Response) (*GetAgentCapabilitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentCapabilitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentCapabilities
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentStatusResponse parses an HTTP response from a GetAgentStatusWithResponse call
+func ParseGetAgentStatusResponse(rsp *http.Response) (*GetAgentStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFilesResponse parses an HTTP response from a ListFilesWithResponse call
+func ParseListFilesResponse(rsp *http.Response) (*ListFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateFileResponse parses an HTTP response from a CreateFileWithResponse call
+func ParseCreateFileResponse(rsp *http.Response) (*CreateFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchDeleteFilesResponse parses an HTTP response from a BatchDeleteFilesWithResponse call
+func ParseBatchDeleteFilesResponse(rsp *http.Response) (*BatchDeleteFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchDeleteFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchDeleteFilesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchDownloadFilesResponse parses an HTTP response from a BatchDownloadFilesWithResponse call
+func ParseBatchDownloadFilesResponse(rsp *http.Response) (*BatchDownloadFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchDownloadFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFileChangesResponse parses an HTTP response from a ListFileChangesWithResponse call
+func ParseListFileChangesResponse(rsp *http.Response) (*ListFileChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFileChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileChangesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProcessingErrorSummaryResponse parses an HTTP response from a GetProcessingErrorSummaryWithResponse call
+func ParseGetProcessingErrorSummaryResponse(rsp *http.Response) (*GetProcessingErrorSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProcessingErrorSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessingErrorSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFilesGroupedResponse parses an HTTP response from a ListFilesGroupedWithResponse call
+func ParseListFilesGroupedResponse(rsp *http.Response) (*ListFilesGroupedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFilesGroupedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlinkFileInvoiceResponse parses an HTTP response from a UnlinkFileInvoiceWithResponse call
+func ParseUnlinkFileInvoiceResponse(rsp *http.Response) (*UnlinkFileInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlinkFileInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveFilesResponse parses an HTTP response from a MoveFilesWithResponse call
+func ParseMoveFilesResponse(rsp *http.Response) (*MoveFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message    string `json:"message"`
+			MovedCount int    `json:"moved_count"`
+
+			// UnchangedCount Files that were already in the target folder
+			UnchangedCount int   `json:"unchanged_count"`
+			UnchangedIds   []int `json:"unchanged_ids"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveFilesByFilterResponse parses an HTTP response from a MoveFilesByFilterWithResponse call
+func ParseMoveFilesByFilterResponse(rsp *http.Response) (*MoveFilesByFilterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveFilesByFilterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message    string `json:"message"`
+			MovedCount int    `json:"moved_count"`
+
+			// UnchangedCount Matching files that were already in the target folder
+			UnchangedCount int `json:"unchanged_count"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelFilesProcessingResponse parses an HTTP response from a CancelFilesProcessingWithResponse call
+func ParseCancelFilesProcessingResponse(rsp *http.Response) (*CancelFilesProcessingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelFilesProcessingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusTransitionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetryFilesProcessingResponse parses an HTTP response from a RetryFilesProcessingWithResponse call
+func ParseRetryFilesProcessingResponse(rsp *http.Response) (*RetryFilesProcessingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryFilesProcessingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusTransitionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFileByPublicIDResponse parses an HTTP response from a GetFileByPublicIDWithResponse call
+func ParseGetFileByPublicIDResponse(rsp *http.Response) (*GetFileByPublicIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFileByPublicIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFileDownloadURLByPublicIDResponse parses an HTTP response from a GetFileDownloadURLByPublicIDWithResponse call
+func ParseGetFileDownloadURLByPublicIDResponse(rsp *http.Response) (*GetFileDownloadURLByPublicIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFileDownloadURLByPublicIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileDownloadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return 0
-}
 
-type UpdateFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *File
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseReclassifyFilesResponse parses an HTTP response from a ReclassifyFilesWithResponse call
+func ParseReclassifyFilesResponse(rsp *http.Response) (*ReclassifyFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ReclassifyFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type StreamAgentProgressResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-	JSON503      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReclassifyFilesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r StreamAgentProgressResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StreamAgentProgressResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetFileDownloadURLResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FileDownloadResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFileDownloadURLResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseStreamFilesResponse parses an HTTP response from a StreamFilesWithResponse call
+func ParseStreamFilesResponse(rsp *http.Response) (*StreamFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFileDownloadURLResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &StreamFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type OrganizeFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizeFileResult
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-	JSON503      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// Status returns HTTPResponse.Status
-func (r OrganizeFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r OrganizeFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteFileResponse parses an HTTP response from a DeleteFileWithResponse call
+func ParseDeleteFileResponse(rsp *http.Response) (*DeleteFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *struct {
-		Message string           `json:"message"`
-		Status  ProcessingStatus `json:"status"`
+	response := &DeleteFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	JSON400 *BadRequest
-	JSON401 *Unauthorized
-}
 
-// Status returns HTTPResponse.Status
-func (r ProcessFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetFileResponse parses an HTTP response from a GetFileWithResponse call
+func ParseGetFileResponse(rsp *http.Response) (*GetFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RemoveTagsFromFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *File
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	response := &GetFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r RemoveTagsFromFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveTagsFromFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateFileResponse parses an HTTP response from a UpdateFileWithResponse call
+func ParseUpdateFileResponse(rsp *http.Response) (*UpdateFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddTagsToFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *File
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddTagsToFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddTagsToFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListFoldersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FolderListResponse
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListFoldersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseStreamAgentProgressResponse parses an HTTP response from a StreamAgentProgressWithResponse call
+func ParseStreamAgentProgressResponse(rsp *http.Response) (*StreamAgentProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListFoldersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &StreamAgentProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Folder
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetFolderTreeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]FolderTree
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFolderTreeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetFileAssociationsResponse parses an HTTP response from a GetFileAssociationsWithResponse call
+func ParseGetFileAssociationsResponse(rsp *http.Response) (*GetFileAssociationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFolderTreeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetFileAssociationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileAssociations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Folder
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetFileContentTextResponse parses an HTTP response from a GetFileContentTextWithResponse call
+func ParseGetFileContentTextResponse(rsp *http.Response) (*GetFileContentTextResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetFileContentTextResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Folder
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-type MoveFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Folder
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+// ParseCopyFileResponse parses an HTTP response from a CopyFileWithResponse call
+func ParseCopyFileResponse(rsp *http.Response) (*CopyFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r MoveFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &CopyFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MoveFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return 0
-}
 
-type RemoveTagsFromFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Folder
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RemoveTagsFromFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetFileDownloadURLResponse parses an HTTP response from a GetFileDownloadURLWithResponse call
+func ParseGetFileDownloadURLResponse(rsp *http.Response) (*GetFileDownloadURLResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveTagsFromFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetFileDownloadURLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddTagsToFolderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Folder
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileDownloadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddTagsToFolderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddTagsToFolderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type SearchFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SearchResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SearchFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseClearFileEmbeddingResponse parses an HTTP response from a ClearFileEmbeddingWithResponse call
+func ParseClearFileEmbeddingResponse(rsp *http.Response) (*ClearFileEmbeddingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ClearFileEmbeddingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TagListResponse
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type CreateTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Tag
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseOrganizeFileResponse parses an HTTP response from a OrganizeFileWithResponse call
+func ParseOrganizeFileResponse(rsp *http.Response) (*OrganizeFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &OrganizeFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizeFileResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Tag
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseProcessFileResponse parses an HTTP response from a ProcessFileWithResponse call
+func ParseProcessFileResponse(rsp *http.Response) (*ProcessFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ProcessFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Tag
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Message string `json:"message"`
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			// Status `needs_review` means the file parsed but the result looks unusable, e.g. a
+			// document with almost no text; re-upload it or retry processing.
+			Status ProcessingStatus `json:"status"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
-type UploadFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UploadResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UploadFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetPresignedURLResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PresignedURLResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPresignedURLResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListFileRelationsResponse parses an HTTP response from a ListFileRelationsWithResponse call
+func ParseListFileRelationsResponse(rsp *http.Response) (*ListFileRelationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPresignedURLResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListFileRelationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type HealthCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileRelationListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetAgentStatusWithResponse request returning *GetAgentStatusResponse
-func (c *ClientWithResponses) GetAgentStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentStatusResponse, error) {
-	rsp, err := c.GetAgentStatus(ctx, reqEditors...)
+// ParseAddFileRelationResponse parses an HTTP response from a AddFileRelationWithResponse call
+func ParseAddFileRelationResponse(rsp *http.Response) (*AddFileRelationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAgentStatusResponse(rsp)
-}
 
-// ListFilesWithResponse request returning *ListFilesResponse
-func (c *ClientWithResponses) ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error) {
-	rsp, err := c.ListFiles(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddFileRelationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListFilesResponse(rsp)
-}
 
-// CreateFileWithBodyWithResponse request with arbitrary body returning *CreateFileResponse
-func (c *ClientWithResponses) CreateFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFileResponse, error) {
-	rsp, err := c.CreateFileWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FileRelation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseCreateFileResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateFileWithResponse(ctx context.Context, body CreateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFileResponse, error) {
-	rsp, err := c.CreateFile(ctx, body, reqEditors...)
+// ParseRemoveFileRelationResponse parses an HTTP response from a RemoveFileRelationWithResponse call
+func ParseRemoveFileRelationResponse(rsp *http.Response) (*RemoveFileRelationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateFileResponse(rsp)
-}
 
-// BatchDownloadFilesWithBodyWithResponse request with arbitrary body returning *BatchDownloadFilesResponse
-func (c *ClientWithResponses) BatchDownloadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error) {
-	rsp, err := c.BatchDownloadFilesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RemoveFileRelationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseBatchDownloadFilesResponse(rsp)
-}
 
-func (c *ClientWithResponses) BatchDownloadFilesWithResponse(ctx context.Context, body BatchDownloadFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchDownloadFilesResponse, error) {
-	rsp, err := c.BatchDownloadFiles(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseBatchDownloadFilesResponse(rsp)
-}
 
-// UnlinkFileInvoiceWithResponse request returning *UnlinkFileInvoiceResponse
-func (c *ClientWithResponses) UnlinkFileInvoiceWithResponse(ctx context.Context, params *UnlinkFileInvoiceParams, reqEditors ...RequestEditorFn) (*UnlinkFileInvoiceResponse, error) {
-	rsp, err := c.UnlinkFileInvoice(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUnlinkFileInvoiceResponse(rsp)
+	return response, nil
 }
 
-// MoveFilesWithBodyWithResponse request with arbitrary body returning *MoveFilesResponse
-func (c *ClientWithResponses) MoveFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error) {
-	rsp, err := c.MoveFilesWithBody(ctx, contentType, body, reqEditors...)
+// ParseRemoveTagsFromFileResponse parses an HTTP response from a RemoveTagsFromFileWithResponse call
+func ParseRemoveTagsFromFileResponse(rsp *http.Response) (*RemoveTagsFromFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMoveFilesResponse(rsp)
-}
 
-func (c *ClientWithResponses) MoveFilesWithResponse(ctx context.Context, body MoveFilesJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFilesResponse, error) {
-	rsp, err := c.MoveFiles(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RemoveTagsFromFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMoveFilesResponse(rsp)
-}
 
-// DeleteFileWithResponse request returning *DeleteFileResponse
-func (c *ClientWithResponses) DeleteFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error) {
-	rsp, err := c.DeleteFile(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteFileResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// GetFileWithResponse request returning *GetFileResponse
-func (c *ClientWithResponses) GetFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileResponse, error) {
-	rsp, err := c.GetFile(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetFileResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateFileWithBodyWithResponse request with arbitrary body returning *UpdateFileResponse
-func (c *ClientWithResponses) UpdateFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error) {
-	rsp, err := c.UpdateFileWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseAddTagsToFileResponse parses an HTTP response from a AddTagsToFileWithResponse call
+func ParseAddTagsToFileResponse(rsp *http.Response) (*AddTagsToFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateFileResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateFileWithResponse(ctx context.Context, id FileId, body UpdateFileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFileResponse, error) {
-	rsp, err := c.UpdateFile(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddTagsToFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateFileResponse(rsp)
-}
 
-// StreamAgentProgressWithResponse request returning *StreamAgentProgressResponse
-func (c *ClientWithResponses) StreamAgentProgressWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*StreamAgentProgressResponse, error) {
-	rsp, err := c.StreamAgentProgress(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileTagAdditionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseStreamAgentProgressResponse(rsp)
+
+	return response, nil
 }
 
-// GetFileDownloadURLWithResponse request returning *GetFileDownloadURLResponse
-func (c *ClientWithResponses) GetFileDownloadURLWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error) {
-	rsp, err := c.GetFileDownloadURL(ctx, id, reqEditors...)
+// ParseListFoldersResponse parses an HTTP response from a ListFoldersWithResponse call
+func ParseListFoldersResponse(rsp *http.Response) (*ListFoldersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFileDownloadURLResponse(rsp)
-}
 
-// OrganizeFileWithResponse request returning *OrganizeFileResponse
-func (c *ClientWithResponses) OrganizeFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*OrganizeFileResponse, error) {
-	rsp, err := c.OrganizeFile(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListFoldersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseOrganizeFileResponse(rsp)
-}
 
-// ProcessFileWithResponse request returning *ProcessFileResponse
-func (c *ClientWithResponses) ProcessFileWithResponse(ctx context.Context, id FileId, reqEditors ...RequestEditorFn) (*ProcessFileResponse, error) {
-	rsp, err := c.ProcessFile(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FolderListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseProcessFileResponse(rsp)
+
+	return response, nil
 }
 
-// RemoveTagsFromFileWithBodyWithResponse request with arbitrary body returning *RemoveTagsFromFileResponse
-func (c *ClientWithResponses) RemoveTagsFromFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error) {
-	rsp, err := c.RemoveTagsFromFileWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseCreateFolderResponse parses an HTTP response from a CreateFolderWithResponse call
+func ParseCreateFolderResponse(rsp *http.Response) (*CreateFolderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveTagsFromFileResponse(rsp)
-}
 
-func (c *ClientWithResponses) RemoveTagsFromFileWithResponse(ctx context.Context, id FileId, body RemoveTagsFromFileJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFileResponse, error) {
-	rsp, err := c.RemoveTagsFromFile(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateFolderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRemoveTagsFromFileResponse(rsp)
-}
 
-// AddTagsToFileWithBodyWithResponse request with arbitrary body returning *AddTagsToFileResponse
-func (c *ClientWithResponses) AddTagsToFileWithBodyWithResponse(ctx context.Context, id FileId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error) {
-	rsp, err := c.AddTagsToFileWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Folder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseAddTagsToFileResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddTagsToFileWithResponse(ctx context.Context, id FileId, body AddTagsToFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFileResponse, error) {
-	rsp, err := c.AddTagsToFile(ctx, id, body, reqEditors...)
+// ParseResolveFolderPathResponse parses an HTTP response from a ResolveFolderPathWithResponse call
+func ParseResolveFolderPathResponse(rsp *http.Response) (*ResolveFolderPathResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddTagsToFileResponse(rsp)
-}
 
-// ListFoldersWithResponse request returning *ListFoldersResponse
-func (c *ClientWithResponses) ListFoldersWithResponse(ctx context.Context, params *ListFoldersParams, reqEditors ...RequestEditorFn) (*ListFoldersResponse, error) {
-	rsp, err := c.ListFolders(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ResolveFolderPathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListFoldersResponse(rsp)
-}
 
-// CreateFolderWithBodyWithResponse request with arbitrary body returning *CreateFolderResponse
-func (c *ClientWithResponses) CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
-	rsp, err := c.CreateFolderWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Folder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseCreateFolderResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
-	rsp, err := c.CreateFolder(ctx, body, reqEditors...)
+// ParseListSharedFoldersResponse parses an HTTP response from a ListSharedFoldersWithResponse call
+func ParseListSharedFoldersResponse(rsp *http.Response) (*ListSharedFoldersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateFolderResponse(rsp)
-}
 
-// GetFolderTreeWithResponse request returning *GetFolderTreeResponse
-func (c *ClientWithResponses) GetFolderTreeWithResponse(ctx context.Context, params *GetFolderTreeParams, reqEditors ...RequestEditorFn) (*GetFolderTreeResponse, error) {
-	rsp, err := c.GetFolderTree(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListSharedFoldersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFolderTreeResponse(rsp)
-}
 
-// DeleteFolderWithResponse request returning *DeleteFolderResponse
-func (c *ClientWithResponses) DeleteFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*DeleteFolderResponse, error) {
-	rsp, err := c.DeleteFolder(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedFolderListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseDeleteFolderResponse(rsp)
+
+	return response, nil
 }
 
-// GetFolderWithResponse request returning *GetFolderResponse
-func (c *ClientWithResponses) GetFolderWithResponse(ctx context.Context, id FolderId, reqEditors ...RequestEditorFn) (*GetFolderResponse, error) {
-	rsp, err := c.GetFolder(ctx, id, reqEditors...)
+// ParseGetFolderTreeResponse parses an HTTP response from a GetFolderTreeWithResponse call
+func ParseGetFolderTreeResponse(rsp *http.Response) (*GetFolderTreeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFolderResponse(rsp)
-}
 
-// UpdateFolderWithBodyWithResponse request with arbitrary body returning *UpdateFolderResponse
-func (c *ClientWithResponses) UpdateFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error) {
-	rsp, err := c.UpdateFolderWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetFolderTreeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateFolderResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateFolderWithResponse(ctx context.Context, id FolderId, body UpdateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFolderResponse, error) {
-	rsp, err := c.UpdateFolder(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FolderTree
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseUpdateFolderResponse(rsp)
+
+	return response, nil
 }
 
-// MoveFolderWithBodyWithResponse request with arbitrary body returning *MoveFolderResponse
-func (c *ClientWithResponses) MoveFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error) {
-	rsp, err := c.MoveFolderWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseExpandFolderTreeResponse parses an HTTP response from a ExpandFolderTreeWithResponse call
+func ParseExpandFolderTreeResponse(rsp *http.Response) (*ExpandFolderTreeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMoveFolderResponse(rsp)
-}
 
-func (c *ClientWithResponses) MoveFolderWithResponse(ctx context.Context, id FolderId, body MoveFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveFolderResponse, error) {
-	rsp, err := c.MoveFolder(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ExpandFolderTreeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMoveFolderResponse(rsp)
-}
 
-// RemoveTagsFromFolderWithBodyWithResponse request with arbitrary body returning *RemoveTagsFromFolderResponse
-func (c *ClientWithResponses) RemoveTagsFromFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error) {
-	rsp, err := c.RemoveTagsFromFolderWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExpandFolderTreeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseRemoveTagsFromFolderResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RemoveTagsFromFolderWithResponse(ctx context.Context, id FolderId, body RemoveTagsFromFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTagsFromFolderResponse, error) {
-	rsp, err := c.RemoveTagsFromFolder(ctx, id, body, reqEditors...)
+// ParseDeleteFolderResponse parses an HTTP response from a DeleteFolderWithResponse call
+func ParseDeleteFolderResponse(rsp *http.Response) (*DeleteFolderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveTagsFromFolderResponse(rsp)
-}
 
-// AddTagsToFolderWithBodyWithResponse request with arbitrary body returning *AddTagsToFolderResponse
-func (c *ClientWithResponses) AddTagsToFolderWithBodyWithResponse(ctx context.Context, id FolderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error) {
-	rsp, err := c.AddTagsToFolderWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteFolderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddTagsToFolderResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddTagsToFolderWithResponse(ctx context.Context, id FolderId, body AddTagsToFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagsToFolderResponse, error) {
-	rsp, err := c.AddTagsToFolder(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseAddTagsToFolderResponse(rsp)
+
+	return response, nil
 }
 
-// SearchFilesWithResponse request returning *SearchFilesResponse
-func (c *ClientWithResponses) SearchFilesWithResponse(ctx context.Context, params *SearchFilesParams, reqEditors ...RequestEditorFn) (*SearchFilesResponse, error) {
-	rsp, err := c.SearchFiles(ctx, params, reqEditors...)
+// ParseGetFolderResponse parses an HTTP response from a GetFolderWithResponse call
+func ParseGetFolderResponse(rsp *http.Response) (*GetFolderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchFilesResponse(rsp)
-}
 
-// ListTagsWithResponse request returning *ListTagsResponse
-func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
-	rsp, err := c.ListTags(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetFolderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListTagsResponse(rsp)
-}
 
-// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
-func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Folder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseCreateTagResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+// ParseUpdateFolderResponse parses an HTTP response from a UpdateFolderWithResponse call
+func ParseUpdateFolderResponse(rsp *http.Response) (*UpdateFolderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTagResponse(rsp)
-}
 
-// DeleteTagWithResponse request returning *DeleteTagResponse
-func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
-	rsp, err := c.DeleteTag(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateFolderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Folder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseDeleteTagResponse(rsp)
+
+	return response, nil
 }
 
-// GetTagWithResponse request returning *GetTagResponse
-func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
-	rsp, err := c.GetTag(ctx, id, reqEditors...)
+// ParseListFolderAliasesResponse parses an HTTP response from a ListFolderAliasesWithResponse call
+func ParseListFolderAliasesResponse(rsp *http.Response) (*ListFolderAliasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTagResponse(rsp)
-}
 
-// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
-func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
-	rsp, err := c.UpdateTagWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListFolderAliasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTagResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
-	rsp, err := c.UpdateTag(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FolderAliasListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUpdateTagResponse(rsp)
+
+	return response, nil
 }
 
-// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
-func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error) {
-	rsp, err := c.UploadFileWithBody(ctx, contentType, body, reqEditors...)
+// ParseAddFolderAliasResponse parses an HTTP response from a AddFolderAliasWithResponse call
+func ParseAddFolderAliasResponse(rsp *http.Response) (*AddFolderAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadFileResponse(rsp)
-}
 
-// GetPresignedURLWithResponse request returning *GetPresignedURLResponse
-func (c *ClientWithResponses) GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error) {
-	rsp, err := c.GetPresignedURL(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddFolderAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetPresignedURLResponse(rsp)
-}
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FolderAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseHealthCheckResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetAgentStatusResponse parses an HTTP response from a GetAgentStatusWithResponse call
-func ParseGetAgentStatusResponse(rsp *http.Response) (*GetAgentStatusResponse, error) {
+// ParseRemoveFolderAliasResponse parses an HTTP response from a RemoveFolderAliasWithResponse call
+func ParseRemoveFolderAliasResponse(rsp *http.Response) (*RemoveFolderAliasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAgentStatusResponse{
+	response := &RemoveFolderAliasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AgentStatusResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListFilesResponse parses an HTTP response from a ListFilesWithResponse call
-func ParseListFilesResponse(rsp *http.Response) (*ListFilesResponse, error) {
+// ParseGetFolderContentsResponse parses an HTTP response from a GetFolderContentsWithResponse call
+func ParseGetFolderContentsResponse(rsp *http.Response) (*GetFolderContentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListFilesResponse{
+	response := &GetFolderContentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FileListResponse
+		var dest FolderContents
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3862,71 +12169,78 @@ func ParseListFilesResponse(rsp *http.Response) (*ListFilesResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateFileResponse parses an HTTP response from a CreateFileWithResponse call
-func ParseCreateFileResponse(rsp *http.Response) (*CreateFileResponse, error) {
+// ParseGetFolderDeletePreviewResponse parses an HTTP response from a GetFolderDeletePreviewWithResponse call
+func ParseGetFolderDeletePreviewResponse(rsp *http.Response) (*GetFolderDeletePreviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateFileResponse{
+	response := &GetFolderDeletePreviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest File
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FolderDeletePreview
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseBatchDownloadFilesResponse parses an HTTP response from a BatchDownloadFilesWithResponse call
-func ParseBatchDownloadFilesResponse(rsp *http.Response) (*BatchDownloadFilesResponse, error) {
+// ParseListFolderDescendantsResponse parses an HTTP response from a ListFolderDescendantsWithResponse call
+func ParseListFolderDescendantsResponse(rsp *http.Response) (*ListFolderDescendantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BatchDownloadFilesResponse{
+	response := &ListFolderDescendantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FolderDescendantListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -3935,20 +12249,27 @@ func ParseBatchDownloadFilesResponse(rsp *http.Response) (*BatchDownloadFilesRes
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUnlinkFileInvoiceResponse parses an HTTP response from a UnlinkFileInvoiceWithResponse call
-func ParseUnlinkFileInvoiceResponse(rsp *http.Response) (*UnlinkFileInvoiceResponse, error) {
+// ParseDownloadFolderResponse parses an HTTP response from a DownloadFolderWithResponse call
+func ParseDownloadFolderResponse(rsp *http.Response) (*DownloadFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnlinkFileInvoiceResponse{
+	response := &DownloadFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3968,6 +12289,13 @@ func ParseUnlinkFileInvoiceResponse(rsp *http.Response) (*UnlinkFileInvoiceRespo
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3980,30 +12308,20 @@ func ParseUnlinkFileInvoiceResponse(rsp *http.Response) (*UnlinkFileInvoiceRespo
 	return response, nil
 }
 
-// ParseMoveFilesResponse parses an HTTP response from a MoveFilesWithResponse call
-func ParseMoveFilesResponse(rsp *http.Response) (*MoveFilesResponse, error) {
+// ParseRemoveFileLinksResponse parses an HTTP response from a RemoveFileLinksWithResponse call
+func ParseRemoveFileLinksResponse(rsp *http.Response) (*RemoveFileLinksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MoveFilesResponse{
+	response := &RemoveFileLinksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Message    string `json:"message"`
-			MovedCount int    `json:"moved_count"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4018,25 +12336,39 @@ func ParseMoveFilesResponse(rsp *http.Response) (*MoveFilesResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteFileResponse parses an HTTP response from a DeleteFileWithResponse call
-func ParseDeleteFileResponse(rsp *http.Response) (*DeleteFileResponse, error) {
+// ParseAddFileLinksResponse parses an HTTP response from a AddFileLinksWithResponse call
+func ParseAddFileLinksResponse(rsp *http.Response) (*AddFileLinksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFileResponse{
+	response := &AddFileLinksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4056,22 +12388,22 @@ func ParseDeleteFileResponse(rsp *http.Response) (*DeleteFileResponse, error) {
 	return response, nil
 }
 
-// ParseGetFileResponse parses an HTTP response from a GetFileWithResponse call
-func ParseGetFileResponse(rsp *http.Response) (*GetFileResponse, error) {
+// ParseListFolderMembersResponse parses an HTTP response from a ListFolderMembersWithResponse call
+func ParseListFolderMembersResponse(rsp *http.Response) (*ListFolderMembersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFileResponse{
+	response := &ListFolderMembersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest File
+		var dest FolderMemberListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4096,22 +12428,22 @@ func ParseGetFileResponse(rsp *http.Response) (*GetFileResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateFileResponse parses an HTTP response from a UpdateFileWithResponse call
-func ParseUpdateFileResponse(rsp *http.Response) (*UpdateFileResponse, error) {
+// ParseShareFolderResponse parses an HTTP response from a ShareFolderWithResponse call
+func ParseShareFolderResponse(rsp *http.Response) (*ShareFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFileResponse{
+	response := &ShareFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest File
+		var dest FolderMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4131,20 +12463,27 @@ func ParseUpdateFileResponse(rsp *http.Response) (*UpdateFileResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseStreamAgentProgressResponse parses an HTTP response from a StreamAgentProgressWithResponse call
-func ParseStreamAgentProgressResponse(rsp *http.Response) (*StreamAgentProgressResponse, error) {
+// ParseUnshareFolderResponse parses an HTTP response from a UnshareFolderWithResponse call
+func ParseUnshareFolderResponse(rsp *http.Response) (*UnshareFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StreamAgentProgressResponse{
+	response := &UnshareFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4164,79 +12503,79 @@ func ParseStreamAgentProgressResponse(rsp *http.Response) (*StreamAgentProgressR
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetFileDownloadURLResponse parses an HTTP response from a GetFileDownloadURLWithResponse call
-func ParseGetFileDownloadURLResponse(rsp *http.Response) (*GetFileDownloadURLResponse, error) {
+// ParseMoveFolderResponse parses an HTTP response from a MoveFolderWithResponse call
+func ParseMoveFolderResponse(rsp *http.Response) (*MoveFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFileDownloadURLResponse{
+	response := &MoveFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FileDownloadResponse
+		var dest Folder
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseOrganizeFileResponse parses an HTTP response from a OrganizeFileWithResponse call
-func ParseOrganizeFileResponse(rsp *http.Response) (*OrganizeFileResponse, error) {
+// ParseRestoreFolderResponse parses an HTTP response from a RestoreFolderWithResponse call
+func ParseRestoreFolderResponse(rsp *http.Response) (*RestoreFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &OrganizeFileResponse{
+	response := &RestoreFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizeFileResult
+		var dest Folder
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4251,41 +12590,31 @@ func ParseOrganizeFileResponse(rsp *http.Response) (*OrganizeFileResponse, error
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseProcessFileResponse parses an HTTP response from a ProcessFileWithResponse call
-func ParseProcessFileResponse(rsp *http.Response) (*ProcessFileResponse, error) {
+// ParseRemoveTagsFromFolderResponse parses an HTTP response from a RemoveTagsFromFolderWithResponse call
+func ParseRemoveTagsFromFolderResponse(rsp *http.Response) (*RemoveTagsFromFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ProcessFileResponse{
+	response := &RemoveTagsFromFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest struct {
-			Message string           `json:"message"`
-			Status  ProcessingStatus `json:"status"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Folder
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4306,62 +12635,62 @@ func ParseProcessFileResponse(rsp *http.Response) (*ProcessFileResponse, error)
 	return response, nil
 }
 
-// ParseRemoveTagsFromFileResponse parses an HTTP response from a RemoveTagsFromFileWithResponse call
-func ParseRemoveTagsFromFileResponse(rsp *http.Response) (*RemoveTagsFromFileResponse, error) {
+// ParseListFolderTagsResponse parses an HTTP response from a ListFolderTagsWithResponse call
+func ParseListFolderTagsResponse(rsp *http.Response) (*ListFolderTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveTagsFromFileResponse{
+	response := &ListFolderTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest File
+		var dest FolderTagsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAddTagsToFileResponse parses an HTTP response from a AddTagsToFileWithResponse call
-func ParseAddTagsToFileResponse(rsp *http.Response) (*AddTagsToFileResponse, error) {
+// ParseAddTagsToFolderResponse parses an HTTP response from a AddTagsToFolderWithResponse call
+func ParseAddTagsToFolderResponse(rsp *http.Response) (*AddTagsToFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTagsToFileResponse{
+	response := &AddTagsToFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest File
+		var dest Folder
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4386,22 +12715,22 @@ func ParseAddTagsToFileResponse(rsp *http.Response) (*AddTagsToFileResponse, err
 	return response, nil
 }
 
-// ParseListFoldersResponse parses an HTTP response from a ListFoldersWithResponse call
-func ParseListFoldersResponse(rsp *http.Response) (*ListFoldersResponse, error) {
+// ParseListFoldingRulesResponse parses an HTTP response from a ListFoldingRulesWithResponse call
+func ParseListFoldingRulesResponse(rsp *http.Response) (*ListFoldingRulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListFoldersResponse{
+	response := &ListFoldingRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FolderListResponse
+		var dest FoldingRuleListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4419,22 +12748,22 @@ func ParseListFoldersResponse(rsp *http.Response) (*ListFoldersResponse, error)
 	return response, nil
 }
 
-// ParseCreateFolderResponse parses an HTTP response from a CreateFolderWithResponse call
-func ParseCreateFolderResponse(rsp *http.Response) (*CreateFolderResponse, error) {
+// ParseCreateFoldingRuleResponse parses an HTTP response from a CreateFoldingRuleWithResponse call
+func ParseCreateFoldingRuleResponse(rsp *http.Response) (*CreateFoldingRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateFolderResponse{
+	response := &CreateFoldingRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Folder
+		var dest FoldingRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4459,53 +12788,67 @@ func ParseCreateFolderResponse(rsp *http.Response) (*CreateFolderResponse, error
 	return response, nil
 }
 
-// ParseGetFolderTreeResponse parses an HTTP response from a GetFolderTreeWithResponse call
-func ParseGetFolderTreeResponse(rsp *http.Response) (*GetFolderTreeResponse, error) {
+// ParseDeleteFoldingRuleResponse parses an HTTP response from a DeleteFoldingRuleWithResponse call
+func ParseDeleteFoldingRuleResponse(rsp *http.Response) (*DeleteFoldingRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFolderTreeResponse{
+	response := &DeleteFoldingRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FolderTree
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteFolderResponse parses an HTTP response from a DeleteFolderWithResponse call
-func ParseDeleteFolderResponse(rsp *http.Response) (*DeleteFolderResponse, error) {
+// ParseUpdateFoldingRuleResponse parses an HTTP response from a UpdateFoldingRuleWithResponse call
+func ParseUpdateFoldingRuleResponse(rsp *http.Response) (*UpdateFoldingRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFolderResponse{
+	response := &UpdateFoldingRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FoldingRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4525,33 +12868,33 @@ func ParseDeleteFolderResponse(rsp *http.Response) (*DeleteFolderResponse, error
 	return response, nil
 }
 
-// ParseGetFolderResponse parses an HTTP response from a GetFolderWithResponse call
-func ParseGetFolderResponse(rsp *http.Response) (*GetFolderResponse, error) {
+// ParseParserCallbackResponse parses an HTTP response from a ParserCallbackWithResponse call
+func ParseParserCallbackResponse(rsp *http.Response) (*ParserCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFolderResponse{
+	response := &ParserCallbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Folder
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ParserCallbackResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
@@ -4565,22 +12908,22 @@ func ParseGetFolderResponse(rsp *http.Response) (*GetFolderResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateFolderResponse parses an HTTP response from a UpdateFolderWithResponse call
-func ParseUpdateFolderResponse(rsp *http.Response) (*UpdateFolderResponse, error) {
+// ParseSearchFilesResponse parses an HTTP response from a SearchFilesWithResponse call
+func ParseSearchFilesResponse(rsp *http.Response) (*SearchFilesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFolderResponse{
+	response := &SearchFilesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Folder
+		var dest SearchResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4600,39 +12943,35 @@ func ParseUpdateFolderResponse(rsp *http.Response) (*UpdateFolderResponse, error
 		}
 		response.JSON401 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
 	}
 
 	return response, nil
 }
 
-// ParseMoveFolderResponse parses an HTTP response from a MoveFolderWithResponse call
-func ParseMoveFolderResponse(rsp *http.Response) (*MoveFolderResponse, error) {
+// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MoveFolderResponse{
+	response := &ListTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Folder
+		var dest TagListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4645,26 +12984,26 @@ func ParseMoveFolderResponse(rsp *http.Response) (*MoveFolderResponse, error) {
 	return response, nil
 }
 
-// ParseRemoveTagsFromFolderResponse parses an HTTP response from a RemoveTagsFromFolderWithResponse call
-func ParseRemoveTagsFromFolderResponse(rsp *http.Response) (*RemoveTagsFromFolderResponse, error) {
+// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
+func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveTagsFromFolderResponse{
+	response := &CreateTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Folder
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedTag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4685,26 +13024,26 @@ func ParseRemoveTagsFromFolderResponse(rsp *http.Response) (*RemoveTagsFromFolde
 	return response, nil
 }
 
-// ParseAddTagsToFolderResponse parses an HTTP response from a AddTagsToFolderWithResponse call
-func ParseAddTagsToFolderResponse(rsp *http.Response) (*AddTagsToFolderResponse, error) {
+// ParseCreateTagsResponse parses an HTTP response from a CreateTagsWithResponse call
+func ParseCreateTagsResponse(rsp *http.Response) (*CreateTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTagsToFolderResponse{
+	response := &CreateTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Folder
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BulkCreateTagsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4725,22 +13064,22 @@ func ParseAddTagsToFolderResponse(rsp *http.Response) (*AddTagsToFolderResponse,
 	return response, nil
 }
 
-// ParseSearchFilesResponse parses an HTTP response from a SearchFilesWithResponse call
-func ParseSearchFilesResponse(rsp *http.Response) (*SearchFilesResponse, error) {
+// ParseDeleteTagsResponse parses an HTTP response from a DeleteTagsWithResponse call
+func ParseDeleteTagsResponse(rsp *http.Response) (*DeleteTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchFilesResponse{
+	response := &DeleteTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SearchResponse
+		var dest BulkDeleteTagsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4765,22 +13104,55 @@ func ParseSearchFilesResponse(rsp *http.Response) (*SearchFilesResponse, error)
 	return response, nil
 }
 
-// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
-func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTagsResponse{
+	response := &DeleteTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
+func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagListResponse
+		var dest Tag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4793,31 +13165,38 @@ func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
-func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
+// ParseUpdateTagResponse parses an HTTP response from a UpdateTagWithResponse call
+func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTagResponse{
+	response := &UpdateTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest Tag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4838,20 +13217,34 @@ func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
-func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
+// ParseAddTagAliasResponse parses an HTTP response from a AddTagAliasWithResponse call
+func ParseAddTagAliasResponse(rsp *http.Response) (*AddTagAliasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTagResponse{
+	response := &AddTagAliasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4871,27 +13264,20 @@ func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
 	return response, nil
 }
 
-// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
-func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
+// ParseRemoveTagAliasResponse parses an HTTP response from a RemoveTagAliasWithResponse call
+func ParseRemoveTagAliasResponse(rsp *http.Response) (*RemoveTagAliasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagResponse{
+	response := &RemoveTagAliasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tag
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4911,26 +13297,26 @@ func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateTagResponse parses an HTTP response from a UpdateTagWithResponse call
-func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
+// ParseUploadFileResponse parses an HTTP response from a UploadFileWithResponse call
+func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTagResponse{
+	response := &UploadFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tag
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UploadResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4951,26 +13337,26 @@ func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
 	return response, nil
 }
 
-// ParseUploadFileResponse parses an HTTP response from a UploadFileWithResponse call
-func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
+// ParsePresignUploadBatchResponse parses an HTTP response from a PresignUploadBatchWithResponse call
+func ParsePresignUploadBatchResponse(rsp *http.Response) (*PresignUploadBatchResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadFileResponse{
+	response := &PresignUploadBatchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UploadResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PresignBatchResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
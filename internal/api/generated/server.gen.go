@@ -17,6 +17,27 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get processing status
+	// (GET /api/admin/processing-status)
+	GetProcessingStatus(c *fiber.Ctx, params GetProcessingStatusParams) error
+	// Pause processing
+	// (POST /api/admin/processing/pause)
+	PauseProcessing(c *fiber.Ctx) error
+	// Resume processing
+	// (POST /api/admin/processing/resume)
+	ResumeProcessing(c *fiber.Ctx) error
+	// Reassign ownership
+	// (POST /api/admin/reassign)
+	ReassignOwnership(c *fiber.Ctx) error
+	// Get re-embed progress
+	// (GET /api/admin/reembed)
+	GetReembedStatus(c *fiber.Ctx) error
+	// Re-embed files
+	// (POST /api/admin/reembed)
+	StartReembed(c *fiber.Ctx) error
+	// Get AI agent capabilities
+	// (GET /api/agent/capabilities)
+	GetAgentCapabilities(c *fiber.Ctx) error
 	// Get AI agent status
 	// (GET /api/agent/status)
 	GetAgentStatus(c *fiber.Ctx) error
@@ -26,18 +47,51 @@ type ServerInterface interface {
 	// Create file
 	// (POST /api/files)
 	CreateFile(c *fiber.Ctx) error
+	// Delete files in bulk
+	// (POST /api/files/batch-delete)
+	BatchDeleteFiles(c *fiber.Ctx) error
 	// Batch download files as ZIP
 	// (POST /api/files/batch-download)
 	BatchDownloadFiles(c *fiber.Ctx) error
+	// List file changes
+	// (GET /api/files/changes)
+	ListFileChanges(c *fiber.Ctx, params ListFileChangesParams) error
+	// Summarize processing errors
+	// (GET /api/files/errors/summary)
+	GetProcessingErrorSummary(c *fiber.Ctx) error
+	// List files grouped by folder
+	// (GET /api/files/grouped)
+	ListFilesGrouped(c *fiber.Ctx, params ListFilesGroupedParams) error
 	// Unlink invoice from file
 	// (DELETE /api/files/invoice)
 	UnlinkFileInvoice(c *fiber.Ctx, params UnlinkFileInvoiceParams) error
 	// Move files
 	// (POST /api/files/move)
 	MoveFiles(c *fiber.Ctx) error
+	// Move files matching a filter
+	// (POST /api/files/move-by-filter)
+	MoveFilesByFilter(c *fiber.Ctx) error
+	// Cancel processing for files
+	// (POST /api/files/process/cancel)
+	CancelFilesProcessing(c *fiber.Ctx) error
+	// Retry processing for failed files
+	// (POST /api/files/process/retry)
+	RetryFilesProcessing(c *fiber.Ctx) error
+	// Get file by public ID
+	// (GET /api/files/public/{public_id})
+	GetFileByPublicID(c *fiber.Ctx, publicId FilePublicId) error
+	// Get file download URL by public ID
+	// (GET /api/files/public/{public_id}/download)
+	GetFileDownloadURLByPublicID(c *fiber.Ctx, publicId FilePublicId) error
+	// Reclassify files from stored content
+	// (POST /api/files/reclassify)
+	ReclassifyFiles(c *fiber.Ctx) error
+	// Stream files as NDJSON
+	// (GET /api/files/stream)
+	StreamFiles(c *fiber.Ctx, params StreamFilesParams) error
 	// Delete file
 	// (DELETE /api/files/{id})
-	DeleteFile(c *fiber.Ctx, id FileId) error
+	DeleteFile(c *fiber.Ctx, id FileId, params DeleteFileParams) error
 	// Get file
 	// (GET /api/files/{id})
 	GetFile(c *fiber.Ctx, id FileId) error
@@ -46,16 +100,37 @@ type ServerInterface interface {
 	UpdateFile(c *fiber.Ctx, id FileId) error
 	// Stream AI agent progress
 	// (GET /api/files/{id}/agent-stream)
-	StreamAgentProgress(c *fiber.Ctx, id FileId) error
+	StreamAgentProgress(c *fiber.Ctx, id FileId, params StreamAgentProgressParams) error
+	// Get file associations
+	// (GET /api/files/{id}/associations)
+	GetFileAssociations(c *fiber.Ctx, id FileId) error
+	// Download extracted text
+	// (GET /api/files/{id}/content.txt)
+	GetFileContentText(c *fiber.Ctx, id FileId) error
+	// Copy a file
+	// (POST /api/files/{id}/copy)
+	CopyFile(c *fiber.Ctx, id FileId) error
 	// Get file download URL
 	// (GET /api/files/{id}/download)
 	GetFileDownloadURL(c *fiber.Ctx, id FileId) error
+	// Clear file embedding
+	// (POST /api/files/{id}/embedding/clear)
+	ClearFileEmbedding(c *fiber.Ctx, id FileId) error
 	// Trigger AI organization
 	// (POST /api/files/{id}/organize)
 	OrganizeFile(c *fiber.Ctx, id FileId) error
 	// Process file
 	// (POST /api/files/{id}/process)
-	ProcessFile(c *fiber.Ctx, id FileId) error
+	ProcessFile(c *fiber.Ctx, id FileId, params ProcessFileParams) error
+	// List related files
+	// (GET /api/files/{id}/relations)
+	ListFileRelations(c *fiber.Ctx, id FileId) error
+	// Relate a file
+	// (POST /api/files/{id}/relations)
+	AddFileRelation(c *fiber.Ctx, id FileId) error
+	// Remove file relation
+	// (DELETE /api/files/{id}/relations/{related_file_id})
+	RemoveFileRelation(c *fiber.Ctx, id FileId, relatedFileId int) error
 	// Remove tags from file
 	// (DELETE /api/files/{id}/tags)
 	RemoveTagsFromFile(c *fiber.Ctx, id FileId) error
@@ -68,27 +143,93 @@ type ServerInterface interface {
 	// Create folder
 	// (POST /api/folders)
 	CreateFolder(c *fiber.Ctx) error
+	// Resolve folder path
+	// (GET /api/folders/resolve)
+	ResolveFolderPath(c *fiber.Ctx, params ResolveFolderPathParams) error
+	// List folders shared with me
+	// (GET /api/folders/shared)
+	ListSharedFolders(c *fiber.Ctx) error
 	// Get folder tree
 	// (GET /api/folders/tree)
 	GetFolderTree(c *fiber.Ctx, params GetFolderTreeParams) error
+	// Expand folder tree branches
+	// (POST /api/folders/tree/expand)
+	ExpandFolderTree(c *fiber.Ctx) error
 	// Delete folder
 	// (DELETE /api/folders/{id})
-	DeleteFolder(c *fiber.Ctx, id FolderId) error
+	DeleteFolder(c *fiber.Ctx, id FolderId, params DeleteFolderParams) error
 	// Get folder
 	// (GET /api/folders/{id})
 	GetFolder(c *fiber.Ctx, id FolderId) error
 	// Update folder
 	// (PUT /api/folders/{id})
 	UpdateFolder(c *fiber.Ctx, id FolderId) error
+	// List folder aliases
+	// (GET /api/folders/{id}/aliases)
+	ListFolderAliases(c *fiber.Ctx, id FolderId) error
+	// Add folder alias
+	// (POST /api/folders/{id}/aliases)
+	AddFolderAlias(c *fiber.Ctx, id FolderId) error
+	// Remove folder alias
+	// (DELETE /api/folders/{id}/aliases/{alias_id})
+	RemoveFolderAlias(c *fiber.Ctx, id FolderId, aliasId int) error
+	// Get folder contents
+	// (GET /api/folders/{id}/contents)
+	GetFolderContents(c *fiber.Ctx, id FolderId, params GetFolderContentsParams) error
+	// Preview folder deletion
+	// (GET /api/folders/{id}/delete-preview)
+	GetFolderDeletePreview(c *fiber.Ctx, id FolderId, params GetFolderDeletePreviewParams) error
+	// List folder descendants
+	// (GET /api/folders/{id}/descendants)
+	ListFolderDescendants(c *fiber.Ctx, id FolderId) error
+	// Download folder as ZIP
+	// (GET /api/folders/{id}/download)
+	DownloadFolder(c *fiber.Ctx, id FolderId, params DownloadFolderParams) error
+	// Unlink files from folder
+	// (DELETE /api/folders/{id}/links)
+	RemoveFileLinks(c *fiber.Ctx, id FolderId) error
+	// Link files into folder
+	// (POST /api/folders/{id}/links)
+	AddFileLinks(c *fiber.Ctx, id FolderId) error
+	// List folder members
+	// (GET /api/folders/{id}/members)
+	ListFolderMembers(c *fiber.Ctx, id FolderId) error
+	// Share folder
+	// (POST /api/folders/{id}/members)
+	ShareFolder(c *fiber.Ctx, id FolderId) error
+	// Unshare folder
+	// (DELETE /api/folders/{id}/members/{user_id})
+	UnshareFolder(c *fiber.Ctx, id FolderId, userId string) error
 	// Move folder
 	// (POST /api/folders/{id}/move)
 	MoveFolder(c *fiber.Ctx, id FolderId) error
+	// Restore deleted folder
+	// (POST /api/folders/{id}/restore)
+	RestoreFolder(c *fiber.Ctx, id FolderId) error
 	// Remove tags from folder
 	// (DELETE /api/folders/{id}/tags)
 	RemoveTagsFromFolder(c *fiber.Ctx, id FolderId) error
+	// List tags used in folder
+	// (GET /api/folders/{id}/tags)
+	ListFolderTags(c *fiber.Ctx, id FolderId, params ListFolderTagsParams) error
 	// Add tags to folder
 	// (POST /api/folders/{id}/tags)
 	AddTagsToFolder(c *fiber.Ctx, id FolderId) error
+	// List folding rules
+	// (GET /api/folding-rules)
+	ListFoldingRules(c *fiber.Ctx) error
+	// Create folding rule
+	// (POST /api/folding-rules)
+	CreateFoldingRule(c *fiber.Ctx) error
+	// Delete folding rule
+	// (DELETE /api/folding-rules/{id})
+	DeleteFoldingRule(c *fiber.Ctx, id FoldingRuleId) error
+	// Update folding rule
+	// (PUT /api/folding-rules/{id})
+	UpdateFoldingRule(c *fiber.Ctx, id FoldingRuleId) error
+	// Content parser callback
+	// (POST /api/internal/parser-callback)
+	ParserCallback(c *fiber.Ctx) error
 	// Search files
 	// (GET /api/search)
 	SearchFiles(c *fiber.Ctx, params SearchFilesParams) error
@@ -98,6 +239,12 @@ type ServerInterface interface {
 	// Create tag
 	// (POST /api/tags)
 	CreateTag(c *fiber.Ctx) error
+	// Create tags in bulk
+	// (POST /api/tags/bulk)
+	CreateTags(c *fiber.Ctx) error
+	// Delete tags in bulk
+	// (POST /api/tags/bulk-delete)
+	DeleteTags(c *fiber.Ctx) error
 	// Delete tag
 	// (DELETE /api/tags/{id})
 	DeleteTag(c *fiber.Ctx, id TagId) error
@@ -107,9 +254,18 @@ type ServerInterface interface {
 	// Update tag
 	// (PUT /api/tags/{id})
 	UpdateTag(c *fiber.Ctx, id TagId) error
+	// Add tag alias
+	// (POST /api/tags/{id}/aliases)
+	AddTagAlias(c *fiber.Ctx, id TagId) error
+	// Remove tag alias
+	// (DELETE /api/tags/{id}/aliases/{alias_id})
+	RemoveTagAlias(c *fiber.Ctx, id TagId, aliasId int) error
 	// Upload file
 	// (POST /api/upload)
-	UploadFile(c *fiber.Ctx) error
+	UploadFile(c *fiber.Ctx, params UploadFileParams) error
+	// Get presigned upload URLs for several files
+	// (POST /api/upload/presign-batch)
+	PresignUploadBatch(c *fiber.Ctx) error
 	// Get presigned upload URL
 	// (GET /api/upload/presigned)
 	GetPresignedURL(c *fiber.Ctx, params GetPresignedURLParams) error
@@ -125,6 +281,80 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc fiber.Handler
 
+// GetProcessingStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetProcessingStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProcessingStatusParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "window_minutes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_minutes", query, &params.WindowMinutes)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter window_minutes: %w", err).Error())
+	}
+
+	return siw.Handler.GetProcessingStatus(c, params)
+}
+
+// PauseProcessing operation middleware
+func (siw *ServerInterfaceWrapper) PauseProcessing(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PauseProcessing(c)
+}
+
+// ResumeProcessing operation middleware
+func (siw *ServerInterfaceWrapper) ResumeProcessing(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ResumeProcessing(c)
+}
+
+// ReassignOwnership operation middleware
+func (siw *ServerInterfaceWrapper) ReassignOwnership(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ReassignOwnership(c)
+}
+
+// GetReembedStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetReembedStatus(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetReembedStatus(c)
+}
+
+// StartReembed operation middleware
+func (siw *ServerInterfaceWrapper) StartReembed(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.StartReembed(c)
+}
+
+// GetAgentCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentCapabilities(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAgentCapabilities(c)
+}
+
 // GetAgentStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetAgentStatus(c *fiber.Ctx) error {
 
@@ -156,6 +386,13 @@ func (siw *ServerInterfaceWrapper) ListFiles(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "include_folder_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_folder_name", query, &params.IncludeFolderName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_folder_name: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "folder_id" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "folder_id", query, &params.FolderId)
@@ -170,6 +407,13 @@ func (siw *ServerInterfaceWrapper) ListFiles(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter all_folders: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "include_linked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_linked", query, &params.IncludeLinked)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_linked: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "file_type" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "file_type", query, &params.FileType)
@@ -177,6 +421,13 @@ func (siw *ServerInterfaceWrapper) ListFiles(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter file_type: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "ext" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ext", query, &params.Ext)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ext: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "tag_ids" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "tag_ids", query, &params.TagIds)
@@ -191,6 +442,97 @@ func (siw *ServerInterfaceWrapper) ListFiles(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "error_contains" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_contains", query, &params.ErrorContains)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter error_contains: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "min_word_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_word_count", query, &params.MinWordCount)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter min_word_count: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "max_word_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_word_count", query, &params.MaxWordCount)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter max_word_count: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "min_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_size", query, &params.MinSize)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter min_size: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "max_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_size", query, &params.MaxSize)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter max_size: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", query, &params.CreatedAfter)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter created_after: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", query, &params.CreatedBefore)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter created_before: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "updated_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_after", query, &params.UpdatedAfter)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter updated_after: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "updated_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_before", query, &params.UpdatedBefore)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter updated_before: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity", query, &params.Entity)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", query, &params.EntityType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity_type: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "entity_date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_date_from", query, &params.EntityDateFrom)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity_date_from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "entity_date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_date_to", query, &params.EntityDateTo)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity_date_to: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "sort_by" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "sort_by", query, &params.SortBy)
@@ -205,6 +547,13 @@ func (siw *ServerInterfaceWrapper) ListFiles(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort_order: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "ids_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ids_only", query, &params.IdsOnly)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ids_only: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
@@ -230,6 +579,14 @@ func (siw *ServerInterfaceWrapper) CreateFile(c *fiber.Ctx) error {
 	return siw.Handler.CreateFile(c)
 }
 
+// BatchDeleteFiles operation middleware
+func (siw *ServerInterfaceWrapper) BatchDeleteFiles(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.BatchDeleteFiles(c)
+}
+
 // BatchDownloadFiles operation middleware
 func (siw *ServerInterfaceWrapper) BatchDownloadFiles(c *fiber.Ctx) error {
 
@@ -238,15 +595,15 @@ func (siw *ServerInterfaceWrapper) BatchDownloadFiles(c *fiber.Ctx) error {
 	return siw.Handler.BatchDownloadFiles(c)
 }
 
-// UnlinkFileInvoice operation middleware
-func (siw *ServerInterfaceWrapper) UnlinkFileInvoice(c *fiber.Ctx) error {
+// ListFileChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListFileChanges(c *fiber.Ctx) error {
 
 	var err error
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UnlinkFileInvoiceParams
+	var params ListFileChangesParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -254,203 +611,204 @@ func (siw *ServerInterfaceWrapper) UnlinkFileInvoice(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Required query parameter "invoice_id" -------------
+	// ------------- Optional query parameter "since" -------------
 
-	if paramValue := c.Query("invoice_id"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "since", query, &params.Since)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter since: %w", err).Error())
+	}
 
-	} else {
-		err = fmt.Errorf("Query argument invoice_id is required, but not found")
-		c.Status(fiber.StatusBadRequest).JSON(err)
-		return err
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "invoice_id", query, &params.InvoiceId)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invoice_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	return siw.Handler.UnlinkFileInvoice(c, params)
+	return siw.Handler.ListFileChanges(c, params)
 }
 
-// MoveFiles operation middleware
-func (siw *ServerInterfaceWrapper) MoveFiles(c *fiber.Ctx) error {
+// GetProcessingErrorSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetProcessingErrorSummary(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.MoveFiles(c)
+	return siw.Handler.GetProcessingErrorSummary(c)
 }
 
-// DeleteFile operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFile(c *fiber.Ctx) error {
+// ListFilesGrouped operation middleware
+func (siw *ServerInterfaceWrapper) ListFilesGrouped(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFilesGroupedParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "root_folder_id" -------------
 
-	return siw.Handler.DeleteFile(c, id)
-}
+	err = runtime.BindQueryParameter("form", true, false, "root_folder_id", query, &params.RootFolderId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter root_folder_id: %w", err).Error())
+	}
 
-// GetFile operation middleware
-func (siw *ServerInterfaceWrapper) GetFile(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "max_depth" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "max_depth", query, &params.MaxDepth)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter max_depth: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	// ------------- Optional query parameter "files_per_folder" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "files_per_folder", query, &params.FilesPerFolder)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter files_per_folder: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.GetFile(c, id)
+	return siw.Handler.ListFilesGrouped(c, params)
 }
 
-// UpdateFile operation middleware
-func (siw *ServerInterfaceWrapper) UpdateFile(c *fiber.Ctx) error {
+// UnlinkFileInvoice operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkFileInvoice(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnlinkFileInvoiceParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "invoice_id" -------------
 
-	return siw.Handler.UpdateFile(c, id)
-}
+	if paramValue := c.Query("invoice_id"); paramValue != "" {
 
-// StreamAgentProgress operation middleware
-func (siw *ServerInterfaceWrapper) StreamAgentProgress(c *fiber.Ctx) error {
+	} else {
+		err = fmt.Errorf("Query argument invoice_id is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, true, "invoice_id", query, &params.InvoiceId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invoice_id: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	// ------------- Optional query parameter "remove_relations" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "remove_relations", query, &params.RemoveRelations)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter remove_relations: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.StreamAgentProgress(c, id)
+	return siw.Handler.UnlinkFileInvoice(c, params)
 }
 
-// GetFileDownloadURL operation middleware
-func (siw *ServerInterfaceWrapper) GetFileDownloadURL(c *fiber.Ctx) error {
+// MoveFiles operation middleware
+func (siw *ServerInterfaceWrapper) MoveFiles(c *fiber.Ctx) error {
 
-	var err error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	return siw.Handler.MoveFiles(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
+// MoveFilesByFilter operation middleware
+func (siw *ServerInterfaceWrapper) MoveFilesByFilter(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetFileDownloadURL(c, id)
+	return siw.Handler.MoveFilesByFilter(c)
 }
 
-// OrganizeFile operation middleware
-func (siw *ServerInterfaceWrapper) OrganizeFile(c *fiber.Ctx) error {
+// CancelFilesProcessing operation middleware
+func (siw *ServerInterfaceWrapper) CancelFilesProcessing(c *fiber.Ctx) error {
 
-	var err error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	return siw.Handler.CancelFilesProcessing(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
+// RetryFilesProcessing operation middleware
+func (siw *ServerInterfaceWrapper) RetryFilesProcessing(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.OrganizeFile(c, id)
+	return siw.Handler.RetryFilesProcessing(c)
 }
 
-// ProcessFile operation middleware
-func (siw *ServerInterfaceWrapper) ProcessFile(c *fiber.Ctx) error {
+// GetFileByPublicID operation middleware
+func (siw *ServerInterfaceWrapper) GetFileByPublicID(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	// ------------- Path parameter "public_id" -------------
+	var publicId FilePublicId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "public_id", c.Params("public_id"), &publicId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter public_id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.ProcessFile(c, id)
+	return siw.Handler.GetFileByPublicID(c, publicId)
 }
 
-// RemoveTagsFromFile operation middleware
-func (siw *ServerInterfaceWrapper) RemoveTagsFromFile(c *fiber.Ctx) error {
+// GetFileDownloadURLByPublicID operation middleware
+func (siw *ServerInterfaceWrapper) GetFileDownloadURLByPublicID(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
+	// ------------- Path parameter "public_id" -------------
+	var publicId FilePublicId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "public_id", c.Params("public_id"), &publicId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter public_id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.RemoveTagsFromFile(c, id)
+	return siw.Handler.GetFileDownloadURLByPublicID(c, publicId)
 }
 
-// AddTagsToFile operation middleware
-func (siw *ServerInterfaceWrapper) AddTagsToFile(c *fiber.Ctx) error {
+// ReclassifyFiles operation middleware
+func (siw *ServerInterfaceWrapper) ReclassifyFiles(c *fiber.Ctx) error {
 
-	var err error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "id" -------------
-	var id FileId
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
-
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.AddTagsToFile(c, id)
+	return siw.Handler.ReclassifyFiles(c)
 }
 
-// ListFolders operation middleware
-func (siw *ServerInterfaceWrapper) ListFolders(c *fiber.Ctx) error {
+// StreamFiles operation middleware
+func (siw *ServerInterfaceWrapper) StreamFiles(c *fiber.Ctx) error {
 
 	var err error
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListFoldersParams
+	var params StreamFilesParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -465,11 +823,39 @@ func (siw *ServerInterfaceWrapper) ListFolders(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "parent_id" -------------
+	// ------------- Optional query parameter "folder_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "parent_id", query, &params.ParentId)
+	err = runtime.BindQueryParameter("form", true, false, "folder_id", query, &params.FolderId)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter parent_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter folder_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "all_folders" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all_folders", query, &params.AllFolders)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter all_folders: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "include_linked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_linked", query, &params.IncludeLinked)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_linked: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "file_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "file_type", query, &params.FileType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter file_type: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "ext" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ext", query, &params.Ext)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ext: %w", err).Error())
 	}
 
 	// ------------- Optional query parameter "tag_ids" -------------
@@ -479,40 +865,61 @@ func (siw *ServerInterfaceWrapper) ListFolders(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tag_ids: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "entity" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "entity", query, &params.Entity)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity: %w", err).Error())
 	}
 
-	return siw.Handler.ListFolders(c, params)
-}
+	// ------------- Optional query parameter "entity_type" -------------
 
-// CreateFolder operation middleware
-func (siw *ServerInterfaceWrapper) CreateFolder(c *fiber.Ctx) error {
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", query, &params.EntityType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity_type: %w", err).Error())
+	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "entity_date_from" -------------
 
-	return siw.Handler.CreateFolder(c)
+	err = runtime.BindQueryParameter("form", true, false, "entity_date_from", query, &params.EntityDateFrom)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity_date_from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "entity_date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_date_to", query, &params.EntityDateTo)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter entity_date_to: %w", err).Error())
+	}
+
+	return siw.Handler.StreamFiles(c, params)
 }
 
-// GetFolderTree operation middleware
-func (siw *ServerInterfaceWrapper) GetFolderTree(c *fiber.Ctx) error {
+// DeleteFile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFile(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFolderTreeParams
+	var params DeleteFileParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -520,23 +927,23 @@ func (siw *ServerInterfaceWrapper) GetFolderTree(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "parent_id" -------------
+	// ------------- Optional query parameter "cascade_relations" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "parent_id", query, &params.ParentId)
+	err = runtime.BindQueryParameter("form", true, false, "cascade_relations", query, &params.CascadeRelations)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter parent_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cascade_relations: %w", err).Error())
 	}
 
-	return siw.Handler.GetFolderTree(c, params)
+	return siw.Handler.DeleteFile(c, id, params)
 }
 
-// DeleteFolder operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFolder(c *fiber.Ctx) error {
+// GetFile operation middleware
+func (siw *ServerInterfaceWrapper) GetFile(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id FolderId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -545,16 +952,16 @@ func (siw *ServerInterfaceWrapper) DeleteFolder(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeleteFolder(c, id)
+	return siw.Handler.GetFile(c, id)
 }
 
-// GetFolder operation middleware
-func (siw *ServerInterfaceWrapper) GetFolder(c *fiber.Ctx) error {
+// UpdateFile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFile(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id FolderId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -563,16 +970,16 @@ func (siw *ServerInterfaceWrapper) GetFolder(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetFolder(c, id)
+	return siw.Handler.UpdateFile(c, id)
 }
 
-// UpdateFolder operation middleware
-func (siw *ServerInterfaceWrapper) UpdateFolder(c *fiber.Ctx) error {
+// StreamAgentProgress operation middleware
+func (siw *ServerInterfaceWrapper) StreamAgentProgress(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id FolderId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -581,16 +988,32 @@ func (siw *ServerInterfaceWrapper) UpdateFolder(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UpdateFolder(c, id)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamAgentProgressParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
+
+	return siw.Handler.StreamAgentProgress(c, id, params)
 }
 
-// MoveFolder operation middleware
-func (siw *ServerInterfaceWrapper) MoveFolder(c *fiber.Ctx) error {
+// GetFileAssociations operation middleware
+func (siw *ServerInterfaceWrapper) GetFileAssociations(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id FolderId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -599,16 +1022,16 @@ func (siw *ServerInterfaceWrapper) MoveFolder(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.MoveFolder(c, id)
+	return siw.Handler.GetFileAssociations(c, id)
 }
 
-// RemoveTagsFromFolder operation middleware
-func (siw *ServerInterfaceWrapper) RemoveTagsFromFolder(c *fiber.Ctx) error {
+// GetFileContentText operation middleware
+func (siw *ServerInterfaceWrapper) GetFileContentText(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id FolderId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -617,16 +1040,16 @@ func (siw *ServerInterfaceWrapper) RemoveTagsFromFolder(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.RemoveTagsFromFolder(c, id)
+	return siw.Handler.GetFileContentText(c, id)
 }
 
-// AddTagsToFolder operation middleware
-func (siw *ServerInterfaceWrapper) AddTagsToFolder(c *fiber.Ctx) error {
+// CopyFile operation middleware
+func (siw *ServerInterfaceWrapper) CopyFile(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id FolderId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -635,94 +1058,80 @@ func (siw *ServerInterfaceWrapper) AddTagsToFolder(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.AddTagsToFolder(c, id)
+	return siw.Handler.CopyFile(c, id)
 }
 
-// SearchFiles operation middleware
-func (siw *ServerInterfaceWrapper) SearchFiles(c *fiber.Ctx) error {
+// GetFileDownloadURL operation middleware
+func (siw *ServerInterfaceWrapper) GetFileDownloadURL(c *fiber.Ctx) error {
 
 	var err error
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchFilesParams
+	// ------------- Path parameter "id" -------------
+	var id FileId
 
-	var query url.Values
-	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Required query parameter "q" -------------
-
-	if paramValue := c.Query("q"); paramValue != "" {
-
-	} else {
-		err = fmt.Errorf("Query argument q is required, but not found")
-		c.Status(fiber.StatusBadRequest).JSON(err)
-		return err
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, true, "q", query, &params.Q)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
-	}
+	return siw.Handler.GetFileDownloadURL(c, id)
+}
 
-	// ------------- Optional query parameter "type" -------------
+// ClearFileEmbedding operation middleware
+func (siw *ServerInterfaceWrapper) ClearFileEmbedding(c *fiber.Ctx) error {
 
-	err = runtime.BindQueryParameter("form", true, false, "type", query, &params.Type)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter type: %w", err).Error())
-	}
+	var err error
 
-	// ------------- Optional query parameter "folder_id" -------------
+	// ------------- Path parameter "id" -------------
+	var id FileId
 
-	err = runtime.BindQueryParameter("form", true, false, "folder_id", query, &params.FolderId)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter folder_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "file_type" -------------
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "file_type", query, &params.FileType)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter file_type: %w", err).Error())
-	}
+	return siw.Handler.ClearFileEmbedding(c, id)
+}
 
-	// ------------- Optional query parameter "tag_ids" -------------
+// OrganizeFile operation middleware
+func (siw *ServerInterfaceWrapper) OrganizeFile(c *fiber.Ctx) error {
 
-	err = runtime.BindQueryParameter("form", true, false, "tag_ids", query, &params.TagIds)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tag_ids: %w", err).Error())
-	}
+	var err error
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id FileId
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.SearchFiles(c, params)
+	return siw.Handler.OrganizeFile(c, id)
 }
 
-// ListTags operation middleware
-func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
+// ProcessFile operation middleware
+func (siw *ServerInterfaceWrapper) ProcessFile(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTagsParams
+	var params ProcessFileParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -730,45 +1139,44 @@ func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "keyword" -------------
+	// ------------- Optional query parameter "wait" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	err = runtime.BindQueryParameter("form", true, false, "wait", query, &params.Wait)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter wait: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "wait_timeout" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "wait_timeout", query, &params.WaitTimeout)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter wait_timeout: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "summary_model" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "summary_model", query, &params.SummaryModel)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter summary_model: %w", err).Error())
 	}
 
-	return siw.Handler.ListTags(c, params)
-}
-
-// CreateTag operation middleware
-func (siw *ServerInterfaceWrapper) CreateTag(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "agent_model" -------------
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "agent_model", query, &params.AgentModel)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter agent_model: %w", err).Error())
+	}
 
-	return siw.Handler.CreateTag(c)
+	return siw.Handler.ProcessFile(c, id, params)
 }
 
-// DeleteTag operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTag(c *fiber.Ctx) error {
+// ListFileRelations operation middleware
+func (siw *ServerInterfaceWrapper) ListFileRelations(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TagId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -777,16 +1185,16 @@ func (siw *ServerInterfaceWrapper) DeleteTag(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeleteTag(c, id)
+	return siw.Handler.ListFileRelations(c, id)
 }
 
-// GetTag operation middleware
-func (siw *ServerInterfaceWrapper) GetTag(c *fiber.Ctx) error {
+// AddFileRelation operation middleware
+func (siw *ServerInterfaceWrapper) AddFileRelation(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TagId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -795,44 +1203,80 @@ func (siw *ServerInterfaceWrapper) GetTag(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetTag(c, id)
+	return siw.Handler.AddFileRelation(c, id)
 }
 
-// UpdateTag operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTag(c *fiber.Ctx) error {
+// RemoveFileRelation operation middleware
+func (siw *ServerInterfaceWrapper) RemoveFileRelation(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TagId
+	var id FileId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
+	// ------------- Path parameter "related_file_id" -------------
+	var relatedFileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "related_file_id", c.Params("related_file_id"), &relatedFileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter related_file_id: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UpdateTag(c, id)
+	return siw.Handler.RemoveFileRelation(c, id, relatedFileId)
 }
 
-// UploadFile operation middleware
-func (siw *ServerInterfaceWrapper) UploadFile(c *fiber.Ctx) error {
+// RemoveTagsFromFile operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTagsFromFile(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UploadFile(c)
+	return siw.Handler.RemoveTagsFromFile(c, id)
 }
 
-// GetPresignedURL operation middleware
-func (siw *ServerInterfaceWrapper) GetPresignedURL(c *fiber.Ctx) error {
+// AddTagsToFile operation middleware
+func (siw *ServerInterfaceWrapper) AddTagsToFile(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AddTagsToFile(c, id)
+}
+
+// ListFolders operation middleware
+func (siw *ServerInterfaceWrapper) ListFolders(c *fiber.Ctx) error {
 
 	var err error
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPresignedURLParams
+	var params ListFoldersParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -840,1292 +1284,4645 @@ func (siw *ServerInterfaceWrapper) GetPresignedURL(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Required query parameter "filename" -------------
+	// ------------- Optional query parameter "keyword" -------------
 
-	if paramValue := c.Query("filename"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+	}
 
-	} else {
-		err = fmt.Errorf("Query argument filename is required, but not found")
-		c.Status(fiber.StatusBadRequest).JSON(err)
-		return err
+	// ------------- Optional query parameter "parent_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent_id", query, &params.ParentId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter parent_id: %w", err).Error())
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "filename", query, &params.Filename)
+	// ------------- Optional query parameter "tag_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_ids", query, &params.TagIds)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter filename: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tag_ids: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "content_type" -------------
+	// ------------- Optional query parameter "ids_only" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "content_type", query, &params.ContentType)
+	err = runtime.BindQueryParameter("form", true, false, "ids_only", query, &params.IdsOnly)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter content_type: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ids_only: %w", err).Error())
 	}
 
-	return siw.Handler.GetPresignedURL(c, params)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// HealthCheck operation middleware
-func (siw *ServerInterfaceWrapper) HealthCheck(c *fiber.Ctx) error {
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-	return siw.Handler.HealthCheck(c)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// FiberServerOptions provides options for the Fiber server.
-type FiberServerOptions struct {
-	BaseURL     string
-	Middlewares []MiddlewareFunc
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router fiber.Router, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+	return siw.Handler.ListFolders(c, params)
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+// CreateFolder operation middleware
+func (siw *ServerInterfaceWrapper) CreateFolder(c *fiber.Ctx) error {
 
-	for _, m := range options.Middlewares {
-		router.Use(fiber.Handler(m))
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Get(options.BaseURL+"/api/agent/status", wrapper.GetAgentStatus)
+	return siw.Handler.CreateFolder(c)
+}
 
-	router.Get(options.BaseURL+"/api/files", wrapper.ListFiles)
+// ResolveFolderPath operation middleware
+func (siw *ServerInterfaceWrapper) ResolveFolderPath(c *fiber.Ctx) error {
 
-	router.Post(options.BaseURL+"/api/files", wrapper.CreateFile)
+	var err error
 
-	router.Post(options.BaseURL+"/api/files/batch-download", wrapper.BatchDownloadFiles)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Delete(options.BaseURL+"/api/files/invoice", wrapper.UnlinkFileInvoice)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResolveFolderPathParams
 
-	router.Post(options.BaseURL+"/api/files/move", wrapper.MoveFiles)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	router.Delete(options.BaseURL+"/api/files/:id", wrapper.DeleteFile)
+	// ------------- Required query parameter "path" -------------
 
-	router.Get(options.BaseURL+"/api/files/:id", wrapper.GetFile)
+	if paramValue := c.Query("path"); paramValue != "" {
 
-	router.Put(options.BaseURL+"/api/files/:id", wrapper.UpdateFile)
+	} else {
+		err = fmt.Errorf("Query argument path is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	router.Get(options.BaseURL+"/api/files/:id/agent-stream", wrapper.StreamAgentProgress)
+	err = runtime.BindQueryParameter("form", true, true, "path", query, &params.Path)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter path: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/files/:id/download", wrapper.GetFileDownloadURL)
+	return siw.Handler.ResolveFolderPath(c, params)
+}
 
-	router.Post(options.BaseURL+"/api/files/:id/organize", wrapper.OrganizeFile)
+// ListSharedFolders operation middleware
+func (siw *ServerInterfaceWrapper) ListSharedFolders(c *fiber.Ctx) error {
 
-	router.Post(options.BaseURL+"/api/files/:id/process", wrapper.ProcessFile)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Delete(options.BaseURL+"/api/files/:id/tags", wrapper.RemoveTagsFromFile)
+	return siw.Handler.ListSharedFolders(c)
+}
 
-	router.Post(options.BaseURL+"/api/files/:id/tags", wrapper.AddTagsToFile)
+// GetFolderTree operation middleware
+func (siw *ServerInterfaceWrapper) GetFolderTree(c *fiber.Ctx) error {
 
-	router.Get(options.BaseURL+"/api/folders", wrapper.ListFolders)
+	var err error
 
-	router.Post(options.BaseURL+"/api/folders", wrapper.CreateFolder)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Get(options.BaseURL+"/api/folders/tree", wrapper.GetFolderTree)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFolderTreeParams
 
-	router.Delete(options.BaseURL+"/api/folders/:id", wrapper.DeleteFolder)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/folders/:id", wrapper.GetFolder)
+	// ------------- Optional query parameter "parent_id" -------------
 
-	router.Put(options.BaseURL+"/api/folders/:id", wrapper.UpdateFolder)
+	err = runtime.BindQueryParameter("form", true, false, "parent_id", query, &params.ParentId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter parent_id: %w", err).Error())
+	}
 
-	router.Post(options.BaseURL+"/api/folders/:id/move", wrapper.MoveFolder)
+	// ------------- Optional query parameter "with_counts" -------------
 
-	router.Delete(options.BaseURL+"/api/folders/:id/tags", wrapper.RemoveTagsFromFolder)
+	err = runtime.BindQueryParameter("form", true, false, "with_counts", query, &params.WithCounts)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter with_counts: %w", err).Error())
+	}
 
-	router.Post(options.BaseURL+"/api/folders/:id/tags", wrapper.AddTagsToFolder)
+	// ------------- Optional query parameter "sort" -------------
 
-	router.Get(options.BaseURL+"/api/search", wrapper.SearchFiles)
+	err = runtime.BindQueryParameter("form", true, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/tags", wrapper.ListTags)
+	return siw.Handler.GetFolderTree(c, params)
+}
 
-	router.Post(options.BaseURL+"/api/tags", wrapper.CreateTag)
+// ExpandFolderTree operation middleware
+func (siw *ServerInterfaceWrapper) ExpandFolderTree(c *fiber.Ctx) error {
 
-	router.Delete(options.BaseURL+"/api/tags/:id", wrapper.DeleteTag)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Get(options.BaseURL+"/api/tags/:id", wrapper.GetTag)
+	return siw.Handler.ExpandFolderTree(c)
+}
 
-	router.Put(options.BaseURL+"/api/tags/:id", wrapper.UpdateTag)
+// DeleteFolder operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFolder(c *fiber.Ctx) error {
 
-	router.Post(options.BaseURL+"/api/upload", wrapper.UploadFile)
+	var err error
 
-	router.Get(options.BaseURL+"/api/upload/presigned", wrapper.GetPresignedURL)
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-	router.Get(options.BaseURL+"/health", wrapper.HealthCheck)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type BadRequestJSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFolderParams
 
-type NotFoundJSONResponse Error
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type UnauthorizedJSONResponse Error
+	// ------------- Optional query parameter "exclude_folder_ids" -------------
 
-type GetAgentStatusRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "exclude_folder_ids", query, &params.ExcludeFolderIds)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter exclude_folder_ids: %w", err).Error())
+	}
 
-type GetAgentStatusResponseObject interface {
-	VisitGetAgentStatusResponse(ctx *fiber.Ctx) error
+	return siw.Handler.DeleteFolder(c, id, params)
 }
 
-type GetAgentStatus200JSONResponse AgentStatusResponse
+// GetFolder operation middleware
+func (siw *ServerInterfaceWrapper) GetFolder(c *fiber.Ctx) error {
 
-func (response GetAgentStatus200JSONResponse) VisitGetAgentStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type ListFilesRequestObject struct {
-	Params ListFilesParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type ListFilesResponseObject interface {
-	VisitListFilesResponse(ctx *fiber.Ctx) error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetFolder(c, id)
 }
 
-type ListFiles200JSONResponse FileListResponse
+// UpdateFolder operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFolder(c *fiber.Ctx) error {
 
-func (response ListFiles200JSONResponse) VisitListFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type ListFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response ListFiles401JSONResponse) VisitListFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.UpdateFolder(c, id)
 }
 
-type CreateFileRequestObject struct {
-	Body *CreateFileJSONRequestBody
-}
+// ListFolderAliases operation middleware
+func (siw *ServerInterfaceWrapper) ListFolderAliases(c *fiber.Ctx) error {
 
-type CreateFileResponseObject interface {
-	VisitCreateFileResponse(ctx *fiber.Ctx) error
-}
+	var err error
 
-type CreateFile201JSONResponse File
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-func (response CreateFile201JSONResponse) VisitCreateFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListFolderAliases(c, id)
 }
 
-type CreateFile400JSONResponse struct{ BadRequestJSONResponse }
+// AddFolderAlias operation middleware
+func (siw *ServerInterfaceWrapper) AddFolderAlias(c *fiber.Ctx) error {
 
-func (response CreateFile400JSONResponse) VisitCreateFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type CreateFile401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response CreateFile401JSONResponse) VisitCreateFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.AddFolderAlias(c, id)
 }
 
-type BatchDownloadFilesRequestObject struct {
-	Body *BatchDownloadFilesJSONRequestBody
-}
+// RemoveFolderAlias operation middleware
+func (siw *ServerInterfaceWrapper) RemoveFolderAlias(c *fiber.Ctx) error {
 
-type BatchDownloadFilesResponseObject interface {
-	VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error
-}
+	var err error
 
-type BatchDownloadFiles200ApplicationzipResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-func (response BatchDownloadFiles200ApplicationzipResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/zip")
-	if response.ContentLength != 0 {
-		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	ctx.Status(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
-	return err
-}
+	// ------------- Path parameter "alias_id" -------------
+	var aliasId int
 
-type BatchDownloadFiles400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "alias_id", c.Params("alias_id"), &aliasId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter alias_id: %w", err).Error())
+	}
 
-func (response BatchDownloadFiles400JSONResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.RemoveFolderAlias(c, id, aliasId)
 }
 
-type BatchDownloadFiles401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response BatchDownloadFiles401JSONResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// GetFolderContents operation middleware
+func (siw *ServerInterfaceWrapper) GetFolderContents(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type UnlinkFileInvoiceRequestObject struct {
-	Params UnlinkFileInvoiceParams
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type UnlinkFileInvoiceResponseObject interface {
-	VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type UnlinkFileInvoice204Response struct {
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response UnlinkFileInvoice204Response) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFolderContentsParams
 
-type UnlinkFileInvoice400JSONResponse struct{ BadRequestJSONResponse }
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-func (response UnlinkFileInvoice400JSONResponse) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	// ------------- Optional query parameter "limit" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-type UnlinkFileInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "offset" -------------
 
-func (response UnlinkFileInvoice401JSONResponse) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetFolderContents(c, id, params)
 }
 
-type UnlinkFileInvoice404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response UnlinkFileInvoice404JSONResponse) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+// GetFolderDeletePreview operation middleware
+func (siw *ServerInterfaceWrapper) GetFolderDeletePreview(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type MoveFilesRequestObject struct {
-	Body *MoveFilesJSONRequestBody
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type MoveFilesResponseObject interface {
-	VisitMoveFilesResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type MoveFiles200JSONResponse struct {
-	Message    string `json:"message"`
-	MovedCount int    `json:"moved_count"`
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response MoveFiles200JSONResponse) VisitMoveFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFolderDeletePreviewParams
 
-	return ctx.JSON(&response)
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type MoveFiles400JSONResponse struct{ BadRequestJSONResponse }
+	// ------------- Optional query parameter "exclude_folder_ids" -------------
 
-func (response MoveFiles400JSONResponse) VisitMoveFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindQueryParameter("form", true, false, "exclude_folder_ids", query, &params.ExcludeFolderIds)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter exclude_folder_ids: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetFolderDeletePreview(c, id, params)
 }
 
-type MoveFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+// ListFolderDescendants operation middleware
+func (siw *ServerInterfaceWrapper) ListFolderDescendants(c *fiber.Ctx) error {
 
-func (response MoveFiles401JSONResponse) VisitMoveFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type DeleteFileRequestObject struct {
-	Id FileId `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type DeleteFileResponseObject interface {
-	VisitDeleteFileResponse(ctx *fiber.Ctx) error
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type DeleteFile204Response struct {
+	return siw.Handler.ListFolderDescendants(c, id)
 }
 
-func (response DeleteFile204Response) VisitDeleteFileResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
-}
+// DownloadFolder operation middleware
+func (siw *ServerInterfaceWrapper) DownloadFolder(c *fiber.Ctx) error {
 
-type DeleteFile401JSONResponse struct{ UnauthorizedJSONResponse }
+	var err error
 
-func (response DeleteFile401JSONResponse) VisitDeleteFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type DeleteFile404JSONResponse struct{ NotFoundJSONResponse }
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response DeleteFile404JSONResponse) VisitDeleteFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadFolderParams
 
-	return ctx.JSON(&response)
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type GetFileRequestObject struct {
-	Id FileId `json:"id"`
-}
+	// ------------- Optional query parameter "recursive" -------------
 
-type GetFileResponseObject interface {
-	VisitGetFileResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "recursive", query, &params.Recursive)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter recursive: %w", err).Error())
+	}
 
-type GetFile200JSONResponse File
+	// ------------- Optional query parameter "exclude_direct" -------------
 
-func (response GetFile200JSONResponse) VisitGetFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindQueryParameter("form", true, false, "exclude_direct", query, &params.ExcludeDirect)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter exclude_direct: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	// ------------- Optional query parameter "exclude_folder_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_folder_ids", query, &params.ExcludeFolderIds)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter exclude_folder_ids: %w", err).Error())
+	}
+
+	return siw.Handler.DownloadFolder(c, id, params)
 }
 
-type GetFile401JSONResponse struct{ UnauthorizedJSONResponse }
+// RemoveFileLinks operation middleware
+func (siw *ServerInterfaceWrapper) RemoveFileLinks(c *fiber.Ctx) error {
 
-func (response GetFile401JSONResponse) VisitGetFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type GetFile404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response GetFile404JSONResponse) VisitGetFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.RemoveFileLinks(c, id)
 }
 
-type UpdateFileRequestObject struct {
-	Id   FileId `json:"id"`
-	Body *UpdateFileJSONRequestBody
-}
+// AddFileLinks operation middleware
+func (siw *ServerInterfaceWrapper) AddFileLinks(c *fiber.Ctx) error {
 
-type UpdateFileResponseObject interface {
-	VisitUpdateFileResponse(ctx *fiber.Ctx) error
-}
+	var err error
 
-type UpdateFile200JSONResponse File
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-func (response UpdateFile200JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type UpdateFile400JSONResponse struct{ BadRequestJSONResponse }
+	return siw.Handler.AddFileLinks(c, id)
+}
 
-func (response UpdateFile400JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+// ListFolderMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListFolderMembers(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type UpdateFile401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-func (response UpdateFile401JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type StreamAgentProgressRequestObject struct {
-	Id FileId `json:"id"`
+	return siw.Handler.ListFolderMembers(c, id)
 }
 
-type StreamAgentProgressResponseObject interface {
-	VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error
-}
+// ShareFolder operation middleware
+func (siw *ServerInterfaceWrapper) ShareFolder(c *fiber.Ctx) error {
 
-type StreamAgentProgress200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	var err error
 
-func (response StreamAgentProgress200TexteventStreamResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	ctx.Status(200)
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
-	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
-	return err
-}
 
-type StreamAgentProgress401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response StreamAgentProgress401JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.ShareFolder(c, id)
 }
 
-type StreamAgentProgress404JSONResponse struct{ NotFoundJSONResponse }
+// UnshareFolder operation middleware
+func (siw *ServerInterfaceWrapper) UnshareFolder(c *fiber.Ctx) error {
 
-func (response StreamAgentProgress404JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type StreamAgentProgress503JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response StreamAgentProgress503JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(503)
+	// ------------- Path parameter "user_id" -------------
+	var userId string
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Params("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
+	}
 
-type GetFileDownloadURLRequestObject struct {
-	Id FileId `json:"id"`
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type GetFileDownloadURLResponseObject interface {
-	VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error
+	return siw.Handler.UnshareFolder(c, id, userId)
 }
 
-type GetFileDownloadURL200JSONResponse FileDownloadResponse
+// MoveFolder operation middleware
+func (siw *ServerInterfaceWrapper) MoveFolder(c *fiber.Ctx) error {
 
-func (response GetFileDownloadURL200JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type GetFileDownloadURL401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response GetFileDownloadURL401JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.MoveFolder(c, id)
 }
 
-type GetFileDownloadURL404JSONResponse struct{ NotFoundJSONResponse }
+// RestoreFolder operation middleware
+func (siw *ServerInterfaceWrapper) RestoreFolder(c *fiber.Ctx) error {
 
-func (response GetFileDownloadURL404JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type OrganizeFileRequestObject struct {
-	Id FileId `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type OrganizeFileResponseObject interface {
-	VisitOrganizeFileResponse(ctx *fiber.Ctx) error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RestoreFolder(c, id)
 }
 
-type OrganizeFile200JSONResponse OrganizeFileResult
+// RemoveTagsFromFolder operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTagsFromFolder(c *fiber.Ctx) error {
 
-func (response OrganizeFile200JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type OrganizeFile401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response OrganizeFile401JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.RemoveTagsFromFolder(c, id)
 }
 
-type OrganizeFile404JSONResponse struct{ NotFoundJSONResponse }
+// ListFolderTags operation middleware
+func (siw *ServerInterfaceWrapper) ListFolderTags(c *fiber.Ctx) error {
 
-func (response OrganizeFile404JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-type OrganizeFile503JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response OrganizeFile503JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(503)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFolderTagsParams
 
-type ProcessFileRequestObject struct {
-	Id FileId `json:"id"`
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type ProcessFileResponseObject interface {
-	VisitProcessFileResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Optional query parameter "recursive" -------------
 
-type ProcessFile202JSONResponse struct {
-	Message string           `json:"message"`
-	Status  ProcessingStatus `json:"status"`
+	err = runtime.BindQueryParameter("form", true, false, "recursive", query, &params.Recursive)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter recursive: %w", err).Error())
+	}
+
+	return siw.Handler.ListFolderTags(c, id, params)
 }
 
-func (response ProcessFile202JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(202)
+// AddTagsToFolder operation middleware
+func (siw *ServerInterfaceWrapper) AddTagsToFolder(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type ProcessFile400JSONResponse struct{ BadRequestJSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id FolderId
 
-func (response ProcessFile400JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AddTagsToFolder(c, id)
 }
 
-type ProcessFile401JSONResponse struct{ UnauthorizedJSONResponse }
+// ListFoldingRules operation middleware
+func (siw *ServerInterfaceWrapper) ListFoldingRules(c *fiber.Ctx) error {
 
-func (response ProcessFile401JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.ListFoldingRules(c)
 }
 
-type RemoveTagsFromFileRequestObject struct {
-	Id   FileId `json:"id"`
-	Body *RemoveTagsFromFileJSONRequestBody
-}
+// CreateFoldingRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateFoldingRule(c *fiber.Ctx) error {
 
-type RemoveTagsFromFileResponseObject interface {
-	VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateFoldingRule(c)
 }
 
-type RemoveTagsFromFile200JSONResponse File
+// DeleteFoldingRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFoldingRule(c *fiber.Ctx) error {
 
-func (response RemoveTagsFromFile200JSONResponse) VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FoldingRuleId
 
-type RemoveTagsFromFile400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response RemoveTagsFromFile400JSONResponse) VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteFoldingRule(c, id)
 }
 
-type RemoveTagsFromFile401JSONResponse struct{ UnauthorizedJSONResponse }
+// UpdateFoldingRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFoldingRule(c *fiber.Ctx) error {
 
-func (response RemoveTagsFromFile401JSONResponse) VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id FoldingRuleId
 
-type AddTagsToFileRequestObject struct {
-	Id   FileId `json:"id"`
-	Body *AddTagsToFileJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type AddTagsToFileResponseObject interface {
-	VisitAddTagsToFileResponse(ctx *fiber.Ctx) error
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type AddTagsToFile200JSONResponse File
+	return siw.Handler.UpdateFoldingRule(c, id)
+}
 
-func (response AddTagsToFile200JSONResponse) VisitAddTagsToFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+// ParserCallback operation middleware
+func (siw *ServerInterfaceWrapper) ParserCallback(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.ParserCallback(c)
 }
 
-type AddTagsToFile400JSONResponse struct{ BadRequestJSONResponse }
+// SearchFiles operation middleware
+func (siw *ServerInterfaceWrapper) SearchFiles(c *fiber.Ctx) error {
 
-func (response AddTagsToFile400JSONResponse) VisitAddTagsToFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type AddTagsToFile401JSONResponse struct{ UnauthorizedJSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchFilesParams
 
-func (response AddTagsToFile401JSONResponse) VisitAddTagsToFileResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "q" -------------
 
-type ListFoldersRequestObject struct {
-	Params ListFoldersParams
-}
+	if paramValue := c.Query("q"); paramValue != "" {
 
-type ListFoldersResponseObject interface {
-	VisitListFoldersResponse(ctx *fiber.Ctx) error
-}
+	} else {
+		err = fmt.Errorf("Query argument q is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-type ListFolders200JSONResponse FolderListResponse
+	err = runtime.BindQueryParameter("form", true, true, "q", query, &params.Q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
+	}
 
-func (response ListFolders200JSONResponse) VisitListFoldersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// ------------- Optional query parameter "type" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", query, &params.Type)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter type: %w", err).Error())
+	}
 
-type ListFolders401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "folder_id" -------------
 
-func (response ListFolders401JSONResponse) VisitListFoldersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, false, "folder_id", query, &params.FolderId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter folder_id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "scope_folder_id" -------------
 
-type CreateFolderRequestObject struct {
-	Body *CreateFolderJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "scope_folder_id", query, &params.ScopeFolderId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter scope_folder_id: %w", err).Error())
+	}
 
-type CreateFolderResponseObject interface {
-	VisitCreateFolderResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Optional query parameter "file_type" -------------
 
-type CreateFolder201JSONResponse Folder
+	err = runtime.BindQueryParameter("form", true, false, "file_type", query, &params.FileType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter file_type: %w", err).Error())
+	}
 
-func (response CreateFolder201JSONResponse) VisitCreateFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	// ------------- Optional query parameter "tag_ids" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "tag_ids", query, &params.TagIds)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tag_ids: %w", err).Error())
+	}
 
-type CreateFolder400JSONResponse struct{ BadRequestJSONResponse }
+	// ------------- Optional query parameter "boost_tag_ids" -------------
 
-func (response CreateFolder400JSONResponse) VisitCreateFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindQueryParameter("form", true, false, "boost_tag_ids", query, &params.BoostTagIds)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter boost_tag_ids: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "title_only" -------------
 
-type CreateFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "title_only", query, &params.TitleOnly)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter title_only: %w", err).Error())
+	}
 
-func (response CreateFolder401JSONResponse) VisitCreateFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Optional query parameter "include_folder_name" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "include_folder_name", query, &params.IncludeFolderName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_folder_name: %w", err).Error())
+	}
 
-type GetFolderTreeRequestObject struct {
-	Params GetFolderTreeParams
-}
+	// ------------- Optional query parameter "snippet_length" -------------
 
-type GetFolderTreeResponseObject interface {
-	VisitGetFolderTreeResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "snippet_length", query, &params.SnippetLength)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter snippet_length: %w", err).Error())
+	}
 
-type GetFolderTree200JSONResponse []FolderTree
+	// ------------- Optional query parameter "recency_half_life_days" -------------
 
-func (response GetFolderTree200JSONResponse) VisitGetFolderTreeResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindQueryParameter("form", true, false, "recency_half_life_days", query, &params.RecencyHalfLifeDays)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter recency_half_life_days: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "rerank" -------------
 
-type GetFolderTree401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "rerank", query, &params.Rerank)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter rerank: %w", err).Error())
+	}
 
-func (response GetFolderTree401JSONResponse) VisitGetFolderTreeResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Optional query parameter "include_raw_scores" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "include_raw_scores", query, &params.IncludeRawScores)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_raw_scores: %w", err).Error())
+	}
 
-type DeleteFolderRequestObject struct {
-	Id FolderId `json:"id"`
-}
+	// ------------- Optional query parameter "format" -------------
 
-type DeleteFolderResponseObject interface {
-	VisitDeleteFolderResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
 
-type DeleteFolder204Response struct {
-}
+	// ------------- Optional query parameter "limit" -------------
 
-func (response DeleteFolder204Response) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-type DeleteFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "offset" -------------
 
-func (response DeleteFolder401JSONResponse) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.SearchFiles(c, params)
 }
 
-type DeleteFolder404JSONResponse struct{ NotFoundJSONResponse }
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
 
-func (response DeleteFolder404JSONResponse) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type GetFolderRequestObject struct {
-	Id FolderId `json:"id"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagsParams
 
-type GetFolderResponseObject interface {
-	VisitGetFolderResponse(ctx *fiber.Ctx) error
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type GetFolder200JSONResponse Folder
+	// ------------- Optional query parameter "keyword" -------------
 
-func (response GetFolder200JSONResponse) VisitGetFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-func (response GetFolder401JSONResponse) VisitGetFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Optional query parameter "offset" -------------
 
-	return ctx.JSON(&response)
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.ListTags(c, params)
 }
 
-type GetFolder404JSONResponse struct{ NotFoundJSONResponse }
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(c *fiber.Ctx) error {
 
-func (response GetFolder404JSONResponse) VisitGetFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.CreateTag(c)
 }
 
-type UpdateFolderRequestObject struct {
-	Id   FolderId `json:"id"`
-	Body *UpdateFolderJSONRequestBody
-}
+// CreateTags operation middleware
+func (siw *ServerInterfaceWrapper) CreateTags(c *fiber.Ctx) error {
 
-type UpdateFolderResponseObject interface {
-	VisitUpdateFolderResponse(ctx *fiber.Ctx) error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateTags(c)
 }
 
-type UpdateFolder200JSONResponse Folder
+// DeleteTags operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTags(c *fiber.Ctx) error {
 
-func (response UpdateFolder200JSONResponse) VisitUpdateFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteTags(c)
 }
 
-type UpdateFolder400JSONResponse struct{ BadRequestJSONResponse }
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(c *fiber.Ctx) error {
 
-func (response UpdateFolder400JSONResponse) VisitUpdateFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-type UpdateFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response UpdateFolder401JSONResponse) VisitUpdateFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteTag(c, id)
 }
 
-type MoveFolderRequestObject struct {
-	Id   FolderId `json:"id"`
-	Body *MoveFolderJSONRequestBody
-}
+// GetTag operation middleware
+func (siw *ServerInterfaceWrapper) GetTag(c *fiber.Ctx) error {
 
-type MoveFolderResponseObject interface {
-	VisitMoveFolderResponse(ctx *fiber.Ctx) error
-}
+	var err error
 
-type MoveFolder200JSONResponse Folder
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-func (response MoveFolder200JSONResponse) VisitMoveFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetTag(c, id)
 }
 
-type MoveFolder400JSONResponse struct{ BadRequestJSONResponse }
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(c *fiber.Ctx) error {
 
-func (response MoveFolder400JSONResponse) VisitMoveFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-type MoveFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response MoveFolder401JSONResponse) VisitMoveFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	return siw.Handler.UpdateTag(c, id)
 }
 
-type RemoveTagsFromFolderRequestObject struct {
-	Id   FolderId `json:"id"`
-	Body *RemoveTagsFromFolderJSONRequestBody
-}
+// AddTagAlias operation middleware
+func (siw *ServerInterfaceWrapper) AddTagAlias(c *fiber.Ctx) error {
 
-type RemoveTagsFromFolderResponseObject interface {
-	VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error
-}
+	var err error
 
-type RemoveTagsFromFolder200JSONResponse Folder
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-func (response RemoveTagsFromFolder200JSONResponse) VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AddTagAlias(c, id)
 }
 
-type RemoveTagsFromFolder400JSONResponse struct{ BadRequestJSONResponse }
+// RemoveTagAlias operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTagAlias(c *fiber.Ctx) error {
 
-func (response RemoveTagsFromFolder400JSONResponse) VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-type RemoveTagsFromFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response RemoveTagsFromFolder401JSONResponse) VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Path parameter "alias_id" -------------
+	var aliasId int
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "alias_id", c.Params("alias_id"), &aliasId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter alias_id: %w", err).Error())
+	}
 
-type AddTagsToFolderRequestObject struct {
-	Id   FolderId `json:"id"`
-	Body *AddTagsToFolderJSONRequestBody
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type AddTagsToFolderResponseObject interface {
-	VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error
+	return siw.Handler.RemoveTagAlias(c, id, aliasId)
 }
 
-type AddTagsToFolder200JSONResponse Folder
-
-func (response AddTagsToFolder200JSONResponse) VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+// UploadFile operation middleware
+func (siw *ServerInterfaceWrapper) UploadFile(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type AddTagsToFolder400JSONResponse struct{ BadRequestJSONResponse }
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response AddTagsToFolder400JSONResponse) VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadFileParams
 
-	return ctx.JSON(&response)
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type AddTagsToFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "folder_id" -------------
 
-func (response AddTagsToFolder401JSONResponse) VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, false, "folder_id", query, &params.FolderId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter folder_id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.UploadFile(c, params)
 }
 
-type SearchFilesRequestObject struct {
-	Params SearchFilesParams
-}
+// PresignUploadBatch operation middleware
+func (siw *ServerInterfaceWrapper) PresignUploadBatch(c *fiber.Ctx) error {
 
-type SearchFilesResponseObject interface {
-	VisitSearchFilesResponse(ctx *fiber.Ctx) error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PresignUploadBatch(c)
 }
 
-type SearchFiles200JSONResponse SearchResponse
+// GetPresignedURL operation middleware
+func (siw *ServerInterfaceWrapper) GetPresignedURL(c *fiber.Ctx) error {
 
-func (response SearchFiles200JSONResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type SearchFiles400JSONResponse struct{ BadRequestJSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPresignedURLParams
 
-func (response SearchFiles400JSONResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "filename" -------------
 
-type SearchFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+	if paramValue := c.Query("filename"); paramValue != "" {
 
-func (response SearchFiles401JSONResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	} else {
+		err = fmt.Errorf("Query argument filename is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "filename", query, &params.Filename)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter filename: %w", err).Error())
+	}
 
-type ListTagsRequestObject struct {
-	Params ListTagsParams
-}
+	// ------------- Optional query parameter "content_type" -------------
 
-type ListTagsResponseObject interface {
-	VisitListTagsResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "content_type", query, &params.ContentType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter content_type: %w", err).Error())
+	}
 
-type ListTags200JSONResponse TagListResponse
+	// ------------- Optional query parameter "folder_id" -------------
 
-func (response ListTags200JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindQueryParameter("form", true, false, "folder_id", query, &params.FolderId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter folder_id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetPresignedURL(c, params)
 }
 
-type ListTags401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response ListTags401JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.HealthCheck(c)
 }
 
-type CreateTagRequestObject struct {
-	Body *CreateTagJSONRequestBody
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
 }
 
-type CreateTagResponseObject interface {
-	VisitCreateTagResponse(ctx *fiber.Ctx) error
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
 }
 
-type CreateTag201JSONResponse Tag
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
 
-func (response CreateTag201JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/admin/processing-status", wrapper.GetProcessingStatus)
 
-type CreateTag400JSONResponse struct{ BadRequestJSONResponse }
+	router.Post(options.BaseURL+"/api/admin/processing/pause", wrapper.PauseProcessing)
 
-func (response CreateTag400JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	router.Post(options.BaseURL+"/api/admin/processing/resume", wrapper.ResumeProcessing)
 
-	return ctx.JSON(&response)
-}
+	router.Post(options.BaseURL+"/api/admin/reassign", wrapper.ReassignOwnership)
 
-type CreateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+	router.Get(options.BaseURL+"/api/admin/reembed", wrapper.GetReembedStatus)
 
-func (response CreateTag401JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	router.Post(options.BaseURL+"/api/admin/reembed", wrapper.StartReembed)
+
+	router.Get(options.BaseURL+"/api/agent/capabilities", wrapper.GetAgentCapabilities)
+
+	router.Get(options.BaseURL+"/api/agent/status", wrapper.GetAgentStatus)
+
+	router.Get(options.BaseURL+"/api/files", wrapper.ListFiles)
+
+	router.Post(options.BaseURL+"/api/files", wrapper.CreateFile)
+
+	router.Post(options.BaseURL+"/api/files/batch-delete", wrapper.BatchDeleteFiles)
+
+	router.Post(options.BaseURL+"/api/files/batch-download", wrapper.BatchDownloadFiles)
+
+	router.Get(options.BaseURL+"/api/files/changes", wrapper.ListFileChanges)
+
+	router.Get(options.BaseURL+"/api/files/errors/summary", wrapper.GetProcessingErrorSummary)
+
+	router.Get(options.BaseURL+"/api/files/grouped", wrapper.ListFilesGrouped)
+
+	router.Delete(options.BaseURL+"/api/files/invoice", wrapper.UnlinkFileInvoice)
+
+	router.Post(options.BaseURL+"/api/files/move", wrapper.MoveFiles)
+
+	router.Post(options.BaseURL+"/api/files/move-by-filter", wrapper.MoveFilesByFilter)
+
+	router.Post(options.BaseURL+"/api/files/process/cancel", wrapper.CancelFilesProcessing)
+
+	router.Post(options.BaseURL+"/api/files/process/retry", wrapper.RetryFilesProcessing)
+
+	router.Get(options.BaseURL+"/api/files/public/:public_id", wrapper.GetFileByPublicID)
+
+	router.Get(options.BaseURL+"/api/files/public/:public_id/download", wrapper.GetFileDownloadURLByPublicID)
+
+	router.Post(options.BaseURL+"/api/files/reclassify", wrapper.ReclassifyFiles)
+
+	router.Get(options.BaseURL+"/api/files/stream", wrapper.StreamFiles)
+
+	router.Delete(options.BaseURL+"/api/files/:id", wrapper.DeleteFile)
+
+	router.Get(options.BaseURL+"/api/files/:id", wrapper.GetFile)
+
+	router.Put(options.BaseURL+"/api/files/:id", wrapper.UpdateFile)
+
+	router.Get(options.BaseURL+"/api/files/:id/agent-stream", wrapper.StreamAgentProgress)
+
+	router.Get(options.BaseURL+"/api/files/:id/associations", wrapper.GetFileAssociations)
+
+	router.Get(options.BaseURL+"/api/files/:id/content.txt", wrapper.GetFileContentText)
+
+	router.Post(options.BaseURL+"/api/files/:id/copy", wrapper.CopyFile)
+
+	router.Get(options.BaseURL+"/api/files/:id/download", wrapper.GetFileDownloadURL)
+
+	router.Post(options.BaseURL+"/api/files/:id/embedding/clear", wrapper.ClearFileEmbedding)
+
+	router.Post(options.BaseURL+"/api/files/:id/organize", wrapper.OrganizeFile)
+
+	router.Post(options.BaseURL+"/api/files/:id/process", wrapper.ProcessFile)
+
+	router.Get(options.BaseURL+"/api/files/:id/relations", wrapper.ListFileRelations)
+
+	router.Post(options.BaseURL+"/api/files/:id/relations", wrapper.AddFileRelation)
+
+	router.Delete(options.BaseURL+"/api/files/:id/relations/:related_file_id", wrapper.RemoveFileRelation)
+
+	router.Delete(options.BaseURL+"/api/files/:id/tags", wrapper.RemoveTagsFromFile)
+
+	router.Post(options.BaseURL+"/api/files/:id/tags", wrapper.AddTagsToFile)
+
+	router.Get(options.BaseURL+"/api/folders", wrapper.ListFolders)
+
+	router.Post(options.BaseURL+"/api/folders", wrapper.CreateFolder)
+
+	router.Get(options.BaseURL+"/api/folders/resolve", wrapper.ResolveFolderPath)
+
+	router.Get(options.BaseURL+"/api/folders/shared", wrapper.ListSharedFolders)
+
+	router.Get(options.BaseURL+"/api/folders/tree", wrapper.GetFolderTree)
+
+	router.Post(options.BaseURL+"/api/folders/tree/expand", wrapper.ExpandFolderTree)
+
+	router.Delete(options.BaseURL+"/api/folders/:id", wrapper.DeleteFolder)
+
+	router.Get(options.BaseURL+"/api/folders/:id", wrapper.GetFolder)
+
+	router.Put(options.BaseURL+"/api/folders/:id", wrapper.UpdateFolder)
+
+	router.Get(options.BaseURL+"/api/folders/:id/aliases", wrapper.ListFolderAliases)
+
+	router.Post(options.BaseURL+"/api/folders/:id/aliases", wrapper.AddFolderAlias)
+
+	router.Delete(options.BaseURL+"/api/folders/:id/aliases/:alias_id", wrapper.RemoveFolderAlias)
+
+	router.Get(options.BaseURL+"/api/folders/:id/contents", wrapper.GetFolderContents)
+
+	router.Get(options.BaseURL+"/api/folders/:id/delete-preview", wrapper.GetFolderDeletePreview)
+
+	router.Get(options.BaseURL+"/api/folders/:id/descendants", wrapper.ListFolderDescendants)
+
+	router.Get(options.BaseURL+"/api/folders/:id/download", wrapper.DownloadFolder)
+
+	router.Delete(options.BaseURL+"/api/folders/:id/links", wrapper.RemoveFileLinks)
+
+	router.Post(options.BaseURL+"/api/folders/:id/links", wrapper.AddFileLinks)
+
+	router.Get(options.BaseURL+"/api/folders/:id/members", wrapper.ListFolderMembers)
+
+	router.Post(options.BaseURL+"/api/folders/:id/members", wrapper.ShareFolder)
+
+	router.Delete(options.BaseURL+"/api/folders/:id/members/:user_id", wrapper.UnshareFolder)
+
+	router.Post(options.BaseURL+"/api/folders/:id/move", wrapper.MoveFolder)
+
+	router.Post(options.BaseURL+"/api/folders/:id/restore", wrapper.RestoreFolder)
+
+	router.Delete(options.BaseURL+"/api/folders/:id/tags", wrapper.RemoveTagsFromFolder)
+
+	router.Get(options.BaseURL+"/api/folders/:id/tags", wrapper.ListFolderTags)
+
+	router.Post(options.BaseURL+"/api/folders/:id/tags", wrapper.AddTagsToFolder)
+
+	router.Get(options.BaseURL+"/api/folding-rules", wrapper.ListFoldingRules)
+
+	router.Post(options.BaseURL+"/api/folding-rules", wrapper.CreateFoldingRule)
+
+	router.Delete(options.BaseURL+"/api/folding-rules/:id", wrapper.DeleteFoldingRule)
+
+	router.Put(options.BaseURL+"/api/folding-rules/:id", wrapper.UpdateFoldingRule)
+
+	router.Post(options.BaseURL+"/api/internal/parser-callback", wrapper.ParserCallback)
+
+	router.Get(options.BaseURL+"/api/search", wrapper.SearchFiles)
+
+	router.Get(options.BaseURL+"/api/tags", wrapper.ListTags)
+
+	router.Post(options.BaseURL+"/api/tags", wrapper.CreateTag)
+
+	router.Post(options.BaseURL+"/api/tags/bulk", wrapper.CreateTags)
+
+	router.Post(options.BaseURL+"/api/tags/bulk-delete", wrapper.DeleteTags)
+
+	router.Delete(options.BaseURL+"/api/tags/:id", wrapper.DeleteTag)
+
+	router.Get(options.BaseURL+"/api/tags/:id", wrapper.GetTag)
+
+	router.Put(options.BaseURL+"/api/tags/:id", wrapper.UpdateTag)
+
+	router.Post(options.BaseURL+"/api/tags/:id/aliases", wrapper.AddTagAlias)
+
+	router.Delete(options.BaseURL+"/api/tags/:id/aliases/:alias_id", wrapper.RemoveTagAlias)
+
+	router.Post(options.BaseURL+"/api/upload", wrapper.UploadFile)
+
+	router.Post(options.BaseURL+"/api/upload/presign-batch", wrapper.PresignUploadBatch)
+
+	router.Get(options.BaseURL+"/api/upload/presigned", wrapper.GetPresignedURL)
+
+	router.Get(options.BaseURL+"/health", wrapper.HealthCheck)
+
+}
+
+type BadRequestJSONResponse Error
+
+type ForbiddenJSONResponse Error
+
+type NotFoundJSONResponse Error
+
+type UnauthorizedJSONResponse Error
+
+type GetProcessingStatusRequestObject struct {
+	Params GetProcessingStatusParams
+}
+
+type GetProcessingStatusResponseObject interface {
+	VisitGetProcessingStatusResponse(ctx *fiber.Ctx) error
+}
+
+type GetProcessingStatus200JSONResponse ProcessingOverview
+
+func (response GetProcessingStatus200JSONResponse) VisitGetProcessingStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetProcessingStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetProcessingStatus400JSONResponse) VisitGetProcessingStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetProcessingStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetProcessingStatus401JSONResponse) VisitGetProcessingStatusResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type DeleteTagRequestObject struct {
-	Id TagId `json:"id"`
+type GetProcessingStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetProcessingStatus403JSONResponse) VisitGetProcessingStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
 }
 
-type DeleteTagResponseObject interface {
-	VisitDeleteTagResponse(ctx *fiber.Ctx) error
+type PauseProcessingRequestObject struct {
 }
 
-type DeleteTag204Response struct {
+type PauseProcessingResponseObject interface {
+	VisitPauseProcessingResponse(ctx *fiber.Ctx) error
 }
 
-func (response DeleteTag204Response) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
+type PauseProcessing200JSONResponse ProcessingPauseState
+
+func (response PauseProcessing200JSONResponse) VisitPauseProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
 }
 
-type DeleteTag401JSONResponse struct{ UnauthorizedJSONResponse }
+type PauseProcessing401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteTag401JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+func (response PauseProcessing401JSONResponse) VisitPauseProcessingResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type DeleteTag404JSONResponse struct{ NotFoundJSONResponse }
+type PauseProcessing403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+func (response PauseProcessing403JSONResponse) VisitPauseProcessingResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	ctx.Status(403)
 
 	return ctx.JSON(&response)
 }
 
-type GetTagRequestObject struct {
-	Id TagId `json:"id"`
+type ResumeProcessingRequestObject struct {
 }
 
-type GetTagResponseObject interface {
-	VisitGetTagResponse(ctx *fiber.Ctx) error
+type ResumeProcessingResponseObject interface {
+	VisitResumeProcessingResponse(ctx *fiber.Ctx) error
 }
 
-type GetTag200JSONResponse Tag
+type ResumeProcessing200JSONResponse ProcessingPauseState
 
-func (response GetTag200JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+func (response ResumeProcessing200JSONResponse) VisitResumeProcessingResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type GetTag401JSONResponse struct{ UnauthorizedJSONResponse }
+type ResumeProcessing401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetTag401JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+func (response ResumeProcessing401JSONResponse) VisitResumeProcessingResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type GetTag404JSONResponse struct{ NotFoundJSONResponse }
+type ResumeProcessing403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetTag404JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+func (response ResumeProcessing403JSONResponse) VisitResumeProcessingResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	ctx.Status(403)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateTagRequestObject struct {
-	Id   TagId `json:"id"`
-	Body *UpdateTagJSONRequestBody
+type ReassignOwnershipRequestObject struct {
+	Body *ReassignOwnershipJSONRequestBody
 }
 
-type UpdateTagResponseObject interface {
-	VisitUpdateTagResponse(ctx *fiber.Ctx) error
+type ReassignOwnershipResponseObject interface {
+	VisitReassignOwnershipResponse(ctx *fiber.Ctx) error
 }
 
-type UpdateTag200JSONResponse Tag
+type ReassignOwnership200JSONResponse ReassignResult
 
-func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+func (response ReassignOwnership200JSONResponse) VisitReassignOwnershipResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateTag400JSONResponse struct{ BadRequestJSONResponse }
+type ReassignOwnership400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateTag400JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+func (response ReassignOwnership400JSONResponse) VisitReassignOwnershipResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+type ReassignOwnership401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateTag401JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+func (response ReassignOwnership401JSONResponse) VisitReassignOwnershipResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type UploadFileRequestObject struct {
-	Body *multipart.Reader
-}
+type ReassignOwnership403JSONResponse struct{ ForbiddenJSONResponse }
 
-type UploadFileResponseObject interface {
-	VisitUploadFileResponse(ctx *fiber.Ctx) error
+func (response ReassignOwnership403JSONResponse) VisitReassignOwnershipResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
 }
 
-type UploadFile201JSONResponse UploadResponse
+type ReassignOwnership404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UploadFile201JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+func (response ReassignOwnership404JSONResponse) VisitReassignOwnershipResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type UploadFile400JSONResponse struct{ BadRequestJSONResponse }
+type GetReembedStatusRequestObject struct {
+}
 
-func (response UploadFile400JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+type GetReembedStatusResponseObject interface {
+	VisitGetReembedStatusResponse(ctx *fiber.Ctx) error
+}
+
+type GetReembedStatus200JSONResponse ReembedJob
+
+func (response GetReembedStatus200JSONResponse) VisitGetReembedStatusResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type UploadFile401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetReembedStatus401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UploadFile401JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+func (response GetReembedStatus401JSONResponse) VisitGetReembedStatusResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type GetPresignedURLRequestObject struct {
-	Params GetPresignedURLParams
-}
+type GetReembedStatus403JSONResponse struct{ ForbiddenJSONResponse }
 
-type GetPresignedURLResponseObject interface {
-	VisitGetPresignedURLResponse(ctx *fiber.Ctx) error
+func (response GetReembedStatus403JSONResponse) VisitGetReembedStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
 }
 
-type GetPresignedURL200JSONResponse PresignedURLResponse
+type GetReembedStatus404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetPresignedURL200JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+func (response GetReembedStatus404JSONResponse) VisitGetReembedStatusResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type GetPresignedURL400JSONResponse struct{ BadRequestJSONResponse }
+type StartReembedRequestObject struct {
+}
 
-func (response GetPresignedURL400JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+type StartReembedResponseObject interface {
+	VisitStartReembedResponse(ctx *fiber.Ctx) error
+}
+
+type StartReembed202JSONResponse ReembedJob
+
+func (response StartReembed202JSONResponse) VisitStartReembedResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	ctx.Status(202)
 
 	return ctx.JSON(&response)
 }
 
-type GetPresignedURL401JSONResponse struct{ UnauthorizedJSONResponse }
+type StartReembed401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetPresignedURL401JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+func (response StartReembed401JSONResponse) VisitStartReembedResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type HealthCheckRequestObject struct {
+type StartReembed403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response StartReembed403JSONResponse) VisitStartReembedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
 }
 
-type HealthCheckResponseObject interface {
-	VisitHealthCheckResponse(ctx *fiber.Ctx) error
+type StartReembed409JSONResponse Error
+
+func (response StartReembed409JSONResponse) VisitStartReembedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
 }
 
-type HealthCheck200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+type GetAgentCapabilitiesRequestObject struct {
 }
 
-func (response HealthCheck200JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+type GetAgentCapabilitiesResponseObject interface {
+	VisitGetAgentCapabilitiesResponse(ctx *fiber.Ctx) error
+}
+
+type GetAgentCapabilities200JSONResponse AgentCapabilities
+
+func (response GetAgentCapabilities200JSONResponse) VisitGetAgentCapabilitiesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get AI agent status
-	// (GET /api/agent/status)
-	GetAgentStatus(ctx context.Context, request GetAgentStatusRequestObject) (GetAgentStatusResponseObject, error)
-	// List files
-	// (GET /api/files)
-	ListFiles(ctx context.Context, request ListFilesRequestObject) (ListFilesResponseObject, error)
-	// Create file
+type GetAgentCapabilities401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetAgentCapabilities401JSONResponse) VisitGetAgentCapabilitiesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetAgentStatusRequestObject struct {
+}
+
+type GetAgentStatusResponseObject interface {
+	VisitGetAgentStatusResponse(ctx *fiber.Ctx) error
+}
+
+type GetAgentStatus200JSONResponse AgentStatusResponse
+
+func (response GetAgentStatus200JSONResponse) VisitGetAgentStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFilesRequestObject struct {
+	Params ListFilesParams
+}
+
+type ListFilesResponseObject interface {
+	VisitListFilesResponse(ctx *fiber.Ctx) error
+}
+
+type ListFiles200JSONResponse FileListResponse
+
+func (response ListFiles200JSONResponse) VisitListFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFiles400JSONResponse) VisitListFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFiles401JSONResponse) VisitListFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFileRequestObject struct {
+	Body *CreateFileJSONRequestBody
+}
+
+type CreateFileResponseObject interface {
+	VisitCreateFileResponse(ctx *fiber.Ctx) error
+}
+
+type CreateFile201JSONResponse File
+
+func (response CreateFile201JSONResponse) VisitCreateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateFile400JSONResponse) VisitCreateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateFile401JSONResponse) VisitCreateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type BatchDeleteFilesRequestObject struct {
+	Body *BatchDeleteFilesJSONRequestBody
+}
+
+type BatchDeleteFilesResponseObject interface {
+	VisitBatchDeleteFilesResponse(ctx *fiber.Ctx) error
+}
+
+type BatchDeleteFiles200JSONResponse BatchDeleteFilesResult
+
+func (response BatchDeleteFiles200JSONResponse) VisitBatchDeleteFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type BatchDeleteFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BatchDeleteFiles400JSONResponse) VisitBatchDeleteFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type BatchDeleteFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response BatchDeleteFiles401JSONResponse) VisitBatchDeleteFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type BatchDownloadFilesRequestObject struct {
+	Body *BatchDownloadFilesJSONRequestBody
+}
+
+type BatchDownloadFilesResponseObject interface {
+	VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error
+}
+
+type BatchDownloadFiles200ApplicationzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response BatchDownloadFiles200ApplicationzipResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type BatchDownloadFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BatchDownloadFiles400JSONResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type BatchDownloadFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response BatchDownloadFiles401JSONResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type BatchDownloadFiles403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response BatchDownloadFiles403JSONResponse) VisitBatchDownloadFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListFileChangesRequestObject struct {
+	Params ListFileChangesParams
+}
+
+type ListFileChangesResponseObject interface {
+	VisitListFileChangesResponse(ctx *fiber.Ctx) error
+}
+
+type ListFileChanges200JSONResponse FileChangesResponse
+
+func (response ListFileChanges200JSONResponse) VisitListFileChangesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFileChanges400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFileChanges400JSONResponse) VisitListFileChangesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListFileChanges401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFileChanges401JSONResponse) VisitListFileChangesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetProcessingErrorSummaryRequestObject struct {
+}
+
+type GetProcessingErrorSummaryResponseObject interface {
+	VisitGetProcessingErrorSummaryResponse(ctx *fiber.Ctx) error
+}
+
+type GetProcessingErrorSummary200JSONResponse ProcessingErrorSummaryResponse
+
+func (response GetProcessingErrorSummary200JSONResponse) VisitGetProcessingErrorSummaryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetProcessingErrorSummary401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetProcessingErrorSummary401JSONResponse) VisitGetProcessingErrorSummaryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFilesGroupedRequestObject struct {
+	Params ListFilesGroupedParams
+}
+
+type ListFilesGroupedResponseObject interface {
+	VisitListFilesGroupedResponse(ctx *fiber.Ctx) error
+}
+
+type ListFilesGrouped200JSONResponse FileGroup
+
+func (response ListFilesGrouped200JSONResponse) VisitListFilesGroupedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFilesGrouped400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFilesGrouped400JSONResponse) VisitListFilesGroupedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListFilesGrouped401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFilesGrouped401JSONResponse) VisitListFilesGroupedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFilesGrouped404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFilesGrouped404JSONResponse) VisitListFilesGroupedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UnlinkFileInvoiceRequestObject struct {
+	Params UnlinkFileInvoiceParams
+}
+
+type UnlinkFileInvoiceResponseObject interface {
+	VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type UnlinkFileInvoice204Response struct {
+}
+
+func (response UnlinkFileInvoice204Response) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type UnlinkFileInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UnlinkFileInvoice400JSONResponse) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UnlinkFileInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UnlinkFileInvoice401JSONResponse) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UnlinkFileInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnlinkFileInvoice404JSONResponse) VisitUnlinkFileInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFilesRequestObject struct {
+	Body *MoveFilesJSONRequestBody
+}
+
+type MoveFilesResponseObject interface {
+	VisitMoveFilesResponse(ctx *fiber.Ctx) error
+}
+
+type MoveFiles200JSONResponse struct {
+	Message    string `json:"message"`
+	MovedCount int    `json:"moved_count"`
+
+	// UnchangedCount Files that were already in the target folder
+	UnchangedCount int   `json:"unchanged_count"`
+	UnchangedIds   []int `json:"unchanged_ids"`
+}
+
+func (response MoveFiles200JSONResponse) VisitMoveFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MoveFiles400JSONResponse) VisitMoveFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MoveFiles401JSONResponse) VisitMoveFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFilesByFilterRequestObject struct {
+	Body *MoveFilesByFilterJSONRequestBody
+}
+
+type MoveFilesByFilterResponseObject interface {
+	VisitMoveFilesByFilterResponse(ctx *fiber.Ctx) error
+}
+
+type MoveFilesByFilter200JSONResponse struct {
+	Message    string `json:"message"`
+	MovedCount int    `json:"moved_count"`
+
+	// UnchangedCount Matching files that were already in the target folder
+	UnchangedCount int `json:"unchanged_count"`
+}
+
+func (response MoveFilesByFilter200JSONResponse) VisitMoveFilesByFilterResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFilesByFilter400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MoveFilesByFilter400JSONResponse) VisitMoveFilesByFilterResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFilesByFilter401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MoveFilesByFilter401JSONResponse) VisitMoveFilesByFilterResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CancelFilesProcessingRequestObject struct {
+	Body *CancelFilesProcessingJSONRequestBody
+}
+
+type CancelFilesProcessingResponseObject interface {
+	VisitCancelFilesProcessingResponse(ctx *fiber.Ctx) error
+}
+
+type CancelFilesProcessing200JSONResponse StatusTransitionResult
+
+func (response CancelFilesProcessing200JSONResponse) VisitCancelFilesProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CancelFilesProcessing400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CancelFilesProcessing400JSONResponse) VisitCancelFilesProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CancelFilesProcessing401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelFilesProcessing401JSONResponse) VisitCancelFilesProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RetryFilesProcessingRequestObject struct {
+	Body *RetryFilesProcessingJSONRequestBody
+}
+
+type RetryFilesProcessingResponseObject interface {
+	VisitRetryFilesProcessingResponse(ctx *fiber.Ctx) error
+}
+
+type RetryFilesProcessing200JSONResponse StatusTransitionResult
+
+func (response RetryFilesProcessing200JSONResponse) VisitRetryFilesProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RetryFilesProcessing400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RetryFilesProcessing400JSONResponse) VisitRetryFilesProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RetryFilesProcessing401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RetryFilesProcessing401JSONResponse) VisitRetryFilesProcessingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileByPublicIDRequestObject struct {
+	PublicId FilePublicId `json:"public_id"`
+}
+
+type GetFileByPublicIDResponseObject interface {
+	VisitGetFileByPublicIDResponse(ctx *fiber.Ctx) error
+}
+
+type GetFileByPublicID200JSONResponse File
+
+func (response GetFileByPublicID200JSONResponse) VisitGetFileByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileByPublicID401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFileByPublicID401JSONResponse) VisitGetFileByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileByPublicID404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFileByPublicID404JSONResponse) VisitGetFileByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURLByPublicIDRequestObject struct {
+	PublicId FilePublicId `json:"public_id"`
+}
+
+type GetFileDownloadURLByPublicIDResponseObject interface {
+	VisitGetFileDownloadURLByPublicIDResponse(ctx *fiber.Ctx) error
+}
+
+type GetFileDownloadURLByPublicID200JSONResponse FileDownloadResponse
+
+func (response GetFileDownloadURLByPublicID200JSONResponse) VisitGetFileDownloadURLByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURLByPublicID401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFileDownloadURLByPublicID401JSONResponse) VisitGetFileDownloadURLByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURLByPublicID403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetFileDownloadURLByPublicID403JSONResponse) VisitGetFileDownloadURLByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURLByPublicID404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFileDownloadURLByPublicID404JSONResponse) VisitGetFileDownloadURLByPublicIDResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ReclassifyFilesRequestObject struct {
+	Body *ReclassifyFilesJSONRequestBody
+}
+
+type ReclassifyFilesResponseObject interface {
+	VisitReclassifyFilesResponse(ctx *fiber.Ctx) error
+}
+
+type ReclassifyFiles200JSONResponse ReclassifyFilesResult
+
+func (response ReclassifyFiles200JSONResponse) VisitReclassifyFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ReclassifyFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReclassifyFiles400JSONResponse) VisitReclassifyFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ReclassifyFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReclassifyFiles401JSONResponse) VisitReclassifyFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type StreamFilesRequestObject struct {
+	Params StreamFilesParams
+}
+
+type StreamFilesResponseObject interface {
+	VisitStreamFilesResponse(ctx *fiber.Ctx) error
+}
+
+type StreamFiles200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamFiles200ApplicationxNdjsonResponse) VisitStreamFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type StreamFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StreamFiles400JSONResponse) VisitStreamFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type StreamFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StreamFiles401JSONResponse) VisitStreamFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFileRequestObject struct {
+	Id     FileId `json:"id"`
+	Params DeleteFileParams
+}
+
+type DeleteFileResponseObject interface {
+	VisitDeleteFileResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteFile204Response struct {
+}
+
+func (response DeleteFile204Response) VisitDeleteFileResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteFile401JSONResponse) VisitDeleteFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteFile404JSONResponse) VisitDeleteFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type GetFileResponseObject interface {
+	VisitGetFileResponse(ctx *fiber.Ctx) error
+}
+
+type GetFile200JSONResponse File
+
+func (response GetFile200JSONResponse) VisitGetFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFile401JSONResponse) VisitGetFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFile404JSONResponse) VisitGetFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFileRequestObject struct {
+	Id   FileId `json:"id"`
+	Body *UpdateFileJSONRequestBody
+}
+
+type UpdateFileResponseObject interface {
+	VisitUpdateFileResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateFile200JSONResponse File
+
+func (response UpdateFile200JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateFile400JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateFile401JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateFile404JSONResponse) VisitUpdateFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type StreamAgentProgressRequestObject struct {
+	Id     FileId `json:"id"`
+	Params StreamAgentProgressParams
+}
+
+type StreamAgentProgressResponseObject interface {
+	VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error
+}
+
+type StreamAgentProgress200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamAgentProgress200ApplicationxNdjsonResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type StreamAgentProgress200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamAgentProgress200TexteventStreamResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type StreamAgentProgress400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StreamAgentProgress400JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type StreamAgentProgress401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StreamAgentProgress401JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type StreamAgentProgress404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response StreamAgentProgress404JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type StreamAgentProgress503JSONResponse Error
+
+func (response StreamAgentProgress503JSONResponse) VisitStreamAgentProgressResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(503)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileAssociationsRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type GetFileAssociationsResponseObject interface {
+	VisitGetFileAssociationsResponse(ctx *fiber.Ctx) error
+}
+
+type GetFileAssociations200JSONResponse FileAssociations
+
+func (response GetFileAssociations200JSONResponse) VisitGetFileAssociationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileAssociations401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFileAssociations401JSONResponse) VisitGetFileAssociationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileAssociations404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFileAssociations404JSONResponse) VisitGetFileAssociationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileContentTextRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type GetFileContentTextResponseObject interface {
+	VisitGetFileContentTextResponse(ctx *fiber.Ctx) error
+}
+
+type GetFileContentText200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetFileContentText200TextplainCharsetUtf8Response struct {
+	Body          io.Reader
+	Headers       GetFileContentText200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetFileContentText200TextplainCharsetUtf8Response) VisitGetFileContentTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	ctx.Response().Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetFileContentText401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFileContentText401JSONResponse) VisitGetFileContentTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileContentText403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetFileContentText403JSONResponse) VisitGetFileContentTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileContentText404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFileContentText404JSONResponse) VisitGetFileContentTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CopyFileRequestObject struct {
+	Id   FileId `json:"id"`
+	Body *CopyFileJSONRequestBody
+}
+
+type CopyFileResponseObject interface {
+	VisitCopyFileResponse(ctx *fiber.Ctx) error
+}
+
+type CopyFile201JSONResponse File
+
+func (response CopyFile201JSONResponse) VisitCopyFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CopyFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CopyFile400JSONResponse) VisitCopyFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CopyFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CopyFile401JSONResponse) VisitCopyFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CopyFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CopyFile404JSONResponse) VisitCopyFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURLRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type GetFileDownloadURLResponseObject interface {
+	VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error
+}
+
+type GetFileDownloadURL200JSONResponse FileDownloadResponse
+
+func (response GetFileDownloadURL200JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURL401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFileDownloadURL401JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURL403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetFileDownloadURL403JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURL404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFileDownloadURL404JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ClearFileEmbeddingRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type ClearFileEmbeddingResponseObject interface {
+	VisitClearFileEmbeddingResponse(ctx *fiber.Ctx) error
+}
+
+type ClearFileEmbedding200JSONResponse File
+
+func (response ClearFileEmbedding200JSONResponse) VisitClearFileEmbeddingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ClearFileEmbedding401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ClearFileEmbedding401JSONResponse) VisitClearFileEmbeddingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ClearFileEmbedding404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ClearFileEmbedding404JSONResponse) VisitClearFileEmbeddingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type OrganizeFileRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type OrganizeFileResponseObject interface {
+	VisitOrganizeFileResponse(ctx *fiber.Ctx) error
+}
+
+type OrganizeFile200JSONResponse OrganizeFileResult
+
+func (response OrganizeFile200JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type OrganizeFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response OrganizeFile401JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type OrganizeFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response OrganizeFile404JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type OrganizeFile503JSONResponse Error
+
+func (response OrganizeFile503JSONResponse) VisitOrganizeFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(503)
+
+	return ctx.JSON(&response)
+}
+
+type ProcessFileRequestObject struct {
+	Id     FileId `json:"id"`
+	Params ProcessFileParams
+}
+
+type ProcessFileResponseObject interface {
+	VisitProcessFileResponse(ctx *fiber.Ctx) error
+}
+
+type ProcessFile200JSONResponse File
+
+func (response ProcessFile200JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ProcessFile202JSONResponse struct {
+	Message string `json:"message"`
+
+	// Status `needs_review` means the file parsed but the result looks unusable, e.g. a
+	// document with almost no text; re-upload it or retry processing.
+	Status ProcessingStatus `json:"status"`
+}
+
+func (response ProcessFile202JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(202)
+
+	return ctx.JSON(&response)
+}
+
+type ProcessFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ProcessFile400JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ProcessFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ProcessFile401JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ProcessFile408JSONResponse Error
+
+func (response ProcessFile408JSONResponse) VisitProcessFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(408)
+
+	return ctx.JSON(&response)
+}
+
+type ListFileRelationsRequestObject struct {
+	Id FileId `json:"id"`
+}
+
+type ListFileRelationsResponseObject interface {
+	VisitListFileRelationsResponse(ctx *fiber.Ctx) error
+}
+
+type ListFileRelations200JSONResponse FileRelationListResponse
+
+func (response ListFileRelations200JSONResponse) VisitListFileRelationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFileRelations401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFileRelations401JSONResponse) VisitListFileRelationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFileRelations404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFileRelations404JSONResponse) VisitListFileRelationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileRelationRequestObject struct {
+	Id   FileId `json:"id"`
+	Body *AddFileRelationJSONRequestBody
+}
+
+type AddFileRelationResponseObject interface {
+	VisitAddFileRelationResponse(ctx *fiber.Ctx) error
+}
+
+type AddFileRelation201JSONResponse FileRelation
+
+func (response AddFileRelation201JSONResponse) VisitAddFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileRelation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddFileRelation400JSONResponse) VisitAddFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileRelation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddFileRelation401JSONResponse) VisitAddFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileRelation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddFileRelation404JSONResponse) VisitAddFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFileRelationRequestObject struct {
+	Id            FileId `json:"id"`
+	RelatedFileId int    `json:"related_file_id"`
+}
+
+type RemoveFileRelationResponseObject interface {
+	VisitRemoveFileRelationResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveFileRelation204Response struct {
+}
+
+func (response RemoveFileRelation204Response) VisitRemoveFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type RemoveFileRelation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveFileRelation401JSONResponse) VisitRemoveFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFileRelation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveFileRelation404JSONResponse) VisitRemoveFileRelationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagsFromFileRequestObject struct {
+	Id   FileId `json:"id"`
+	Body *RemoveTagsFromFileJSONRequestBody
+}
+
+type RemoveTagsFromFileResponseObject interface {
+	VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveTagsFromFile200JSONResponse File
+
+func (response RemoveTagsFromFile200JSONResponse) VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagsFromFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveTagsFromFile400JSONResponse) VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagsFromFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveTagsFromFile401JSONResponse) VisitRemoveTagsFromFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagsToFileRequestObject struct {
+	Id   FileId `json:"id"`
+	Body *AddTagsToFileJSONRequestBody
+}
+
+type AddTagsToFileResponseObject interface {
+	VisitAddTagsToFileResponse(ctx *fiber.Ctx) error
+}
+
+type AddTagsToFile200JSONResponse FileTagAdditionResult
+
+func (response AddTagsToFile200JSONResponse) VisitAddTagsToFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagsToFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddTagsToFile400JSONResponse) VisitAddTagsToFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagsToFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddTagsToFile401JSONResponse) VisitAddTagsToFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFoldersRequestObject struct {
+	Params ListFoldersParams
+}
+
+type ListFoldersResponseObject interface {
+	VisitListFoldersResponse(ctx *fiber.Ctx) error
+}
+
+type ListFolders200JSONResponse FolderListResponse
+
+func (response ListFolders200JSONResponse) VisitListFoldersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolders400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFolders400JSONResponse) VisitListFoldersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolders401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFolders401JSONResponse) VisitListFoldersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFolderRequestObject struct {
+	Body *CreateFolderJSONRequestBody
+}
+
+type CreateFolderResponseObject interface {
+	VisitCreateFolderResponse(ctx *fiber.Ctx) error
+}
+
+type CreateFolder201JSONResponse Folder
+
+func (response CreateFolder201JSONResponse) VisitCreateFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateFolder400JSONResponse) VisitCreateFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateFolder401JSONResponse) VisitCreateFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ResolveFolderPathRequestObject struct {
+	Params ResolveFolderPathParams
+}
+
+type ResolveFolderPathResponseObject interface {
+	VisitResolveFolderPathResponse(ctx *fiber.Ctx) error
+}
+
+type ResolveFolderPath200JSONResponse Folder
+
+func (response ResolveFolderPath200JSONResponse) VisitResolveFolderPathResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ResolveFolderPath400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResolveFolderPath400JSONResponse) VisitResolveFolderPathResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ResolveFolderPath401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResolveFolderPath401JSONResponse) VisitResolveFolderPathResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ResolveFolderPath404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResolveFolderPath404JSONResponse) VisitResolveFolderPathResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListSharedFoldersRequestObject struct {
+}
+
+type ListSharedFoldersResponseObject interface {
+	VisitListSharedFoldersResponse(ctx *fiber.Ctx) error
+}
+
+type ListSharedFolders200JSONResponse SharedFolderListResponse
+
+func (response ListSharedFolders200JSONResponse) VisitListSharedFoldersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListSharedFolders401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListSharedFolders401JSONResponse) VisitListSharedFoldersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderTreeRequestObject struct {
+	Params GetFolderTreeParams
+}
+
+type GetFolderTreeResponseObject interface {
+	VisitGetFolderTreeResponse(ctx *fiber.Ctx) error
+}
+
+type GetFolderTree200JSONResponse []FolderTree
+
+func (response GetFolderTree200JSONResponse) VisitGetFolderTreeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderTree400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetFolderTree400JSONResponse) VisitGetFolderTreeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderTree401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFolderTree401JSONResponse) VisitGetFolderTreeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ExpandFolderTreeRequestObject struct {
+	Body *ExpandFolderTreeJSONRequestBody
+}
+
+type ExpandFolderTreeResponseObject interface {
+	VisitExpandFolderTreeResponse(ctx *fiber.Ctx) error
+}
+
+type ExpandFolderTree200JSONResponse ExpandFolderTreeResult
+
+func (response ExpandFolderTree200JSONResponse) VisitExpandFolderTreeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ExpandFolderTree400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExpandFolderTree400JSONResponse) VisitExpandFolderTreeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ExpandFolderTree401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExpandFolderTree401JSONResponse) VisitExpandFolderTreeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFolderRequestObject struct {
+	Id     FolderId `json:"id"`
+	Params DeleteFolderParams
+}
+
+type DeleteFolderResponseObject interface {
+	VisitDeleteFolderResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteFolder204Response struct {
+}
+
+func (response DeleteFolder204Response) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteFolder400JSONResponse) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteFolder401JSONResponse) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFolder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteFolder404JSONResponse) VisitDeleteFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderRequestObject struct {
+	Id FolderId `json:"id"`
+}
+
+type GetFolderResponseObject interface {
+	VisitGetFolderResponse(ctx *fiber.Ctx) error
+}
+
+type GetFolder200JSONResponse Folder
+
+func (response GetFolder200JSONResponse) VisitGetFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFolder401JSONResponse) VisitGetFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFolder404JSONResponse) VisitGetFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFolderRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *UpdateFolderJSONRequestBody
+}
+
+type UpdateFolderResponseObject interface {
+	VisitUpdateFolderResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateFolder200JSONResponse Folder
+
+func (response UpdateFolder200JSONResponse) VisitUpdateFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateFolder400JSONResponse) VisitUpdateFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateFolder401JSONResponse) VisitUpdateFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderAliasesRequestObject struct {
+	Id FolderId `json:"id"`
+}
+
+type ListFolderAliasesResponseObject interface {
+	VisitListFolderAliasesResponse(ctx *fiber.Ctx) error
+}
+
+type ListFolderAliases200JSONResponse FolderAliasListResponse
+
+func (response ListFolderAliases200JSONResponse) VisitListFolderAliasesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderAliases401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFolderAliases401JSONResponse) VisitListFolderAliasesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderAliases404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFolderAliases404JSONResponse) VisitListFolderAliasesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddFolderAliasRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *AddFolderAliasJSONRequestBody
+}
+
+type AddFolderAliasResponseObject interface {
+	VisitAddFolderAliasResponse(ctx *fiber.Ctx) error
+}
+
+type AddFolderAlias201JSONResponse FolderAlias
+
+func (response AddFolderAlias201JSONResponse) VisitAddFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AddFolderAlias400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddFolderAlias400JSONResponse) VisitAddFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddFolderAlias401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddFolderAlias401JSONResponse) VisitAddFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddFolderAlias404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddFolderAlias404JSONResponse) VisitAddFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFolderAliasRequestObject struct {
+	Id      FolderId `json:"id"`
+	AliasId int      `json:"alias_id"`
+}
+
+type RemoveFolderAliasResponseObject interface {
+	VisitRemoveFolderAliasResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveFolderAlias204Response struct {
+}
+
+func (response RemoveFolderAlias204Response) VisitRemoveFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type RemoveFolderAlias401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveFolderAlias401JSONResponse) VisitRemoveFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFolderAlias404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveFolderAlias404JSONResponse) VisitRemoveFolderAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderContentsRequestObject struct {
+	Id     FolderId `json:"id"`
+	Params GetFolderContentsParams
+}
+
+type GetFolderContentsResponseObject interface {
+	VisitGetFolderContentsResponse(ctx *fiber.Ctx) error
+}
+
+type GetFolderContents200JSONResponse FolderContents
+
+func (response GetFolderContents200JSONResponse) VisitGetFolderContentsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderContents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFolderContents401JSONResponse) VisitGetFolderContentsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderContents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFolderContents404JSONResponse) VisitGetFolderContentsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderDeletePreviewRequestObject struct {
+	Id     FolderId `json:"id"`
+	Params GetFolderDeletePreviewParams
+}
+
+type GetFolderDeletePreviewResponseObject interface {
+	VisitGetFolderDeletePreviewResponse(ctx *fiber.Ctx) error
+}
+
+type GetFolderDeletePreview200JSONResponse FolderDeletePreview
+
+func (response GetFolderDeletePreview200JSONResponse) VisitGetFolderDeletePreviewResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderDeletePreview401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFolderDeletePreview401JSONResponse) VisitGetFolderDeletePreviewResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFolderDeletePreview404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFolderDeletePreview404JSONResponse) VisitGetFolderDeletePreviewResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderDescendantsRequestObject struct {
+	Id FolderId `json:"id"`
+}
+
+type ListFolderDescendantsResponseObject interface {
+	VisitListFolderDescendantsResponse(ctx *fiber.Ctx) error
+}
+
+type ListFolderDescendants200JSONResponse FolderDescendantListResponse
+
+func (response ListFolderDescendants200JSONResponse) VisitListFolderDescendantsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderDescendants401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFolderDescendants401JSONResponse) VisitListFolderDescendantsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderDescendants404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFolderDescendants404JSONResponse) VisitListFolderDescendantsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DownloadFolderRequestObject struct {
+	Id     FolderId `json:"id"`
+	Params DownloadFolderParams
+}
+
+type DownloadFolderResponseObject interface {
+	VisitDownloadFolderResponse(ctx *fiber.Ctx) error
+}
+
+type DownloadFolder200ApplicationzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadFolder200ApplicationzipResponse) VisitDownloadFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type DownloadFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DownloadFolder400JSONResponse) VisitDownloadFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DownloadFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DownloadFolder401JSONResponse) VisitDownloadFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DownloadFolder403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DownloadFolder403JSONResponse) VisitDownloadFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type DownloadFolder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DownloadFolder404JSONResponse) VisitDownloadFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFileLinksRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *RemoveFileLinksJSONRequestBody
+}
+
+type RemoveFileLinksResponseObject interface {
+	VisitRemoveFileLinksResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveFileLinks204Response struct {
+}
+
+func (response RemoveFileLinks204Response) VisitRemoveFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type RemoveFileLinks400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveFileLinks400JSONResponse) VisitRemoveFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFileLinks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveFileLinks401JSONResponse) VisitRemoveFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveFileLinks404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveFileLinks404JSONResponse) VisitRemoveFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileLinksRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *AddFileLinksJSONRequestBody
+}
+
+type AddFileLinksResponseObject interface {
+	VisitAddFileLinksResponse(ctx *fiber.Ctx) error
+}
+
+type AddFileLinks204Response struct {
+}
+
+func (response AddFileLinks204Response) VisitAddFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AddFileLinks400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddFileLinks400JSONResponse) VisitAddFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileLinks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddFileLinks401JSONResponse) VisitAddFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddFileLinks404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddFileLinks404JSONResponse) VisitAddFileLinksResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderMembersRequestObject struct {
+	Id FolderId `json:"id"`
+}
+
+type ListFolderMembersResponseObject interface {
+	VisitListFolderMembersResponse(ctx *fiber.Ctx) error
+}
+
+type ListFolderMembers200JSONResponse FolderMemberListResponse
+
+func (response ListFolderMembers200JSONResponse) VisitListFolderMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFolderMembers401JSONResponse) VisitListFolderMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFolderMembers404JSONResponse) VisitListFolderMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ShareFolderRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *ShareFolderJSONRequestBody
+}
+
+type ShareFolderResponseObject interface {
+	VisitShareFolderResponse(ctx *fiber.Ctx) error
+}
+
+type ShareFolder200JSONResponse FolderMember
+
+func (response ShareFolder200JSONResponse) VisitShareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ShareFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ShareFolder400JSONResponse) VisitShareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ShareFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ShareFolder401JSONResponse) VisitShareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ShareFolder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ShareFolder404JSONResponse) VisitShareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UnshareFolderRequestObject struct {
+	Id     FolderId `json:"id"`
+	UserId string   `json:"user_id"`
+}
+
+type UnshareFolderResponseObject interface {
+	VisitUnshareFolderResponse(ctx *fiber.Ctx) error
+}
+
+type UnshareFolder204Response struct {
+}
+
+func (response UnshareFolder204Response) VisitUnshareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type UnshareFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UnshareFolder401JSONResponse) VisitUnshareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UnshareFolder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnshareFolder404JSONResponse) VisitUnshareFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFolderRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *MoveFolderJSONRequestBody
+}
+
+type MoveFolderResponseObject interface {
+	VisitMoveFolderResponse(ctx *fiber.Ctx) error
+}
+
+type MoveFolder200JSONResponse Folder
+
+func (response MoveFolder200JSONResponse) VisitMoveFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MoveFolder400JSONResponse) VisitMoveFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type MoveFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MoveFolder401JSONResponse) VisitMoveFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreFolderRequestObject struct {
+	Id FolderId `json:"id"`
+}
+
+type RestoreFolderResponseObject interface {
+	VisitRestoreFolderResponse(ctx *fiber.Ctx) error
+}
+
+type RestoreFolder200JSONResponse Folder
+
+func (response RestoreFolder200JSONResponse) VisitRestoreFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RestoreFolder400JSONResponse) VisitRestoreFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RestoreFolder401JSONResponse) VisitRestoreFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreFolder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreFolder404JSONResponse) VisitRestoreFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagsFromFolderRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *RemoveTagsFromFolderJSONRequestBody
+}
+
+type RemoveTagsFromFolderResponseObject interface {
+	VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveTagsFromFolder200JSONResponse Folder
+
+func (response RemoveTagsFromFolder200JSONResponse) VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagsFromFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveTagsFromFolder400JSONResponse) VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagsFromFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveTagsFromFolder401JSONResponse) VisitRemoveTagsFromFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderTagsRequestObject struct {
+	Id     FolderId `json:"id"`
+	Params ListFolderTagsParams
+}
+
+type ListFolderTagsResponseObject interface {
+	VisitListFolderTagsResponse(ctx *fiber.Ctx) error
+}
+
+type ListFolderTags200JSONResponse FolderTagsResponse
+
+func (response ListFolderTags200JSONResponse) VisitListFolderTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderTags401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFolderTags401JSONResponse) VisitListFolderTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFolderTags404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListFolderTags404JSONResponse) VisitListFolderTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagsToFolderRequestObject struct {
+	Id   FolderId `json:"id"`
+	Body *AddTagsToFolderJSONRequestBody
+}
+
+type AddTagsToFolderResponseObject interface {
+	VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error
+}
+
+type AddTagsToFolder200JSONResponse Folder
+
+func (response AddTagsToFolder200JSONResponse) VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagsToFolder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddTagsToFolder400JSONResponse) VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagsToFolder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddTagsToFolder401JSONResponse) VisitAddTagsToFolderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListFoldingRulesRequestObject struct {
+}
+
+type ListFoldingRulesResponseObject interface {
+	VisitListFoldingRulesResponse(ctx *fiber.Ctx) error
+}
+
+type ListFoldingRules200JSONResponse FoldingRuleListResponse
+
+func (response ListFoldingRules200JSONResponse) VisitListFoldingRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListFoldingRules401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFoldingRules401JSONResponse) VisitListFoldingRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFoldingRuleRequestObject struct {
+	Body *CreateFoldingRuleJSONRequestBody
+}
+
+type CreateFoldingRuleResponseObject interface {
+	VisitCreateFoldingRuleResponse(ctx *fiber.Ctx) error
+}
+
+type CreateFoldingRule201JSONResponse FoldingRule
+
+func (response CreateFoldingRule201JSONResponse) VisitCreateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFoldingRule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateFoldingRule400JSONResponse) VisitCreateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateFoldingRule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateFoldingRule401JSONResponse) VisitCreateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFoldingRuleRequestObject struct {
+	Id FoldingRuleId `json:"id"`
+}
+
+type DeleteFoldingRuleResponseObject interface {
+	VisitDeleteFoldingRuleResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteFoldingRule204Response struct {
+}
+
+func (response DeleteFoldingRule204Response) VisitDeleteFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteFoldingRule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteFoldingRule401JSONResponse) VisitDeleteFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteFoldingRule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteFoldingRule404JSONResponse) VisitDeleteFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFoldingRuleRequestObject struct {
+	Id   FoldingRuleId `json:"id"`
+	Body *UpdateFoldingRuleJSONRequestBody
+}
+
+type UpdateFoldingRuleResponseObject interface {
+	VisitUpdateFoldingRuleResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateFoldingRule200JSONResponse FoldingRule
+
+func (response UpdateFoldingRule200JSONResponse) VisitUpdateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFoldingRule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateFoldingRule400JSONResponse) VisitUpdateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFoldingRule401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateFoldingRule401JSONResponse) VisitUpdateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateFoldingRule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateFoldingRule404JSONResponse) VisitUpdateFoldingRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ParserCallbackRequestObject struct {
+	Body *ParserCallbackJSONRequestBody
+}
+
+type ParserCallbackResponseObject interface {
+	VisitParserCallbackResponse(ctx *fiber.Ctx) error
+}
+
+type ParserCallback202JSONResponse ParserCallbackResult
+
+func (response ParserCallback202JSONResponse) VisitParserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(202)
+
+	return ctx.JSON(&response)
+}
+
+type ParserCallback400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ParserCallback400JSONResponse) VisitParserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ParserCallback404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ParserCallback404JSONResponse) VisitParserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SearchFilesRequestObject struct {
+	Params SearchFilesParams
+}
+
+type SearchFilesResponseObject interface {
+	VisitSearchFilesResponse(ctx *fiber.Ctx) error
+}
+
+type SearchFiles200ResponseHeaders struct {
+	XSearchCache string
+}
+
+type SearchFiles200JSONResponse struct {
+	Body    SearchResponse
+	Headers SearchFiles200ResponseHeaders
+}
+
+func (response SearchFiles200JSONResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("X-Search-Cache", fmt.Sprint(response.Headers.XSearchCache))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type SearchFiles200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       SearchFiles200ResponseHeaders
+	ContentLength int64
+}
+
+func (response SearchFiles200TextcsvResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("X-Search-Cache", fmt.Sprint(response.Headers.XSearchCache))
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type SearchFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchFiles400JSONResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SearchFiles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchFiles401JSONResponse) VisitSearchFilesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListTagsRequestObject struct {
+	Params ListTagsParams
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(ctx *fiber.Ctx) error
+}
+
+type ListTags200JSONResponse TagListResponse
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListTags401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListTags401JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(ctx *fiber.Ctx) error
+}
+
+type CreateTag201JSONResponse CreatedTag
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTag400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateTag400JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateTag401JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTagsRequestObject struct {
+	Body *CreateTagsJSONRequestBody
+}
+
+type CreateTagsResponseObject interface {
+	VisitCreateTagsResponse(ctx *fiber.Ctx) error
+}
+
+type CreateTags201JSONResponse BulkCreateTagsResponse
+
+func (response CreateTags201JSONResponse) VisitCreateTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateTags400JSONResponse) VisitCreateTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTags401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateTags401JSONResponse) VisitCreateTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTagsRequestObject struct {
+	Body *DeleteTagsJSONRequestBody
+}
+
+type DeleteTagsResponseObject interface {
+	VisitDeleteTagsResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteTags200JSONResponse BulkDeleteTagsResponse
+
+func (response DeleteTags200JSONResponse) VisitDeleteTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteTags400JSONResponse) VisitDeleteTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTags401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteTags401JSONResponse) VisitDeleteTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTagRequestObject struct {
+	Id TagId `json:"id"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteTag204Response struct {
+}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteTag401JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTag404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetTagRequestObject struct {
+	Id TagId `json:"id"`
+}
+
+type GetTagResponseObject interface {
+	VisitGetTagResponse(ctx *fiber.Ctx) error
+}
+
+type GetTag200JSONResponse Tag
+
+func (response GetTag200JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTag401JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetTag404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTag404JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateTagRequestObject struct {
+	Id   TagId `json:"id"`
+	Body *UpdateTagJSONRequestBody
+}
+
+type UpdateTagResponseObject interface {
+	VisitUpdateTagResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateTag200JSONResponse Tag
+
+func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateTag400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateTag400JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateTag401JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagAliasRequestObject struct {
+	Id   TagId `json:"id"`
+	Body *AddTagAliasJSONRequestBody
+}
+
+type AddTagAliasResponseObject interface {
+	VisitAddTagAliasResponse(ctx *fiber.Ctx) error
+}
+
+type AddTagAlias201JSONResponse Tag
+
+func (response AddTagAlias201JSONResponse) VisitAddTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagAlias400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddTagAlias400JSONResponse) VisitAddTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagAlias401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddTagAlias401JSONResponse) VisitAddTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagAlias404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddTagAlias404JSONResponse) VisitAddTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagAliasRequestObject struct {
+	Id      TagId `json:"id"`
+	AliasId int   `json:"alias_id"`
+}
+
+type RemoveTagAliasResponseObject interface {
+	VisitRemoveTagAliasResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveTagAlias204Response struct {
+}
+
+func (response RemoveTagAlias204Response) VisitRemoveTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type RemoveTagAlias401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveTagAlias401JSONResponse) VisitRemoveTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagAlias404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveTagAlias404JSONResponse) VisitRemoveTagAliasResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFileRequestObject struct {
+	Params UploadFileParams
+	Body   *multipart.Reader
+}
+
+type UploadFileResponseObject interface {
+	VisitUploadFileResponse(ctx *fiber.Ctx) error
+}
+
+type UploadFile201JSONResponse UploadResponse
+
+func (response UploadFile201JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UploadFile400JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UploadFile401JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PresignUploadBatchRequestObject struct {
+	Body *PresignUploadBatchJSONRequestBody
+}
+
+type PresignUploadBatchResponseObject interface {
+	VisitPresignUploadBatchResponse(ctx *fiber.Ctx) error
+}
+
+type PresignUploadBatch200JSONResponse PresignBatchResponse
+
+func (response PresignUploadBatch200JSONResponse) VisitPresignUploadBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PresignUploadBatch400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PresignUploadBatch400JSONResponse) VisitPresignUploadBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PresignUploadBatch401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PresignUploadBatch401JSONResponse) VisitPresignUploadBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetPresignedURLRequestObject struct {
+	Params GetPresignedURLParams
+}
+
+type GetPresignedURLResponseObject interface {
+	VisitGetPresignedURLResponse(ctx *fiber.Ctx) error
+}
+
+type GetPresignedURL200JSONResponse PresignedURLResponse
+
+func (response GetPresignedURL200JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetPresignedURL400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPresignedURL400JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetPresignedURL401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPresignedURL401JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type HealthCheckRequestObject struct {
+}
+
+type HealthCheckResponseObject interface {
+	VisitHealthCheckResponse(ctx *fiber.Ctx) error
+}
+
+type HealthCheck200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response HealthCheck200JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get processing status
+	// (GET /api/admin/processing-status)
+	GetProcessingStatus(ctx context.Context, request GetProcessingStatusRequestObject) (GetProcessingStatusResponseObject, error)
+	// Pause processing
+	// (POST /api/admin/processing/pause)
+	PauseProcessing(ctx context.Context, request PauseProcessingRequestObject) (PauseProcessingResponseObject, error)
+	// Resume processing
+	// (POST /api/admin/processing/resume)
+	ResumeProcessing(ctx context.Context, request ResumeProcessingRequestObject) (ResumeProcessingResponseObject, error)
+	// Reassign ownership
+	// (POST /api/admin/reassign)
+	ReassignOwnership(ctx context.Context, request ReassignOwnershipRequestObject) (ReassignOwnershipResponseObject, error)
+	// Get re-embed progress
+	// (GET /api/admin/reembed)
+	GetReembedStatus(ctx context.Context, request GetReembedStatusRequestObject) (GetReembedStatusResponseObject, error)
+	// Re-embed files
+	// (POST /api/admin/reembed)
+	StartReembed(ctx context.Context, request StartReembedRequestObject) (StartReembedResponseObject, error)
+	// Get AI agent capabilities
+	// (GET /api/agent/capabilities)
+	GetAgentCapabilities(ctx context.Context, request GetAgentCapabilitiesRequestObject) (GetAgentCapabilitiesResponseObject, error)
+	// Get AI agent status
+	// (GET /api/agent/status)
+	GetAgentStatus(ctx context.Context, request GetAgentStatusRequestObject) (GetAgentStatusResponseObject, error)
+	// List files
+	// (GET /api/files)
+	ListFiles(ctx context.Context, request ListFilesRequestObject) (ListFilesResponseObject, error)
+	// Create file
 	// (POST /api/files)
 	CreateFile(ctx context.Context, request CreateFileRequestObject) (CreateFileResponseObject, error)
+	// Delete files in bulk
+	// (POST /api/files/batch-delete)
+	BatchDeleteFiles(ctx context.Context, request BatchDeleteFilesRequestObject) (BatchDeleteFilesResponseObject, error)
 	// Batch download files as ZIP
 	// (POST /api/files/batch-download)
 	BatchDownloadFiles(ctx context.Context, request BatchDownloadFilesRequestObject) (BatchDownloadFilesResponseObject, error)
+	// List file changes
+	// (GET /api/files/changes)
+	ListFileChanges(ctx context.Context, request ListFileChangesRequestObject) (ListFileChangesResponseObject, error)
+	// Summarize processing errors
+	// (GET /api/files/errors/summary)
+	GetProcessingErrorSummary(ctx context.Context, request GetProcessingErrorSummaryRequestObject) (GetProcessingErrorSummaryResponseObject, error)
+	// List files grouped by folder
+	// (GET /api/files/grouped)
+	ListFilesGrouped(ctx context.Context, request ListFilesGroupedRequestObject) (ListFilesGroupedResponseObject, error)
 	// Unlink invoice from file
 	// (DELETE /api/files/invoice)
 	UnlinkFileInvoice(ctx context.Context, request UnlinkFileInvoiceRequestObject) (UnlinkFileInvoiceResponseObject, error)
 	// Move files
 	// (POST /api/files/move)
 	MoveFiles(ctx context.Context, request MoveFilesRequestObject) (MoveFilesResponseObject, error)
+	// Move files matching a filter
+	// (POST /api/files/move-by-filter)
+	MoveFilesByFilter(ctx context.Context, request MoveFilesByFilterRequestObject) (MoveFilesByFilterResponseObject, error)
+	// Cancel processing for files
+	// (POST /api/files/process/cancel)
+	CancelFilesProcessing(ctx context.Context, request CancelFilesProcessingRequestObject) (CancelFilesProcessingResponseObject, error)
+	// Retry processing for failed files
+	// (POST /api/files/process/retry)
+	RetryFilesProcessing(ctx context.Context, request RetryFilesProcessingRequestObject) (RetryFilesProcessingResponseObject, error)
+	// Get file by public ID
+	// (GET /api/files/public/{public_id})
+	GetFileByPublicID(ctx context.Context, request GetFileByPublicIDRequestObject) (GetFileByPublicIDResponseObject, error)
+	// Get file download URL by public ID
+	// (GET /api/files/public/{public_id}/download)
+	GetFileDownloadURLByPublicID(ctx context.Context, request GetFileDownloadURLByPublicIDRequestObject) (GetFileDownloadURLByPublicIDResponseObject, error)
+	// Reclassify files from stored content
+	// (POST /api/files/reclassify)
+	ReclassifyFiles(ctx context.Context, request ReclassifyFilesRequestObject) (ReclassifyFilesResponseObject, error)
+	// Stream files as NDJSON
+	// (GET /api/files/stream)
+	StreamFiles(ctx context.Context, request StreamFilesRequestObject) (StreamFilesResponseObject, error)
 	// Delete file
 	// (DELETE /api/files/{id})
 	DeleteFile(ctx context.Context, request DeleteFileRequestObject) (DeleteFileResponseObject, error)
@@ -2138,15 +5935,36 @@ type StrictServerInterface interface {
 	// Stream AI agent progress
 	// (GET /api/files/{id}/agent-stream)
 	StreamAgentProgress(ctx context.Context, request StreamAgentProgressRequestObject) (StreamAgentProgressResponseObject, error)
+	// Get file associations
+	// (GET /api/files/{id}/associations)
+	GetFileAssociations(ctx context.Context, request GetFileAssociationsRequestObject) (GetFileAssociationsResponseObject, error)
+	// Download extracted text
+	// (GET /api/files/{id}/content.txt)
+	GetFileContentText(ctx context.Context, request GetFileContentTextRequestObject) (GetFileContentTextResponseObject, error)
+	// Copy a file
+	// (POST /api/files/{id}/copy)
+	CopyFile(ctx context.Context, request CopyFileRequestObject) (CopyFileResponseObject, error)
 	// Get file download URL
 	// (GET /api/files/{id}/download)
 	GetFileDownloadURL(ctx context.Context, request GetFileDownloadURLRequestObject) (GetFileDownloadURLResponseObject, error)
+	// Clear file embedding
+	// (POST /api/files/{id}/embedding/clear)
+	ClearFileEmbedding(ctx context.Context, request ClearFileEmbeddingRequestObject) (ClearFileEmbeddingResponseObject, error)
 	// Trigger AI organization
 	// (POST /api/files/{id}/organize)
 	OrganizeFile(ctx context.Context, request OrganizeFileRequestObject) (OrganizeFileResponseObject, error)
 	// Process file
 	// (POST /api/files/{id}/process)
 	ProcessFile(ctx context.Context, request ProcessFileRequestObject) (ProcessFileResponseObject, error)
+	// List related files
+	// (GET /api/files/{id}/relations)
+	ListFileRelations(ctx context.Context, request ListFileRelationsRequestObject) (ListFileRelationsResponseObject, error)
+	// Relate a file
+	// (POST /api/files/{id}/relations)
+	AddFileRelation(ctx context.Context, request AddFileRelationRequestObject) (AddFileRelationResponseObject, error)
+	// Remove file relation
+	// (DELETE /api/files/{id}/relations/{related_file_id})
+	RemoveFileRelation(ctx context.Context, request RemoveFileRelationRequestObject) (RemoveFileRelationResponseObject, error)
 	// Remove tags from file
 	// (DELETE /api/files/{id}/tags)
 	RemoveTagsFromFile(ctx context.Context, request RemoveTagsFromFileRequestObject) (RemoveTagsFromFileResponseObject, error)
@@ -2159,9 +5977,18 @@ type StrictServerInterface interface {
 	// Create folder
 	// (POST /api/folders)
 	CreateFolder(ctx context.Context, request CreateFolderRequestObject) (CreateFolderResponseObject, error)
+	// Resolve folder path
+	// (GET /api/folders/resolve)
+	ResolveFolderPath(ctx context.Context, request ResolveFolderPathRequestObject) (ResolveFolderPathResponseObject, error)
+	// List folders shared with me
+	// (GET /api/folders/shared)
+	ListSharedFolders(ctx context.Context, request ListSharedFoldersRequestObject) (ListSharedFoldersResponseObject, error)
 	// Get folder tree
 	// (GET /api/folders/tree)
 	GetFolderTree(ctx context.Context, request GetFolderTreeRequestObject) (GetFolderTreeResponseObject, error)
+	// Expand folder tree branches
+	// (POST /api/folders/tree/expand)
+	ExpandFolderTree(ctx context.Context, request ExpandFolderTreeRequestObject) (ExpandFolderTreeResponseObject, error)
 	// Delete folder
 	// (DELETE /api/folders/{id})
 	DeleteFolder(ctx context.Context, request DeleteFolderRequestObject) (DeleteFolderResponseObject, error)
@@ -2171,15 +5998,72 @@ type StrictServerInterface interface {
 	// Update folder
 	// (PUT /api/folders/{id})
 	UpdateFolder(ctx context.Context, request UpdateFolderRequestObject) (UpdateFolderResponseObject, error)
+	// List folder aliases
+	// (GET /api/folders/{id}/aliases)
+	ListFolderAliases(ctx context.Context, request ListFolderAliasesRequestObject) (ListFolderAliasesResponseObject, error)
+	// Add folder alias
+	// (POST /api/folders/{id}/aliases)
+	AddFolderAlias(ctx context.Context, request AddFolderAliasRequestObject) (AddFolderAliasResponseObject, error)
+	// Remove folder alias
+	// (DELETE /api/folders/{id}/aliases/{alias_id})
+	RemoveFolderAlias(ctx context.Context, request RemoveFolderAliasRequestObject) (RemoveFolderAliasResponseObject, error)
+	// Get folder contents
+	// (GET /api/folders/{id}/contents)
+	GetFolderContents(ctx context.Context, request GetFolderContentsRequestObject) (GetFolderContentsResponseObject, error)
+	// Preview folder deletion
+	// (GET /api/folders/{id}/delete-preview)
+	GetFolderDeletePreview(ctx context.Context, request GetFolderDeletePreviewRequestObject) (GetFolderDeletePreviewResponseObject, error)
+	// List folder descendants
+	// (GET /api/folders/{id}/descendants)
+	ListFolderDescendants(ctx context.Context, request ListFolderDescendantsRequestObject) (ListFolderDescendantsResponseObject, error)
+	// Download folder as ZIP
+	// (GET /api/folders/{id}/download)
+	DownloadFolder(ctx context.Context, request DownloadFolderRequestObject) (DownloadFolderResponseObject, error)
+	// Unlink files from folder
+	// (DELETE /api/folders/{id}/links)
+	RemoveFileLinks(ctx context.Context, request RemoveFileLinksRequestObject) (RemoveFileLinksResponseObject, error)
+	// Link files into folder
+	// (POST /api/folders/{id}/links)
+	AddFileLinks(ctx context.Context, request AddFileLinksRequestObject) (AddFileLinksResponseObject, error)
+	// List folder members
+	// (GET /api/folders/{id}/members)
+	ListFolderMembers(ctx context.Context, request ListFolderMembersRequestObject) (ListFolderMembersResponseObject, error)
+	// Share folder
+	// (POST /api/folders/{id}/members)
+	ShareFolder(ctx context.Context, request ShareFolderRequestObject) (ShareFolderResponseObject, error)
+	// Unshare folder
+	// (DELETE /api/folders/{id}/members/{user_id})
+	UnshareFolder(ctx context.Context, request UnshareFolderRequestObject) (UnshareFolderResponseObject, error)
 	// Move folder
 	// (POST /api/folders/{id}/move)
 	MoveFolder(ctx context.Context, request MoveFolderRequestObject) (MoveFolderResponseObject, error)
+	// Restore deleted folder
+	// (POST /api/folders/{id}/restore)
+	RestoreFolder(ctx context.Context, request RestoreFolderRequestObject) (RestoreFolderResponseObject, error)
 	// Remove tags from folder
 	// (DELETE /api/folders/{id}/tags)
 	RemoveTagsFromFolder(ctx context.Context, request RemoveTagsFromFolderRequestObject) (RemoveTagsFromFolderResponseObject, error)
+	// List tags used in folder
+	// (GET /api/folders/{id}/tags)
+	ListFolderTags(ctx context.Context, request ListFolderTagsRequestObject) (ListFolderTagsResponseObject, error)
 	// Add tags to folder
 	// (POST /api/folders/{id}/tags)
 	AddTagsToFolder(ctx context.Context, request AddTagsToFolderRequestObject) (AddTagsToFolderResponseObject, error)
+	// List folding rules
+	// (GET /api/folding-rules)
+	ListFoldingRules(ctx context.Context, request ListFoldingRulesRequestObject) (ListFoldingRulesResponseObject, error)
+	// Create folding rule
+	// (POST /api/folding-rules)
+	CreateFoldingRule(ctx context.Context, request CreateFoldingRuleRequestObject) (CreateFoldingRuleResponseObject, error)
+	// Delete folding rule
+	// (DELETE /api/folding-rules/{id})
+	DeleteFoldingRule(ctx context.Context, request DeleteFoldingRuleRequestObject) (DeleteFoldingRuleResponseObject, error)
+	// Update folding rule
+	// (PUT /api/folding-rules/{id})
+	UpdateFoldingRule(ctx context.Context, request UpdateFoldingRuleRequestObject) (UpdateFoldingRuleResponseObject, error)
+	// Content parser callback
+	// (POST /api/internal/parser-callback)
+	ParserCallback(ctx context.Context, request ParserCallbackRequestObject) (ParserCallbackResponseObject, error)
 	// Search files
 	// (GET /api/search)
 	SearchFiles(ctx context.Context, request SearchFilesRequestObject) (SearchFilesResponseObject, error)
@@ -2189,6 +6073,12 @@ type StrictServerInterface interface {
 	// Create tag
 	// (POST /api/tags)
 	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
+	// Create tags in bulk
+	// (POST /api/tags/bulk)
+	CreateTags(ctx context.Context, request CreateTagsRequestObject) (CreateTagsResponseObject, error)
+	// Delete tags in bulk
+	// (POST /api/tags/bulk-delete)
+	DeleteTags(ctx context.Context, request DeleteTagsRequestObject) (DeleteTagsResponseObject, error)
 	// Delete tag
 	// (DELETE /api/tags/{id})
 	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
@@ -2198,9 +6088,18 @@ type StrictServerInterface interface {
 	// Update tag
 	// (PUT /api/tags/{id})
 	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
+	// Add tag alias
+	// (POST /api/tags/{id}/aliases)
+	AddTagAlias(ctx context.Context, request AddTagAliasRequestObject) (AddTagAliasResponseObject, error)
+	// Remove tag alias
+	// (DELETE /api/tags/{id}/aliases/{alias_id})
+	RemoveTagAlias(ctx context.Context, request RemoveTagAliasRequestObject) (RemoveTagAliasResponseObject, error)
 	// Upload file
 	// (POST /api/upload)
 	UploadFile(ctx context.Context, request UploadFileRequestObject) (UploadFileResponseObject, error)
+	// Get presigned upload URLs for several files
+	// (POST /api/upload/presign-batch)
+	PresignUploadBatch(ctx context.Context, request PresignUploadBatchRequestObject) (PresignUploadBatchResponseObject, error)
 	// Get presigned upload URL
 	// (GET /api/upload/presigned)
 	GetPresignedURL(ctx context.Context, request GetPresignedURLRequestObject) (GetPresignedURLResponseObject, error)
@@ -2209,36 +6108,1257 @@ type StrictServerInterface interface {
 	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
 }
 
-type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetProcessingStatus operation middleware
+func (sh *strictHandler) GetProcessingStatus(ctx *fiber.Ctx, params GetProcessingStatusParams) error {
+	var request GetProcessingStatusRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProcessingStatus(ctx.UserContext(), request.(GetProcessingStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProcessingStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetProcessingStatusResponseObject); ok {
+		if err := validResponse.VisitGetProcessingStatusResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PauseProcessing operation middleware
+func (sh *strictHandler) PauseProcessing(ctx *fiber.Ctx) error {
+	var request PauseProcessingRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseProcessing(ctx.UserContext(), request.(PauseProcessingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseProcessing")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PauseProcessingResponseObject); ok {
+		if err := validResponse.VisitPauseProcessingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ResumeProcessing operation middleware
+func (sh *strictHandler) ResumeProcessing(ctx *fiber.Ctx) error {
+	var request ResumeProcessingRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeProcessing(ctx.UserContext(), request.(ResumeProcessingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeProcessing")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ResumeProcessingResponseObject); ok {
+		if err := validResponse.VisitResumeProcessingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReassignOwnership operation middleware
+func (sh *strictHandler) ReassignOwnership(ctx *fiber.Ctx) error {
+	var request ReassignOwnershipRequestObject
+
+	var body ReassignOwnershipJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignOwnership(ctx.UserContext(), request.(ReassignOwnershipRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignOwnership")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ReassignOwnershipResponseObject); ok {
+		if err := validResponse.VisitReassignOwnershipResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetReembedStatus operation middleware
+func (sh *strictHandler) GetReembedStatus(ctx *fiber.Ctx) error {
+	var request GetReembedStatusRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReembedStatus(ctx.UserContext(), request.(GetReembedStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReembedStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetReembedStatusResponseObject); ok {
+		if err := validResponse.VisitGetReembedStatusResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StartReembed operation middleware
+func (sh *strictHandler) StartReembed(ctx *fiber.Ctx) error {
+	var request StartReembedRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.StartReembed(ctx.UserContext(), request.(StartReembedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartReembed")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(StartReembedResponseObject); ok {
+		if err := validResponse.VisitStartReembedResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAgentCapabilities operation middleware
+func (sh *strictHandler) GetAgentCapabilities(ctx *fiber.Ctx) error {
+	var request GetAgentCapabilitiesRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgentCapabilities(ctx.UserContext(), request.(GetAgentCapabilitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgentCapabilities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAgentCapabilitiesResponseObject); ok {
+		if err := validResponse.VisitGetAgentCapabilitiesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAgentStatus operation middleware
+func (sh *strictHandler) GetAgentStatus(ctx *fiber.Ctx) error {
+	var request GetAgentStatusRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgentStatus(ctx.UserContext(), request.(GetAgentStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgentStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAgentStatusResponseObject); ok {
+		if err := validResponse.VisitGetAgentStatusResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFiles operation middleware
+func (sh *strictHandler) ListFiles(ctx *fiber.Ctx, params ListFilesParams) error {
+	var request ListFilesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFiles(ctx.UserContext(), request.(ListFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListFilesResponseObject); ok {
+		if err := validResponse.VisitListFilesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateFile operation middleware
+func (sh *strictHandler) CreateFile(ctx *fiber.Ctx) error {
+	var request CreateFileRequestObject
+
+	var body CreateFileJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateFile(ctx.UserContext(), request.(CreateFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateFileResponseObject); ok {
+		if err := validResponse.VisitCreateFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BatchDeleteFiles operation middleware
+func (sh *strictHandler) BatchDeleteFiles(ctx *fiber.Ctx) error {
+	var request BatchDeleteFilesRequestObject
+
+	var body BatchDeleteFilesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchDeleteFiles(ctx.UserContext(), request.(BatchDeleteFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchDeleteFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(BatchDeleteFilesResponseObject); ok {
+		if err := validResponse.VisitBatchDeleteFilesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BatchDownloadFiles operation middleware
+func (sh *strictHandler) BatchDownloadFiles(ctx *fiber.Ctx) error {
+	var request BatchDownloadFilesRequestObject
+
+	var body BatchDownloadFilesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchDownloadFiles(ctx.UserContext(), request.(BatchDownloadFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchDownloadFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(BatchDownloadFilesResponseObject); ok {
+		if err := validResponse.VisitBatchDownloadFilesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFileChanges operation middleware
+func (sh *strictHandler) ListFileChanges(ctx *fiber.Ctx, params ListFileChangesParams) error {
+	var request ListFileChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFileChanges(ctx.UserContext(), request.(ListFileChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFileChanges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListFileChangesResponseObject); ok {
+		if err := validResponse.VisitListFileChangesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProcessingErrorSummary operation middleware
+func (sh *strictHandler) GetProcessingErrorSummary(ctx *fiber.Ctx) error {
+	var request GetProcessingErrorSummaryRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProcessingErrorSummary(ctx.UserContext(), request.(GetProcessingErrorSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProcessingErrorSummary")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetProcessingErrorSummaryResponseObject); ok {
+		if err := validResponse.VisitGetProcessingErrorSummaryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFilesGrouped operation middleware
+func (sh *strictHandler) ListFilesGrouped(ctx *fiber.Ctx, params ListFilesGroupedParams) error {
+	var request ListFilesGroupedRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFilesGrouped(ctx.UserContext(), request.(ListFilesGroupedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFilesGrouped")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListFilesGroupedResponseObject); ok {
+		if err := validResponse.VisitListFilesGroupedResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UnlinkFileInvoice operation middleware
+func (sh *strictHandler) UnlinkFileInvoice(ctx *fiber.Ctx, params UnlinkFileInvoiceParams) error {
+	var request UnlinkFileInvoiceRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlinkFileInvoice(ctx.UserContext(), request.(UnlinkFileInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlinkFileInvoice")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UnlinkFileInvoiceResponseObject); ok {
+		if err := validResponse.VisitUnlinkFileInvoiceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MoveFiles operation middleware
+func (sh *strictHandler) MoveFiles(ctx *fiber.Ctx) error {
+	var request MoveFilesRequestObject
+
+	var body MoveFilesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveFiles(ctx.UserContext(), request.(MoveFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(MoveFilesResponseObject); ok {
+		if err := validResponse.VisitMoveFilesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MoveFilesByFilter operation middleware
+func (sh *strictHandler) MoveFilesByFilter(ctx *fiber.Ctx) error {
+	var request MoveFilesByFilterRequestObject
+
+	var body MoveFilesByFilterJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveFilesByFilter(ctx.UserContext(), request.(MoveFilesByFilterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveFilesByFilter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(MoveFilesByFilterResponseObject); ok {
+		if err := validResponse.VisitMoveFilesByFilterResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelFilesProcessing operation middleware
+func (sh *strictHandler) CancelFilesProcessing(ctx *fiber.Ctx) error {
+	var request CancelFilesProcessingRequestObject
+
+	var body CancelFilesProcessingJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelFilesProcessing(ctx.UserContext(), request.(CancelFilesProcessingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelFilesProcessing")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CancelFilesProcessingResponseObject); ok {
+		if err := validResponse.VisitCancelFilesProcessingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RetryFilesProcessing operation middleware
+func (sh *strictHandler) RetryFilesProcessing(ctx *fiber.Ctx) error {
+	var request RetryFilesProcessingRequestObject
+
+	var body RetryFilesProcessingJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryFilesProcessing(ctx.UserContext(), request.(RetryFilesProcessingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryFilesProcessing")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RetryFilesProcessingResponseObject); ok {
+		if err := validResponse.VisitRetryFilesProcessingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFileByPublicID operation middleware
+func (sh *strictHandler) GetFileByPublicID(ctx *fiber.Ctx, publicId FilePublicId) error {
+	var request GetFileByPublicIDRequestObject
+
+	request.PublicId = publicId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFileByPublicID(ctx.UserContext(), request.(GetFileByPublicIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFileByPublicID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileByPublicIDResponseObject); ok {
+		if err := validResponse.VisitGetFileByPublicIDResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFileDownloadURLByPublicID operation middleware
+func (sh *strictHandler) GetFileDownloadURLByPublicID(ctx *fiber.Ctx, publicId FilePublicId) error {
+	var request GetFileDownloadURLByPublicIDRequestObject
+
+	request.PublicId = publicId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFileDownloadURLByPublicID(ctx.UserContext(), request.(GetFileDownloadURLByPublicIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFileDownloadURLByPublicID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileDownloadURLByPublicIDResponseObject); ok {
+		if err := validResponse.VisitGetFileDownloadURLByPublicIDResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReclassifyFiles operation middleware
+func (sh *strictHandler) ReclassifyFiles(ctx *fiber.Ctx) error {
+	var request ReclassifyFilesRequestObject
+
+	var body ReclassifyFilesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ReclassifyFiles(ctx.UserContext(), request.(ReclassifyFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReclassifyFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ReclassifyFilesResponseObject); ok {
+		if err := validResponse.VisitReclassifyFilesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StreamFiles operation middleware
+func (sh *strictHandler) StreamFiles(ctx *fiber.Ctx, params StreamFilesParams) error {
+	var request StreamFilesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamFiles(ctx.UserContext(), request.(StreamFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(StreamFilesResponseObject); ok {
+		if err := validResponse.VisitStreamFilesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteFile operation middleware
+func (sh *strictHandler) DeleteFile(ctx *fiber.Ctx, id FileId, params DeleteFileParams) error {
+	var request DeleteFileRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFile(ctx.UserContext(), request.(DeleteFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteFileResponseObject); ok {
+		if err := validResponse.VisitDeleteFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFile operation middleware
+func (sh *strictHandler) GetFile(ctx *fiber.Ctx, id FileId) error {
+	var request GetFileRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFile(ctx.UserContext(), request.(GetFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileResponseObject); ok {
+		if err := validResponse.VisitGetFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateFile operation middleware
+func (sh *strictHandler) UpdateFile(ctx *fiber.Ctx, id FileId) error {
+	var request UpdateFileRequestObject
+
+	request.Id = id
+
+	var body UpdateFileJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateFile(ctx.UserContext(), request.(UpdateFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateFileResponseObject); ok {
+		if err := validResponse.VisitUpdateFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StreamAgentProgress operation middleware
+func (sh *strictHandler) StreamAgentProgress(ctx *fiber.Ctx, id FileId, params StreamAgentProgressParams) error {
+	var request StreamAgentProgressRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamAgentProgress(ctx.UserContext(), request.(StreamAgentProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamAgentProgress")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(StreamAgentProgressResponseObject); ok {
+		if err := validResponse.VisitStreamAgentProgressResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFileAssociations operation middleware
+func (sh *strictHandler) GetFileAssociations(ctx *fiber.Ctx, id FileId) error {
+	var request GetFileAssociationsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFileAssociations(ctx.UserContext(), request.(GetFileAssociationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFileAssociations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileAssociationsResponseObject); ok {
+		if err := validResponse.VisitGetFileAssociationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFileContentText operation middleware
+func (sh *strictHandler) GetFileContentText(ctx *fiber.Ctx, id FileId) error {
+	var request GetFileContentTextRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFileContentText(ctx.UserContext(), request.(GetFileContentTextRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFileContentText")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileContentTextResponseObject); ok {
+		if err := validResponse.VisitGetFileContentTextResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CopyFile operation middleware
+func (sh *strictHandler) CopyFile(ctx *fiber.Ctx, id FileId) error {
+	var request CopyFileRequestObject
+
+	request.Id = id
+
+	var body CopyFileJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CopyFile(ctx.UserContext(), request.(CopyFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CopyFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CopyFileResponseObject); ok {
+		if err := validResponse.VisitCopyFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFileDownloadURL operation middleware
+func (sh *strictHandler) GetFileDownloadURL(ctx *fiber.Ctx, id FileId) error {
+	var request GetFileDownloadURLRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFileDownloadURL(ctx.UserContext(), request.(GetFileDownloadURLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFileDownloadURL")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileDownloadURLResponseObject); ok {
+		if err := validResponse.VisitGetFileDownloadURLResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ClearFileEmbedding operation middleware
+func (sh *strictHandler) ClearFileEmbedding(ctx *fiber.Ctx, id FileId) error {
+	var request ClearFileEmbeddingRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearFileEmbedding(ctx.UserContext(), request.(ClearFileEmbeddingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearFileEmbedding")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ClearFileEmbeddingResponseObject); ok {
+		if err := validResponse.VisitClearFileEmbeddingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// OrganizeFile operation middleware
+func (sh *strictHandler) OrganizeFile(ctx *fiber.Ctx, id FileId) error {
+	var request OrganizeFileRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.OrganizeFile(ctx.UserContext(), request.(OrganizeFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OrganizeFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(OrganizeFileResponseObject); ok {
+		if err := validResponse.VisitOrganizeFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ProcessFile operation middleware
+func (sh *strictHandler) ProcessFile(ctx *fiber.Ctx, id FileId, params ProcessFileParams) error {
+	var request ProcessFileRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ProcessFile(ctx.UserContext(), request.(ProcessFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ProcessFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ProcessFileResponseObject); ok {
+		if err := validResponse.VisitProcessFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFileRelations operation middleware
+func (sh *strictHandler) ListFileRelations(ctx *fiber.Ctx, id FileId) error {
+	var request ListFileRelationsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFileRelations(ctx.UserContext(), request.(ListFileRelationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFileRelations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListFileRelationsResponseObject); ok {
+		if err := validResponse.VisitListFileRelationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddFileRelation operation middleware
+func (sh *strictHandler) AddFileRelation(ctx *fiber.Ctx, id FileId) error {
+	var request AddFileRelationRequestObject
+
+	request.Id = id
+
+	var body AddFileRelationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddFileRelation(ctx.UserContext(), request.(AddFileRelationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddFileRelation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AddFileRelationResponseObject); ok {
+		if err := validResponse.VisitAddFileRelationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveFileRelation operation middleware
+func (sh *strictHandler) RemoveFileRelation(ctx *fiber.Ctx, id FileId, relatedFileId int) error {
+	var request RemoveFileRelationRequestObject
+
+	request.Id = id
+	request.RelatedFileId = relatedFileId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveFileRelation(ctx.UserContext(), request.(RemoveFileRelationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveFileRelation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RemoveFileRelationResponseObject); ok {
+		if err := validResponse.VisitRemoveFileRelationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveTagsFromFile operation middleware
+func (sh *strictHandler) RemoveTagsFromFile(ctx *fiber.Ctx, id FileId) error {
+	var request RemoveTagsFromFileRequestObject
+
+	request.Id = id
+
+	var body RemoveTagsFromFileJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveTagsFromFile(ctx.UserContext(), request.(RemoveTagsFromFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveTagsFromFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RemoveTagsFromFileResponseObject); ok {
+		if err := validResponse.VisitRemoveTagsFromFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddTagsToFile operation middleware
+func (sh *strictHandler) AddTagsToFile(ctx *fiber.Ctx, id FileId) error {
+	var request AddTagsToFileRequestObject
+
+	request.Id = id
+
+	var body AddTagsToFileJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTagsToFile(ctx.UserContext(), request.(AddTagsToFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTagsToFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AddTagsToFileResponseObject); ok {
+		if err := validResponse.VisitAddTagsToFileResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFolders operation middleware
+func (sh *strictHandler) ListFolders(ctx *fiber.Ctx, params ListFoldersParams) error {
+	var request ListFoldersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFolders(ctx.UserContext(), request.(ListFoldersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFolders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListFoldersResponseObject); ok {
+		if err := validResponse.VisitListFoldersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateFolder operation middleware
+func (sh *strictHandler) CreateFolder(ctx *fiber.Ctx) error {
+	var request CreateFolderRequestObject
+
+	var body CreateFolderJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateFolder(ctx.UserContext(), request.(CreateFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateFolder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateFolderResponseObject); ok {
+		if err := validResponse.VisitCreateFolderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ResolveFolderPath operation middleware
+func (sh *strictHandler) ResolveFolderPath(ctx *fiber.Ctx, params ResolveFolderPathParams) error {
+	var request ResolveFolderPathRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveFolderPath(ctx.UserContext(), request.(ResolveFolderPathRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveFolderPath")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ResolveFolderPathResponseObject); ok {
+		if err := validResponse.VisitResolveFolderPathResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetAgentStatus operation middleware
-func (sh *strictHandler) GetAgentStatus(ctx *fiber.Ctx) error {
-	var request GetAgentStatusRequestObject
+// ListSharedFolders operation middleware
+func (sh *strictHandler) ListSharedFolders(ctx *fiber.Ctx) error {
+	var request ListSharedFoldersRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAgentStatus(ctx.UserContext(), request.(GetAgentStatusRequestObject))
+		return sh.ssi.ListSharedFolders(ctx.UserContext(), request.(ListSharedFoldersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAgentStatus")
+		handler = middleware(handler, "ListSharedFolders")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetAgentStatusResponseObject); ok {
-		if err := validResponse.VisitGetAgentStatusResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListSharedFoldersResponseObject); ok {
+		if err := validResponse.VisitListSharedFoldersResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2247,25 +7367,25 @@ func (sh *strictHandler) GetAgentStatus(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// ListFiles operation middleware
-func (sh *strictHandler) ListFiles(ctx *fiber.Ctx, params ListFilesParams) error {
-	var request ListFilesRequestObject
+// GetFolderTree operation middleware
+func (sh *strictHandler) GetFolderTree(ctx *fiber.Ctx, params GetFolderTreeParams) error {
+	var request GetFolderTreeRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ListFiles(ctx.UserContext(), request.(ListFilesRequestObject))
+		return sh.ssi.GetFolderTree(ctx.UserContext(), request.(GetFolderTreeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListFiles")
+		handler = middleware(handler, "GetFolderTree")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ListFilesResponseObject); ok {
-		if err := validResponse.VisitListFilesResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetFolderTreeResponseObject); ok {
+		if err := validResponse.VisitGetFolderTreeResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2274,29 +7394,29 @@ func (sh *strictHandler) ListFiles(ctx *fiber.Ctx, params ListFilesParams) error
 	return nil
 }
 
-// CreateFile operation middleware
-func (sh *strictHandler) CreateFile(ctx *fiber.Ctx) error {
-	var request CreateFileRequestObject
+// ExpandFolderTree operation middleware
+func (sh *strictHandler) ExpandFolderTree(ctx *fiber.Ctx) error {
+	var request ExpandFolderTreeRequestObject
 
-	var body CreateFileJSONRequestBody
+	var body ExpandFolderTreeJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateFile(ctx.UserContext(), request.(CreateFileRequestObject))
+		return sh.ssi.ExpandFolderTree(ctx.UserContext(), request.(ExpandFolderTreeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateFile")
+		handler = middleware(handler, "ExpandFolderTree")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(CreateFileResponseObject); ok {
-		if err := validResponse.VisitCreateFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ExpandFolderTreeResponseObject); ok {
+		if err := validResponse.VisitExpandFolderTreeResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2305,29 +7425,86 @@ func (sh *strictHandler) CreateFile(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// BatchDownloadFiles operation middleware
-func (sh *strictHandler) BatchDownloadFiles(ctx *fiber.Ctx) error {
-	var request BatchDownloadFilesRequestObject
+// DeleteFolder operation middleware
+func (sh *strictHandler) DeleteFolder(ctx *fiber.Ctx, id FolderId, params DeleteFolderParams) error {
+	var request DeleteFolderRequestObject
 
-	var body BatchDownloadFilesJSONRequestBody
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFolder(ctx.UserContext(), request.(DeleteFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFolder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteFolderResponseObject); ok {
+		if err := validResponse.VisitDeleteFolderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFolder operation middleware
+func (sh *strictHandler) GetFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request GetFolderRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFolder(ctx.UserContext(), request.(GetFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFolder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFolderResponseObject); ok {
+		if err := validResponse.VisitGetFolderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateFolder operation middleware
+func (sh *strictHandler) UpdateFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request UpdateFolderRequestObject
+
+	request.Id = id
+
+	var body UpdateFolderJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.BatchDownloadFiles(ctx.UserContext(), request.(BatchDownloadFilesRequestObject))
+		return sh.ssi.UpdateFolder(ctx.UserContext(), request.(UpdateFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BatchDownloadFiles")
+		handler = middleware(handler, "UpdateFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(BatchDownloadFilesResponseObject); ok {
-		if err := validResponse.VisitBatchDownloadFilesResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(UpdateFolderResponseObject); ok {
+		if err := validResponse.VisitUpdateFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2336,25 +7513,25 @@ func (sh *strictHandler) BatchDownloadFiles(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// UnlinkFileInvoice operation middleware
-func (sh *strictHandler) UnlinkFileInvoice(ctx *fiber.Ctx, params UnlinkFileInvoiceParams) error {
-	var request UnlinkFileInvoiceRequestObject
+// ListFolderAliases operation middleware
+func (sh *strictHandler) ListFolderAliases(ctx *fiber.Ctx, id FolderId) error {
+	var request ListFolderAliasesRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UnlinkFileInvoice(ctx.UserContext(), request.(UnlinkFileInvoiceRequestObject))
+		return sh.ssi.ListFolderAliases(ctx.UserContext(), request.(ListFolderAliasesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UnlinkFileInvoice")
+		handler = middleware(handler, "ListFolderAliases")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UnlinkFileInvoiceResponseObject); ok {
-		if err := validResponse.VisitUnlinkFileInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListFolderAliasesResponseObject); ok {
+		if err := validResponse.VisitListFolderAliasesResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2363,29 +7540,31 @@ func (sh *strictHandler) UnlinkFileInvoice(ctx *fiber.Ctx, params UnlinkFileInvo
 	return nil
 }
 
-// MoveFiles operation middleware
-func (sh *strictHandler) MoveFiles(ctx *fiber.Ctx) error {
-	var request MoveFilesRequestObject
+// AddFolderAlias operation middleware
+func (sh *strictHandler) AddFolderAlias(ctx *fiber.Ctx, id FolderId) error {
+	var request AddFolderAliasRequestObject
 
-	var body MoveFilesJSONRequestBody
+	request.Id = id
+
+	var body AddFolderAliasJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.MoveFiles(ctx.UserContext(), request.(MoveFilesRequestObject))
+		return sh.ssi.AddFolderAlias(ctx.UserContext(), request.(AddFolderAliasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MoveFiles")
+		handler = middleware(handler, "AddFolderAlias")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(MoveFilesResponseObject); ok {
-		if err := validResponse.VisitMoveFilesResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddFolderAliasResponseObject); ok {
+		if err := validResponse.VisitAddFolderAliasResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2394,25 +7573,26 @@ func (sh *strictHandler) MoveFiles(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteFile operation middleware
-func (sh *strictHandler) DeleteFile(ctx *fiber.Ctx, id FileId) error {
-	var request DeleteFileRequestObject
+// RemoveFolderAlias operation middleware
+func (sh *strictHandler) RemoveFolderAlias(ctx *fiber.Ctx, id FolderId, aliasId int) error {
+	var request RemoveFolderAliasRequestObject
 
 	request.Id = id
+	request.AliasId = aliasId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFile(ctx.UserContext(), request.(DeleteFileRequestObject))
+		return sh.ssi.RemoveFolderAlias(ctx.UserContext(), request.(RemoveFolderAliasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFile")
+		handler = middleware(handler, "RemoveFolderAlias")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(DeleteFileResponseObject); ok {
-		if err := validResponse.VisitDeleteFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RemoveFolderAliasResponseObject); ok {
+		if err := validResponse.VisitRemoveFolderAliasResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2421,25 +7601,26 @@ func (sh *strictHandler) DeleteFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// GetFile operation middleware
-func (sh *strictHandler) GetFile(ctx *fiber.Ctx, id FileId) error {
-	var request GetFileRequestObject
+// GetFolderContents operation middleware
+func (sh *strictHandler) GetFolderContents(ctx *fiber.Ctx, id FolderId, params GetFolderContentsParams) error {
+	var request GetFolderContentsRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFile(ctx.UserContext(), request.(GetFileRequestObject))
+		return sh.ssi.GetFolderContents(ctx.UserContext(), request.(GetFolderContentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFile")
+		handler = middleware(handler, "GetFolderContents")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetFileResponseObject); ok {
-		if err := validResponse.VisitGetFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetFolderContentsResponseObject); ok {
+		if err := validResponse.VisitGetFolderContentsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2448,31 +7629,53 @@ func (sh *strictHandler) GetFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// UpdateFile operation middleware
-func (sh *strictHandler) UpdateFile(ctx *fiber.Ctx, id FileId) error {
-	var request UpdateFileRequestObject
+// GetFolderDeletePreview operation middleware
+func (sh *strictHandler) GetFolderDeletePreview(ctx *fiber.Ctx, id FolderId, params GetFolderDeletePreviewParams) error {
+	var request GetFolderDeletePreviewRequestObject
 
 	request.Id = id
+	request.Params = params
 
-	var body UpdateFileJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFolderDeletePreview(ctx.UserContext(), request.(GetFolderDeletePreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFolderDeletePreview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFolderDeletePreviewResponseObject); ok {
+		if err := validResponse.VisitGetFolderDeletePreviewResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// ListFolderDescendants operation middleware
+func (sh *strictHandler) ListFolderDescendants(ctx *fiber.Ctx, id FolderId) error {
+	var request ListFolderDescendantsRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateFile(ctx.UserContext(), request.(UpdateFileRequestObject))
+		return sh.ssi.ListFolderDescendants(ctx.UserContext(), request.(ListFolderDescendantsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateFile")
+		handler = middleware(handler, "ListFolderDescendants")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateFileResponseObject); ok {
-		if err := validResponse.VisitUpdateFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListFolderDescendantsResponseObject); ok {
+		if err := validResponse.VisitListFolderDescendantsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2481,25 +7684,26 @@ func (sh *strictHandler) UpdateFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// StreamAgentProgress operation middleware
-func (sh *strictHandler) StreamAgentProgress(ctx *fiber.Ctx, id FileId) error {
-	var request StreamAgentProgressRequestObject
+// DownloadFolder operation middleware
+func (sh *strictHandler) DownloadFolder(ctx *fiber.Ctx, id FolderId, params DownloadFolderParams) error {
+	var request DownloadFolderRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.StreamAgentProgress(ctx.UserContext(), request.(StreamAgentProgressRequestObject))
+		return sh.ssi.DownloadFolder(ctx.UserContext(), request.(DownloadFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StreamAgentProgress")
+		handler = middleware(handler, "DownloadFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(StreamAgentProgressResponseObject); ok {
-		if err := validResponse.VisitStreamAgentProgressResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(DownloadFolderResponseObject); ok {
+		if err := validResponse.VisitDownloadFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2508,25 +7712,31 @@ func (sh *strictHandler) StreamAgentProgress(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// GetFileDownloadURL operation middleware
-func (sh *strictHandler) GetFileDownloadURL(ctx *fiber.Ctx, id FileId) error {
-	var request GetFileDownloadURLRequestObject
+// RemoveFileLinks operation middleware
+func (sh *strictHandler) RemoveFileLinks(ctx *fiber.Ctx, id FolderId) error {
+	var request RemoveFileLinksRequestObject
 
 	request.Id = id
 
+	var body RemoveFileLinksJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFileDownloadURL(ctx.UserContext(), request.(GetFileDownloadURLRequestObject))
+		return sh.ssi.RemoveFileLinks(ctx.UserContext(), request.(RemoveFileLinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFileDownloadURL")
+		handler = middleware(handler, "RemoveFileLinks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetFileDownloadURLResponseObject); ok {
-		if err := validResponse.VisitGetFileDownloadURLResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RemoveFileLinksResponseObject); ok {
+		if err := validResponse.VisitRemoveFileLinksResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2535,25 +7745,58 @@ func (sh *strictHandler) GetFileDownloadURL(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// OrganizeFile operation middleware
-func (sh *strictHandler) OrganizeFile(ctx *fiber.Ctx, id FileId) error {
-	var request OrganizeFileRequestObject
+// AddFileLinks operation middleware
+func (sh *strictHandler) AddFileLinks(ctx *fiber.Ctx, id FolderId) error {
+	var request AddFileLinksRequestObject
+
+	request.Id = id
+
+	var body AddFileLinksJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddFileLinks(ctx.UserContext(), request.(AddFileLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddFileLinks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AddFileLinksResponseObject); ok {
+		if err := validResponse.VisitAddFileLinksResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFolderMembers operation middleware
+func (sh *strictHandler) ListFolderMembers(ctx *fiber.Ctx, id FolderId) error {
+	var request ListFolderMembersRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.OrganizeFile(ctx.UserContext(), request.(OrganizeFileRequestObject))
+		return sh.ssi.ListFolderMembers(ctx.UserContext(), request.(ListFolderMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "OrganizeFile")
+		handler = middleware(handler, "ListFolderMembers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(OrganizeFileResponseObject); ok {
-		if err := validResponse.VisitOrganizeFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListFolderMembersResponseObject); ok {
+		if err := validResponse.VisitListFolderMembersResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2562,25 +7805,31 @@ func (sh *strictHandler) OrganizeFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// ProcessFile operation middleware
-func (sh *strictHandler) ProcessFile(ctx *fiber.Ctx, id FileId) error {
-	var request ProcessFileRequestObject
+// ShareFolder operation middleware
+func (sh *strictHandler) ShareFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request ShareFolderRequestObject
 
 	request.Id = id
 
+	var body ShareFolderJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ProcessFile(ctx.UserContext(), request.(ProcessFileRequestObject))
+		return sh.ssi.ShareFolder(ctx.UserContext(), request.(ShareFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ProcessFile")
+		handler = middleware(handler, "ShareFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ProcessFileResponseObject); ok {
-		if err := validResponse.VisitProcessFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ShareFolderResponseObject); ok {
+		if err := validResponse.VisitShareFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2589,31 +7838,26 @@ func (sh *strictHandler) ProcessFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// RemoveTagsFromFile operation middleware
-func (sh *strictHandler) RemoveTagsFromFile(ctx *fiber.Ctx, id FileId) error {
-	var request RemoveTagsFromFileRequestObject
+// UnshareFolder operation middleware
+func (sh *strictHandler) UnshareFolder(ctx *fiber.Ctx, id FolderId, userId string) error {
+	var request UnshareFolderRequestObject
 
 	request.Id = id
-
-	var body RemoveTagsFromFileJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
+	request.UserId = userId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveTagsFromFile(ctx.UserContext(), request.(RemoveTagsFromFileRequestObject))
+		return sh.ssi.UnshareFolder(ctx.UserContext(), request.(UnshareFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveTagsFromFile")
+		handler = middleware(handler, "UnshareFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(RemoveTagsFromFileResponseObject); ok {
-		if err := validResponse.VisitRemoveTagsFromFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(UnshareFolderResponseObject); ok {
+		if err := validResponse.VisitUnshareFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2622,31 +7866,31 @@ func (sh *strictHandler) RemoveTagsFromFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// AddTagsToFile operation middleware
-func (sh *strictHandler) AddTagsToFile(ctx *fiber.Ctx, id FileId) error {
-	var request AddTagsToFileRequestObject
+// MoveFolder operation middleware
+func (sh *strictHandler) MoveFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request MoveFolderRequestObject
 
 	request.Id = id
 
-	var body AddTagsToFileJSONRequestBody
+	var body MoveFolderJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.AddTagsToFile(ctx.UserContext(), request.(AddTagsToFileRequestObject))
+		return sh.ssi.MoveFolder(ctx.UserContext(), request.(MoveFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddTagsToFile")
+		handler = middleware(handler, "MoveFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(AddTagsToFileResponseObject); ok {
-		if err := validResponse.VisitAddTagsToFileResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(MoveFolderResponseObject); ok {
+		if err := validResponse.VisitMoveFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2655,25 +7899,25 @@ func (sh *strictHandler) AddTagsToFile(ctx *fiber.Ctx, id FileId) error {
 	return nil
 }
 
-// ListFolders operation middleware
-func (sh *strictHandler) ListFolders(ctx *fiber.Ctx, params ListFoldersParams) error {
-	var request ListFoldersRequestObject
+// RestoreFolder operation middleware
+func (sh *strictHandler) RestoreFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request RestoreFolderRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ListFolders(ctx.UserContext(), request.(ListFoldersRequestObject))
+		return sh.ssi.RestoreFolder(ctx.UserContext(), request.(RestoreFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListFolders")
+		handler = middleware(handler, "RestoreFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ListFoldersResponseObject); ok {
-		if err := validResponse.VisitListFoldersResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RestoreFolderResponseObject); ok {
+		if err := validResponse.VisitRestoreFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2682,29 +7926,31 @@ func (sh *strictHandler) ListFolders(ctx *fiber.Ctx, params ListFoldersParams) e
 	return nil
 }
 
-// CreateFolder operation middleware
-func (sh *strictHandler) CreateFolder(ctx *fiber.Ctx) error {
-	var request CreateFolderRequestObject
+// RemoveTagsFromFolder operation middleware
+func (sh *strictHandler) RemoveTagsFromFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request RemoveTagsFromFolderRequestObject
 
-	var body CreateFolderJSONRequestBody
+	request.Id = id
+
+	var body RemoveTagsFromFolderJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateFolder(ctx.UserContext(), request.(CreateFolderRequestObject))
+		return sh.ssi.RemoveTagsFromFolder(ctx.UserContext(), request.(RemoveTagsFromFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateFolder")
+		handler = middleware(handler, "RemoveTagsFromFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(CreateFolderResponseObject); ok {
-		if err := validResponse.VisitCreateFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RemoveTagsFromFolderResponseObject); ok {
+		if err := validResponse.VisitRemoveTagsFromFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2713,25 +7959,26 @@ func (sh *strictHandler) CreateFolder(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// GetFolderTree operation middleware
-func (sh *strictHandler) GetFolderTree(ctx *fiber.Ctx, params GetFolderTreeParams) error {
-	var request GetFolderTreeRequestObject
+// ListFolderTags operation middleware
+func (sh *strictHandler) ListFolderTags(ctx *fiber.Ctx, id FolderId, params ListFolderTagsParams) error {
+	var request ListFolderTagsRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFolderTree(ctx.UserContext(), request.(GetFolderTreeRequestObject))
+		return sh.ssi.ListFolderTags(ctx.UserContext(), request.(ListFolderTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFolderTree")
+		handler = middleware(handler, "ListFolderTags")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetFolderTreeResponseObject); ok {
-		if err := validResponse.VisitGetFolderTreeResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListFolderTagsResponseObject); ok {
+		if err := validResponse.VisitListFolderTagsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2740,25 +7987,31 @@ func (sh *strictHandler) GetFolderTree(ctx *fiber.Ctx, params GetFolderTreeParam
 	return nil
 }
 
-// DeleteFolder operation middleware
-func (sh *strictHandler) DeleteFolder(ctx *fiber.Ctx, id FolderId) error {
-	var request DeleteFolderRequestObject
+// AddTagsToFolder operation middleware
+func (sh *strictHandler) AddTagsToFolder(ctx *fiber.Ctx, id FolderId) error {
+	var request AddTagsToFolderRequestObject
 
 	request.Id = id
 
+	var body AddTagsToFolderJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFolder(ctx.UserContext(), request.(DeleteFolderRequestObject))
+		return sh.ssi.AddTagsToFolder(ctx.UserContext(), request.(AddTagsToFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFolder")
+		handler = middleware(handler, "AddTagsToFolder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(DeleteFolderResponseObject); ok {
-		if err := validResponse.VisitDeleteFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddTagsToFolderResponseObject); ok {
+		if err := validResponse.VisitAddTagsToFolderResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2767,25 +8020,23 @@ func (sh *strictHandler) DeleteFolder(ctx *fiber.Ctx, id FolderId) error {
 	return nil
 }
 
-// GetFolder operation middleware
-func (sh *strictHandler) GetFolder(ctx *fiber.Ctx, id FolderId) error {
-	var request GetFolderRequestObject
-
-	request.Id = id
+// ListFoldingRules operation middleware
+func (sh *strictHandler) ListFoldingRules(ctx *fiber.Ctx) error {
+	var request ListFoldingRulesRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFolder(ctx.UserContext(), request.(GetFolderRequestObject))
+		return sh.ssi.ListFoldingRules(ctx.UserContext(), request.(ListFoldingRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFolder")
+		handler = middleware(handler, "ListFoldingRules")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetFolderResponseObject); ok {
-		if err := validResponse.VisitGetFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListFoldingRulesResponseObject); ok {
+		if err := validResponse.VisitListFoldingRulesResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2794,31 +8045,29 @@ func (sh *strictHandler) GetFolder(ctx *fiber.Ctx, id FolderId) error {
 	return nil
 }
 
-// UpdateFolder operation middleware
-func (sh *strictHandler) UpdateFolder(ctx *fiber.Ctx, id FolderId) error {
-	var request UpdateFolderRequestObject
-
-	request.Id = id
+// CreateFoldingRule operation middleware
+func (sh *strictHandler) CreateFoldingRule(ctx *fiber.Ctx) error {
+	var request CreateFoldingRuleRequestObject
 
-	var body UpdateFolderJSONRequestBody
+	var body CreateFoldingRuleJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateFolder(ctx.UserContext(), request.(UpdateFolderRequestObject))
+		return sh.ssi.CreateFoldingRule(ctx.UserContext(), request.(CreateFoldingRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateFolder")
+		handler = middleware(handler, "CreateFoldingRule")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateFolderResponseObject); ok {
-		if err := validResponse.VisitUpdateFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(CreateFoldingRuleResponseObject); ok {
+		if err := validResponse.VisitCreateFoldingRuleResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2827,31 +8076,25 @@ func (sh *strictHandler) UpdateFolder(ctx *fiber.Ctx, id FolderId) error {
 	return nil
 }
 
-// MoveFolder operation middleware
-func (sh *strictHandler) MoveFolder(ctx *fiber.Ctx, id FolderId) error {
-	var request MoveFolderRequestObject
+// DeleteFoldingRule operation middleware
+func (sh *strictHandler) DeleteFoldingRule(ctx *fiber.Ctx, id FoldingRuleId) error {
+	var request DeleteFoldingRuleRequestObject
 
 	request.Id = id
 
-	var body MoveFolderJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
-
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.MoveFolder(ctx.UserContext(), request.(MoveFolderRequestObject))
+		return sh.ssi.DeleteFoldingRule(ctx.UserContext(), request.(DeleteFoldingRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MoveFolder")
+		handler = middleware(handler, "DeleteFoldingRule")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(MoveFolderResponseObject); ok {
-		if err := validResponse.VisitMoveFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(DeleteFoldingRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteFoldingRuleResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2860,31 +8103,31 @@ func (sh *strictHandler) MoveFolder(ctx *fiber.Ctx, id FolderId) error {
 	return nil
 }
 
-// RemoveTagsFromFolder operation middleware
-func (sh *strictHandler) RemoveTagsFromFolder(ctx *fiber.Ctx, id FolderId) error {
-	var request RemoveTagsFromFolderRequestObject
+// UpdateFoldingRule operation middleware
+func (sh *strictHandler) UpdateFoldingRule(ctx *fiber.Ctx, id FoldingRuleId) error {
+	var request UpdateFoldingRuleRequestObject
 
 	request.Id = id
 
-	var body RemoveTagsFromFolderJSONRequestBody
+	var body UpdateFoldingRuleJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveTagsFromFolder(ctx.UserContext(), request.(RemoveTagsFromFolderRequestObject))
+		return sh.ssi.UpdateFoldingRule(ctx.UserContext(), request.(UpdateFoldingRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveTagsFromFolder")
+		handler = middleware(handler, "UpdateFoldingRule")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(RemoveTagsFromFolderResponseObject); ok {
-		if err := validResponse.VisitRemoveTagsFromFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(UpdateFoldingRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateFoldingRuleResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2893,31 +8136,29 @@ func (sh *strictHandler) RemoveTagsFromFolder(ctx *fiber.Ctx, id FolderId) error
 	return nil
 }
 
-// AddTagsToFolder operation middleware
-func (sh *strictHandler) AddTagsToFolder(ctx *fiber.Ctx, id FolderId) error {
-	var request AddTagsToFolderRequestObject
-
-	request.Id = id
+// ParserCallback operation middleware
+func (sh *strictHandler) ParserCallback(ctx *fiber.Ctx) error {
+	var request ParserCallbackRequestObject
 
-	var body AddTagsToFolderJSONRequestBody
+	var body ParserCallbackJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.AddTagsToFolder(ctx.UserContext(), request.(AddTagsToFolderRequestObject))
+		return sh.ssi.ParserCallback(ctx.UserContext(), request.(ParserCallbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddTagsToFolder")
+		handler = middleware(handler, "ParserCallback")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(AddTagsToFolderResponseObject); ok {
-		if err := validResponse.VisitAddTagsToFolderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ParserCallbackResponseObject); ok {
+		if err := validResponse.VisitParserCallbackResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3011,6 +8252,68 @@ func (sh *strictHandler) CreateTag(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// CreateTags operation middleware
+func (sh *strictHandler) CreateTags(ctx *fiber.Ctx) error {
+	var request CreateTagsRequestObject
+
+	var body CreateTagsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTags(ctx.UserContext(), request.(CreateTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTags")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateTagsResponseObject); ok {
+		if err := validResponse.VisitCreateTagsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTags operation middleware
+func (sh *strictHandler) DeleteTags(ctx *fiber.Ctx) error {
+	var request DeleteTagsRequestObject
+
+	var body DeleteTagsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTags(ctx.UserContext(), request.(DeleteTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTags")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteTagsResponseObject); ok {
+		if err := validResponse.VisitDeleteTagsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteTag operation middleware
 func (sh *strictHandler) DeleteTag(ctx *fiber.Ctx, id TagId) error {
 	var request DeleteTagRequestObject
@@ -3098,10 +8401,73 @@ func (sh *strictHandler) UpdateTag(ctx *fiber.Ctx, id TagId) error {
 	return nil
 }
 
+// AddTagAlias operation middleware
+func (sh *strictHandler) AddTagAlias(ctx *fiber.Ctx, id TagId) error {
+	var request AddTagAliasRequestObject
+
+	request.Id = id
+
+	var body AddTagAliasJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTagAlias(ctx.UserContext(), request.(AddTagAliasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTagAlias")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AddTagAliasResponseObject); ok {
+		if err := validResponse.VisitAddTagAliasResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveTagAlias operation middleware
+func (sh *strictHandler) RemoveTagAlias(ctx *fiber.Ctx, id TagId, aliasId int) error {
+	var request RemoveTagAliasRequestObject
+
+	request.Id = id
+	request.AliasId = aliasId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveTagAlias(ctx.UserContext(), request.(RemoveTagAliasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveTagAlias")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RemoveTagAliasResponseObject); ok {
+		if err := validResponse.VisitRemoveTagAliasResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // UploadFile operation middleware
-func (sh *strictHandler) UploadFile(ctx *fiber.Ctx) error {
+func (sh *strictHandler) UploadFile(ctx *fiber.Ctx, params UploadFileParams) error {
 	var request UploadFileRequestObject
 
+	request.Params = params
+
 	request.Body = multipart.NewReader(bytes.NewReader(ctx.Request().Body()), string(ctx.Request().Header.MultipartFormBoundary()))
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
@@ -3125,6 +8491,37 @@ func (sh *strictHandler) UploadFile(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// PresignUploadBatch operation middleware
+func (sh *strictHandler) PresignUploadBatch(ctx *fiber.Ctx) error {
+	var request PresignUploadBatchRequestObject
+
+	var body PresignUploadBatchJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PresignUploadBatch(ctx.UserContext(), request.(PresignUploadBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PresignUploadBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PresignUploadBatchResponseObject); ok {
+		if err := validResponse.VisitPresignUploadBatchResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetPresignedURL operation middleware
 func (sh *strictHandler) GetPresignedURL(ctx *fiber.Ctx, params GetPresignedURLParams) error {
 	var request GetPresignedURLRequestObject
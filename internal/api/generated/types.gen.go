@@ -30,6 +30,7 @@ const (
 	AgentEventTypeStatus     AgentEventType = "status"
 	AgentEventTypeThinking   AgentEventType = "thinking"
 	AgentEventTypeToolCall   AgentEventType = "tool_call"
+	AgentEventTypeToolError  AgentEventType = "tool_error"
 	AgentEventTypeToolResult AgentEventType = "tool_result"
 )
 
@@ -42,20 +43,50 @@ const (
 	Video    FileType = "video"
 )
 
+// Defines values for FolderRole.
+const (
+	Read  FolderRole = "read"
+	Write FolderRole = "write"
+)
+
 // Defines values for ProcessingStatus.
 const (
-	Completed  ProcessingStatus = "completed"
-	Failed     ProcessingStatus = "failed"
-	Pending    ProcessingStatus = "pending"
-	Processing ProcessingStatus = "processing"
+	Completed   ProcessingStatus = "completed"
+	Failed      ProcessingStatus = "failed"
+	NeedsReview ProcessingStatus = "needs_review"
+	Pending     ProcessingStatus = "pending"
+	Processing  ProcessingStatus = "processing"
+)
+
+// Defines values for ReassignOwnershipRequestResourceType.
+const (
+	ReassignOwnershipRequestResourceTypeFile   ReassignOwnershipRequestResourceType = "file"
+	ReassignOwnershipRequestResourceTypeFolder ReassignOwnershipRequestResourceType = "folder"
+)
+
+// Defines values for TagDeleteResultStatus.
+const (
+	TagDeleteResultStatusDeleted      TagDeleteResultStatus = "deleted"
+	TagDeleteResultStatusNotFound     TagDeleteResultStatus = "not_found"
+	TagDeleteResultStatusSkippedInUse TagDeleteResultStatus = "skipped_in_use"
+)
+
+// Defines values for ListFilesParamsEntityType.
+const (
+	ListFilesParamsEntityTypeAmounts       ListFilesParamsEntityType = "amounts"
+	ListFilesParamsEntityTypeDates         ListFilesParamsEntityType = "dates"
+	ListFilesParamsEntityTypeOrganizations ListFilesParamsEntityType = "organizations"
+	ListFilesParamsEntityTypePeople        ListFilesParamsEntityType = "people"
 )
 
 // Defines values for ListFilesParamsSortBy.
 const (
+	CharCount ListFilesParamsSortBy = "char_count"
 	CreatedAt ListFilesParamsSortBy = "created_at"
 	Size      ListFilesParamsSortBy = "size"
 	Title     ListFilesParamsSortBy = "title"
 	UpdatedAt ListFilesParamsSortBy = "updated_at"
+	WordCount ListFilesParamsSortBy = "word_count"
 )
 
 // Defines values for ListFilesParamsSortOrder.
@@ -64,6 +95,27 @@ const (
 	Desc ListFilesParamsSortOrder = "desc"
 )
 
+// Defines values for StreamFilesParamsEntityType.
+const (
+	StreamFilesParamsEntityTypeAmounts       StreamFilesParamsEntityType = "amounts"
+	StreamFilesParamsEntityTypeDates         StreamFilesParamsEntityType = "dates"
+	StreamFilesParamsEntityTypeOrganizations StreamFilesParamsEntityType = "organizations"
+	StreamFilesParamsEntityTypePeople        StreamFilesParamsEntityType = "people"
+)
+
+// Defines values for StreamAgentProgressParamsFormat.
+const (
+	Ndjson StreamAgentProgressParamsFormat = "ndjson"
+	Sse    StreamAgentProgressParamsFormat = "sse"
+)
+
+// Defines values for GetFolderTreeParamsSort.
+const (
+	FilesAsc  GetFolderTreeParamsSort = "files_asc"
+	FilesDesc GetFolderTreeParamsSort = "files_desc"
+	Name      GetFolderTreeParamsSort = "name"
+)
+
 // Defines values for SearchFilesParamsType.
 const (
 	Fulltext SearchFilesParamsType = "fulltext"
@@ -71,8 +123,51 @@ const (
 	Semantic SearchFilesParamsType = "semantic"
 )
 
+// Defines values for SearchFilesParamsFormat.
+const (
+	Csv  SearchFilesParamsFormat = "csv"
+	Json SearchFilesParamsFormat = "json"
+)
+
+// AddFileRelationRequest defines model for AddFileRelationRequest.
+type AddFileRelationRequest struct {
+	RelatedFileId int `json:"related_file_id"`
+
+	// RelationType How the files are related (default "attachment")
+	RelationType *string `json:"relation_type,omitempty"`
+}
+
+// AddFolderAliasRequest defines model for AddFolderAliasRequest.
+type AddFolderAliasRequest struct {
+	// Path Slash-separated path of folder names from the root, e.g. Projects/2024
+	Path string `json:"path"`
+}
+
+// AgentCapabilities defines model for AgentCapabilities.
+type AgentCapabilities struct {
+	DryRunSupported bool `json:"dry_run_supported"`
+
+	// EmbedBeforeAgent Whether processing generates the embedding before running the agent, letting it look up similar files
+	EmbedBeforeAgent *bool `json:"embed_before_agent,omitempty"`
+	Enabled          bool  `json:"enabled"`
+
+	// FileTools Tools used when organizing a single file
+	FileTools []AgentToolInfo `json:"file_tools"`
+
+	// FolderTools Tools used when organizing a folder
+	FolderTools []AgentToolInfo `json:"folder_tools"`
+	MaxTurns    int             `json:"max_turns"`
+	Model       string          `json:"model"`
+}
+
 // AgentEvent defines model for AgentEvent.
 type AgentEvent struct {
+	// Data Event-specific data. The terminal result (or max-turns error) of a
+	// file run lists the applied changes: tags_added and tags_created
+	// ({id, name}), folders_created and moved_to ({id, path}, id 0 for
+	// the root, omitted when the file wasn't moved), and
+	// tags_already_present ({id, name}) for requested tags the file
+	// already had. The agent never removes tags from a file.
 	Data    *map[string]interface{} `json:"data,omitempty"`
 	FileId  *int                    `json:"file_id,omitempty"`
 	Message string                  `json:"message"`
@@ -88,21 +183,90 @@ type AgentStatusResponse struct {
 	Enabled bool `json:"enabled"`
 }
 
+// AgentToolInfo defines model for AgentToolInfo.
+type AgentToolInfo struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+
+	// Parameters JSON schema of the tool arguments
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+// BatchDeleteFilesRequest defines model for BatchDeleteFilesRequest.
+type BatchDeleteFilesRequest struct {
+	// FileIds Array of file IDs to delete
+	FileIds []int `json:"file_ids"`
+}
+
+// BatchDeleteFilesResult defines model for BatchDeleteFilesResult.
+type BatchDeleteFilesResult struct {
+	DeletedCount int `json:"deleted_count"`
+
+	// FailedIds Requested IDs that weren't deleted because they aren't the caller's files
+	FailedIds []int `json:"failed_ids"`
+}
+
 // BatchDownloadRequest defines model for BatchDownloadRequest.
 type BatchDownloadRequest struct {
 	// FileIds Array of file IDs to download
 	FileIds []int `json:"file_ids"`
 }
 
+// BulkCreateTagsRequest defines model for BulkCreateTagsRequest.
+type BulkCreateTagsRequest struct {
+	Tags []CreateTagRequest `json:"tags"`
+}
+
+// BulkCreateTagsResponse defines model for BulkCreateTagsResponse.
+type BulkCreateTagsResponse struct {
+	// Created Newly created tags, in request order
+	Created []Tag `json:"created"`
+
+	// Skipped Existing tags whose names were requested, in request order
+	Skipped []Tag `json:"skipped"`
+}
+
+// BulkDeleteTagsRequest defines model for BulkDeleteTagsRequest.
+type BulkDeleteTagsRequest struct {
+	// Force Also delete tags attached to files or folders
+	Force  *bool `json:"force,omitempty"`
+	TagIds []int `json:"tag_ids"`
+}
+
+// BulkDeleteTagsResponse defines model for BulkDeleteTagsResponse.
+type BulkDeleteTagsResponse struct {
+	// Results One result per requested tag ID, in request order
+	Results []TagDeleteResult `json:"results"`
+}
+
+// CopyFileRequest defines model for CopyFileRequest.
+type CopyFileRequest struct {
+	// FolderId Folder to copy the file into (null or omitted for the root)
+	FolderId *int `json:"folder_id"`
+}
+
 // CreateFileRequest defines model for CreateFileRequest.
 type CreateFileRequest struct {
-	FileType         *FileType `json:"file_type,omitempty"`
-	FolderId         *int      `json:"folder_id"`
-	MimeType         *string   `json:"mime_type,omitempty"`
-	OriginalFilename string    `json:"original_filename"`
-	S3Key            string    `json:"s3_key"`
-	Size             *int64    `json:"size,omitempty"`
-	Title            string    `json:"title"`
+	// Content Already-extracted text. When provided the file is created in the
+	// `completed` state and the processing pipeline is skipped.
+	Content  *string   `json:"content,omitempty"`
+	FileType *FileType `json:"file_type,omitempty"`
+	FolderId *int      `json:"folder_id"`
+
+	// GenerateEmbedding When content is provided, generate the embedding for semantic search
+	GenerateEmbedding *bool   `json:"generate_embedding,omitempty"`
+	MimeType          *string `json:"mime_type,omitempty"`
+	OriginalFilename  string  `json:"original_filename"`
+
+	// ProcessingHint Instructions the agent follows when organizing this file, e.g.
+	// "file invoices from ACME under Clients/ACME"
+	ProcessingHint *string `json:"processing_hint,omitempty"`
+	S3Key          string  `json:"s3_key"`
+	Size           *int64  `json:"size,omitempty"`
+
+	// Summary Already-generated summary (stored together with content)
+	Summary *string `json:"summary,omitempty"`
+	Title   string  `json:"title"`
 }
 
 // CreateFolderRequest defines model for CreateFolderRequest.
@@ -110,6 +274,22 @@ type CreateFolderRequest struct {
 	Description *string `json:"description,omitempty"`
 	Name        string  `json:"name"`
 	ParentId    *int    `json:"parent_id"`
+
+	// S3Prefix Places the storage objects of files uploaded to, created in or moved to this folder
+	// under files/<user>/<s3_prefix>/ instead of files/<user>/. A relative path of letters,
+	// digits, ".", "_" and "-" segments; an empty string removes the prefix.
+	S3Prefix *string `json:"s3_prefix,omitempty"`
+}
+
+// CreateFoldingRuleRequest defines model for CreateFoldingRuleRequest.
+type CreateFoldingRuleRequest struct {
+	TagId          int `json:"tag_id"`
+	TargetFolderId int `json:"target_folder_id"`
+}
+
+// CreateTagAliasRequest defines model for CreateTagAliasRequest.
+type CreateTagAliasRequest struct {
+	Alias string `json:"alias"`
 }
 
 // CreateTagRequest defines model for CreateTagRequest.
@@ -119,36 +299,170 @@ type CreateTagRequest struct {
 	Name        string  `json:"name"`
 }
 
+// CreatedTag defines model for CreatedTag.
+type CreatedTag struct {
+	Aliases *[]TagAlias `json:"aliases,omitempty"`
+
+	// Color Hex color code (e.g.,
+	Color       *string   `json:"color,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description *string   `json:"description,omitempty"`
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+
+	// SimilarExistingTags Existing tags with similar names that could be reused instead
+	SimilarExistingTags *[]Tag    `json:"similar_existing_tags,omitempty"`
+	UpdatedAt           time.Time `json:"updated_at"`
+	UserId              string    `json:"user_id"`
+}
+
+// EntityAmount defines model for EntityAmount.
+type EntityAmount struct {
+	// Currency ISO 4217 code when known
+	Currency *string `json:"currency,omitempty"`
+	Value    float64 `json:"value"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Error Error message
 	Error string `json:"error"`
+
+	// Errors Per-field problems, returned when request body validation fails
+	Errors *[]FieldError `json:"errors,omitempty"`
+}
+
+// ExpandFolderTreeRequest defines model for ExpandFolderTreeRequest.
+type ExpandFolderTreeRequest struct {
+	// FolderIds Expanded folders whose children to load (at most 200)
+	FolderIds []int `json:"folder_ids"`
+
+	// IncludeRoot Also load the user's top-level folders; folder_ids may then be empty
+	IncludeRoot *bool `json:"include_root,omitempty"`
+}
+
+// ExpandFolderTreeResult defines model for ExpandFolderTreeResult.
+type ExpandFolderTreeResult struct {
+	Data []ExpandedFolder `json:"data"`
+
+	// NotFoundIds Requested IDs of folders the user can't read
+	NotFoundIds []int `json:"not_found_ids"`
+}
+
+// ExpandedFolder defines model for ExpandedFolder.
+type ExpandedFolder struct {
+	Children []Folder `json:"children"`
+
+	// ParentId Expanded folder; null for the root
+	ParentId *int `json:"parent_id"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field JSON name of the invalid field
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 // File defines model for File.
 type File struct {
-	// Content Parsed text content
-	Content      *string   `json:"content,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
-	FileType     FileType  `json:"file_type"`
-	Folder       *Folder   `json:"folder,omitempty"`
-	FolderId     *int      `json:"folder_id"`
-	HasEmbedding bool      `json:"has_embedding"`
-	Id           int       `json:"id"`
+	// CharCount Number of characters in the parsed content
+	CharCount int `json:"char_count"`
+
+	// Content Parsed text content; omitted for tokens whose masked_fields claim includes content
+	Content   *string   `json:"content,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeclaredMimeType MIME type sent when the file was created
+	DeclaredMimeType *string `json:"declared_mime_type,omitempty"`
+
+	// DetectedMimeType MIME type sniffed from the file's leading bytes during processing
+	DetectedMimeType *string `json:"detected_mime_type,omitempty"`
+
+	// Entities Key entities extracted alongside the summary when SUMMARY_EXTRACT_ENTITIES is enabled
+	Entities     *FileEntities `json:"entities,omitempty"`
+	FileType     FileType      `json:"file_type"`
+	Folder       *Folder       `json:"folder,omitempty"`
+	FolderId     *int          `json:"folder_id"`
+	HasEmbedding bool          `json:"has_embedding"`
+	Id           int           `json:"id"`
 
 	// InvoiceId External invoice system ID (only set for invoice file types)
-	InvoiceId        *int             `json:"invoice_id"`
-	MimeType         *string          `json:"mime_type,omitempty"`
-	OriginalFilename string           `json:"original_filename"`
-	ProcessingError  *string          `json:"processing_error,omitempty"`
+	InvoiceId *int `json:"invoice_id"`
+
+	// MimeType The declared MIME type, corrected from the file's content during processing
+	MimeType         *string `json:"mime_type,omitempty"`
+	OriginalFilename string  `json:"original_filename"`
+
+	// ProcessingEndedAt When processing last completed or failed
+	ProcessingEndedAt *time.Time `json:"processing_ended_at,omitempty"`
+	ProcessingError   *string    `json:"processing_error,omitempty"`
+
+	// ProcessingErrorCode Machine-readable reason processing failed, when known.
+	// `file_encrypted` means the file is password-protected,
+	// `canceled` means processing was canceled and `content_too_short`
+	// means the parsed text was too short to use (status `needs_review`).
+	ProcessingErrorCode *string `json:"processing_error_code,omitempty"`
+
+	// ProcessingHint Instructions the agent follows when organizing this file
+	ProcessingHint *string `json:"processing_hint,omitempty"`
+
+	// ProcessingStartedAt When the file last entered the processing state
+	ProcessingStartedAt *time.Time `json:"processing_started_at,omitempty"`
+
+	// ProcessingStatus `needs_review` means the file parsed but the result looks unusable, e.g. a
+	// document with almost no text; re-upload it or retry processing.
 	ProcessingStatus ProcessingStatus `json:"processing_status"`
-	S3Key            string           `json:"s3_key"`
-	Size             *int64           `json:"size,omitempty"`
-	Summary          *string          `json:"summary,omitempty"`
-	Tags             *[]Tag           `json:"tags,omitempty"`
-	Title            string           `json:"title"`
-	UpdatedAt        time.Time        `json:"updated_at"`
-	UserId           string           `json:"user_id"`
+
+	// PublicId Immutable UUID to use in links instead of the sequential ID
+	PublicId string `json:"public_id"`
+
+	// RelatedFiles The file's relations to supporting files; only included when getting a single file
+	RelatedFiles *[]FileRelation `json:"related_files,omitempty"`
+	S3Key        string          `json:"s3_key"`
+	Size         *int64          `json:"size,omitempty"`
+
+	// Summary Omitted for tokens whose masked_fields claim includes summary
+	Summary *string `json:"summary,omitempty"`
+
+	// SummaryIsFallback True when the AI summary was unavailable during processing and the
+	// summary is an excerpt of the file's text instead
+	SummaryIsFallback bool      `json:"summary_is_fallback"`
+	Tags              *[]Tag    `json:"tags,omitempty"`
+	Title             string    `json:"title"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	UserId            string    `json:"user_id"`
+
+	// WordCount Number of words in the parsed content
+	WordCount int `json:"word_count"`
+}
+
+// FileAssociations defines model for FileAssociations.
+type FileAssociations struct {
+	Folder *Folder `json:"folder,omitempty"`
+
+	// FolderPath Folders from the root down to the file's folder; empty for root-level files
+	FolderPath []Folder `json:"folder_path"`
+	Tags       []Tag    `json:"tags"`
+}
+
+// FileChange defines model for FileChange.
+type FileChange struct {
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Deleted True when the file was deleted
+	Deleted bool `json:"deleted"`
+	File    File `json:"file"`
+}
+
+// FileChangesResponse defines model for FileChangesResponse.
+type FileChangesResponse struct {
+	// Cursor Opaque position after the last change returned
+	Cursor string       `json:"cursor"`
+	Data   []FileChange `json:"data"`
+
+	// HasMore True when more changes are available right away
+	HasMore bool `json:"has_more"`
 }
 
 // FileDownloadResponse defines model for FileDownloadResponse.
@@ -159,44 +473,252 @@ type FileDownloadResponse struct {
 	Key         string    `json:"key"`
 }
 
+// FileEntities Key entities extracted alongside the summary when SUMMARY_EXTRACT_ENTITIES is enabled
+type FileEntities struct {
+	Amounts []EntityAmount `json:"amounts"`
+
+	// Dates Dates in YYYY-MM-DD format
+	Dates         []string `json:"dates"`
+	Organizations []string `json:"organizations"`
+	People        []string `json:"people"`
+}
+
+// FileFilter Selects files the same way the list files query parameters do
+type FileFilter struct {
+	// AllFolders Match files in all folders (ignores folder_id)
+	AllFolders *bool       `json:"all_folders,omitempty"`
+	FileTypes  *[]FileType `json:"file_types,omitempty"`
+
+	// FolderId Only files in this folder
+	FolderId *int `json:"folder_id,omitempty"`
+
+	// IncludeLinked With folder_id, also match files linked into the folder
+	IncludeLinked *bool `json:"include_linked,omitempty"`
+
+	// Keyword Search keyword for title, summary, or content
+	Keyword *string `json:"keyword,omitempty"`
+
+	// Status `needs_review` means the file parsed but the result looks unusable, e.g. a
+	// document with almost no text; re-upload it or retry processing.
+	Status *ProcessingStatus `json:"status,omitempty"`
+
+	// TagIds Match files with any of these tags
+	TagIds *[]int `json:"tag_ids,omitempty"`
+}
+
+// FileGroup defines model for FileGroup.
+type FileGroup struct {
+	// ChildCount Direct subfolders, including those past max_depth that children leaves out
+	ChildCount int         `json:"child_count"`
+	Children   []FileGroup `json:"children"`
+
+	// FileCount All files directly in the folder
+	FileCount int `json:"file_count"`
+
+	// Files Newest files directly in the folder, at most files_per_folder
+	Files []File `json:"files"`
+
+	// FolderId The folder, or null for the top level
+	FolderId *int `json:"folder_id"`
+
+	// Name Folder name, empty for the top level
+	Name string `json:"name"`
+}
+
+// FileIdsRequest defines model for FileIdsRequest.
+type FileIdsRequest struct {
+	FileIds []int `json:"file_ids"`
+}
+
 // FileListResponse defines model for FileListResponse.
 type FileListResponse struct {
-	Data   []File `json:"data"`
+	Data []File `json:"data"`
+
+	// Ids Matching IDs, only returned when ids_only is true
+	Ids    *[]int `json:"ids,omitempty"`
 	Limit  int    `json:"limit"`
 	Offset int    `json:"offset"`
 	Total  int    `json:"total"`
 }
 
+// FileRelation defines model for FileRelation.
+type FileRelation struct {
+	CreatedAt     time.Time `json:"created_at"`
+	FileId        int       `json:"file_id"`
+	Id            int       `json:"id"`
+	RelatedFile   *File     `json:"related_file,omitempty"`
+	RelatedFileId int       `json:"related_file_id"`
+
+	// RelationType How the files are related, e.g. "attachment"
+	RelationType string `json:"relation_type"`
+}
+
+// FileRelationListResponse defines model for FileRelationListResponse.
+type FileRelationListResponse struct {
+	Data []FileRelation `json:"data"`
+}
+
+// FileTagAdditionResult defines model for FileTagAdditionResult.
+type FileTagAdditionResult struct {
+	// AddedTagIds Tag IDs that were newly applied to the file
+	AddedTagIds []int `json:"added_tag_ids"`
+
+	// AlreadyPresentTagIds Tag IDs that were already applied to the file
+	AlreadyPresentTagIds []int `json:"already_present_tag_ids"`
+	File                 File  `json:"file"`
+
+	// MovedToFolderId Folder a folding rule moved the file to, if one matched an added tag
+	MovedToFolderId *int `json:"moved_to_folder_id,omitempty"`
+}
+
 // FileType defines model for FileType.
 type FileType string
 
 // Folder defines model for Folder.
 type Folder struct {
+	// ChildCount Number of direct subfolders, counted without loading them so trees can tell
+	// which folders expand. Returned by the list, get and contents endpoints.
+	ChildCount  *int      `json:"child_count,omitempty"`
 	Children    *[]Folder `json:"children,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	Description *string   `json:"description,omitempty"`
 	Id          int       `json:"id"`
 	Name        string    `json:"name"`
 	ParentId    *int      `json:"parent_id"`
-	Tags        *[]Tag    `json:"tags,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	UserId      string    `json:"user_id"`
+
+	// S3Prefix Key prefix for the storage objects of files in this folder
+	S3Prefix  *string   `json:"s3_prefix,omitempty"`
+	Tags      *[]Tag    `json:"tags,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserId    string    `json:"user_id"`
+}
+
+// FolderAlias defines model for FolderAlias.
+type FolderAlias struct {
+	CreatedAt time.Time `json:"created_at"`
+	FolderId  int       `json:"folder_id"`
+	Id        int       `json:"id"`
+
+	// Path Former slash-separated path of folder names from the root
+	Path string `json:"path"`
+}
+
+// FolderAliasListResponse defines model for FolderAliasListResponse.
+type FolderAliasListResponse struct {
+	Data []FolderAlias `json:"data"`
+}
+
+// FolderContents defines model for FolderContents.
+type FolderContents struct {
+	Files        []File   `json:"files"`
+	Folders      []Folder `json:"folders"`
+	Limit        int      `json:"limit"`
+	Offset       int      `json:"offset"`
+	TotalFiles   int      `json:"total_files"`
+	TotalFolders int      `json:"total_folders"`
+}
+
+// FolderDeletePreview defines model for FolderDeletePreview.
+type FolderDeletePreview struct {
+	// FileCount Number of files (recursive) that would be deleted
+	FileCount int `json:"file_count"`
+	FolderId  int `json:"folder_id"`
+
+	// SubfolderCount Number of subfolders (recursive) that would be deleted
+	SubfolderCount int `json:"subfolder_count"`
+
+	// TotalSize Total size in bytes of the files that would be deleted
+	TotalSize int64 `json:"total_size"`
+}
+
+// FolderDescendant defines model for FolderDescendant.
+type FolderDescendant struct {
+	// Depth Levels below the listed folder; direct subfolders are 1
+	Depth  int    `json:"depth"`
+	Folder Folder `json:"folder"`
+}
+
+// FolderDescendantListResponse defines model for FolderDescendantListResponse.
+type FolderDescendantListResponse struct {
+	Data []FolderDescendant `json:"data"`
 }
 
 // FolderListResponse defines model for FolderListResponse.
 type FolderListResponse struct {
-	Data   []Folder `json:"data"`
-	Limit  int      `json:"limit"`
-	Offset int      `json:"offset"`
-	Total  int      `json:"total"`
+	Data []Folder `json:"data"`
+
+	// Ids Matching IDs, only returned when ids_only is true
+	Ids    *[]int `json:"ids,omitempty"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+	Total  int    `json:"total"`
+}
+
+// FolderMember defines model for FolderMember.
+type FolderMember struct {
+	CreatedAt time.Time  `json:"created_at"`
+	FolderId  int        `json:"folder_id"`
+	Id        int        `json:"id"`
+	Role      FolderRole `json:"role"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	UserId    string     `json:"user_id"`
+}
+
+// FolderMemberListResponse defines model for FolderMemberListResponse.
+type FolderMemberListResponse struct {
+	Data []FolderMember `json:"data"`
+}
+
+// FolderRole defines model for FolderRole.
+type FolderRole string
+
+// FolderTagCount defines model for FolderTagCount.
+type FolderTagCount struct {
+	// FileCount Number of files in the folder with this tag
+	FileCount int `json:"file_count"`
+	Tag       Tag `json:"tag"`
+}
+
+// FolderTagsResponse defines model for FolderTagsResponse.
+type FolderTagsResponse struct {
+	Data []FolderTagCount `json:"data"`
 }
 
 // FolderTree defines model for FolderTree.
 type FolderTree struct {
 	Children *[]FolderTree `json:"children,omitempty"`
-	Id       int           `json:"id"`
-	Name     string        `json:"name"`
-	ParentId *int          `json:"parent_id"`
+
+	// FileCount Files directly in the folder (only with with_counts=true)
+	FileCount *int   `json:"file_count,omitempty"`
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	ParentId  *int   `json:"parent_id"`
+
+	// TotalFileCount Files in the folder and its subfolders (only with with_counts=true)
+	TotalFileCount *int `json:"total_file_count,omitempty"`
+}
+
+// FoldingRule defines model for FoldingRule.
+type FoldingRule struct {
+	CreatedAt      time.Time `json:"created_at"`
+	Id             int       `json:"id"`
+	TagId          int       `json:"tag_id"`
+	TargetFolderId int       `json:"target_folder_id"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// FoldingRuleListResponse defines model for FoldingRuleListResponse.
+type FoldingRuleListResponse struct {
+	Data []FoldingRule `json:"data"`
+}
+
+// MoveFilesByFilterRequest defines model for MoveFilesByFilterRequest.
+type MoveFilesByFilterRequest struct {
+	// Filter Selects files the same way the list files query parameters do
+	Filter FileFilter `json:"filter"`
+
+	// TargetFolderId Target folder ID (null for root)
+	TargetFolderId *int `json:"target_folder_id"`
 }
 
 // MoveFilesRequest defines model for MoveFilesRequest.
@@ -209,6 +731,9 @@ type MoveFilesRequest struct {
 
 // MoveFolderRequest defines model for MoveFolderRequest.
 type MoveFolderRequest struct {
+	// KeepAlias Keep the folder's old path resolving to it through a folder alias
+	KeepAlias *bool `json:"keep_alias,omitempty"`
+
 	// ParentId New parent folder ID (null for root)
 	ParentId *int `json:"parent_id"`
 }
@@ -222,6 +747,47 @@ type OrganizeFileResult struct {
 	StreamUrl string `json:"stream_url"`
 }
 
+// ParserCallbackRequest defines model for ParserCallbackRequest.
+type ParserCallbackRequest struct {
+	// Content Parsed text content of the file
+	Content *string `json:"content,omitempty"`
+
+	// Error Why parsing failed. When set, content is ignored and the file fails processing.
+	Error *string `json:"error,omitempty"`
+
+	// JobToken Token the job was submitted with
+	JobToken string `json:"job_token"`
+}
+
+// ParserCallbackResult defines model for ParserCallbackResult.
+type ParserCallbackResult struct {
+	// FileId File the job belongs to
+	FileId int `json:"file_id"`
+
+	// Resumed Whether processing resumed with the content. False when the parser reported an error or the file is no longer processing.
+	Resumed bool `json:"resumed"`
+}
+
+// PresignBatchFile defines model for PresignBatchFile.
+type PresignBatchFile struct {
+	ContentType *string `json:"content_type,omitempty"`
+	Filename    string  `json:"filename"`
+}
+
+// PresignBatchRequest defines model for PresignBatchRequest.
+type PresignBatchRequest struct {
+	// Files Files to upload (at most 100)
+	Files []PresignBatchFile `json:"files"`
+
+	// FolderId Folder the files will be created in; the keys are placed under the folder's s3_prefix
+	FolderId *int `json:"folder_id,omitempty"`
+}
+
+// PresignBatchResponse defines model for PresignBatchResponse.
+type PresignBatchResponse struct {
+	Data []PresignedUpload `json:"data"`
+}
+
 // PresignedURLResponse defines model for PresignedURLResponse.
 type PresignedURLResponse struct {
 	ContentType string `json:"content_type"`
@@ -229,26 +795,204 @@ type PresignedURLResponse struct {
 	UploadUrl   string `json:"upload_url"`
 }
 
-// ProcessingStatus defines model for ProcessingStatus.
+// PresignedUpload defines model for PresignedUpload.
+type PresignedUpload struct {
+	ContentType string `json:"content_type"`
+	Filename    string `json:"filename"`
+	Key         string `json:"key"`
+	UploadUrl   string `json:"upload_url"`
+}
+
+// ProcessingErrorGroup defines model for ProcessingErrorGroup.
+type ProcessingErrorGroup struct {
+	Count   int64  `json:"count"`
+	Message string `json:"message"`
+}
+
+// ProcessingErrorSummaryResponse defines model for ProcessingErrorSummaryResponse.
+type ProcessingErrorSummaryResponse struct {
+	Data []ProcessingErrorGroup `json:"data"`
+}
+
+// ProcessingOverview defines model for ProcessingOverview.
+type ProcessingOverview struct {
+	// AverageDurationSeconds Mean processing time of the files completed within the window; omitted when none completed
+	AverageDurationSeconds *float64 `json:"average_duration_seconds,omitempty"`
+
+	// Completed Files that finished processing successfully within the window
+	Completed int `json:"completed"`
+
+	// Counts Number of files in each processing status (pending, processing, completed, failed, needs_review)
+	Counts map[string]int `json:"counts"`
+
+	// Failed Files whose processing failed within the window
+	Failed int `json:"failed"`
+
+	// Paused Whether processing is paused on this server instance
+	Paused bool `json:"paused"`
+
+	// Processing Number of files being processed right now
+	Processing int `json:"processing"`
+
+	// Waiting Processing runs accepted while paused that have not started yet
+	Waiting int `json:"waiting"`
+
+	// WindowMinutes Length of the window the throughput figures cover
+	WindowMinutes int `json:"window_minutes"`
+}
+
+// ProcessingPauseState defines model for ProcessingPauseState.
+type ProcessingPauseState struct {
+	// Paused Whether processing is paused on this server instance
+	Paused bool `json:"paused"`
+
+	// Waiting Processing runs accepted while paused that have not started yet
+	Waiting int `json:"waiting"`
+}
+
+// ProcessingStatus `needs_review` means the file parsed but the result looks unusable, e.g. a
+// document with almost no text; re-upload it or retry processing.
 type ProcessingStatus string
 
+// ReassignOwnershipRequest defines model for ReassignOwnershipRequest.
+type ReassignOwnershipRequest struct {
+	// FromUser Current owner's user ID
+	FromUser     string                               `json:"from_user"`
+	ResourceIds  []int                                `json:"resource_ids"`
+	ResourceType ReassignOwnershipRequestResourceType `json:"resource_type"`
+
+	// ToUser New owner's user ID
+	ToUser string `json:"to_user"`
+}
+
+// ReassignOwnershipRequestResourceType defines model for ReassignOwnershipRequest.ResourceType.
+type ReassignOwnershipRequestResourceType string
+
+// ReassignResult defines model for ReassignResult.
+type ReassignResult struct {
+	// Files Files reassigned, including files in reassigned folders
+	Files int `json:"files"`
+
+	// Folders Folders reassigned, including subfolders
+	Folders int `json:"folders"`
+
+	// ObjectsMoved S3 objects moved to the new owner's prefix
+	ObjectsMoved int `json:"objects_moved"`
+
+	// TagsCreated Tags created for the new owner
+	TagsCreated int `json:"tags_created"`
+}
+
+// ReclassifyFilesRequest defines model for ReclassifyFilesRequest.
+type ReclassifyFilesRequest struct {
+	// Filter Selects files the same way the list files query parameters do
+	Filter *FileFilter `json:"filter,omitempty"`
+}
+
+// ReclassifyFilesResult defines model for ReclassifyFilesResult.
+type ReclassifyFilesResult struct {
+	// ChangedByType Number of changed files per new file type
+	ChangedByType  map[string]int `json:"changed_by_type"`
+	ChangedCount   int            `json:"changed_count"`
+	ChangedFileIds []int          `json:"changed_file_ids"`
+
+	// UnchangedCount Checked files whose type already matched their content
+	UnchangedCount int `json:"unchanged_count"`
+}
+
+// ReembedJob defines model for ReembedJob.
+type ReembedJob struct {
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// Dimensions Configured embedding dimensions (0 means model default)
+	Dimensions int `json:"dimensions"`
+
+	// Failed Number of files that failed to re-embed
+	Failed int `json:"failed"`
+
+	// Model Embedding model used for the new vectors
+	Model string `json:"model"`
+
+	// Processed Number of files processed so far
+	Processed int       `json:"processed"`
+	StartedAt time.Time `json:"started_at"`
+
+	// Status Job state, either "running" or "completed"
+	Status string `json:"status"`
+
+	// Total Number of files that need re-embedding
+	Total int `json:"total"`
+}
+
 // SearchResponse defines model for SearchResponse.
 type SearchResponse struct {
-	Data       []SearchResult `json:"data"`
-	Query      string         `json:"query"`
-	SearchType string         `json:"search_type"`
-	Total      int            `json:"total"`
+	Data []SearchResult `json:"data"`
+
+	// Limit Effective limit after applying the configured default and maximum
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+	Query  string `json:"query"`
+
+	// RerankUnavailable Set on reranked hybrid searches when the reranking model failed; the
+	// results then keep their blended order and scores
+	RerankUnavailable *bool  `json:"rerank_unavailable,omitempty"`
+	SearchType        string `json:"search_type"`
+	Total             int    `json:"total"`
+
+	// VectorSearchUnavailable Set on hybrid searches when the query embedding couldn't be generated;
+	// the results are then full-text matches only
+	VectorSearchUnavailable *bool `json:"vector_search_unavailable,omitempty"`
 }
 
 // SearchResult defines model for SearchResult.
 type SearchResult struct {
-	File    File    `json:"file"`
-	Score   float64 `json:"score"`
-	Snippet *string `json:"snippet,omitempty"`
+	// Components Raw component scores of a hybrid result, present with `include_raw_scores=true`.
+	// A missing key means that search didn't match the file.
+	Components *map[string]float64 `json:"components,omitempty"`
+	File       File                `json:"file"`
+
+	// MatchedBy Searches that found a hybrid result: `fulltext` (the query text
+	// matched), `vector` (semantically related), or both.
+	MatchedBy *[]string `json:"matched_by,omitempty"`
+	Score     float64   `json:"score"`
+	Snippet   *string   `json:"snippet,omitempty"`
+}
+
+// ShareFolderRequest defines model for ShareFolderRequest.
+type ShareFolderRequest struct {
+	Role FolderRole `json:"role"`
+
+	// UserId User to share the folder with
+	UserId string `json:"user_id"`
+}
+
+// SharedFolder defines model for SharedFolder.
+type SharedFolder struct {
+	Folder Folder     `json:"folder"`
+	Role   FolderRole `json:"role"`
+}
+
+// SharedFolderListResponse defines model for SharedFolderListResponse.
+type SharedFolderListResponse struct {
+	Data []SharedFolder `json:"data"`
+}
+
+// StatusTransitionResult defines model for StatusTransitionResult.
+type StatusTransitionResult struct {
+	// Requested Distinct file IDs in the request
+	Requested int `json:"requested"`
+
+	// Skipped Files not found or not in the expected status
+	Skipped int `json:"skipped"`
+
+	// Transitioned Files whose status changed
+	Transitioned int `json:"transitioned"`
 }
 
 // Tag defines model for Tag.
 type Tag struct {
+	Aliases *[]TagAlias `json:"aliases,omitempty"`
+
 	// Color Hex color code (e.g.,
 	Color       *string   `json:"color,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -259,6 +1003,26 @@ type Tag struct {
 	UserId      string    `json:"user_id"`
 }
 
+// TagAlias defines model for TagAlias.
+type TagAlias struct {
+	Alias string `json:"alias"`
+	Id    int    `json:"id"`
+}
+
+// TagDeleteResult defines model for TagDeleteResult.
+type TagDeleteResult struct {
+	// FileCount Files the tag was attached to
+	FileCount int `json:"file_count"`
+
+	// FolderCount Folders the tag was attached to
+	FolderCount int                   `json:"folder_count"`
+	Status      TagDeleteResultStatus `json:"status"`
+	TagId       int                   `json:"tag_id"`
+}
+
+// TagDeleteResultStatus defines model for TagDeleteResult.Status.
+type TagDeleteResultStatus string
+
 // TagIdsRequest defines model for TagIdsRequest.
 type TagIdsRequest struct {
 	TagIds []int `json:"tag_ids"`
@@ -276,14 +1040,30 @@ type TagListResponse struct {
 type UpdateFileRequest struct {
 	FileType *FileType `json:"file_type,omitempty"`
 	FolderId *int      `json:"folder_id"`
-	Summary  *string   `json:"summary,omitempty"`
-	Title    *string   `json:"title,omitempty"`
+
+	// ProcessingHint Instructions for the agent; an empty string clears them
+	ProcessingHint *string `json:"processing_hint,omitempty"`
+	Summary        *string `json:"summary,omitempty"`
+	Title          *string `json:"title,omitempty"`
 }
 
 // UpdateFolderRequest defines model for UpdateFolderRequest.
 type UpdateFolderRequest struct {
 	Description *string `json:"description,omitempty"`
-	Name        *string `json:"name,omitempty"`
+
+	// KeepAlias When renaming, keep the folder's old path resolving to it through a folder alias
+	KeepAlias *bool   `json:"keep_alias,omitempty"`
+	Name      *string `json:"name,omitempty"`
+
+	// S3Prefix Places the storage objects of files uploaded to, created in or moved to this folder
+	// under files/<user>/<s3_prefix>/ instead of files/<user>/. A relative path of letters,
+	// digits, ".", "_" and "-" segments; an empty string removes the prefix.
+	S3Prefix *string `json:"s3_prefix,omitempty"`
+}
+
+// UpdateFoldingRuleRequest defines model for UpdateFoldingRuleRequest.
+type UpdateFoldingRuleRequest struct {
+	TargetFolderId int `json:"target_folder_id"`
 }
 
 // UpdateTagRequest defines model for UpdateTagRequest.
@@ -295,21 +1075,35 @@ type UpdateTagRequest struct {
 
 // UploadResponse defines model for UploadResponse.
 type UploadResponse struct {
-	ContentType string  `json:"content_type"`
-	DownloadUrl *string `json:"download_url,omitempty"`
-	Filename    string  `json:"filename"`
+	// ContentType Content type stored with the object: the part's declared type, corrected
+	// when it is missing, generic or contradicted by the file's content
+	ContentType string `json:"content_type"`
+
+	// DetectedContentType Content type sniffed from the file's leading bytes, omitted when unrecognised
+	DetectedContentType *string `json:"detected_content_type,omitempty"`
+	DownloadUrl         *string `json:"download_url,omitempty"`
+	Filename            string  `json:"filename"`
 
 	// Key S3 object key
 	Key  string `json:"key"`
 	Size int    `json:"size"`
 }
 
+// ExcludeFolderIds defines model for ExcludeFolderIds.
+type ExcludeFolderIds = string
+
 // FileId defines model for FileId.
 type FileId = int
 
+// FilePublicId defines model for FilePublicId.
+type FilePublicId = string
+
 // FolderId defines model for FolderId.
 type FolderId = int
 
+// FoldingRuleId defines model for FoldingRuleId.
+type FoldingRuleId = int
+
 // Limit defines model for Limit.
 type Limit = int
 
@@ -322,55 +1116,225 @@ type TagId = int
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
 // NotFound defines model for NotFound.
 type NotFound = Error
 
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
+// GetProcessingStatusParams defines parameters for GetProcessingStatus.
+type GetProcessingStatusParams struct {
+	// WindowMinutes How far back throughput and the average duration look
+	WindowMinutes *int `form:"window_minutes,omitempty" json:"window_minutes,omitempty"`
+}
+
 // ListFilesParams defines parameters for ListFiles.
 type ListFilesParams struct {
 	// Keyword Search keyword for title, summary, or content
 	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
 
+	// IncludeFolderName When true, keyword also matches the name of the file's folder. Combine with
+	// all_folders to find files in any folder with a matching name.
+	IncludeFolderName *bool `form:"include_folder_name,omitempty" json:"include_folder_name,omitempty"`
+
 	// FolderId Filter by folder ID
 	FolderId *int `form:"folder_id,omitempty" json:"folder_id,omitempty"`
 
 	// AllFolders When true, search across all folders (ignores folder_id). Useful for tag filtering.
 	AllFolders *bool `form:"all_folders,omitempty" json:"all_folders,omitempty"`
 
+	// IncludeLinked When true with folder_id, also include files linked into the folder from other folders
+	IncludeLinked *bool `form:"include_linked,omitempty" json:"include_linked,omitempty"`
+
 	// FileType Filter by file type
 	FileType *FileType `form:"file_type,omitempty" json:"file_type,omitempty"`
 
-	// TagIds Filter by tag IDs (comma-separated)
+	// Ext Filter by the extension of the original filename (comma-separated,
+	// case-insensitive, leading dot optional), e.g. docx,xlsx. Finer
+	// grained than file_type; an entry that isn't letters and digits is
+	// rejected with 400.
+	Ext *string `form:"ext,omitempty" json:"ext,omitempty"`
+
+	// TagIds Filter by tag IDs (comma-separated); a non-numeric ID is rejected with 400
 	TagIds *string `form:"tag_ids,omitempty" json:"tag_ids,omitempty"`
 
 	// Status Filter by processing status
 	Status *ProcessingStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	// SortBy Field to sort by
+	// ErrorContains Only files whose processing error contains this text
+	ErrorContains *string `form:"error_contains,omitempty" json:"error_contains,omitempty"`
+
+	// MinWordCount Only files whose parsed content has at least this many words
+	MinWordCount *int `form:"min_word_count,omitempty" json:"min_word_count,omitempty"`
+
+	// MaxWordCount Only files whose parsed content has at most this many words
+	MaxWordCount *int `form:"max_word_count,omitempty" json:"max_word_count,omitempty"`
+
+	// MinSize Only files of at least this many bytes
+	MinSize *int64 `form:"min_size,omitempty" json:"min_size,omitempty"`
+
+	// MaxSize Only files of at most this many bytes; a max_size below min_size is rejected with 400
+	MaxSize *int64 `form:"max_size,omitempty" json:"max_size,omitempty"`
+
+	// CreatedAfter Only files created at or after this time (RFC 3339)
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Only files created at or before this time (RFC 3339); a created_before earlier than created_after is rejected with 400
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// UpdatedAfter Only files last updated at or after this time (RFC 3339)
+	UpdatedAfter *time.Time `form:"updated_after,omitempty" json:"updated_after,omitempty"`
+
+	// UpdatedBefore Only files last updated at or before this time (RFC 3339); a updated_before earlier than updated_after is rejected with 400
+	UpdatedBefore *time.Time `form:"updated_before,omitempty" json:"updated_before,omitempty"`
+
+	// Entity Only files with an extracted entity containing this text (case-insensitive)
+	Entity *string `form:"entity,omitempty" json:"entity,omitempty"`
+
+	// EntityType Restrict `entity` to one type of entity
+	EntityType *ListFilesParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+
+	// EntityDateFrom Only files with an extracted date on or after this date (YYYY-MM-DD)
+	EntityDateFrom *string `form:"entity_date_from,omitempty" json:"entity_date_from,omitempty"`
+
+	// EntityDateTo Only files with an extracted date on or before this date (YYYY-MM-DD)
+	EntityDateTo *string `form:"entity_date_to,omitempty" json:"entity_date_to,omitempty"`
+
+	// SortBy Field to sort by. Other values are rejected with 400.
 	SortBy *ListFilesParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
 
-	// SortOrder Sort order
+	// SortOrder Sort order. Other values are rejected with 400.
 	SortOrder *ListFilesParamsSortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
 
-	// Limit Maximum number of items to return
+	// IdsOnly When true, return only the matching IDs in `ids` and leave `data` empty
+	IdsOnly *bool `form:"ids_only,omitempty" json:"ids_only,omitempty"`
+
+	// Limit Maximum number of items to return. Defaults and maximums are configured
+	// per endpoint; larger values are clamped to the maximum and the
+	// effective limit is returned in the response.
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Number of items to skip
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ListFilesParamsEntityType defines parameters for ListFiles.
+type ListFilesParamsEntityType string
+
 // ListFilesParamsSortBy defines parameters for ListFiles.
 type ListFilesParamsSortBy string
 
 // ListFilesParamsSortOrder defines parameters for ListFiles.
 type ListFilesParamsSortOrder string
 
+// ListFileChangesParams defines parameters for ListFileChanges.
+type ListFileChangesParams struct {
+	// Since Return files changed after this time (RFC 3339). Ignored when cursor is set.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Cursor Continue from the cursor of a previous response
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return. Defaults and maximums are configured
+	// per endpoint; larger values are clamped to the maximum and the
+	// effective limit is returned in the response.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListFilesGroupedParams defines parameters for ListFilesGrouped.
+type ListFilesGroupedParams struct {
+	// RootFolderId Folder at the root of the subtree (omit for the top level)
+	RootFolderId *int `form:"root_folder_id,omitempty" json:"root_folder_id,omitempty"`
+
+	// MaxDepth Folder levels below the root to include (0-10)
+	MaxDepth *int `form:"max_depth,omitempty" json:"max_depth,omitempty"`
+
+	// FilesPerFolder Most files embedded per folder (1-200)
+	FilesPerFolder *int `form:"files_per_folder,omitempty" json:"files_per_folder,omitempty"`
+}
+
 // UnlinkFileInvoiceParams defines parameters for UnlinkFileInvoice.
 type UnlinkFileInvoiceParams struct {
 	// InvoiceId The invoice ID to unlink
 	InvoiceId int64 `form:"invoice_id" json:"invoice_id"`
+
+	// RemoveRelations Also remove the file's relations to its attachments (the files themselves are kept)
+	RemoveRelations *bool `form:"remove_relations,omitempty" json:"remove_relations,omitempty"`
+}
+
+// StreamFilesParams defines parameters for StreamFiles.
+type StreamFilesParams struct {
+	// Keyword Search keyword for title, summary, or content
+	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// FolderId Filter by folder ID
+	FolderId *int `form:"folder_id,omitempty" json:"folder_id,omitempty"`
+
+	// AllFolders When true, stream files from all folders (ignores folder_id)
+	AllFolders *bool `form:"all_folders,omitempty" json:"all_folders,omitempty"`
+
+	// IncludeLinked When true with folder_id, also include files linked into the folder from other folders
+	IncludeLinked *bool `form:"include_linked,omitempty" json:"include_linked,omitempty"`
+
+	// FileType Filter by file type
+	FileType *FileType `form:"file_type,omitempty" json:"file_type,omitempty"`
+
+	// Ext Filter by original filename extension (comma-separated), as in listFiles
+	Ext *string `form:"ext,omitempty" json:"ext,omitempty"`
+
+	// TagIds Filter by tag IDs (comma-separated); a non-numeric ID is rejected with 400
+	TagIds *string `form:"tag_ids,omitempty" json:"tag_ids,omitempty"`
+
+	// Status Filter by processing status
+	Status *ProcessingStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Entity Only files with an extracted entity containing this text (case-insensitive)
+	Entity *string `form:"entity,omitempty" json:"entity,omitempty"`
+
+	// EntityType Restrict `entity` to one type of entity
+	EntityType *StreamFilesParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+
+	// EntityDateFrom Only files with an extracted date on or after this date (YYYY-MM-DD)
+	EntityDateFrom *string `form:"entity_date_from,omitempty" json:"entity_date_from,omitempty"`
+
+	// EntityDateTo Only files with an extracted date on or before this date (YYYY-MM-DD)
+	EntityDateTo *string `form:"entity_date_to,omitempty" json:"entity_date_to,omitempty"`
+}
+
+// StreamFilesParamsEntityType defines parameters for StreamFiles.
+type StreamFilesParamsEntityType string
+
+// DeleteFileParams defines parameters for DeleteFile.
+type DeleteFileParams struct {
+	// CascadeRelations Also delete the files related to this one, e.g. an invoice's attachments. Files still related to other files are kept.
+	CascadeRelations *bool `form:"cascade_relations,omitempty" json:"cascade_relations,omitempty"`
+}
+
+// StreamAgentProgressParams defines parameters for StreamAgentProgress.
+type StreamAgentProgressParams struct {
+	// Format Event framing
+	Format *StreamAgentProgressParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamAgentProgressParamsFormat defines parameters for StreamAgentProgress.
+type StreamAgentProgressParamsFormat string
+
+// ProcessFileParams defines parameters for ProcessFile.
+type ProcessFileParams struct {
+	// Wait Wait for processing to finish and return the file
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// WaitTimeout Seconds to wait when wait=true
+	WaitTimeout *int `form:"wait_timeout,omitempty" json:"wait_timeout,omitempty"`
+
+	// SummaryModel Model used for the summary in this run only (defaults to SUMMARY_MODEL)
+	SummaryModel *string `form:"summary_model,omitempty" json:"summary_model,omitempty"`
+
+	// AgentModel Model used by the organizing agent in this run only (defaults to AGENT_MODEL)
+	AgentModel *string `form:"agent_model,omitempty" json:"agent_model,omitempty"`
 }
 
 // ListFoldersParams defines parameters for ListFolders.
@@ -381,20 +1345,81 @@ type ListFoldersParams struct {
 	// ParentId Filter by parent folder ID (omit for root folders)
 	ParentId *int `form:"parent_id,omitempty" json:"parent_id,omitempty"`
 
-	// TagIds Filter by tag IDs (comma-separated)
+	// TagIds Filter by tag IDs (comma-separated); a non-numeric ID is rejected with 400
 	TagIds *string `form:"tag_ids,omitempty" json:"tag_ids,omitempty"`
 
-	// Limit Maximum number of items to return
+	// IdsOnly When true, return only the matching IDs in `ids` and leave `data` empty
+	IdsOnly *bool `form:"ids_only,omitempty" json:"ids_only,omitempty"`
+
+	// Limit Maximum number of items to return. Defaults and maximums are configured
+	// per endpoint; larger values are clamped to the maximum and the
+	// effective limit is returned in the response.
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Number of items to skip
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ResolveFolderPathParams defines parameters for ResolveFolderPath.
+type ResolveFolderPathParams struct {
+	// Path Folder path, e.g. Projects/2024
+	Path string `form:"path" json:"path"`
+}
+
 // GetFolderTreeParams defines parameters for GetFolderTree.
 type GetFolderTreeParams struct {
 	// ParentId Start from this parent folder (omit for full tree from root)
 	ParentId *int `form:"parent_id,omitempty" json:"parent_id,omitempty"`
+
+	// WithCounts Include each folder's direct and recursive file counts
+	WithCounts *bool `form:"with_counts,omitempty" json:"with_counts,omitempty"`
+
+	// Sort Order of sibling folders; file count sorts use the recursive count
+	Sort *GetFolderTreeParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetFolderTreeParamsSort defines parameters for GetFolderTree.
+type GetFolderTreeParamsSort string
+
+// DeleteFolderParams defines parameters for DeleteFolder.
+type DeleteFolderParams struct {
+	// ExcludeFolderIds Subfolder IDs (comma-separated) whose subtrees are skipped by the recursive operation
+	ExcludeFolderIds *ExcludeFolderIds `form:"exclude_folder_ids,omitempty" json:"exclude_folder_ids,omitempty"`
+}
+
+// GetFolderContentsParams defines parameters for GetFolderContents.
+type GetFolderContentsParams struct {
+	// Limit Maximum number of items to return. Defaults and maximums are configured
+	// per endpoint; larger values are clamped to the maximum and the
+	// effective limit is returned in the response.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetFolderDeletePreviewParams defines parameters for GetFolderDeletePreview.
+type GetFolderDeletePreviewParams struct {
+	// ExcludeFolderIds Subfolder IDs (comma-separated) whose subtrees are skipped by the recursive operation
+	ExcludeFolderIds *ExcludeFolderIds `form:"exclude_folder_ids,omitempty" json:"exclude_folder_ids,omitempty"`
+}
+
+// DownloadFolderParams defines parameters for DownloadFolder.
+type DownloadFolderParams struct {
+	// Recursive Include files in subfolders
+	Recursive *bool `form:"recursive,omitempty" json:"recursive,omitempty"`
+
+	// ExcludeDirect With recursive, only include files in subfolders
+	ExcludeDirect *bool `form:"exclude_direct,omitempty" json:"exclude_direct,omitempty"`
+
+	// ExcludeFolderIds Subfolder IDs (comma-separated) whose subtrees are skipped by the recursive operation
+	ExcludeFolderIds *ExcludeFolderIds `form:"exclude_folder_ids,omitempty" json:"exclude_folder_ids,omitempty"`
+}
+
+// ListFolderTagsParams defines parameters for ListFolderTags.
+type ListFolderTagsParams struct {
+	// Recursive Include files in subfolders
+	Recursive *bool `form:"recursive,omitempty" json:"recursive,omitempty"`
 }
 
 // SearchFilesParams defines parameters for SearchFiles.
@@ -408,13 +1433,53 @@ type SearchFilesParams struct {
 	// FolderId Limit search to a folder
 	FolderId *int `form:"folder_id,omitempty" json:"folder_id,omitempty"`
 
+	// ScopeFolderId Limit search to a folder and all of its subfolders
+	ScopeFolderId *int `form:"scope_folder_id,omitempty" json:"scope_folder_id,omitempty"`
+
 	// FileType Filter by file type
 	FileType *FileType `form:"file_type,omitempty" json:"file_type,omitempty"`
 
-	// TagIds Filter by tag IDs (comma-separated)
+	// TagIds Filter by tag IDs (comma-separated); a non-numeric ID is rejected with 400
 	TagIds *string `form:"tag_ids,omitempty" json:"tag_ids,omitempty"`
 
-	// Limit Maximum number of items to return
+	// BoostTagIds Rank files carrying these tags higher. Comma-separated tag IDs, each with an
+	// optional weight (`id:weight`, default 2.0) that multiplies the file's score,
+	// e.g. `3,7:1.5`.
+	BoostTagIds *string `form:"boost_tag_ids,omitempty" json:"boost_tag_ids,omitempty"`
+
+	// TitleOnly When true, only file titles are matched. This is a fast path for finding a
+	// document by name; it always runs a fulltext search and ignores `type`.
+	TitleOnly *bool `form:"title_only,omitempty" json:"title_only,omitempty"`
+
+	// IncludeFolderName When true, fulltext matching (also in hybrid search) includes the name of the
+	// file's folder, so files in a "Contracts" folder match "contracts"
+	IncludeFolderName *bool `form:"include_folder_name,omitempty" json:"include_folder_name,omitempty"`
+
+	// SnippetLength Snippet size in characters; values outside 20-2000 are clamped
+	SnippetLength *int `form:"snippet_length,omitempty" json:"snippet_length,omitempty"`
+
+	// RecencyHalfLifeDays Hybrid search only. Decays scores by file age so a file's score halves
+	// every half-life, ranking recent files above equally relevant older ones.
+	// Omit or use 0 to disable.
+	RecencyHalfLifeDays *float32 `form:"recency_half_life_days,omitempty" json:"recency_half_life_days,omitempty"`
+
+	// Rerank Hybrid search only. Reorders the best candidates with the configured reranking
+	// model and returns its relevance scores as `score`. Costs an extra model call;
+	// returns 400 when no reranking model is configured.
+	Rerank *bool `form:"rerank,omitempty" json:"rerank,omitempty"`
+
+	// IncludeRawScores Hybrid search only. Adds `components` to each result with the raw full-text
+	// score (`fulltext`) and cosine similarity (`vector`) that were normalized and
+	// blended into `score`, e.g. to debug rankings or re-weight results client-side.
+	IncludeRawScores *bool `form:"include_raw_scores,omitempty" json:"include_raw_scores,omitempty"`
+
+	// Format Response format. `csv` returns the page of results as CSV with the
+	// columns id, title, file_type, folder_path, score, snippet.
+	Format *SearchFilesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Limit Maximum number of items to return. Defaults and maximums are configured
+	// per endpoint; larger values are clamped to the maximum and the
+	// effective limit is returned in the response.
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Number of items to skip
@@ -424,12 +1489,17 @@ type SearchFilesParams struct {
 // SearchFilesParamsType defines parameters for SearchFiles.
 type SearchFilesParamsType string
 
+// SearchFilesParamsFormat defines parameters for SearchFiles.
+type SearchFilesParamsFormat string
+
 // ListTagsParams defines parameters for ListTags.
 type ListTagsParams struct {
-	// Keyword Search keyword for tag name
+	// Keyword Search keyword for tag name, description, or alias
 	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
 
-	// Limit Maximum number of items to return
+	// Limit Maximum number of items to return. Defaults and maximums are configured
+	// per endpoint; larger values are clamped to the maximum and the
+	// effective limit is returned in the response.
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Number of items to skip
@@ -442,6 +1512,12 @@ type UploadFileMultipartBody struct {
 	File openapi_types.File `json:"file"`
 }
 
+// UploadFileParams defines parameters for UploadFile.
+type UploadFileParams struct {
+	// FolderId Folder the file will be created in; the key is placed under the folder's s3_prefix
+	FolderId *int `form:"folder_id,omitempty" json:"folder_id,omitempty"`
+}
+
 // GetPresignedURLParams defines parameters for GetPresignedURL.
 type GetPresignedURLParams struct {
 	// Filename Name of the file to upload
@@ -449,20 +1525,47 @@ type GetPresignedURLParams struct {
 
 	// ContentType MIME type of the file
 	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty"`
+
+	// FolderId Folder the file will be created in; the key is placed under the folder's s3_prefix
+	FolderId *int `form:"folder_id,omitempty" json:"folder_id,omitempty"`
 }
 
+// ReassignOwnershipJSONRequestBody defines body for ReassignOwnership for application/json ContentType.
+type ReassignOwnershipJSONRequestBody = ReassignOwnershipRequest
+
 // CreateFileJSONRequestBody defines body for CreateFile for application/json ContentType.
 type CreateFileJSONRequestBody = CreateFileRequest
 
+// BatchDeleteFilesJSONRequestBody defines body for BatchDeleteFiles for application/json ContentType.
+type BatchDeleteFilesJSONRequestBody = BatchDeleteFilesRequest
+
 // BatchDownloadFilesJSONRequestBody defines body for BatchDownloadFiles for application/json ContentType.
 type BatchDownloadFilesJSONRequestBody = BatchDownloadRequest
 
 // MoveFilesJSONRequestBody defines body for MoveFiles for application/json ContentType.
 type MoveFilesJSONRequestBody = MoveFilesRequest
 
+// MoveFilesByFilterJSONRequestBody defines body for MoveFilesByFilter for application/json ContentType.
+type MoveFilesByFilterJSONRequestBody = MoveFilesByFilterRequest
+
+// CancelFilesProcessingJSONRequestBody defines body for CancelFilesProcessing for application/json ContentType.
+type CancelFilesProcessingJSONRequestBody = FileIdsRequest
+
+// RetryFilesProcessingJSONRequestBody defines body for RetryFilesProcessing for application/json ContentType.
+type RetryFilesProcessingJSONRequestBody = FileIdsRequest
+
+// ReclassifyFilesJSONRequestBody defines body for ReclassifyFiles for application/json ContentType.
+type ReclassifyFilesJSONRequestBody = ReclassifyFilesRequest
+
 // UpdateFileJSONRequestBody defines body for UpdateFile for application/json ContentType.
 type UpdateFileJSONRequestBody = UpdateFileRequest
 
+// CopyFileJSONRequestBody defines body for CopyFile for application/json ContentType.
+type CopyFileJSONRequestBody = CopyFileRequest
+
+// AddFileRelationJSONRequestBody defines body for AddFileRelation for application/json ContentType.
+type AddFileRelationJSONRequestBody = AddFileRelationRequest
+
 // RemoveTagsFromFileJSONRequestBody defines body for RemoveTagsFromFile for application/json ContentType.
 type RemoveTagsFromFileJSONRequestBody = TagIdsRequest
 
@@ -472,9 +1575,24 @@ type AddTagsToFileJSONRequestBody = TagIdsRequest
 // CreateFolderJSONRequestBody defines body for CreateFolder for application/json ContentType.
 type CreateFolderJSONRequestBody = CreateFolderRequest
 
+// ExpandFolderTreeJSONRequestBody defines body for ExpandFolderTree for application/json ContentType.
+type ExpandFolderTreeJSONRequestBody = ExpandFolderTreeRequest
+
 // UpdateFolderJSONRequestBody defines body for UpdateFolder for application/json ContentType.
 type UpdateFolderJSONRequestBody = UpdateFolderRequest
 
+// AddFolderAliasJSONRequestBody defines body for AddFolderAlias for application/json ContentType.
+type AddFolderAliasJSONRequestBody = AddFolderAliasRequest
+
+// RemoveFileLinksJSONRequestBody defines body for RemoveFileLinks for application/json ContentType.
+type RemoveFileLinksJSONRequestBody = FileIdsRequest
+
+// AddFileLinksJSONRequestBody defines body for AddFileLinks for application/json ContentType.
+type AddFileLinksJSONRequestBody = FileIdsRequest
+
+// ShareFolderJSONRequestBody defines body for ShareFolder for application/json ContentType.
+type ShareFolderJSONRequestBody = ShareFolderRequest
+
 // MoveFolderJSONRequestBody defines body for MoveFolder for application/json ContentType.
 type MoveFolderJSONRequestBody = MoveFolderRequest
 
@@ -484,85 +1602,329 @@ type RemoveTagsFromFolderJSONRequestBody = TagIdsRequest
 // AddTagsToFolderJSONRequestBody defines body for AddTagsToFolder for application/json ContentType.
 type AddTagsToFolderJSONRequestBody = TagIdsRequest
 
+// CreateFoldingRuleJSONRequestBody defines body for CreateFoldingRule for application/json ContentType.
+type CreateFoldingRuleJSONRequestBody = CreateFoldingRuleRequest
+
+// UpdateFoldingRuleJSONRequestBody defines body for UpdateFoldingRule for application/json ContentType.
+type UpdateFoldingRuleJSONRequestBody = UpdateFoldingRuleRequest
+
+// ParserCallbackJSONRequestBody defines body for ParserCallback for application/json ContentType.
+type ParserCallbackJSONRequestBody = ParserCallbackRequest
+
 // CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
 type CreateTagJSONRequestBody = CreateTagRequest
 
+// CreateTagsJSONRequestBody defines body for CreateTags for application/json ContentType.
+type CreateTagsJSONRequestBody = BulkCreateTagsRequest
+
+// DeleteTagsJSONRequestBody defines body for DeleteTags for application/json ContentType.
+type DeleteTagsJSONRequestBody = BulkDeleteTagsRequest
+
 // UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
 type UpdateTagJSONRequestBody = UpdateTagRequest
 
+// AddTagAliasJSONRequestBody defines body for AddTagAlias for application/json ContentType.
+type AddTagAliasJSONRequestBody = CreateTagAliasRequest
+
 // UploadFileMultipartRequestBody defines body for UploadFile for multipart/form-data ContentType.
 type UploadFileMultipartRequestBody UploadFileMultipartBody
 
+// PresignUploadBatchJSONRequestBody defines body for PresignUploadBatch for application/json ContentType.
+type PresignUploadBatchJSONRequestBody = PresignBatchRequest
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcW2/cOpL+K4R2H3wA2e1MMvvgN2dyGQ+cM4bd2QNMEBhsqVrNiSQqJOW4E/i/L1ik",
-	"7mS32u7bWcxbt8RLsepjVbGqqF9BxLOC55ArGVz8CgoqaAYKBP77wFK4ivWvGGQkWKEYz4MLfE6u3gVh",
-	"wPTfgqpFEAY5zSC4CFgchIGA7yUTEAcXSpQQBjJaQEb1SGpZYKtcQQIieHoKgw88jUE4J8I3W5zqmmVM",
-	"Def5RB9ZVmYkL7MZCMLnhCnIJFGcCFClyKv5v5cglg0BKQ7XnjOGOS1TFVz89TwMMjNscPHqXP9juf0X",
-	"ukj753wuwUHb70Oa5DdWeCjiZhQnSW0azp00TGniEsOUJluTwZNuLQueS0CMvaXxLXwvQeLSI54ryPEn",
-	"LYqURVSTMPm31HT8ao373wLmwUXwX5MGvxPzVk7eC8HtVN11vKUxEXaypzD4nasPvMzj3U98C5KXIgKS",
-	"c0XmOOdTGHzOaakWXLCfsAcaOrPp17aHHvAygVy9f7CTF4IXIBQzAoqpakuSz/4NEbJvzlK4Z7FLymGQ",
-	"gZQ0gdZLqQTLE/1OcZ66X+CDXwHkGqJfAqmoKmVgetxHNE2r3wKkhnQYqAXLv+nuCCvzDJAFoeZnDpEC",
-	"jdCY5xB8DftzPrWx+8W8bYhv2jerRlbdIWG3FsdDnkFOZym0WTPjPAWaD2asWrqmektVtHjHf+Qp72yS",
-	"7lxWDHK4bS+FoEutOOZGX6PuiO14ejdrfeIWn31C9QgDmusZXUT/TQBVoC3EaoorWa/Csh5lqttptKEp",
-	"sHjLyzTVfKv0jQN/LGvmGACNC5awnKb3mhSjyByt5Ov7b7B0v2I/sc+ci4wqM/X/vAlclCimUtf4fehh",
-	"s3pSF40r2I3M8TK8AwvHarwcKKiAXI1kem9Ba0ie0sRLb8RTLpwEPXMlY0kzynO4navH3f2FrUmlLNbp",
-	"FjOIa1YNcxcTamvQnfaGCgkxUfCoSNUoHLIiQjbH91R1gBpTBaeKZeDq84KdubaHabX5Tl5QeQ/ZDOJY",
-	"E+nQqGHgM0Isf+AsqoxUT3iPCkROU2IbEbmUCjJy9Y6c8DxdEgnaVIv6PSpRPYf8TXs/e9FAheARSMny",
-	"5L7G4KpG1mCuEcVN3cHYse3pOllmGRXuYRRNkLLa6qwicUqToRny69IwKIt4Y7SXsobh6q2LPm7VOhyj",
-	"qttbySWhPqw727WzGp/CaPwCnxNSWfr7UqQdppSCudgBjwUTIDdWGF70ugHV422HStOnNWyHKh8rrplU",
-	"K9hg/ddRuENV7ABeWp0bh5jn9bnNYfu5oqnnKNphgqaxah7Wx0o7tG/d0567nJWSRRpuC654EAYPLAaO",
-	"vm9UZsZKWGXm8ISrY7jDEC1YGgvIxzOxVvV9Nj7HJq0z+T7lvx2nZjt6a5/aye6bjfQJCmyb28iLgOPb",
-	"SEjqVABsDfk4mGPtu0WqCxVeR/cTf8Azmhx1rBx9UOw5d/0okkjQp7IxPXKil4Q+luBcjfGqNjmI4hJX",
-	"n4s6/O1F3eAHMa9fSvCAsH+KhObspz0jY8zCx/3NYytSCaBZZfJ7EaDba4weljP9dAb6z93de2L64LoK",
-	"wRMBUhKjMeTaU01z+qlI7tDgEsyNAMmSHOLPt9d+fWNPNn4P2ueulsV4p6e3mFbXyhPpkOFeTc+Zbhnk",
-	"AnLr3TUeII6ZFSmYuNScsm74p1nIHVARLbakkevBNNwc+9aEkZ2Iwp5+OTxTNVdx6/bwLv52CHfuk7E+",
-	"nYy46B5kYl7O0pbxNeF/bJuzougYIw9kkIBqbBf92iPwBzi6G/Tv8EjwFYl4DOQEzpKzcFtn+637UUfu",
-	"1GAuw2/fFE02Nm/9kJ0dwjP7Fv0pj1t5dM7UZxTJwYO/K8MQ/nCsbzk7Ca7659t3ZNRBxurAwlrTvGnk",
-	"YUwUoasp714TQy8xZtobtFoD8UG4AfutNftoFqNSMLW803C1qUygAsRlqRb63wz/faiW/o8/pkE/JfeP",
-	"P6bEdCKKf4Oc0FItIFc281dlcTHaic2alS6UKkyWj+VzXkmFRogZm5q9fZxCtCDXdKa1pRYFdpMXk0nC",
-	"1KKcnUU8m4hHBdHiNKWzieaDPM1oThPAgMEgiXh5c4V+IrZheYJRURlaH1mGRJ+WQ0LzmEjIqF4KMRa+",
-	"jpvZ8oFP9Szk8uYqCIMHENJM8urs/OwclVgBOS1YcBG8Pjs/e639KKoWyOsJLdiEJpCrSRP0TFz581tM",
-	"4EvyYwFqoTm9AHJ5RbAvYZJUGTicTiDjr+LgIvgIqpXrC3pp67+cn28tZetKKToSuNiM2NU+tdWcprVZ",
-	"U5M1xcjFF+S3DL7qLsg2lNlaflFSaBFrE0tSJlWVSJTkB1ML/VOBMA5tl3Ha7pkpw049yZfBJkZg6B38",
-	"g4sYcYUQCYldWUjQFapSHK56B9s5cNQcNCrOUb+iQJDZsjnbeYZvDM/qwpL+DH8sICdoniz8CY0El5JQ",
-	"PEDiZiEnLMm5AEnqWX47I58lzEtzyFQ0adh85qGQpum9HdBd9TGnqWysZCsPvYIrVarDx5VWbHscxBtj",
-	"vmpehXUmkpxEPMvoqQQNHwXxbx46KgfsmcJvDmXNnnFNU78ct9ZhesVFBKQxHsa5UGS29M3MhbrHtw7B",
-	"dr3h6szpc5FbaQtt4lylEIP9qWnjIka74yWvauCiUI/Xoo3iP3zont/F1kaFTEz11oiGtpbq6esOtfYg",
-	"8+BQ2ddtvakt2pvzV75xa0Inw0qdWtHjgHOrXPv6PQwKLh0a3STctUbP4YfZ3AIirXJP6FzvhbvXxIQ+",
-	"fhso86acw5Z5gVRvebzcGhuH9SJPXSdNa9GngRxfbVWOLtmhl2J3kxHd+XrRtarZtiBtwxtiYwwrzflk",
-	"RlW0OK2re7TT7gRDlTeUJCtTxYoUrFWnGiD/uroh2lyxByAnJo7H8mQIi05pUmXsdwEPZw3UKISs2uk/",
-	"WdEloT6hzFhOheNEMcSHZhXuJcOmA0EE+VMXdTWi/NfVzVrIVMlAxEgKClzOYMYfQKLT3FRTEColjxjy",
-	"kswFzwg1rJgtW63OyP+CYHNmu5sGkPI8wUI0/ax13oGYlBLE2QBqn/OU5d+wFNnSu8atnDa0kqt3eqoS",
-	"h/AYsVaRyKpC1rXVDw5j82bIULsGSxLERJaR9hbmZZou94ch3enN+k51hWwXdEYkTWWMRsAoJaXB5FdN",
-	"nxBqPbWkOKFEtVNHA4TUyawd6aBBsuzF+mc4fzfCsirDo3kY30e8zNWIAEeTn2n3c0Q0nPZPEux1IN2m",
-	"+e51drrA+sXip1V67B0+l5WeonlMmJKkDiMNMGU6WL+np27WOJ/21sQ4fYBehqE6PsheNgv1bd9wXYyg",
-	"UvtX72xYwByoNYNxLEdUZctMPd+PJxiDoiyVB5HRR1B+ARWlQ0AmkiyNeDJQ1Mbye6a1Dte/TB7bV7nD",
-	"RMIOdO6zsWCP1QdSi4Y34wyu1osmTHpq3VRf2O8OxAOI0zvIFcGbILJdFyCAppjUa8KMjlKBLrrusDtG",
-	"LW9s2x1uewWPagIP3ZX6w0IDwbYKIfjcLhGH29uWD4O/nr/e/T2gduw756qOf3dBZoQ3lPY4xLWPoeuC",
-	"zFU1SHOI+Xx7beKfixrlTiNSHQs/314frT0ZlOk6JPKuvfAEchD0UN5AZWk6whgnc27Lmvz+/VSwJAEh",
-	"uykYxUnVtfInTmgc20yS9j91E+NXDAMR7WKqowSBo9rLAQHbypyopaJijwA4Kr1jMaLhwVs8GQdBG9Af",
-	"gUAql3m0EDznpawyTKSgApMB2nmtS/OrDWmI6ILPRvq3jL2/7Ojg+NzLId4TpR1wzGHyppNqEYdzniwh",
-	"G3hPVf332hAZTWQ7GDZAi2k4pYn8IHh2jF53t2TqSDxuzTAi4JCBCCO5loT9hzGn2rmMY4sPjGY50XEZ",
-	"x3qlU/4fYGwCDBrHB4PFpfVS0D9Zo09shv5ZRRe2XADjKxx70HRd/UVdEbBpBYYN4NhyqB2UXAyr6nnG",
-	"VF1VXy3Xl/NvavY3q8jYeZXBnyp3Pbzwsyp7bcG0tfx1Dc56u9gno3PY7kRA+zL8btPVnZLQfSes7eUq",
-	"R3DK7KnjSFpXUhjKuKcUJ8pevlqpGfWZsbo4USkP3ZFIJcpIlcIdJmguZK1ThdotNdZdLZjsqalGR83L",
-	"NDUTY9vqItALVNVLN/qLb6Z5gaRs85dC4mNz7UsZUawHxQYZnSblQNMU8zqWd9pvjEoh2QOkS1+Kp8Lp",
-	"hg5X9c2qkWkeQ+MxJHr8+3J9ssesopXuqW5Irk74bJ/F5/vTpgdP/KwS2MrkD80JPDKpsE7bbTHbNxxe",
-	"KqCdZYE2N7Z7hMdx5ILGG1uMaYypw6i3O55Z8/o+rLv84kgRNLwEfGz4OXyBxYbYeVY8zK19ehGxI8XQ",
-	"YYMfXvwcZVxspa0aFRtzI6WJjv0HJBuD5GhiZOs1jb0r5i9S0K9tXYskJSYU9InsVMGjCus7Z3hxaLGc",
-	"CRY318961Qn4eJN7StXpznXU+z7mI6grblqYGVZcuRnctmkuWZh1tq5ZaIZofuDtesOQIKyajbn4gbGq",
-	"6uJSd1tu85LU/9sLR3+mUGDvKxOuyhiDA/N5VXkgPWKJ6FeDmsctBVJ5JxtH2VFJdUPsHs1xzaSamqPu",
-	"xtcbabKFyPqfCV797yCsCDOj6LYVY7axiAonKK+x0WVFE09oeUqTncaVW1f/9xxUxi9MOP2H4wgnG5n0",
-	"xNne9BsEDF3yNW+NfDdzLc0308eFATU7jyAG6GTm2uifVl4Y+nPF+LbKufN9wPrQcT2PEEZH9Fworj8g",
-	"8iJZ7CqQt6l22wsMjiJ+t1K7mau5/nCd+VxLfRlDcXL3+lQTQhWb4f1ELkzBVh8s1eXNlTbN3MeiQk3m",
-	"XGSn1XeLfBVo1Re5HGXzittrxkE44sKl4ztb7kqz/RnJ3odx/NcDdLMDYqq+CtoClXk6gNWkrr/2es0f",
-	"bUVyt1q7KtKOmYBI2TUb8LnsQ/ujf+sc599pBugQVrdG28DxHQ+tW/2CQ/inq0/v8RDantszY+f7QO5j",
-	"eRtmPFJQ30sYQn2XFtD5tUVnhWZbsr0q9L1jWBvIBmsWXN1S9A6gF0BTtRhVRGCa2i9+VKKWIB7M1eIu",
-	"cv+Ojf+2gOhbsNUbnk0xLjzSrMDvIvFvTjW4trj2zhBPmLSLW3a+UBVcfPna5q1ZE4nsoip+msean92+",
-	"3e9affmq0SrxspBr717eXBHztv7mlNY26FnYmVxOceubU/Uem5rzoCeV7urxoS5UctofZxf7ZQ5nBxsB",
-	"qyEhm3428ODpaAHr6mhhO+zYFguBPC44y1Wro5XP09en/wsAAP//FJeJQLVrAAA=",
+	"H4sIAAAAAAAC/+y9e3PbOLIo/lVQ+v2qYlfRj0lm7z03qf3Dk8es9ySTlO3cOXtWUzJEQhLWFKAFQDua",
+	"qXz3W90NgKQESpQfsVNn/pmJRRJoNBqNfvcfg1zPF1oJ5ezg5R+DBTd8Lpww+NfbL3lZFeKdLgthTgv8",
+	"rRA2N3LhpFaDl4PzajzBp+z0jWV7uZ7P+YEVMIwTxT67mWkrmK3GzghhGTeC2Su5WIiCjZfMzQQzIq+M",
+	"ldeC6YUwHMfNBhIG/3clzHKQDRSfi8HLgSBoRjThSBZ2kA1sPhNzDoC55QLess5INR18/ZoN3slSnBbr",
+	"QMPv7PRNmGbB3ayeRRaDbGDEvytpRDF46UwlErNI5cRUmDjNp2pcyrxzsgU+Zqdv2N7nz6dv9tNT01uj",
+	"fhA01+n3JzF52Jt7W6suC6mmZ1UHZukxM9W9Yvi9nEu3PtsH/kXOqzlT1XwsDNMTJp2YW+Y0M8JVRh2y",
+	"N2LCq9JZxlXB5vQ+kWGu1UROKyOKoVoIw4QqFloq94qV3EyFYde8rDzJ5iWfA8k6jSTrx8Ex3UwMlZhM",
+	"RO6AhkuAlEnrARAFk8qTuV1oZcXhsIu88dMWRc+lgnkGL3/IUlj5OJlYkUDLL+vogDPXMa2mUZrzFoS0",
+	"wcvjrIbhOAnDBZ+m6OCCT+9t+79mg4A8ZEA/8eJM/LsSFpeea+WEwn/yxaKUOXKQo39ZgOOPxrj/vxGT",
+	"wcvB/3dUM7wjemqP3hqj/VTtdfzEC2b8ZEj9ZiyLQqiHn7me6ms2+EW7d7pSxcNPeyasrkwumNKOTXDO",
+	"r9ngs+KVm2kjfxffAIbWbPDYfwEDnhQFMNQzUeKUDUJYGLg/nCQiMfCCKEYTWQpgqAnCyuglqdWIHq0S",
+	"8d/0DR5dGIP4gB+V7fkTwoYD7hzPZ3Oh3HAAbH3Ov7wXaupmg5d/Oc4SzLqm/H+uQflb/ECP/yVypDlY",
+	"MXLxk1Jy27lgPGPr13PJ7ay+jxm8BYzB39lwIi2bGD0nHqW1y5g4nB6yT0YDAPbo+fHzH9vL+uH4+Y/b",
+	"FobQJFczFcq95gs+lqWMsLdWUpjlyFRqZKvFQhsnmps31roUHM+EmI9FMRqLiTZixKeeHNvL/3Um3EwY",
+	"tjA6F9bCzTQVSgAuLK4YB8EbiwZiplIK/oSHOGjGSuEc/CQdK7W+YtWCWTmXJTdEGYMsBZ3i47ILdNxu",
+	"p3WZEKgu4GdWWVGwm5lQTJspV/J3AIAzWEFJBDnIBsjdt50yRDgMeqomGib34HBj+BKBIWnqNuDQp/cG",
+	"yZx/GcGladOnda4LUaYFoCbpBcyHD5rjZgniam3HCjo6Kfjttae3FdLljq/jEF8+sAuRy4nMGbx0yC5m",
+	"gjlh5lLxkhlhgZvsaQOyxQECywTwx304rXyoAEYgTlZK64h2kfOKguUzrqbCvmSOT+2IF4UoSDKBP3Mj",
+	"4OAP1d4fssjwwH/dz/zOxcf4/lxfi2LkNKNX4Qh/zZgs2DGbaDNUNYfQc+lcoIjAIdkNt+qZo2H2Mxhy",
+	"qAik0gheLEcLI6xQjjVBgaHDDSsI5jjiUPkv2YwXhDE8kkyJawFfwVSWvkEexvEzkrDW9m3jTTAX1vKp",
+	"SFBXNgBSSD/wt4ZQIB/9c2AddxWyA63LUc7LMvyb9jf8hRsLf8ykuoKxskF8ITzLtVIiJ/ostBINUuyg",
+	"e3xar6STdM8RyjMvUa3T8AbO1XHSOqeKh339oDQPSAK1JComHrT1U14UEsbg5afG8CRRtqYY/P384y+M",
+	"OBGcKCAx2AvGzbSao/K7toiV1SJI7WFb4KSw8BN3+eyNKIUTILV0396eONdxMzgB7ohXNimtKM4XOGST",
+	"8a6TdJu7riwmztcPaiTOxCbCK8Uo1xUxw3UgJlyWokiv7CyeelzVjDt2I4wAHuJHZmOR88oK2K4l4/QM",
+	"tg7OljDPbLyAb4uH9hJa8HZjRt+oUreUkLttph/vwbazKq9eI5+/4NNuEgQ2Cv/vdZfH8c5q5WgjhDh6",
+	"H+i62JK/qRLarrgpl8w/xssgA53b3ylMmx1ElAs+TQkm3mKVuNm/SIuyId5BZOsimRooub7X7hmiFdwG",
+	"1NSAdiGaDvVGMphok4uWEWDCS7vGT09KG9gQLZ70ILKRkMKkTRAzkhKy49NwSG5L9mGIPsvtoiu6eRNn",
+	"9aMSQS5biBUZhZ2+udOWEmCer25bZYAwtcrXerEkfbhzO72ttNM26DTL9WJZy3FSgQyoqrKELQyyHshp",
+	"QQIEPReew/UfrtuEbWgdWiTUjfA2LAurBIei4IH44gzPcR/EF3fIfgUZdGH0tQS5t16EjSyBDHBDdQl7",
+	"gez+klnHnQj2u6Z6uJALUUqFA/jT1JIna0mEtAYvAm7ab1jvBbz3NWtvxzYUZoOgrI6into6mSlBB/Hh",
+	"sQiLCKjJoua7ovjCxlox58rJnFnBTT5Lnte5nNfrXcOGNnIKqgyaMrqlt4jo0UymdvlUWWeqHP6ytQ4O",
+	"fKTUN3ZNBXUzSTIAWS2GajjwJHytZR5MGyevP7xllQJif11K3Bv4aTgYqrZl4/nx8XFip+2L0ZVYJhdk",
+	"5e/Cc805d7R5/+vHQWovbTWfc7PspuywPwXzr7I967RBljolO8aNdLOwufsponTSlWK7jkyvxZWltu+3",
+	"7vOLNNx5gu8i2gvlep8N+wIUyon8so7RTyXPvYEHMMingtEibJC9LKsWIHMhcrMmq9CGNFiy9QN54XKH",
+	"iggIPz4aVsfHL/LKCoP/Ev6HCJL/lUllneBFnHX9w0N2QlZFcB4E61wpnBPGZkNVyKl0NmPDweFwAP8b",
+	"DQfItoaDg+GAWTFF5eUV44qJ+cItGeGzVo6RvQFMhwlq36ZT9qAE7wzaJFZ2at2Om6lwoxZTTNwj61f+",
+	"IPFtN5gXfLrZdsrh6fZTQ69tnGfDvVZqkyT7W56X3XaqADkSV1p+nAxe/rOP0Lm6BG/0HAkv9I6CxrBR",
+	"JgaO5b/0ojFqermuStDvmBFoXPQn5a5i8cryf/uaDd4qJ93yZB6U1JV9qYwRKk+w5dPzj+zH5z/8b5br",
+	"QtDNc6X0TVIWQFdh6x4odDUuRf0uuSjXto0+TO0beUfW4BXh5xWkw88s2H4SEOJ3id36JMzBRIqyAEFh",
+	"XIq5zWrXJS46yLhjXSzBJyoL9JkwUJVt3/16B1N4h88WcZdWmETJlwVX3hlyYUQPoTdJnjAICrTwUtDa",
+	"8pksCyMUsH24GNgeB1Omdez58fH+Lqp5NpCK4hRAVE5d99ZPAbwZroJnljm9OCjFtSgDWK9YvQg25yie",
+	"KzgvyOUTAtqqRSB+3ROVHSYeb9DutckBtTRsCjNKA8euVC+LUHRT2YgplnMwARlxJ2sJLmoVmG4sxQWt",
+	"Mw9PNL0x1I2ZlvCzkWJfMVTNmvpYP3Ws7Z8L02X1KlIoaBzc9WMGz9bhRVMrsPpgaJUK2Qaj9xPMqdv6",
+	"vmbnohE2GblB3UptFTe1obIrXgLeAuXS2BC2seAGrqeglqYE0U6V9RN9C3pqGOBVW5/WV0IFDjTn9gpd",
+	"waIsLEScyDnznMSuz18jz8uuI+7aFxB34sDJefI2KEReciOKUUupWwmuOf3wlsEjhk6bNV8Pq81OifEd",
+	"Oi96jq/kZCIKUtbCFM8sKwUn1+zSCcuKCkZv6OqpiQVc9lJsP4iyFG/Du3dT5vuf+R2V/xm3bb1/XSfv",
+	"Eqy9/tvBTpww4HT0LzG7tE7MMTZNq3LJrHBIneE5bjjMYfvYfVYMBSve5JlggfZYJICM5doYJJg1GgiW",
+	"jF67v7MRQqginpyEGaV+k5XcOhYNSGjbRFcBeoz7HLnmrIGdbn1pBLJnKu4tn0klDuAehL2AC9HqFrwE",
+	"XdaQWw+H6hLJXKjcLBdoBpsLrmzLaLbg1t5oUxwsjKYznIHljKtclPUXjYmQE/jHqJle+i0DF/rIzrRx",
+	"l0NVT7RoMEX41mnN8C2QvCorwOgBDkp2qYQo7MiIayluLvc7rHAPZlHaMpl13LhNtBORipQjlBNGrFkb",
+	"YaniNiREONrGej7FD8jpi4PEGNN1VM3nlUN6ghjVsB9SsVKqK9s0ZsAyLAhrykleUqTfGrjN4Cab5gX+",
+	"kIc4LApVpMgMJGL48hVDruSvQq+UTH1Mzq1CYpoBZEl3z8Pa+z7eSgIIwyUQ7R+NpB1NeFmOeX6VQLep",
+	"RH2Jn5yGAfEQVopfc4l8fZ3T1mGu4RNp0d70JRdm4QI9+L3Eg+1JpXlk256f/p7GDpdcl6EzG1SLYmd5",
+	"qLKr9qf6GXDD7ZIjvNVfaFwRa1EMbwZ/B3iyPpbapvSSYhKrkkSaYFoLzZryckvEbOG3S/w+sVbnko50",
+	"l3a+s9iUDnB85zXEVgwjutRDsLany6A8kZUUzh68GZTu1TCC2ylxdyfslNe8jYEunL/GKLCk4qOmO6sH",
+	"KOVsYyJREwjvZx2xjn0YcjKmYVDDkjVXshkJm6IIKmNT9rOPC/7vSrCFthhPxPjECdKvSfDDcaNhLImz",
+	"XSwljQ1LkBEc17k2YhP+4bkHi0KTawZu5HTmGL/hPcxE3hTi0dKYugvDdfBLF4pDOMuoMmWL5CojU4gT",
+	"XxbSCLsTgW6U79N39+rCm1DSN41hW1B1oeJtQ9dsb9N/iiULmiirHda81GpqZUEe2Hj/wnaef/7w4eTs",
+	"H6O3/3VxdvL6YvT2l4vTi9O353DV1gGtK14LNGn3ZzgtQ3iC7ADjicW8gZ/hZvvHP/7xj4MPHw7evGF+",
+	"l9ZNcKvBkfXoXsauL4X+ny6EXpRil29WrV00wCoQYclZRGXXVr+TpRMJtnEuSvQqkksRdxXsXjdkr8VY",
+	"Xf8Mk25YHS7ICr2+oWU5ChEzWwNwPkAomh9cKsbLaDpme3KqtBG2NiHvd7JmFBvsTowrmD86AslTmsVH",
+	"kOAjrA23alJyDvZzUDtEsR0Vv4KPKc6eMQ4W9nkDPzQQBbbgxbUydwMnV2IJclBqqyEygvnnJLxLV4os",
+	"HOQMjAIb7HS3V9kakVLdNICONq6WXhy3FJa1o5U8Sfw/G10tOozfXYLxG2lE7iDv0xNl5jUZ0rG1BSHZ",
+	"Ooh2HxVi4WbeKxi8MKXg4L3WVYfJdWfDe1xHinDhHHQs5KT00iErcEmoiSaJqAFeh8b7i7gR1m0cLmPB",
+	"7YRvjRbCjHbLsiBZaqfTedGYXpu2e8HpBUMZuZfpL9zJyUAzeJg15O/V4Xs4uANqW3uWtUhxqz8DElf7",
+	"BWI/SDQuAPBeWrdBgNpVkkxtdze/gBN4+sZmZFZp+3plYUf4s7QM9ng3v2cZsnTXX9UxVTUxjHa87BH6",
+	"4WVVej2L6bJ+6C5cR0NPV0TxzoJnZzjLxjRDbwjru58Pmr/oc/zayYtbT6CMuVL4r1UIV8FpmQ227c49",
+	"n4hu616KprqAg7ghn2DS5R/HbKtR5wVNadCN5AamMFo9pG41TBS7HbWVlKpdIPCf3h2GXcg55JaNtscj",
+	"U1phLCLgg/FmwQ+lMyYnTCtBEh46HRhuAwg8221tfp3tjetGaCdtrOR+zSsr80E2WMy004NsADG3GnO3",
+	"cswvGkSnXCKTK1Rw2FHIqq2Pxbq4hd8AX5dupiuHESc+sXXOrGZUjyPnijlRlkN1M5P5LKoQAmMODtlZ",
+	"uB7GtUaTsalwaBT20q6NtRNsy0tzJ4Gt08h2O3/35hi7Lgb7DWJVwVZAz6JQ1Bmz2qU71XDdh2n9fg3o",
+	"qUukNm97qW4nA3OdDH9Pd/qm8NNu2uiyR5u5MMzunHff8/qNsGahqsa2W7ZG131esvWod7hjcZDXnomk",
+	"ZXF7Z0m4YVG5I+e5i3xbe0E7X6jh3HaF+Tdrbag9QnvKfpIyfkppSZ/I5d6hG229i4hV7cWyTvte9ggB",
+	"v2vOgqbivPkoxvttOxTx1duCQigM/t7VogiOlwyeAUum8KSGF9R2TrPVbdwVxTlYX/yKDtyAd9MG21yo",
+	"gidrF4hFiqG9B+3csrEo9U0UARrBiGuiByoaP2zY3r4HMImLQeYB7bPIe2d49dB35Xr3DtqfRoCNrO0D",
+	"+L/NowoMRvfQlyi3StNV9vCSWJPB1FIZQnobqYywfO+07TfvjkfuTJctlc1Hkd8Y6TYpZRd8+jqdO7LL",
+	"fdiy8pKxHkX5tM6Kgnwv8X09YKB9MXSjY3Na9C12KSLqjvt0YYS4t1h7HGxHm/+7DQZ6HyOLGwj/oTHs",
+	"X4FR7id38qF1y1rW27ye9jJAfZfOtuSk3VaWYiedWWiNTMH74cHdKYV3Sze8DdNNYaI7T3F3vuoRd89s",
+	"NWzHrU/rB31N9WF+WpJ/fJNTxfWQ+2pHe8dmrZo24Q0Wy8/6mgUhtGt/96wYD+fGxd6v5ygbfOsFdnum",
+	"cImbc7uvhFiMYrbsZq/8fwqxaHCcZ5bp0htCjLC6vEZrpGbSMTczuprOYkU5RlOk/PMb8qR+ETeMHt8V",
+	"ZWuo+UhxI750RdoVcOv6YtYZwechXGqlFObZe4qJHsOvYwF/nJ+/ZfQNrmth9NQIaxlxEruVP9VZorXr",
+	"pgFDijQwk8m89rGiuxfvSGRCNRXmzoTVVGw9RtE0chx8CRArXNase0ExMEWs8QHz4BfN7IXD1Mz/0uMR",
+	"xmWnFP8rH/v4Lz3G0EdbjUMxPOlmW3Ffj90HzVsJbf2ej8CBwq6mlqE3IuU6tNVcFL1KZvp3g9QqApoP",
+	"2Ts4+HVA6ALhZ0ZQaUUMEodtZN6wHZJMFCTAqqkw6a3ozGlteBoJ+iQSjbByqrBUV0cuYEhQiV5Tz8da",
+	"xWx17oQ7oIOxYwxiAu5OyagJ7sa7xXYJdpCnsWjnLP+wkrO8OeJoBV+7XVKhilC0fd3IsgSrV11T4xU+",
+	"vRJLsg0tSp6LwhdkaV0QtZuk101meyD0XsQlP6QoPiOeby8y1QOdve+GbZU+e0a4gizZP/B2BeTGpyEa",
+	"tgXG5tXgx7dYyC3ieO+0ykkd4Hu7BQduhRnRXYFxQQvrkSTUO/O5vrS79foV8M4pLvHezkBi7Xc4CGG0",
+	"j9fCpN0N/FoYPhWjoqKuECMrcq2SJk3BW8mPTtbJ58SU6sxNuMS8KnwjVaFvXrXL2SqtRP36INte0iMb",
+	"1K93sWjwB0ykknYmiiaktsrhn5OqLJfroHWkm4eo73T105T4udU2JXg+W01MrCzbWwgFimLWeJbV2Mli",
+	"mmkzV3M/WX0XX+zCD+W/raWv9sTIgle2pyiDGa7wNtPel26FgWLCUlnHVS7SSkccYLuhbywa2XOi8NkY",
+	"qgP0Gy5dctj6gDBTKct4nosFESn2E6FFIF3N+DWV6/c5qWwp0lGzhMLRXKoqGelPhaHCwaG38Z9eP1tU",
+	"jlHLDDhQ18ng19XSlESsLRSuAdI8QJFS4rbWSNrMSD7B6xA0LVLF8R+YQr7hPq5V8tgJS+cxCr0NZzvb",
+	"ejUx3Cc0jisXeplUJVXDh9zRyoI+7YMK+VCFmCcfk16iSKo0qn+vmBEHXlyVDhQDIxxkRtSKAIYQBSO9",
+	"Z0CrFJQkmOYSkgb9M8EtSCwfb5QwdiYX3QK30fNRZVM5H6+xYpRjGgZ5ZqkuTVcGNDXTuIVZKH66WmTc",
+	"68veG5papdMdkIOBZCvUqxJTREQ98Cp0KwtNEWDA/CadtlPBMf5jUTSzCOLdVT9erze75ny23Smk6Wlq",
+	"A3lyVFoiJM1dpxjM+YsYxdWoKohhoHErOlUeCucadVY+Bg9OVLNC8FgcuW90QSuOpDnf6uLS+5qXgLXJ",
+	"cqtxdEcL8Nc+s6WJKSSKjpfx9NxdVPKDerJbCIOYjrVRUjJPgGNDffTwyi2Nx5Vam2OFWc1EfhWhJjEL",
+	"BolRwCGI1s2ENP0z1tvTrgOSrW1CYq1pgsJE9b/rcUqx8kx/t9hPORfKhqzDFfTEHmCNgrj1B2zv2N+E",
+	"2EqEeUNR2s/XJeGuCoikC+DL1KjsAKdOq4eh5clKCZ8IK8FV2RUGcC1yp43dUESkD6TxVWY1m/B0rlO7",
+	"EEq/LbEdQsjf9Rg1D5ExIVEwGw58Q57hAGSFYU0DqYSFRhhJjz1QQhQR/UWrpFAX2ceKCqG1TIO46qCU",
+	"GsUN4aSBpxTdU3rhPenqcbBkefFGjM4KWa10saMkeLCJLkNLpLppXjgMzcZ66RtyQ9QPtaJLGXmMMFxd",
+	"jRqFSRKXq3AgpNOromCz5djIwtewFra2S9Mb9XmhTXlFRU18eXWGBRavvNNKGjYusToU1XbHVdpcG2E7",
+	"iprQrN2mrs4Ap2xAp3XkR+iz5M6VIkIbvAzLrELJxLGI9b+LV76Xj184N4IWD1aIA/TR0K1gMTosud7N",
+	"UVmhw2ATJ71itVqEm+T/dafSriu9h71mpeokv2FxaL/J2HUpoJkQlTGfG0JqzWWs8slvRvQRhkpcHg7V",
+	"CZtL0vmuxDLqUtz5DWOFxD1BLEcla0Pbot5ZNnSZj8bLrvzleP9A3cvVFb5kl0ACQAGXbK8mJ/hhqPzg",
+	"+xm7JIK9ZHuhbjwvMYoQE8L2MYl0rN2MVtQ/zx+x2HMLrZKLhXCJcdP5PjR2kuZm3Gzze98idM92+Es+",
+	"W+q5YGf+3DVjwrab6dsxep3r6SxZumvxnV1X3hW02wvce4xwaWHh1mZqspZcGK7sxjTA2BwklQlvnVS5",
+	"q1v+xIaw+E1aqOpqOEMKcezNiena2oUhxZcFVVGsO5GtDe3iYraZY2mQoPhsF45qJKzMsrkvja9MnqjI",
+	"LnZKZepIRsnq0usr2bHiC8NHVOB7D6xX2f0VWL3nhLMnnpQV8d+7tn4XDlKgdRfeX+2ls1OM7rtYtcVx",
+	"KmTZaGK0KT+la7xGteq+I9aqUDDw1dki/tSMpAK7W7Niddru1xn62NVDIbKJViZJa5EdSN9UQuGBGztd",
+	"8Ok93hMdOZBPLovhM56/za2evkFDot3KrQaTBJZcXe9RkpeC03GZ9+vDU1fU3KXxTRcu79bGZpdQyF+p",
+	"j4Lic/SfXj1IZGTn1fFno5yHaZSzga62N8W5ReObHv1uCIJv3YcmAcbmEoHrAXgrRll46mvDUwesGHpI",
+	"k7wM4Ybuma0Liq/UEh8qSmfDcFCvkPsuaDIPxboML2Tu6gIH7bLjQ7Wxuv0u6+hT436ls3KljMj1VEnb",
+	"UXJyx0qLfaKtOtxXYMhIDRnyY7cQ71p5Rfxua8QVTCDyyki3PIe7i6jnJ8GNMCcVZauO8a93Yel///Vi",
+	"sNb799cLRh9RvWXGKzcTaLfAN7IB3ozIT/G1eqUz5xaDr1+xKB31MQaQeY4ni3A5OPtyIfIZe8/Hg2yA",
+	"W4Gf2ZdHR1PpZtX4MNfzI/PFiXx2UPLxEXK4gzlXfCqAK62dvsHJp1O8PPGd6OyMbbsz3+YUjZLrrfvo",
+	"KqRw4A9xFnby6XQAxkZjaZIfDo8Pj2FuvRCKL+Tg5eDF4fHhC19aAHF9xBfyiBdzqY7qq/+gFlqnwqXa",
+	"uFADc9R4KQIEjtd6eBHPjbYWaxdWltbV7HQ3VDN9AzgItQNTEVRG5EJBmhYgA96HyGJqGOygW79WQ+Uj",
+	"yaCcCdKkLwQPy2JGB9MbcCgkiNNi8HLws3BrURPt9tP/TNVbmnDDIHi7GTMTQtA9GCwEtGEAxQBIa/Ay",
+	"2kw9Ua2FyJDw1pI1/tdxNgiW95c/HB//B/wtlf87oa//hp56ZMq4e8+Pj1fC9psB0P+ydBPUM/eLD4wR",
+	"fXhuOsNhbKxv+OPxcdfoEdyjn+qGy/jJD9s/+azgoGsjfxcFffRi+0fvtBnLohBk5I4iJ9DDOgUPQsWV",
+	"fw5OgJoGv8FHyUNzhIE6eBdqm0yRqKzwZ6YxT0cQkg+28bXa5xz2WMGDodLgrIAC71PuBFQilSqXBYbr",
+	"e+wH0xOZ/q2TZVnHJXlilWao6NBZx2MO5BoCMowJutHmijLJ0csFfoO8+fJQSRuyCA7ZGcZBef8zCYsA",
+	"Jh1upoCTl+Vyp8OKyPvUDBD6BnTeCDjbTOk+ROtRyBaBXGmb0pdiace6SfYMn6/TLF5LRAjiWpglRL6x",
+	"mShD0Jukzh+ElsOh2mGjaconu9Oexh9nqwk3u+x1iHjq3uIP+tpvcLOfN0mxWvmmbE4zrjRc254tgeii",
+	"J5Ox5gaFW22GiucoCbC5MFNhD1kwloH2FcV7aVqFRJQPXDlkGOrEjRiqnBsjRcH0Nc0MK4wtSfhc+M5j",
+	"N42iZRARAYCSwHT+YqiCklvnoIAG7nvx+WpcCFgjXKv1dFeqXYk7HGTBWv6TLpb3RrGd8Y1f2wK5M5X4",
+	"+oAnZyXaL3FmIoSNqLunLArAFz9u/+IX7d6hfXb1ZNIamQ7L7nE0KSpom4ztb2XKAPXHoOROWNcKbYGU",
+	"wJSI68Otonz7gCQR47oS5HC2AmpLOHzy2/uzqJEdNyOxw1kHkz2nu5Kj4jA1MAMiAT31RoS4DVvHdZBp",
+	"FfSmOrEFOeVQBd8dmk3IUY+3LbgJF0YXVV4zRk7RPqIdTnY4VJ+tILGTQoDsjfQ1g1ZetczqVQ0UzZsW",
+	"ZcIYzd2mO1yvJ4h1mnv+iDRnnCi+KdH9n3tbrG+uu77OkzVGwGQtf/vwujWG5am5GRicZFZTodxRzhd8",
+	"LMvYDWMrx8LPntkYTkbqcNCTndalBSkRqpNCbMlalzZf0Vg1I77XeNsJTPK6CdoD8rf1yVJbAS+xFrZu",
+	"R2xr7OfklPH1wet9e0fpsiv71tOOc+OzZHzjMJqo1ZgkjfuHv1Ua00Rrcyfew53Sjbw1pX4VbTFNYSO+",
+	"OFuA1Q4dJdgCJPpSUM6lWHiSz9uIA7/mO3/iNlqadm1GkbIw+Y9bpqU12366taGpRBYnr7tteFm42fq3",
+	"1XzrkL3W87FUwlv3Gv1OQNCeyCDvY0MTsPo1qmVxmgNOP0zgY8sS6wpxefTxKBic181n3lW3HtqYiBNw",
+	"wsClGeuKdMzdqte4itWGcXwDWv0l2jCNbmjrcsg+WzGpfLsGPq1p67ADwgbO74iVCLOn6pX+K34bNnZg",
+	"8XokMpcaqE2bSuPc33420jeS+9lorNePI9W+9U3zUrCWo9DxcFZCZz8W/CRsL9fzOa/LCGeg/lpxIJUV",
+	"GF51LbLoQSq0Y3pBEbH7wT6o8y/Zl9J+OWTvpALn7NRwqSjjULG4PHKLKmeWJHRKC/Gp3ruK9yz5V5m0",
+	"EDD9L4oyw33/8fi4+yyKL243/tJAkS9cv4qD/VeMQ5L2garm6Mg7fcPQurgCVAdEdcX3W0GVsgCnpokP",
+	"dzU1ncdLakMPpbV0aaqygq4pqayvLEjIT26L7y1Mr++Gi3UwWs0u2QyDnoAqrSNA0IEDV0UXsuZSjVrN",
+	"J3dhnT3hmev+4PAv9wKOnqQQgY7eDYjwntF6zrUqEtHFc5zdBqC5XofnFV6vX3ByX+o3wLLL4QpDPBD8",
+	"IfiEY95waNEoLdV72Dt795q9ePHi/+x3QBfjGeHDNIgbi/z1hmwsJtqIJGiA6ACHf01wU0pcCVfxGS1u",
+	"B9S3B73f1WELTB8Aelvkx/jR+0d+ArwtOxCASe1AC9BddqA96P0u0Td3a/SSFNjMMbD82Lkcs3f2VoWE",
+	"rk2hQXbj/2cCnuWOXdLXlyC6a+XTSvWExUG7Z1wXqep0/507Nd4SgTAgeFfbxIy/7tWdLjdjbgSvj0CI",
+	"vf0dugmwJhnfBjKnd4PrnRQlxvNZbRwbLw/ZR5TNr3lZxQ5Sq8JfBxwwBCQiJUX1dmx52PyugPNGF2y6",
+	"XLo6VfchinNYGub03Xl1OErHAmHSxtI4/oU/9gGyoQ9SbXbyroOWMG+Ubwc9+VIW9hJFdOyZyC4L7vgl",
+	"xTh2AB8KvO+sRqVE2NpOcfQew5h7vPiR4pwfNB5lrc9ewi70vmmc+Xbup5YB6n3sEZuwO3V5DF7jSQFL",
+	"UyxCYEQO1pg9YmbnL3zc7v6akYm+fUdpcQ/hhawn2Mn9+MO9bn1qu99hPBoxmUfabcJNLHq6ycx4NIaT",
+	"fkDpIN0eekp/gTBtYNx/OT6uTWh4KxuuLMd4/HYLViGNjyKsvUrZUIGBhTT+0M3PvmJyguY44CxjbkND",
+	"E6qmmjGlY2FPelAcsgvIrEZAnq1Flsd8Ywq9xkJLnI2FdQdiMsGbh1tpD2PfuqHiRoBBAjPQeVmiI77Z",
+	"1dC3rwB2SLndoONfpnxPWBaScBYsrQ9xBlaneSRH/DoYXQ55fAmMUYFWH+d8vPGUFUh4XJVXfQ+Kj4be",
+	"cFT8G5bNq9LJRRkmAhMB++/TTwxMr+AR3aOyr1JN9zsoyA/18DQU++3fEwH9LhdtEKJyMpaKm0SU9zqx",
+	"AKrwyBOavpsYTsQoC4RSb/5/n37aSmSUBtvPvdkyCmRRN9UmMEhmpcoFOIq0VJS+K+cigygkYZ1PuWUT",
+	"aazLmNVDZZcqZ3kpYaHoFoXrXuWwB5yVOuclyyHPMLZ7NOJgIoLTHoLwHPzzkL0RjWgBiqjytnUv9F56",
+	"EC+ZFRAyyq1llwguanroJI+e2sh5L/PKWCgJAFHN3AmDfNr6ShP0EL5dWhC3ZUERopczDsWljLiEywMF",
+	"Txx6IfMrvNHg1vGIZ3NeCFLVbrgpbIq5B//Za/pkmxeNtizslq/v1G3ZOGSnvqY4uqP9ojA213XqCZIq",
+	"Bt7VFgC5JFJVos4f8dNjnYqFEddSV5aFI9BlJMJvtumE/aT8h5bd/R5uEt9fN0tyPbb4Huh0KyNB47s9",
+	"aiQzJvkJFtglduIjD33NKF9mdOmN/r4wcEZ2XXCWaEWc45B9oGf+nFNkNawhhDWitSgaGTzDYcPBSzYc",
+	"UM0nWVYm1Awp5GQiDGmiUrFCOJD/wKWkq0XM3HjFFsAyOMOfn9kAIPDZy7bjARkKOsil8/Lc1kyMZmXj",
+	"bxP8m6ylnKBGfI9WfS9hHTSl/H3d0bOdxhCKHtGDMa3UVmNnRB2IW3sMsDKwf4s0Baz7q31+ANScKLUR",
+	"JlS4gRSctQDeoI1AWggqB9iNgyldCMoN0LqR2piFfgFOL1gprkXJ9mprGdxuAe59Vqf4DZVPnqtji70n",
+	"g38ZYc9AVBZKMXEMxsCaftRTCpMYfMNkuA9Zo+3yK8IAgErrt0itStyIoLiPFsKMgkc7RCYNFbVBIB8+",
+	"uXjngIc61/6Qnbe7JqJBicLz6hiHjhCRn/0Wb7njQlNtFxvNxmBpv+V7gDY2WUV5l32xvVU7esc8NOVq",
+	"T0mEy9VBA3vHBz8c72/wMuF+pu1XL/p4lT7osHmRqLGmY2jy9cPB8+NOAFY3PQ3HX46/cQYWkIUvy56w",
+	"fSTO+Te+N+8WZFvbyZjnbnVAzlZ+GFqv4/4Ee8oqS6zzwP3rI1kwbq3OJW4HyV6cbvvxsvHWIfu/wsiJ",
+	"FI2SyXX/FfytkXIqKNPgcO1sf0brC6zg1MO75XBf1LBCGITTrMIhOoNoAsCDVR1yo8N2+3E6Ka325pxm",
+	"2Fc0IeHZdqEaCmbis73wIiJtbkV57e05V2LhOtkPTjKKI+9mw14/fj+mSlgQRgmXomgV6f9ejgvRUqQO",
+	"JN1eVkdA77Z8oBXzidOMM9dsVgbhRqWoo4x9+mDrnaGK13GlnK6wSg4ZHkP7IEjQ8XpZ6i6MPdkeyACz",
+	"1vPtAax37cIIm9qEoa10Uy3hrcWAG70oboQRG3dnkG2c4Y71fepuJs1VrS9hdcpEiYCkld9X234knRDo",
+	"ptOls37aDsbLg7o+9qZzR9mU8GXtBvRc1Pk8vPYuJrwAJH7TF0MFWRqWlfJKxN7e/lAj330ZJe4o8TFK",
+	"PImBu9RAWuvwHQJ2OFTbGQC7t/MfGlA+NB9YbXT5nfOD2Al8cjfGcMvD/b0d5vrMcX9+th5vr7kf5Vzl",
+	"otxwvDkcw8Y2wNHIqdFE2Yo65bbZGwe355JGF8XlUIVA0EKEK9iH0lOQdai7YQTzxeVS5+o1joefr2Ra",
+	"3//ZQmm3eCz/WEd9zwQh1u88soeMNqfVK0mbnrdNIEdsudJNjT4fsUl1Uy5VPVFnvyZt6tLqYAAhe8+t",
+	"CfEM4PyTDp8kHZ6ttO0h6miYqLdTYzUuZX70B/1/JIuvfeyVQfkGAsUP2embjHH2+fPpGyK+QgtMXzDi",
+	"WvCSrdQQEl8kmMYhw1U6NPZRyAMYLhUYBq0sSBjii0WzLh38VOccdBiqYaU/LT8hYKdv1vX3Lb4V+Nx/",
+	"XDy8i6UzRsab9h9FcYWEvLDJcYNvQUtHzXiAbel6oZFl7RuGxsuTZtvaeN02gUruf3DZfz57/92QQh1m",
+	"0O3geNPETWwm8B3l6OM+tnZ4JxozsfvQppI4B1Qd0FcB9jHRvnyZ991KEyVsn6fv95GytoYq+KBr6x4N",
+	"ClzfVHgBCyOYnC8MSMZZ1NJCZ+xoWhsquro1OeQlVudZQsarFeZa5gJyMnFuZnweGQf3RZx4zq/IQOfd",
+	"PfWjV+iFMXNhwi9eLPCLaXR5Bo4M7n2w+fP8ahiyAAN6gJl/OP3wFn8I8oI3vmFxmTiDL0aFf3g3S9sz",
+	"FCZvShbs19ptRJpyQ5GW4PrHJkk+WMG/skUzTosrrfZUD1bkJdly66sXWR6sfkeq9VanxhbPinw0xa2G",
+	"uHn82gdu65n3AU5dV8g5Pt5mmMEYIyVuSqmAPWC1Z1Gwv59//IXtaSWQ4EOhzYUwQPpiPxuq+lhPMXjn",
+	"XQx5vDHSOQHnAgz/dYsaX6oXIud8RrZUThiFDTogT1vMtVmyyoqhonCcSUlFRrgpSl9DZkViChadRB0P",
+	"WP3TTlj/trnbiJAmuW3J3/4zSfsJJ2mv52PXKdtrWckZnHFUJuoSDn9mRH+7jOg/E+T+TJC79wS53bSu",
+	"LweqWBeqbhFe/csbFA38baInTfngseIcz5tXG7eMYNwqP/3hrTpdURche8UbdkCEkc6yWHd8Teaokxlu",
+	"pVGDLp2OXiAIG7Fvvp1ZbG+gVWxxHfWRZ62QhiCgUUXdxvf+8pRlI8ChK2I45zbnxcNGOHgjD/WZeQwj",
+	"TyPNI5n11tMIePqmKdYg9eBYHYaZW1PM/2hDXHKDFlVig6j7hK8HPBeO+zY3K1FOsZPN3fbj/jXr9R47",
+	"39gNsJEWfBbJdxOAhOD2izmCW4JK0h1sU7ixDPrBuVCOvb0GSKLSpQ0zgpeYUlGXdIu1UQl9EGD+K7EM",
+	"uJb/Sjd2XTxY0JhoPYLPOxX3oYIJQ0YO+h1yDl6HXCssw3x+/rZbZ8aCdJ/qSqH3dJEhRtjEYOZap5oL",
+	"C09fKgNrRSNpnf4iFKXkyfsQjxLdZMQXdySu28TQ/cHaYTmPYhNRAG3pEz422eAvxy82IO6+6oA2Kjcq",
+	"7WL1xqSct3Z+ep7hOlp2e6ZcLGngo0UpG5nu8qxR3JO6Pe35GCNj3X62Zu7VJrpDOy7/kyZoT1UQaAHZ",
+	"dRG0kPyoLjrexmkPAvGYOnRfXK9MSlTXa9Wv1T0pdL9oR8dwtijB44Ff1sL54VBdQO+fELsw5/ZKFKOJ",
+	"FGVhWV5yOQ/WsNj9iU2FYz8ev9jg6/XOkwsy6zwUUSFLxGW9gjQzY4X7a+UmB/+xI2t8GxHpi8PNBEd7",
+	"3ss/QreqgzfSLjRFGKxvzUnEZzSKZawQRl43N2fdcOYreB462k0yom1Uvb9+H27F6BMVq6jtdRgWG1yJ",
+	"dY0PeK9ZUJXqHARTrm/VjeyR6iRTGtQ+YR0DJYBkiqGKbsZodHfYV0EVdRGIDNwEso6iUIJ02LGoOwFC",
+	"8pc/daKg4KAYVgqcvF3xAV1seiFF4bvYHFCIhfblS67EMhsqyoj2ubgoSVGHbK78MDQD4sKXp4wOwsYl",
+	"4hVzbIMTVWi6TWpE8pC9Dnr6WDSYiIidS9DvIlTRUcP8tV4sn6IOE+B6YqVXwtZ9L9oLoNGTSb+zfL/R",
+	"Jj1CS56sDPM/M5SkH5VELnuEvW+3V/VpcLb4rW/75yzVT4i/o6OB7IJYC6wZCEImyBtpsd6D44Gd0jqM",
+	"XtiQE7vazaFSTpa+iaYRkU1m0Mspn7G6OQUfqokRdlYDmuSbsG7A0Nvw1vdnlYv3ar0luJ2PZExFlNJO",
+	"igZSe5Cj9yFtyPe6MHI6DT3No1IIJm3/abhL93hReA0uNE7yKdprJPDRf/pkTbJNADe0MGo44O6hd8j3",
+	"azLwNALk0XRK9iNBz1B6UCCHGjgzo5Wuag0tBLy1RNjAlDDb6VdsNXB5w6X7qzMVVLEBaZlkCzYuNZaa",
+	"QSbXDGamTqOhElpUSJtyL6wCw1Z+PP4PX88GZhk5ORe6cpdMlHxhobhyY2A3E4oEcakqiooHeOrOP8n2",
+	"hvT9/Xq9fuU+7b8JnfYrb6y7KZQkO5Vy6e4Y63Eucq0KTCSF0UiNiTu2Yd6A6x79UV8cb+uOmigSUAjs",
+	"TFtE4cxTPW0b3IiVL82552fFRZx//vDh5Owfow8f37x93+WH9kONsJHSbu7xBmC+mUCjN44/sRsBPPn5",
+	"7S8Xm8HDYXoA9xi38Ke1g1qwvUgv+69qsSfU4AgtbKRrdMyKCQvAUHdtPNU/Ua/usrNrwEtHWp0fsE/+",
+	"XLvfrvnm/qP/ePhLqrHEQhZ4T3keBnKaVKzJKJCvbeC+q91Taewd9MDabd+3UFyrTkLMMgjRA77xty8M",
+	"h+bvzpowZ42QgacpUgcItxXmfUdnt3xEIzeA2N6FHerz4jpReqDQD2+8C4W8QtWElbgSp73BmnFsay4X",
+	"DkPl63gTaIocSIUbwQppKC2Al0jZhQbDGArgGE67WHaX1TopiuaWPDVD1gp4j2jPihhKNhGkZ9++rPBd",
+	"O5ICge5k4YrH8egPfyxG8HS0JcKrWVcnDEG23+bhOmQ/6VCRqBUitZphMfe5+Hcm2yx9ZgmcRpQ4OB+b",
+	"5WdaK99YR6dH8acfO1gH4MhXR34k8piHtPe4af2ohF7pQQ7geGgUVOrYauj7/M7o+VM0tV/w6eNlDHca",
+	"2gGvLdJ5hMQbsgDFHe6OIktenidF4ekD2QSxh9NCzBca8PaKnoUMOkRh2w3ki4L7KiIhTr5cEjQQz7Nk",
+	"HEu+aSXsYepmBDRe6D+pLpU4wacnRbEtTR23CHD8SER44s2RZNLYfMf5pJZbtfWkb0luD933tnX4jEk0",
+	"u6ZM0WzMt7R8gBypBTfo2w+pUo3ikBR+Q6B32Qzo81uUhXySOS5/NmG5O79AeundhsUfjMes5BzPZuQW",
+	"/pfezVhClaRk15Xw8AH7ruAUj6Uu0fq6648+je4ra0VD4x6v3AkwrC6vxda7oZEuyR3jzJbczmr2RSGM",
+	"etLk4HU9gKEyWmOtfTfzscR17jzWK2EeDuZmRlfTOuOhlNyCfchq8L/6diqGGQy7KpqJEdJZqP4aLqyc",
+	"qxj/wiagAnhTsjRDpUuCOJ3kjpAQyj6RctSnAjGM5+0cn4zGVKaj58fPf+y8SnDkrdrVt7FDbyNrX14Z",
+	"gf5uLABEUY0Y214nAqOyih0OhPXpT5WFf9PntZ2T2vnUDSV0KbBJscJC3uczbnzBtUDwVjN8bBnHqqyh",
+	"tGyDtmPZ8a4C2ucIRC2HPVxdp8ZE225Berd9Cd7fjdZC/Fz02mpnRD/OF1wqYZfgQ2adqXJXGXHIzuW4",
+	"pBJRa7XOh8oXO2eVwvoDl1Ybd8m4vbIk82JvBcxp7YrDxVEvANhtIjU2L/FsV9oVcbeWdaHSLy0C3zVa",
+	"u/sWeU995n2jvP4z6+253vmaV8bKa9HEQJcrVLrZKL5xF0/sR9gVDANqb9mrBhTYHdLCefZGvQBoKPTY",
+	"1TAxDdvAqzMh68T/SUXWfRtF+oOnuyfelfPHIrbbrwAksvXitl3XgvOvP4Ko83NdZtUZ0f+8H4kvC66K",
+	"TRWO6nPvybXBekPDCC/fRn6WhcokpCgrMVRIICi5NPsajStZFqzkZioQcMtK/rsMtht01hmusLJJ8KgM",
+	"1YxbRnDDpfE6dHVItFQg/x83Ztns8QBAoBxGkLArpW+sD3ALnRsQOBGnYZPKwLV2GLIGRqggw/00VPSS",
+	"ja0NMrQXiIL8dyEaXgGXifyj7lpBnJqqv8RF+oljQSJpmL5Rh+ytckZSTZHQTEH7Mwwpaj5G3L5CTbVh",
+	"sIjjUqy1EbxAfHlApYJ+MdDooVJYejlUwkvx4LcIWosNP4R+szrNI9nA1sHoMoJFQgynoiYeL1A+Cmug",
+	"BbTu6nCmerGJbTn953rifNvKRocZmJKXJcpqfh9sfXeUy0N2TqWZQt2yZkRX7RPyRzWM6k+lEVTXKUWd",
+	"vmJA0Pl2NObiZ95ftOXdt1+QEYRP7KBnMj6tpJWO//TVh5DB361KZz1bDzXy+IE2YkZKqwdP7BLVuGvw",
+	"k6b81SEZ3mHTH1WpfPTk/017u7EAAJZrkdbVAmRXFYB72aAHqwSwuyntG5LHN68HkEzv729KowRhMlj1",
+	"NB+YubdMhOZivrCKt4U1b4INnpYTP+dTZgMI49aoqJbR7xG7MLXh2MVW/gHLiXKyhyY38pCdNLgHzlFH",
+	"3/I5RGDDt5SNUvI8felD9FCN2KfHYNrwPaq1njCUiv5H3H9jJ+7dyBO8vk3q3JkxHf2B/9gW1HTu9MLW",
+	"9W+RIslUhCTtQ+k3cCcfyHQvJJr9kdy4rhCmsMAHiF2iiZ9C4NKtaCCoJTsYt2ubXcr+HNrvBLAPh+oT",
+	"hRBQJWdlmb4Wpvmt7/mM/fF97K/VFHoAbucl5Y5wKPCq0w6aKPe+Dst5SKXnCbqb47o3uCHjK48qW9dw",
+	"9KZR4kgHC+qtsYFSKe8hlvJNkude1L/38df49njpoPSirsqC1GvyLI6XpKbGzFJ/Y/+isbs4XMpejT3s",
+	"JkvSHD/5BTy2Qn7fxNdeXSr1GRGoFVRv5/njXJMevECFhQdpFyq0uVAF78MsqTZ2pL9G19dY6sdhUXrs",
+	"6ZpRDResSo1K/w3m7624SeBNtpfgvEawH/ZrU+qKBZhmwDLvamuTXb+d9UKfsv5Qw7lNiajfvKOr8f4U",
+	"iaKF5L4kuK3gQqjN3rqsY/1Szv779BODCDt5LShLlF1GdugzRcMlPlQrNOZt/kX0W9fkXfKlrjBjdmEE",
+	"1juBxgKXgnjRiGi2zkRVFDFG6fd18VF6rYwt2DynHapQOcFLDj8cHx/7T7Rhz9nP8icf/UqBcUmDqB/i",
+	"PkyiaadmFH5qtHW2c/UYv2udc4n6pB8sC03qdwWnvUv3HZp31/vod7m4c21hoHpf/AaOx7dV7B6lMlPQ",
+	"BSyc+P78BbtC9clkwBcbQQVBKLroam+stOsWk+oMl/cIwJOzXNymUdqPXb1oQ4vl76yrcqPBw2Yj+QYb",
+	"mL+JFgvBTczWrru2ch9i37YjwD+XrIRgC6kaDUIg088L5fPV3suEYWrb2mrr2ejCuqEHn8/D+59Ajd8X",
+	"Lb6vKRE7duxqip+Dd9X0M3ZQ7F6DaGQroOyQfQyR8fpGebcsSu9+ksMNIvYHD8dTFq8Jxp72+YDYxxar",
+	"5xGx/XnTzz60EnecokK0wW5DohFv2eQeqiANjywAlcLO7tJRPx/s92tjZCfW3mtY9wlCSGrmhf+DooBQ",
+	"O6WmY0FyTxkwXnnImp9ihCiFmeOL5CZDnW9eNzujF1ApDB9Li8QbS2r4BcJvpibwoaop3BcorIXz9WrP",
+	"8MZTdXI2gHtUHycdrtSBoichV898byXQEcG35ctHf8AR3J5dfa3Jo0afPbPJY7ruflf2XkhzvTQNbRmy",
+	"jy4PhF/YHcP7E9e4n/wxHRAesbvvur4W29r/x5CZWDqVol8O2Qd93QqT9zHxvoO4fw04HGdKH+jFYbqX",
+	"/hNlVDVsTzUW4/Eb1O9Ibj5gblOYL74AFON11Zq2plRLKcanJn0LbsbdUGGf0jCAj/HyqjGNFmurEcXG",
+	"0tFEsihFOD1UIZKWSoO5mfAvrCZK2Y6UJVjL9x0N5nfsO6owgvCuUE9/Cr1VIYlNzvZYSuKJcrnHTezv",
+	"JL8nWVDi1lGnlLVgnVS5owGp4hIVkIiG4qZ3Cl1PgdcNlapQxsC0AG1FcNLPtXW+VKA00GB+R48Cxrki",
+	"FHqzR+qCGlx9fzb7h+eegJpN+jmSMu5Ra4sfT1F3TYBuYUlcrZSSZn91OZM/Od/OnO/J1DDpd31KNT3A",
+	"DvG9zXrPyOoM6oOp1moNHrKT1mNGly733eCl8nKbg4StaHlCIY1+xqAQUuAbFXqyUJAT0z+9tUmbYHrB",
+	"2qOHjApwAAJivQ0LtiZeEqjIOHHsoeIOS/Bi5FRYAQJ8I5XdxFGlmp5VoVv7A9KYn6ePDTHuxb0mAdej",
+	"1kSEl0mfyhbNAQ7ZW7gSYW/BCjaDgifc0Q2I0W7wzoYCGB4TD14Fw8/ziMG1YaVb9vnpVMUIEK2TSJLJ",
+	"7NLetUVA5G+RLrqkKL7MOr4EzoAtfcUSzvfhhnyumpB2v9D8t2m1riNLK+7XU2idunG3OvJzXnt7fHs7",
+	"nq2w7w25OveJ8YfM2rnN0T9+lKP/nXb17MUrpHLCKF4eYf8pc5DzsoRSyxt6XvGyJA8MV1Twv1XpH4o0",
+	"vP74ywUUL/90cnb+9mz0+uT9+59OXv/n6PPZ+30SPDikiBgr2L/0OBbyJ6OTpzqUSSo3E8rBDtc+H/jC",
+	"QZs47P7ZSMH+lx6Hjic+5RTFdq2gJm+jAnWzS5cRtpp7ba9dZfoV42E9whhfhryuCB1zrRnlFteFMCyM",
+	"xJ0PIwmWr7qAvy+wk3OVC0CkqbD5FVYKtjk3RdrH/wlheR2252FOZ3uSnY7m8wcDoit1m56gL2Vxl+O5",
+	"80kD3Vq65eDlP39r3dHtU5DXWxXO3qk/bI3zRx18NvTShccxFKWievpVWR5AC7ssdgJCI+xsOTay8E2B",
+	"1v2c+PM7XyW7T1nDYFNIGRj+vZNnKOuYAd9LT+AfpYqR0Dob5UgAIb6lX0DIIAuv/ZZtBwczJDziVvT0",
+	"dH9eX7Nhx4IyXdPExHs9WUmg7gDA5nohRrcFoy7liJxswybA89HaTmytPgoffDclJM94jNHBoiM+Rst6",
+	"Y+ZMTrGEyOs2pGEJTdsjV0MV64veCDmdObZ3KYuX9O/LjHkaZs8Pj/cpXXZelU4uStnuI2ZzbUQ2VHhT",
+	"XL7I/vfLHw7/cknXQmrhY62tG921giYG5BJJSBd0d1TroWTLBQS/oXtywq3z+XR45ynqeDZUhc4rbDzq",
+	"4/Zfkfpww5eWMqk4C0c1nAKgfDlV6Ma6BGA3rBKhul1Bzs41R3ii+WIPo1OkarPT/brzLOwTQOQFCTKz",
+	"PLPRGG0bRmrOhngvGJ47OxzEsB+YjA0Hef2oc9Whdo0/7fjrHVv5KLlYCMcs9AaTCtvV8txhCalrXlYU",
+	"6Y4NQJ8fHzyH8HU0f5d8vhBFF0uiQUelUFM3S0P4/Pg4wreBP/2tiXikykP2RuR86Q+GjawLEu5sKEAd",
+	"zg2bcYjjHSrKapnxcnJQyonImOHqCkVikYf2uJbxMTguxL8rXpZLZkQprrlyjDYKi08P1Ufg2xpDJtgx",
+	"cO5CWmiz1U2rOEW+HMHsI5h9VPBl+2jGRkc1Ushx0RcnZwLzZogix8Jie/tCUn2HunqfVhM5rQyImsJj",
+	"AMpQFqJsdc6SzobV5yIgmkOxN/jnJXBA63zdCGc4oxFAynk1VGGQH4+PScBXup7NvyptA5ZNmIPP7kji",
+	"KXShJf6yvrOwF6OvhIWSZESZ4Te1kDVURFV7l4FXXO77FjVWKsGsnMuSg0DI9i6vRe60ufTMHT3rSps5",
+	"L0Gxg6+GalwKrC+EdlmP3Lq5SCHG1TQQqqU6oQf+LjFe06AKWNih93Ar2zD8ZkSbeUeUBosoo4yGQ3aZ",
+	"2+vLZuM1SoDVkwgot+z1+f9tOOZyXVZzoLUiozsmY1HECG3lR1SFlK5A5tlK9zoJmvTaBqh41HKi/zO3",
+	"1x1S4feUSEsidMNOnVEPcljdbi3HaaSwa+2W4/91QE8PXoMHdl1B+dvpRR3vEfYd6Z7yquqacP4s5jBO",
+	"xj6cnp/XDU9b2xd262+nF4NsAC+mduvr4xhiPa5Wmw3Rzw29jh7colo9fLhSqr5DoQOvQdrTvLVIPQiv",
+	"oTF8fDVjuk7Hv0Ph+u/pEF3wad/K57ij9+XscbRvO/t4IKDQ8WmH5+aCT71a/jAemws+fSRPDc0PPvIO",
+	"L/DT8M/Q1nSYWuHno3FVbrKt+o2uFiAL/HB8TOzAl6hwhivLc+qZ+guWJw9aS0ZKFLY55lZkjOMZx0sX",
+	"HbfBiTPjKFTAcIKbUgoTAi2QATUSjbxw6Du01IXHY2aA4+nu0YFU7APR4k9VeVVP8kgEuQrEloiWp0Kd",
+	"SEtIg9vJ9KD2GG5ugL6VWhukRIKirlyu5yhKogQOCkQoRnv65pBdpKO+YnsV2yLUUJV6ok0uLpm0Q2WF",
+	"ywCQ4A6wtbcyRjsCUIWgObqLUj4wIdeTPJIjbBWIbkL+JMwBMJUgJz4OLROsu9Byfwd46maNuNnZoYoh",
+	"Uz1d13CDPQGPdfL+2lokFIgCK4SmCsrcK+buVfDrkiQeu6Znxyb0ruaZomJ676578VDhALvKld+EDJ5E",
+	"7c7tAuVqyc4NUaiYeYkeSOct2Ht2qbRazvfJGwUSHdy9QVcn278NVSTBnnMjyhL+D593tuW7XbW8h6W0",
+	"KKw9ZjXHDnL7Tqs4At9fLd+3jUR7Fm8MmSNIslhelLJHUrwtpo7ciez+rNCYSubYtr/VItR3SvOdz/jc",
+	"eg8NcJnzFwcACndyjBVutKGG+av3FXyX7u2ZbuMR4nJufL+skD0u1St8eCWWWPAJy9FSDny77JR9MVoY",
+	"MZFf7ub038i+yNvLjTsCs/VBwR1vM4+FATw4SeQBC0oXwgBMetxnPUoMNYn0nzRsbVLVY/Cuf2tWSDu8",
+	"tcE8LfIRr2GqT9TuTkq/rh2Do4URVk7VwRjuze5D8bNQQOtUZJk+AZKkqT6fvUfNlHYFyTZoySHwjHqz",
+	"eCrLgv2GWopM5bVQh+wdBquF0jPko0EnvVrCDJbJCY1CrUXGgk09UOngM4KS1v0Tru6BAtBoIpzikUTC",
+	"Nggb1OG4c4jQiL9HbCaUIiYKTAw5Gat+iy2UvKGJXJqIgXphPl/20YOBbD+lHEYcfj57v43R/1KHXMTL",
+	"JLLAruAl/OedItU+nH54iyFSzbk7ZvT0N9oQu9akS5074Q58kbceUWpP8qp72FOIlNH7FD7ZQ9h14maC",
+	"l27WKw+MXmXWcVfZQIvgY5X5uvj0N3z59Uz4SOE7bFJbIqHp4V/iC58vSpQfrpISR0K6WPVLIvBAqrS4",
+	"5cbwWloTy/2iAj7pZ8Bn+9s/Bj8JboQ5qQDB//wNqBXQlWYuJ59OGT0dZIPKlIOXyA5RG/UzpUx2c674",
+	"VMyFcvXhuSA/YcfhTX3xLtZ4TYp6yU9kKTo/CFEvgSRs/Z33U3d86Ak29aEn20SkTWNbmFDFQkvlGh/S",
+	"81QVGi6VEwqjjVIznhRzqQap0GEkmwOnDzz5x1Drxtcx1Prrb1//3wA8GbUOQI0BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// adminRole is the role required for cross-user admin operations
+const adminRole = "admin"
+
+// maxProcessingStatusWindowMinutes caps window_minutes (one week)
+const maxProcessingStatusWindowMinutes = 7 * 24 * 60
+
+// StartReembed implements generated.StrictServerInterface
+func (h *StrictHandlers) StartReembed(
+	ctx context.Context,
+	request generated.StartReembedRequestObject,
+) (generated.StartReembedResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.StartReembed401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if !utils.HasRole(ctx, adminRole) {
+		return generated.StartReembed403JSONResponse{ForbiddenJSONResponse: forbidden("Admin role required")}, nil
+	}
+
+	job, err := h.reembedService.StartReembed(userID)
+	if err != nil {
+		if errors.Is(err, services.ErrReembedInProgress) {
+			return generated.StartReembed409JSONResponse{Error: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	return generated.StartReembed202JSONResponse(reembedJobToGenerated(job)), nil
+}
+
+// GetReembedStatus implements generated.StrictServerInterface
+func (h *StrictHandlers) GetReembedStatus(
+	ctx context.Context,
+	request generated.GetReembedStatusRequestObject,
+) (generated.GetReembedStatusResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetReembedStatus401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if !utils.HasRole(ctx, adminRole) {
+		return generated.GetReembedStatus403JSONResponse{ForbiddenJSONResponse: forbidden("Admin role required")}, nil
+	}
+
+	job := h.reembedService.GetReembedStatus(userID)
+	if job == nil {
+		return generated.GetReembedStatus404JSONResponse{NotFoundJSONResponse: notFound("No re-embedding job found")}, nil
+	}
+
+	return generated.GetReembedStatus200JSONResponse(reembedJobToGenerated(job)), nil
+}
+
+func reembedJobToGenerated(job *services.ReembedJob) generated.ReembedJob {
+	return generated.ReembedJob{
+		Status:      string(job.Status),
+		Model:       job.Model,
+		Dimensions:  job.Dimensions,
+		Total:       job.Total,
+		Processed:   job.Processed,
+		Failed:      job.Failed,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+	}
+}
+
+// ReassignOwnership implements generated.StrictServerInterface
+func (h *StrictHandlers) ReassignOwnership(
+	ctx context.Context,
+	request generated.ReassignOwnershipRequestObject,
+) (generated.ReassignOwnershipResponseObject, error) {
+	if _, err := getUserID(ctx); err != nil {
+		return generated.ReassignOwnership401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if !utils.HasRole(ctx, adminRole) {
+		return generated.ReassignOwnership403JSONResponse{ForbiddenJSONResponse: forbidden("Admin role required")}, nil
+	}
+
+	if request.Body == nil {
+		return generated.ReassignOwnership400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if request.Body.FromUser == "" || request.Body.ToUser == "" {
+		return generated.ReassignOwnership400JSONResponse{BadRequestJSONResponse: badRequest("from_user and to_user are required")}, nil
+	}
+	if len(request.Body.ResourceIds) == 0 {
+		return generated.ReassignOwnership400JSONResponse{BadRequestJSONResponse: badRequest("resource_ids is required")}, nil
+	}
+
+	resourceIDs := make([]uint, len(request.Body.ResourceIds))
+	for i, id := range request.Body.ResourceIds {
+		resourceIDs[i] = uint(id)
+	}
+
+	result, err := h.reassignService.Reassign(ctx, services.ReassignRequest{
+		FromUserID:   request.Body.FromUser,
+		ToUserID:     request.Body.ToUser,
+		ResourceType: services.ReassignResourceType(request.Body.ResourceType),
+		ResourceIDs:  resourceIDs,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReassignNotFound):
+			return generated.ReassignOwnership404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
+		case errors.Is(err, services.ErrReassignSameUser), errors.Is(err, services.ErrReassignInvalidType):
+			return generated.ReassignOwnership400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		return nil, err
+	}
+
+	return generated.ReassignOwnership200JSONResponse{
+		Folders:      result.Folders,
+		Files:        result.Files,
+		TagsCreated:  result.TagsCreated,
+		ObjectsMoved: result.ObjectsMoved,
+	}, nil
+}
+
+// GetProcessingStatus implements generated.StrictServerInterface
+func (h *StrictHandlers) GetProcessingStatus(
+	ctx context.Context,
+	request generated.GetProcessingStatusRequestObject,
+) (generated.GetProcessingStatusResponseObject, error) {
+	if _, err := getUserID(ctx); err != nil {
+		return generated.GetProcessingStatus401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if !utils.HasRole(ctx, adminRole) {
+		return generated.GetProcessingStatus403JSONResponse{ForbiddenJSONResponse: forbidden("Admin role required")}, nil
+	}
+
+	window := services.DefaultProcessingStatsWindow
+	if request.Params.WindowMinutes != nil {
+		minutes := *request.Params.WindowMinutes
+		if minutes < 1 || minutes > maxProcessingStatusWindowMinutes {
+			return generated.GetProcessingStatus400JSONResponse{BadRequestJSONResponse: badRequest(
+				fmt.Sprintf("window_minutes must be between 1 and %d", maxProcessingStatusWindowMinutes))}, nil
+		}
+		window = time.Duration(minutes) * time.Minute
+	}
+
+	stats, err := h.statsService.ProcessingStats(window)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(stats.Counts))
+	for status, count := range stats.Counts {
+		counts[string(status)] = int(count)
+	}
+	gate, err := h.processingGate.State()
+	if err != nil {
+		return nil, err
+	}
+	resp := generated.GetProcessingStatus200JSONResponse{
+		Counts:        counts,
+		Processing:    int(stats.Processing),
+		WindowMinutes: int(stats.Window / time.Minute),
+		Completed:     int(stats.CompletedRecent),
+		Failed:        int(stats.FailedRecent),
+		Paused:        gate.Paused,
+		Waiting:       gate.Waiting,
+	}
+	if stats.AverageDuration != nil {
+		resp.AverageDurationSeconds = ptr(stats.AverageDuration.Seconds())
+	}
+	return resp, nil
+}
+
+// PauseProcessing implements generated.StrictServerInterface
+func (h *StrictHandlers) PauseProcessing(
+	ctx context.Context,
+	request generated.PauseProcessingRequestObject,
+) (generated.PauseProcessingResponseObject, error) {
+	if _, err := getUserID(ctx); err != nil {
+		return generated.PauseProcessing401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if !utils.HasRole(ctx, adminRole) {
+		return generated.PauseProcessing403JSONResponse{ForbiddenJSONResponse: forbidden("Admin role required")}, nil
+	}
+
+	state, err := h.processingGate.Pause()
+	if err != nil {
+		return nil, err
+	}
+	log.Println("[Admin] Processing paused")
+	return generated.PauseProcessing200JSONResponse(processingPauseStateToGenerated(state)), nil
+}
+
+// ResumeProcessing implements generated.StrictServerInterface
+func (h *StrictHandlers) ResumeProcessing(
+	ctx context.Context,
+	request generated.ResumeProcessingRequestObject,
+) (generated.ResumeProcessingResponseObject, error) {
+	if _, err := getUserID(ctx); err != nil {
+		return generated.ResumeProcessing401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if !utils.HasRole(ctx, adminRole) {
+		return generated.ResumeProcessing403JSONResponse{ForbiddenJSONResponse: forbidden("Admin role required")}, nil
+	}
+
+	state, err := h.processingGate.Resume()
+	if err != nil {
+		return nil, err
+	}
+	log.Println("[Admin] Processing resumed")
+	return generated.ResumeProcessing200JSONResponse(processingPauseStateToGenerated(state)), nil
+}
+
+func processingPauseStateToGenerated(state services.ProcessingGateState) generated.ProcessingPauseState {
+	return generated.ProcessingPauseState{
+		Paused:  state.Paused,
+		Waiting: state.Waiting,
+	}
+}
@@ -3,7 +3,6 @@ package handlers
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -48,6 +47,11 @@ func (h *AgentHandlers) StreamAgentProgress(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid file ID"})
 	}
 
+	format, ok := parseEventStreamFormat(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be sse or ndjson"})
+	}
+
 	// Check if agent is enabled
 	if h.agentService == nil || !h.agentService.IsEnabled() {
 		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "AI agent is not enabled"})
@@ -59,12 +63,8 @@ func (h *AgentHandlers) StreamAgentProgress(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "File not found"})
 	}
 
-	// Set SSE headers
-	c.Set("Content-Type", "text/event-stream")
-	c.Set("Cache-Control", "no-cache")
-	c.Set("Connection", "keep-alive")
-	c.Set("Transfer-Encoding", "chunked")
-	c.Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	// Set streaming headers
+	format.setHeaders(c)
 
 	// Create context with timeout
 	// NOTE: Don't defer cancel() here - it must be called inside SetBodyStreamWriter
@@ -89,60 +89,60 @@ func (h *AgentHandlers) StreamAgentProgress(c *fiber.Ctx) error {
 
 	// Stream events to client
 	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
-		defer cancel() // Cancel context when streaming ends
+		defer cancel() // Cancel context when streaming ends, which also stops the agent
+
+		heartbeat := time.NewTicker(streamHeartbeatInterval)
+		defer heartbeat.Stop()
 
 		// Send initial connection event
-		data, _ := json.Marshal(services.AgentEvent{
+		format.write(w, services.AgentEvent{
 			Type:    "connected",
 			Message: "Connected to agent stream",
 			FileID:  uint(fileID),
 		})
-		fmt.Fprintf(w, "data: %s\n\n", data)
-		w.Flush()
 
 		for {
 			select {
 			case event, ok := <-eventChan:
 				if !ok {
 					// Channel closed, send done event
-					data, _ := json.Marshal(services.AgentEvent{
+					format.write(w, services.AgentEvent{
 						Type:    "done",
 						Message: "Agent processing complete",
 						FileID:  uint(fileID),
 					})
-					fmt.Fprintf(w, "data: %s\n\n", data)
-					w.Flush()
 					return
 				}
-				data, err := json.Marshal(event)
-				if err != nil {
-					continue
+				if err := format.write(w, event); err != nil {
+					// Client disconnected
+					return
 				}
-				fmt.Fprintf(w, "data: %s\n\n", data)
-				w.Flush()
 
 				// If this is a result or error, we're done
 				if event.Type == "result" || event.Type == "error" {
 					// Send done event
-					doneData, _ := json.Marshal(services.AgentEvent{
+					format.write(w, services.AgentEvent{
 						Type:    "done",
 						Message: "Stream complete",
 						FileID:  uint(fileID),
 					})
-					fmt.Fprintf(w, "data: %s\n\n", doneData)
-					w.Flush()
+					return
+				}
+
+			case <-heartbeat.C:
+				if err := format.heartbeat(w); err != nil {
+					// Client disconnected; stop the agent instead of running
+					// turns nobody will see
 					return
 				}
 
 			case <-ctx.Done():
 				// Context cancelled/timeout
-				data, _ := json.Marshal(services.AgentEvent{
+				format.write(w, services.AgentEvent{
 					Type:    "error",
 					Message: "Request timeout",
 					FileID:  uint(fileID),
 				})
-				fmt.Fprintf(w, "data: %s\n\n", data)
-				w.Flush()
 				return
 			}
 		}
@@ -235,7 +235,7 @@ func (h *AgentHandlers) TriggerFolderOrganize(c *fiber.Ctx) error {
 }
 
 // StreamFolderAgentProgress handles SSE streaming of folder agent progress
-// GET /api/folders/:id/agent-stream
+// GET /api/folders/:id/agent-stream?include_subfolders=&exclude_folder_ids=
 func (h *AgentHandlers) StreamFolderAgentProgress(c *fiber.Ctx) error {
 	// Get authenticated user
 	user := c.Locals(middleware.AuthenticatedUserContextKey)
@@ -252,6 +252,11 @@ func (h *AgentHandlers) StreamFolderAgentProgress(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid folder ID"})
 	}
 
+	format, ok := parseEventStreamFormat(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be sse or ndjson"})
+	}
+
 	// Check if agent is enabled
 	if h.agentService == nil || !h.agentService.IsEnabled() {
 		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "AI agent is not enabled"})
@@ -263,12 +268,8 @@ func (h *AgentHandlers) StreamFolderAgentProgress(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Folder not found"})
 	}
 
-	// Set SSE headers
-	c.Set("Content-Type", "text/event-stream")
-	c.Set("Cache-Control", "no-cache")
-	c.Set("Connection", "keep-alive")
-	c.Set("Transfer-Encoding", "chunked")
-	c.Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	// Set streaming headers
+	format.setHeaders(c)
 
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
@@ -276,10 +277,14 @@ func (h *AgentHandlers) StreamFolderAgentProgress(c *fiber.Ctx) error {
 	// Create event channel
 	eventChan := make(chan services.AgentEvent, 100)
 
-	// Run agent in goroutine (don't include subfolders by default)
+	// Subfolders are not included by default
+	includeSubfolders := c.QueryBool("include_subfolders", false)
+	excludeFolderIDs := parseIDList(c.Query("exclude_folder_ids"))
+
+	// Run agent in goroutine
 	go func() {
 		defer close(eventChan)
-		err := h.agentService.OrganizeFolder(ctx, userID, uint(folderID), false, eventChan)
+		err := h.agentService.OrganizeFolder(ctx, userID, uint(folderID), includeSubfolders, excludeFolderIDs, eventChan)
 		if err != nil {
 			eventChan <- services.AgentEvent{
 				Type:     "error",
@@ -291,60 +296,60 @@ func (h *AgentHandlers) StreamFolderAgentProgress(c *fiber.Ctx) error {
 
 	// Stream events to client
 	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
-		defer cancel() // Cancel context when streaming ends
+		defer cancel() // Cancel context when streaming ends, which also stops the agent
+
+		heartbeat := time.NewTicker(streamHeartbeatInterval)
+		defer heartbeat.Stop()
 
 		// Send initial connection event
-		data, _ := json.Marshal(services.AgentEvent{
+		format.write(w, services.AgentEvent{
 			Type:     "connected",
 			Message:  "Connected to folder agent stream",
 			FolderID: uint(folderID),
 		})
-		fmt.Fprintf(w, "data: %s\n\n", data)
-		w.Flush()
 
 		for {
 			select {
 			case event, ok := <-eventChan:
 				if !ok {
 					// Channel closed, send done event
-					data, _ := json.Marshal(services.AgentEvent{
+					format.write(w, services.AgentEvent{
 						Type:     "done",
 						Message:  "Folder agent processing complete",
 						FolderID: uint(folderID),
 					})
-					fmt.Fprintf(w, "data: %s\n\n", data)
-					w.Flush()
 					return
 				}
-				data, err := json.Marshal(event)
-				if err != nil {
-					continue
+				if err := format.write(w, event); err != nil {
+					// Client disconnected
+					return
 				}
-				fmt.Fprintf(w, "data: %s\n\n", data)
-				w.Flush()
 
 				// If this is a result or error, we're done
 				if event.Type == "result" || event.Type == "error" {
 					// Send done event
-					doneData, _ := json.Marshal(services.AgentEvent{
+					format.write(w, services.AgentEvent{
 						Type:     "done",
 						Message:  "Stream complete",
 						FolderID: uint(folderID),
 					})
-					fmt.Fprintf(w, "data: %s\n\n", doneData)
-					w.Flush()
+					return
+				}
+
+			case <-heartbeat.C:
+				if err := format.heartbeat(w); err != nil {
+					// Client disconnected; stop the agent instead of running
+					// turns nobody will see
 					return
 				}
 
 			case <-ctx.Done():
 				// Context cancelled/timeout
-				data, _ := json.Marshal(services.AgentEvent{
+				format.write(w, services.AgentEvent{
 					Type:     "error",
 					Message:  "Request timeout",
 					FolderID: uint(folderID),
 				})
-				fmt.Fprintf(w, "data: %s\n\n", data)
-				w.Flush()
 				return
 			}
 		}
@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // Tag converters
@@ -22,6 +25,49 @@ func tagModelToGenerated(tag *models.Tag) generated.Tag {
 	if tag.Description != "" {
 		result.Description = &tag.Description
 	}
+	if len(tag.Aliases) > 0 {
+		aliases := make([]generated.TagAlias, len(tag.Aliases))
+		for i, alias := range tag.Aliases {
+			aliases[i] = generated.TagAlias{
+				Id:    int(alias.ID),
+				Alias: alias.Alias,
+			}
+		}
+		result.Aliases = &aliases
+	}
+	return result
+}
+
+func foldingRuleModelToGenerated(rule *models.FoldingRule) generated.FoldingRule {
+	return generated.FoldingRule{
+		Id:             int(rule.ID),
+		TagId:          int(rule.TagID),
+		TargetFolderId: int(rule.TargetFolderID),
+		CreatedAt:      rule.CreatedAt,
+		UpdatedAt:      rule.UpdatedAt,
+	}
+}
+
+func fileRelationModelToGenerated(ctx context.Context, relation *models.FileRelation) generated.FileRelation {
+	result := generated.FileRelation{
+		Id:            int(relation.ID),
+		FileId:        int(relation.FileID),
+		RelatedFileId: int(relation.RelatedFileID),
+		RelationType:  relation.RelationType,
+		CreatedAt:     relation.CreatedAt,
+	}
+	if relation.RelatedFile != nil {
+		relatedFile := fileModelToGenerated(ctx, relation.RelatedFile)
+		result.RelatedFile = &relatedFile
+	}
+	return result
+}
+
+func fileRelationListToGenerated(ctx context.Context, relations []models.FileRelation) []generated.FileRelation {
+	result := make([]generated.FileRelation, len(relations))
+	for i := range relations {
+		result[i] = fileRelationModelToGenerated(ctx, &relations[i])
+	}
 	return result
 }
 
@@ -33,6 +79,19 @@ func tagListToGenerated(tags []models.Tag) []generated.Tag {
 	return result
 }
 
+func tagDeleteResultsToGenerated(results []services.TagDeleteResult) []generated.TagDeleteResult {
+	converted := make([]generated.TagDeleteResult, len(results))
+	for i, result := range results {
+		converted[i] = generated.TagDeleteResult{
+			TagId:       int(result.TagID),
+			Status:      generated.TagDeleteResultStatus(result.Status),
+			FileCount:   int(result.FileCount),
+			FolderCount: int(result.FolderCount),
+		}
+	}
+	return converted
+}
+
 // Folder converters
 
 func folderModelToGenerated(folder *models.Folder) generated.Folder {
@@ -53,6 +112,10 @@ func folderModelToGenerated(folder *models.Folder) generated.Folder {
 		result.Description = &folder.Description
 	}
 
+	if folder.S3Prefix != "" {
+		result.S3Prefix = &folder.S3Prefix
+	}
+
 	if len(folder.Children) > 0 {
 		childList := folderListToGenerated(folder.Children)
 		result.Children = &childList
@@ -105,18 +168,24 @@ func folderListToTreeGenerated(folders []models.Folder) []generated.FolderTree {
 
 // File converters
 
-func fileModelToGenerated(file *models.File) generated.File {
+// fileModelToGenerated converts a file for a response, leaving out the
+// content and summary when the caller's token masks them
+func fileModelToGenerated(ctx context.Context, file *models.File) generated.File {
 	result := generated.File{
-		Id:               int(file.ID),
-		UserId:           file.UserID,
-		Title:            file.Title,
-		S3Key:            file.S3Key,
-		OriginalFilename: file.OriginalFilename,
-		FileType:         generated.FileType(file.FileType),
-		ProcessingStatus: generated.ProcessingStatus(file.ProcessingStatus),
-		HasEmbedding:     file.HasEmbedding,
-		CreatedAt:        file.CreatedAt,
-		UpdatedAt:        file.UpdatedAt,
+		Id:                int(file.ID),
+		PublicId:          file.PublicID,
+		UserId:            file.UserID,
+		Title:             file.Title,
+		S3Key:             file.S3Key,
+		OriginalFilename:  file.OriginalFilename,
+		FileType:          generated.FileType(file.FileType),
+		ProcessingStatus:  generated.ProcessingStatus(file.ProcessingStatus),
+		HasEmbedding:      file.HasEmbedding,
+		SummaryIsFallback: file.SummaryIsFallback,
+		WordCount:         file.WordCount,
+		CharCount:         file.CharCount,
+		CreatedAt:         file.CreatedAt,
+		UpdatedAt:         file.UpdatedAt,
 	}
 
 	if file.FolderID != nil {
@@ -134,17 +203,27 @@ func fileModelToGenerated(file *models.File) generated.File {
 		result.Tags = &tagList
 	}
 
-	if file.Summary != "" {
+	if file.Summary != "" && !utils.IsFieldMasked(ctx, utils.MaskFieldSummary) {
 		result.Summary = &file.Summary
 	}
 
-	if file.Content != "" {
+	if file.Content != "" && !utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
 		result.Content = &file.Content
 	}
 
+	if file.ProcessingHint != "" {
+		result.ProcessingHint = &file.ProcessingHint
+	}
+
 	if file.MimeType != "" {
 		result.MimeType = &file.MimeType
 	}
+	if file.DeclaredMimeType != "" {
+		result.DeclaredMimeType = &file.DeclaredMimeType
+	}
+	if file.DetectedMimeType != "" {
+		result.DetectedMimeType = &file.DetectedMimeType
+	}
 
 	if file.Size > 0 {
 		result.Size = &file.Size
@@ -153,37 +232,75 @@ func fileModelToGenerated(file *models.File) generated.File {
 	if file.ProcessingError != "" {
 		result.ProcessingError = &file.ProcessingError
 	}
+	if file.ProcessingErrorCode != "" {
+		result.ProcessingErrorCode = &file.ProcessingErrorCode
+	}
+	result.ProcessingStartedAt = file.ProcessingStartedAt
+	result.ProcessingEndedAt = file.ProcessingEndedAt
+
+	if file.Entities != nil {
+		result.Entities = fileEntitiesToGenerated(file.Entities)
+	}
 
 	if file.InvoiceID != nil {
 		invoiceID := int(*file.InvoiceID)
 		result.InvoiceId = &invoiceID
 	}
+	if file.RelatedFiles != nil {
+		relatedFiles := fileRelationListToGenerated(ctx, file.RelatedFiles)
+		result.RelatedFiles = &relatedFiles
+	}
+
+	return result
+}
 
+// fileEntitiesToGenerated converts extracted entities, using empty lists for
+// types with none
+func fileEntitiesToGenerated(entities *models.FileEntities) *generated.FileEntities {
+	result := &generated.FileEntities{
+		People:        append([]string{}, entities.People...),
+		Organizations: append([]string{}, entities.Organizations...),
+		Dates:         append([]string{}, entities.Dates...),
+		Amounts:       make([]generated.EntityAmount, len(entities.Amounts)),
+	}
+	for i, amount := range entities.Amounts {
+		result.Amounts[i] = generated.EntityAmount{Value: amount.Value}
+		if amount.Currency != "" {
+			result.Amounts[i].Currency = ptr(amount.Currency)
+		}
+	}
 	return result
 }
 
-func fileListToGenerated(files []models.File) []generated.File {
+func fileListToGenerated(ctx context.Context, files []models.File) []generated.File {
 	result := make([]generated.File, len(files))
 	for i, file := range files {
-		result[i] = fileModelToGenerated(&file)
+		result[i] = fileModelToGenerated(ctx, &file)
 	}
 	return result
 }
 
 // Search result converters
 
-func searchResultToGenerated(result *services.SearchResult) generated.SearchResult {
+func searchResultToGenerated(ctx context.Context, result *services.SearchResult) generated.SearchResult {
 	genResult := generated.SearchResult{
-		File:  fileModelToGenerated(&result.File),
+		File:  fileModelToGenerated(ctx, &result.File),
 		Score: result.Score,
 	}
-	if result.Snippet != "" {
+	// Snippets are excerpts of the content, so they are masked with it
+	if result.Snippet != "" && !utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
 		genResult.Snippet = &result.Snippet
 	}
+	if result.Components != nil {
+		genResult.Components = &result.Components
+	}
+	if result.MatchedBy != nil {
+		genResult.MatchedBy = &result.MatchedBy
+	}
 	return genResult
 }
 
-func searchResultListToGenerated(results []services.SearchResult) []generated.SearchResult {
+func searchResultListToGenerated(ctx context.Context, results []services.SearchResult) []generated.SearchResult {
 	// Deduplicate by file ID, keeping the first occurrence (highest score due to sorting)
 	seen := make(map[uint]bool)
 	genResults := make([]generated.SearchResult, 0, len(results))
@@ -192,7 +309,64 @@ func searchResultListToGenerated(results []services.SearchResult) []generated.Se
 			continue
 		}
 		seen[r.File.ID] = true
-		genResults = append(genResults, searchResultToGenerated(&r))
+		genResults = append(genResults, searchResultToGenerated(ctx, &r))
 	}
 	return genResults
 }
+
+func folderMemberToGenerated(member *models.FolderMember) generated.FolderMember {
+	return generated.FolderMember{
+		Id:        int(member.ID),
+		FolderId:  int(member.FolderID),
+		UserId:    member.UserID,
+		Role:      generated.FolderRole(member.Role),
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	}
+}
+
+func folderAliasToGenerated(alias *models.FolderAlias) generated.FolderAlias {
+	return generated.FolderAlias{
+		Id:        int(alias.ID),
+		FolderId:  int(alias.FolderID),
+		Path:      alias.Path,
+		CreatedAt: alias.CreatedAt,
+	}
+}
+
+func folderAliasListToGenerated(aliases []models.FolderAlias) []generated.FolderAlias {
+	result := make([]generated.FolderAlias, len(aliases))
+	for i := range aliases {
+		result[i] = folderAliasToGenerated(&aliases[i])
+	}
+	return result
+}
+
+// fileFilterToOptions converts a request filter to list options matching the
+// same files as the equivalent list query
+func fileFilterToOptions(filter generated.FileFilter) services.FileListOptions {
+	opts := services.FileListOptions{
+		Keyword:       deref(filter.Keyword),
+		AllFolders:    filter.AllFolders != nil && *filter.AllFolders,
+		IncludeLinked: filter.IncludeLinked != nil && *filter.IncludeLinked,
+	}
+	if filter.FolderId != nil {
+		folderID := uint(*filter.FolderId)
+		opts.FolderID = &folderID
+	}
+	if filter.FileTypes != nil {
+		for _, ft := range *filter.FileTypes {
+			opts.FileTypes = append(opts.FileTypes, models.FileType(ft))
+		}
+	}
+	if filter.TagIds != nil {
+		for _, id := range *filter.TagIds {
+			opts.TagIDs = append(opts.TagIDs, uint(id))
+		}
+	}
+	if filter.Status != nil {
+		status := models.FileProcessingStatus(*filter.Status)
+		opts.Status = &status
+	}
+	return opts
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"gorm.io/gorm"
+)
+
+// internalErrorMessage is all clients see of unexpected errors; the detail is
+// only logged
+const internalErrorMessage = "Internal server error"
+
+// MapErrors is a strict middleware that turns errors returned by handlers into
+// HTTP error responses, so database failures don't leak to clients. The
+// response is written here because the generated wrapper would send any
+// returned error as a 400 with its raw message.
+func MapErrors(f generated.StrictHandlerFunc, operationID string) generated.StrictHandlerFunc {
+	return func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		response, err := f(ctx, request)
+		if err != nil {
+			mapped := mapHandlerError(operationID, err)
+			return nil, ctx.Status(mapped.Code).JSON(generated.Error{Error: mapped.Message})
+		}
+		return response, nil
+	}
+}
+
+// mapHandlerError maps a record-not-found error to 404 and invalid data to
+// 400. Anything else becomes a 500 with a generic message.
+func mapHandlerError(operationID string, err error) *fiber.Error {
+	var fiberErr *fiber.Error
+	switch {
+	case errors.As(err, &fiberErr):
+		return fiberErr
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Resource not found")
+	case errors.Is(err, gorm.ErrInvalidData),
+		errors.Is(err, gorm.ErrInvalidValue),
+		errors.Is(err, gorm.ErrInvalidField),
+		errors.Is(err, gorm.ErrPrimaryKeyRequired):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Internal error in %s: %v", operationID, err)
+		return fiber.NewError(fiber.StatusInternalServerError, internalErrorMessage)
+	}
+}
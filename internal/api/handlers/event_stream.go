@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// streamHeartbeatInterval is how often an idle stream is written to, which
+// keeps proxies from closing it and reveals clients that went away
+const streamHeartbeatInterval = 15 * time.Second
+
+// eventStreamFormat is the framing used for progress event streams. The events
+// are the same either way; NDJSON serves clients that can't consume SSE.
+type eventStreamFormat string
+
+const (
+	eventStreamSSE    eventStreamFormat = "sse"
+	eventStreamNDJSON eventStreamFormat = "ndjson"
+)
+
+// parseEventStreamFormat reads ?format=sse|ndjson, defaulting to SSE. Returns
+// false for any other value.
+func parseEventStreamFormat(c *fiber.Ctx) (eventStreamFormat, bool) {
+	switch format := eventStreamFormat(c.Query("format", string(eventStreamSSE))); format {
+	case eventStreamSSE, eventStreamNDJSON:
+		return format, true
+	default:
+		return "", false
+	}
+}
+
+// setHeaders prepares the response for streaming events in this format
+func (f eventStreamFormat) setHeaders(c *fiber.Ctx) {
+	if f == eventStreamNDJSON {
+		c.Set("Content-Type", "application/x-ndjson")
+	} else {
+		c.Set("Content-Type", "text/event-stream")
+		c.Set("Connection", "keep-alive")
+	}
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Transfer-Encoding", "chunked")
+	c.Set("X-Accel-Buffering", "no") // Disable nginx buffering
+}
+
+// write sends a single event and flushes it to the client. The error is set
+// when the client disconnected.
+func (f eventStreamFormat) write(w *bufio.Writer, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil
+	}
+	if f == eventStreamNDJSON {
+		fmt.Fprintf(w, "%s\n", data)
+	} else {
+		fmt.Fprintf(w, "data: %s\n\n", data)
+	}
+	return w.Flush()
+}
+
+// heartbeat writes a keep-alive: an SSE comment, or a heartbeat event for
+// NDJSON. The error is set when the client disconnected.
+func (f eventStreamFormat) heartbeat(w *bufio.Writer) error {
+	if f == eventStreamNDJSON {
+		fmt.Fprint(w, `{"type":"heartbeat"}`+"\n")
+	} else {
+		fmt.Fprint(w, ": heartbeat\n\n")
+	}
+	return w.Flush()
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// changeCursor is a position in the file change feed: the change time and ID
+// of the last file a client has seen
+type changeCursor struct {
+	ChangedAt time.Time
+	FileID    uint
+}
+
+// encode returns the opaque form of the cursor handed to clients
+func (c changeCursor) encode() string {
+	raw := fmt.Sprintf("%d:%d", c.ChangedAt.UnixNano(), c.FileID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// parseChangeCursor decodes a cursor returned by encode
+func parseChangeCursor(s string) (changeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return changeCursor{}, err
+	}
+
+	var nanos int64
+	var fileID uint
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &nanos, &fileID); err != nil {
+		return changeCursor{}, err
+	}
+	return changeCursor{ChangedAt: time.Unix(0, nanos), FileID: fileID}, nil
+}
+
+// fileChangedAt returns when the file last changed, matching the order of
+// FileService.ListChangedSince
+func fileChangedAt(file *models.File) time.Time {
+	if file.DeletedAt.Valid {
+		return file.DeletedAt.Time
+	}
+	return file.UpdatedAt
+}
+
+// ListFileChanges implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFileChanges(
+	ctx context.Context,
+	request generated.ListFileChangesRequestObject,
+) (generated.ListFileChangesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFileChanges401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	var cursor changeCursor
+	switch {
+	case request.Params.Cursor != nil:
+		cursor, err = parseChangeCursor(*request.Params.Cursor)
+		if err != nil {
+			return generated.ListFileChanges400JSONResponse{BadRequestJSONResponse: badRequest("Invalid cursor")}, nil
+		}
+	case request.Params.Since != nil:
+		cursor.ChangedAt = *request.Params.Since
+	default:
+		return generated.ListFileChanges400JSONResponse{BadRequestJSONResponse: badRequest("since or cursor is required")}, nil
+	}
+
+	// Fetch one extra file to tell whether there are more
+	limit := h.pagination.Files.limit(request.Params.Limit)
+	files, err := h.fileService.ListChangedSince(userID, cursor.ChangedAt, cursor.FileID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(files) > limit
+	if hasMore {
+		files = files[:limit]
+	}
+
+	data := make([]generated.FileChange, len(files))
+	for i := range files {
+		file := &files[i]
+		changedAt := fileChangedAt(file)
+		data[i] = generated.FileChange{
+			File:      fileModelToGenerated(ctx, file),
+			Deleted:   file.DeletedAt.Valid,
+			ChangedAt: changedAt,
+		}
+		cursor = changeCursor{ChangedAt: changedAt, FileID: file.ID}
+	}
+
+	return generated.ListFileChanges200JSONResponse{
+		Data:    data,
+		Cursor:  cursor.encode(),
+		HasMore: hasMore,
+	}, nil
+}
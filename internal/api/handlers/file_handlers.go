@@ -3,11 +3,14 @@ package handlers
 import (
 	"archive/zip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"net/http"
-	"strconv"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -28,15 +31,37 @@ func (h *StrictHandlers) ListFiles(
 	}
 
 	opts := services.FileListOptions{
-		Keyword: deref(request.Params.Keyword),
-		Limit:   derefInt(request.Params.Limit, 100),
-		Offset:  derefInt(request.Params.Offset, 0),
-	}
-
-	// Handle folder_id
+		Keyword:           deref(request.Params.Keyword),
+		IncludeFolderName: deref(request.Params.IncludeFolderName),
+		ErrorContains:     deref(request.Params.ErrorContains),
+		MinWordCount:      request.Params.MinWordCount,
+		MaxWordCount:      request.Params.MaxWordCount,
+		MinSize:           request.Params.MinSize,
+		MaxSize:           request.Params.MaxSize,
+		CreatedAfter:      request.Params.CreatedAfter,
+		CreatedBefore:     request.Params.CreatedBefore,
+		UpdatedAfter:      request.Params.UpdatedAfter,
+		UpdatedBefore:     request.Params.UpdatedBefore,
+		Entity:            deref(request.Params.Entity),
+		EntityDateFrom:    deref(request.Params.EntityDateFrom),
+		EntityDateTo:      deref(request.Params.EntityDateTo),
+		Limit:             h.pagination.Files.limit(request.Params.Limit),
+		Offset:            derefInt(request.Params.Offset, 0),
+	}
+
+	// Handle folder_id, listing a shared folder as its owner
+	ownerID := userID
 	if request.Params.FolderId != nil {
 		folderID := uint(*request.Params.FolderId)
 		opts.FolderID = &folderID
+
+		folderOwner, err := h.folderOwner(userID, folderID, false)
+		if err != nil {
+			return nil, err
+		}
+		if folderOwner != "" {
+			ownerID = folderOwner
+		}
 	}
 
 	// Handle all_folders
@@ -44,12 +69,42 @@ func (h *StrictHandlers) ListFiles(
 		opts.AllFolders = true
 	}
 
+	// Handle include_linked
+	if request.Params.IncludeLinked != nil && *request.Params.IncludeLinked {
+		opts.IncludeLinked = true
+	}
+
+	// A share only covers the folder's subtree: listing all of the owner's
+	// folders, or files linked in from outside it, would expose the rest of
+	// the owner's files
+	if ownerID != userID && (opts.AllFolders || opts.IncludeLinked) {
+		var errs fieldErrors
+		if opts.AllFolders {
+			errs.add("all_folders", "all_folders can't be used with a folder shared with you")
+		}
+		if opts.IncludeLinked {
+			errs.add("include_linked", "include_linked can't be used with a folder shared with you")
+		}
+		return generated.ListFiles400JSONResponse{BadRequestJSONResponse: *errs.response()}, nil
+	}
+
 	// Handle file_type
 	if request.Params.FileType != nil {
 		ft := models.FileType(*request.Params.FileType)
 		opts.FileTypes = []models.FileType{ft}
 	}
 
+	var errs fieldErrors
+
+	// Parse extensions
+	if request.Params.Ext != nil {
+		exts, err := services.ParseFileExtensions(*request.Params.Ext)
+		if err != nil {
+			errs.add("ext", "%s", err.Error())
+		}
+		opts.Extensions = exts
+	}
+
 	// Handle status
 	if request.Params.Status != nil {
 		status := models.FileProcessingStatus(*request.Params.Status)
@@ -57,37 +112,143 @@ func (h *StrictHandlers) ListFiles(
 	}
 
 	// Parse tag IDs
-	if request.Params.TagIds != nil && *request.Params.TagIds != "" {
-		tagIDStrs := strings.Split(*request.Params.TagIds, ",")
-		for _, idStr := range tagIDStrs {
-			id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
-			if err == nil {
-				opts.TagIDs = append(opts.TagIDs, uint(id))
-			}
+	if request.Params.TagIds != nil {
+		tagIDs, err := services.ParseTagIDs(*request.Params.TagIds)
+		if err != nil {
+			errs.add("tag_ids", "%s", err.Error())
 		}
+		opts.TagIDs = tagIDs
 	}
 
-	// Handle sorting
+	// Handle sorting, rejecting unknown fields rather than silently ignoring them
 	if request.Params.SortBy != nil {
 		opts.SortBy = string(*request.Params.SortBy)
 	}
 	if request.Params.SortOrder != nil {
 		opts.SortOrder = string(*request.Params.SortOrder)
 	}
+	if request.Params.EntityType != nil {
+		opts.EntityType = string(*request.Params.EntityType)
+	}
+	errs.options(opts.ValidateSort())
+	errs.options(opts.ValidateEntityFilters())
+	errs.options(opts.ValidateSizeRange())
+	errs.options(opts.ValidateDateRange())
+	if resp := errs.response(); resp != nil {
+		return generated.ListFiles400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
 
-	files, total, err := h.fileService.ListFiles(userID, opts)
+	// IDs only: skip loading full records for sync clients diffing their state
+	if request.Params.IdsOnly != nil && *request.Params.IdsOnly {
+		ids, total, err := h.fileService.ListFileIDs(ownerID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return generated.ListFiles200JSONResponse{
+			Data:   []generated.File{},
+			Ids:    ptr(uintsToInts(ids)),
+			Total:  int(total),
+			Limit:  opts.Limit,
+			Offset: opts.Offset,
+		}, nil
+	}
+
+	files, total, err := h.fileService.ListFiles(ownerID, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	return generated.ListFiles200JSONResponse{
-		Data:   fileListToGenerated(files),
+		Data:   fileListToGenerated(ctx, files),
 		Total:  int(total),
 		Limit:  opts.Limit,
 		Offset: opts.Offset,
 	}, nil
 }
 
+// ListFilesGrouped implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFilesGrouped(
+	ctx context.Context,
+	request generated.ListFilesGroupedRequestObject,
+) (generated.ListFilesGroupedResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFilesGrouped401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if invalid := validateListFilesGroupedParams(request.Params); invalid != nil {
+		return generated.ListFilesGrouped400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+	maxDepth := derefInt(request.Params.MaxDepth, services.DefaultGroupedDepth)
+	perFolder := derefInt(request.Params.FilesPerFolder, services.DefaultGroupedFilesPerFolder)
+
+	// A shared root folder is listed as its owner
+	ownerID := userID
+	root := generated.FileGroup{}
+	var rootID *uint
+	if request.Params.RootFolderId != nil {
+		id := uint(*request.Params.RootFolderId)
+		ownerID, err = h.folderOwner(userID, id, false)
+		if err != nil {
+			return nil, err
+		}
+		if ownerID == "" {
+			return generated.ListFilesGrouped404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+		}
+		folder, err := h.folderService.GetFolderByID(ownerID, id)
+		if err != nil {
+			return nil, err
+		}
+		if folder == nil {
+			return generated.ListFilesGrouped404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+		}
+		rootID = &id
+		root.FolderId = ptr(int(id))
+		root.Name = folder.Name
+	}
+
+	folders, err := h.folderService.GetFolderTree(ownerID, rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the nodes down to maxDepth first so the files of every included
+	// folder load with one query. ID 0 is the top level.
+	folderIDs := []uint{deref(rootID)}
+	var build func(node *generated.FileGroup, children []models.Folder, depth int)
+	build = func(node *generated.FileGroup, children []models.Folder, depth int) {
+		node.ChildCount = len(children)
+		node.Children = []generated.FileGroup{}
+		if depth >= maxDepth {
+			return
+		}
+		for _, folder := range children {
+			child := generated.FileGroup{FolderId: ptr(int(folder.ID)), Name: folder.Name}
+			folderIDs = append(folderIDs, folder.ID)
+			build(&child, folder.Children, depth+1)
+			node.Children = append(node.Children, child)
+		}
+	}
+	build(&root, folders, 0)
+
+	grouped, err := h.fileService.ListFilesByFolder(ownerID, folderIDs, perFolder)
+	if err != nil {
+		return nil, err
+	}
+	var fill func(node *generated.FileGroup)
+	fill = func(node *generated.FileGroup) {
+		files := grouped[uint(deref(node.FolderId))]
+		node.Files = fileListToGenerated(ctx, files.Files)
+		node.FileCount = int(files.Total)
+		for i := range node.Children {
+			fill(&node.Children[i])
+		}
+	}
+	fill(&root)
+
+	return generated.ListFilesGrouped200JSONResponse(root), nil
+}
+
 // CreateFile implements generated.StrictServerInterface
 func (h *StrictHandlers) CreateFile(
 	ctx context.Context,
@@ -101,6 +262,9 @@ func (h *StrictHandlers) CreateFile(
 	if request.Body == nil {
 		return generated.CreateFile400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
 	}
+	if invalid := validateCreateFileRequest(request.Body); invalid != nil {
+		return generated.CreateFile400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
 
 	file := &models.File{
 		Title:            request.Body.Title,
@@ -108,33 +272,75 @@ func (h *StrictHandlers) CreateFile(
 		OriginalFilename: request.Body.OriginalFilename,
 		MimeType:         deref(request.Body.MimeType),
 		Size:             deref(request.Body.Size),
+		ProcessingHint:   strings.TrimSpace(deref(request.Body.ProcessingHint)),
 		ProcessingStatus: models.FileStatusPending,
 	}
 
-	// Set folder if provided
+	// Set folder if provided. Files added to a shared folder belong to the
+	// folder's owner.
+	ownerID := userID
 	if request.Body.FolderId != nil {
 		folderID := uint(*request.Body.FolderId)
 		file.FolderID = &folderID
+
+		folderOwner, err := h.folderOwner(userID, folderID, true)
+		if err != nil {
+			return nil, err
+		}
+		if folderOwner != "" {
+			ownerID = folderOwner
+		}
 	}
 
 	// Set file type - detect from mime type if not provided
 	if request.Body.FileType != nil {
 		file.FileType = models.FileType(*request.Body.FileType)
+		file.FileTypeSetByUser = true
 	} else {
 		file.FileType = models.DetectFileTypeFromMimeType(file.MimeType)
 	}
 
-	if err := h.fileService.CreateFile(userID, file); err != nil {
+	// Imported files with extracted text skip the processing pipeline
+	if content := deref(request.Body.Content); content != "" {
+		file.Content = content
+		file.Summary = deref(request.Body.Summary)
+		file.ProcessingStatus = models.FileStatusCompleted
+	}
+
+	if err := h.fileService.CreateFile(ownerID, file); err != nil {
 		return generated.CreateFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
+	h.placement.PlaceFiles(ctx, ownerID, []uint{file.ID}, file.FolderID)
+
+	if file.ProcessingStatus == models.FileStatusCompleted &&
+		(request.Body.GenerateEmbedding == nil || *request.Body.GenerateEmbedding) {
+		h.embedImportedFile(ctx, ownerID, file)
+	}
 
 	// Fetch file with relations
-	created, err := h.fileService.GetFileByID(userID, file.ID)
+	created, err := h.fileService.GetFileByID(ownerID, file.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	return generated.CreateFile201JSONResponse(fileModelToGenerated(created)), nil
+	return generated.CreateFile201JSONResponse(fileModelToGenerated(ctx, created)), nil
+}
+
+// embedImportedFile generates the embedding for a file created with content.
+// Failures are recorded on the file but don't fail creation.
+func (h *StrictHandlers) embedImportedFile(ctx context.Context, userID string, file *models.File) {
+	embedding, err := h.embeddingService.GenerateEmbedding(ctx, file.Content)
+	if err != nil {
+		h.fileService.UpdateFileProcessingStatus(userID, file.ID, models.FileStatusCompleted, "Embedding generation failed: "+err.Error())
+		return
+	}
+
+	if err := h.embeddingService.StoreFileEmbedding(userID, file.ID, embedding, file.Content); err != nil {
+		h.fileService.UpdateFileProcessingStatus(userID, file.ID, models.FileStatusCompleted, "Embedding storage failed: "+err.Error())
+		return
+	}
+
+	h.fileService.SetFileHasEmbedding(userID, file.ID, true)
 }
 
 // GetFile implements generated.StrictServerInterface
@@ -147,15 +353,98 @@ func (h *StrictHandlers) GetFile(
 		return generated.GetFile401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	file, err := h.fileService.GetFileByID(userID, uint(request.Id))
+	file, err := h.readableFile(userID, uint(request.Id))
 	if err != nil {
 		return nil, err
 	}
 	if file == nil {
 		return generated.GetFile404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
 	}
+	if file.RelatedFiles, err = h.readableFileRelations(userID, file); err != nil {
+		return nil, err
+	}
+
+	return generated.GetFile200JSONResponse(fileModelToGenerated(ctx, file)), nil
+}
+
+// GetFileByPublicID implements generated.StrictServerInterface
+func (h *StrictHandlers) GetFileByPublicID(
+	ctx context.Context,
+	request generated.GetFileByPublicIDRequestObject,
+) (generated.GetFileByPublicIDResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetFileByPublicID401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	file, err := h.readableFileByPublicID(userID, request.PublicId)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return generated.GetFileByPublicID404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+	if file.RelatedFiles, err = h.readableFileRelations(userID, file); err != nil {
+		return nil, err
+	}
+
+	return generated.GetFileByPublicID200JSONResponse(fileModelToGenerated(ctx, file)), nil
+}
+
+// readableFile returns a file the user can read, as its owner or through a
+// shared folder, or nil when there is none
+func (h *StrictHandlers) readableFile(userID string, fileID uint) (*models.File, error) {
+	ownerID, err := h.fileOwner(userID, fileID, false)
+	if err != nil || ownerID == "" {
+		return nil, err
+	}
+	return h.fileService.GetFileByID(ownerID, fileID)
+}
+
+// readableFileByPublicID is readableFile for a file's public ID
+func (h *StrictHandlers) readableFileByPublicID(userID, publicID string) (*models.File, error) {
+	fileID, err := h.fileService.ResolvePublicID(publicID)
+	if err != nil || fileID == 0 {
+		return nil, err
+	}
+	return h.readableFile(userID, fileID)
+}
+
+// GetFileAssociations implements generated.StrictServerInterface
+func (h *StrictHandlers) GetFileAssociations(
+	ctx context.Context,
+	request generated.GetFileAssociationsRequestObject,
+) (generated.GetFileAssociationsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetFileAssociations401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	file, err := h.fileService.GetFileAssociations(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return generated.GetFileAssociations404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
 
-	return generated.GetFile200JSONResponse(fileModelToGenerated(file)), nil
+	result := generated.FileAssociations{
+		Tags:       tagListToGenerated(file.Tags),
+		FolderPath: []generated.Folder{},
+	}
+
+	if file.Folder != nil {
+		folder := folderModelToGenerated(file.Folder)
+		result.Folder = &folder
+
+		path, err := h.folderService.GetFolderPath(userID, file.Folder.ID)
+		if err != nil {
+			return nil, err
+		}
+		result.FolderPath = folderListToGenerated(path)
+	}
+
+	return generated.GetFileAssociations200JSONResponse(result), nil
 }
 
 // UpdateFile implements generated.StrictServerInterface
@@ -171,9 +460,21 @@ func (h *StrictHandlers) UpdateFile(
 	if request.Body == nil {
 		return generated.UpdateFile400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
 	}
+	if invalid := validateUpdateFileRequest(request.Body); invalid != nil {
+		return generated.UpdateFile400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+
+	// Files in folders shared with write access are updated as their owner
+	ownerID, err := h.fileOwner(userID, uint(request.Id), true)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == "" {
+		ownerID = userID
+	}
 
 	// Get existing file
-	existing, err := h.fileService.GetFileByID(userID, uint(request.Id))
+	existing, err := h.fileService.GetFileByID(ownerID, uint(request.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -188,26 +489,43 @@ func (h *StrictHandlers) UpdateFile(
 	if request.Body.Summary != nil {
 		existing.Summary = *request.Body.Summary
 	}
+	if request.Body.ProcessingHint != nil {
+		existing.ProcessingHint = strings.TrimSpace(*request.Body.ProcessingHint)
+	}
 	if request.Body.FileType != nil {
 		existing.FileType = models.FileType(*request.Body.FileType)
+		existing.FileTypeSetByUser = true
 	}
 	// Handle folder_id - even if nil (moving to root)
 	if request.Body.FolderId != nil {
 		folderID := uint(*request.Body.FolderId)
+		// Members can only move files within folders they can write to
+		if ownerID != userID {
+			targetOwner, err := h.folderOwner(userID, folderID, true)
+			if err != nil {
+				return nil, err
+			}
+			if targetOwner != ownerID {
+				return generated.UpdateFile404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+			}
+		}
 		existing.FolderID = &folderID
 	}
 
-	if err := h.fileService.UpdateFile(userID, existing); err != nil {
+	if err := h.fileService.UpdateFile(ownerID, existing); err != nil {
 		return generated.UpdateFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
+	if request.Body.FolderId != nil {
+		h.placement.PlaceFiles(ctx, ownerID, []uint{existing.ID}, existing.FolderID)
+	}
 
 	// Fetch updated file
-	updated, err := h.fileService.GetFileByID(userID, uint(request.Id))
+	updated, err := h.fileService.GetFileByID(ownerID, uint(request.Id))
 	if err != nil {
 		return nil, err
 	}
 
-	return generated.UpdateFile200JSONResponse(fileModelToGenerated(updated)), nil
+	return generated.UpdateFile200JSONResponse(fileModelToGenerated(ctx, updated)), nil
 }
 
 // DeleteFile implements generated.StrictServerInterface
@@ -229,13 +547,54 @@ func (h *StrictHandlers) DeleteFile(
 		return generated.DeleteFile404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
 	}
 
-	// Delete from database first
-	if err := h.fileService.DeleteFile(userID, uint(request.Id)); err != nil {
+	// Relations are removed with the file, so collect the related files first
+	var relations []models.FileRelation
+	if deref(request.Params.CascadeRelations) {
+		if relations, err = h.fileService.ListFileRelations(userID, file.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.deleteFile(ctx, userID, file); err != nil {
 		return generated.DeleteFile404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
-	// Delete from S3 (best effort - don't fail if S3 delete fails)
-	_ = h.uploadService.DeleteFile(ctx, file.S3Key)
+	for _, relation := range relations {
+		related, err := h.fileService.GetFileByID(userID, relation.RelatedFileID)
+		if err != nil || related == nil {
+			continue
+		}
+		// Keep attachments that other files still refer to
+		if shared, err := h.fileService.HasFileRelations(related.ID); err != nil || shared {
+			continue
+		}
+		if err := h.deleteFile(ctx, userID, related); err != nil {
+			log.Printf("[Relations] File %d: failed to delete related file %d: %v", file.ID, related.ID, err)
+		}
+	}
+
+	return generated.DeleteFile204Response{}, nil
+}
+
+// deleteFile deletes a file record, then its S3 object, embedding and invoice
+// on a best-effort basis
+func (h *StrictHandlers) deleteFile(ctx context.Context, userID string, file *models.File) error {
+	// Delete from database first
+	if err := h.fileService.DeleteFile(userID, file.ID); err != nil {
+		return err
+	}
+	h.cleanUpDeletedFile(ctx, userID, file)
+	return nil
+}
+
+// cleanUpDeletedFile removes the S3 object, embedding and invoice of a file
+// whose record was deleted, on a best-effort basis
+func (h *StrictHandlers) cleanUpDeletedFile(ctx context.Context, userID string, file *models.File) {
+	// Delete from S3 (best effort - don't fail if S3 delete fails), unless
+	// another file still references the same object
+	if referenced, err := h.fileService.IsS3KeyReferenced(file.S3Key); err == nil && !referenced {
+		_ = h.uploadService.DeleteFile(ctx, file.S3Key)
+	}
 
 	// Delete embedding if exists
 	if file.HasEmbedding {
@@ -255,8 +614,113 @@ func (h *StrictHandlers) DeleteFile(
 			}()
 		}
 	}
+}
 
-	return generated.DeleteFile204Response{}, nil
+// BatchDeleteFiles implements generated.StrictServerInterface
+func (h *StrictHandlers) BatchDeleteFiles(
+	ctx context.Context,
+	request generated.BatchDeleteFilesRequestObject,
+) (generated.BatchDeleteFilesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.BatchDeleteFiles401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.BatchDeleteFiles400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if invalid := validateBatchDeleteFilesRequest(request.Body); invalid != nil {
+		return generated.BatchDeleteFiles400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+
+	fileIDs := make([]uint, len(request.Body.FileIds))
+	for i, id := range request.Body.FileIds {
+		fileIDs[i] = uint(id)
+	}
+
+	result, err := h.fileService.DeleteFiles(userID, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Deleted {
+		h.cleanUpDeletedFile(ctx, userID, &result.Deleted[i])
+	}
+
+	return generated.BatchDeleteFiles200JSONResponse{
+		DeletedCount: len(result.Deleted),
+		FailedIds:    uintsToInts(result.NotFound),
+	}, nil
+}
+
+// CopyFile implements generated.StrictServerInterface
+func (h *StrictHandlers) CopyFile(
+	ctx context.Context,
+	request generated.CopyFileRequestObject,
+) (generated.CopyFileResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CopyFile401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.CopyFile400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if invalid := validateCopyFileRequest(request.Body); invalid != nil {
+		return generated.CopyFile400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+
+	source, err := h.fileService.GetFileByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return generated.CopyFile404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	// Place the copy's object the way an upload to the target folder would be
+	var targetFolderID *uint
+	prefix := ""
+	if request.Body.FolderId != nil {
+		folderID := uint(*request.Body.FolderId)
+		targetFolderID = &folderID
+		folder, err := h.folderService.GetFolderByID(userID, folderID)
+		if err != nil {
+			return nil, err
+		}
+		if folder == nil {
+			return generated.CopyFile400JSONResponse{BadRequestJSONResponse: badRequest("folder not found")}, nil
+		}
+		prefix = folder.S3Prefix
+	}
+
+	newKey, err := services.NewObjectKey(userID, prefix, source.OriginalFilename)
+	if err != nil {
+		return generated.CopyFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+	if err := h.uploadService.CopyFile(ctx, source.S3Key, newKey); err != nil {
+		return nil, fmt.Errorf("failed to copy object %s: %w", source.S3Key, err)
+	}
+
+	copied, err := h.fileService.CopyFile(userID, source.ID, targetFolderID, newKey)
+	if err != nil || copied == nil {
+		_ = h.uploadService.DeleteFile(ctx, newKey)
+	}
+	switch {
+	case errors.Is(err, services.ErrCopyFolderNotFound), errors.Is(err, services.ErrFolderFull):
+		return generated.CopyFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	case err != nil:
+		return nil, err
+	case copied == nil:
+		return generated.CopyFile404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	// The source's results aren't final yet, so the copy is processed on its own
+	if copied.ProcessingStatus == models.FileStatusProcessing {
+		authToken, _ := utils.GetRawAuthToken(ctx)
+		go h.processFileAsync(userID, copied.ID, authToken, processingModels{})
+	}
+
+	return generated.CopyFile201JSONResponse(fileModelToGenerated(ctx, copied)), nil
 }
 
 // MoveFiles implements generated.StrictServerInterface
@@ -286,16 +750,137 @@ func (h *StrictHandlers) MoveFiles(
 		targetFolderID = &fid
 	}
 
-	if err := h.fileService.MoveFiles(userID, fileIDs, targetFolderID); err != nil {
+	result, err := h.placement.MoveFiles(ctx, userID, fileIDs, targetFolderID)
+	if err != nil {
 		return generated.MoveFiles400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
 	return generated.MoveFiles200JSONResponse{
-		Message:    "Files moved successfully",
-		MovedCount: len(fileIDs),
+		Message:        "Files moved successfully",
+		MovedCount:     len(result.Moved),
+		UnchangedCount: len(result.Unchanged),
+		UnchangedIds:   uintsToInts(result.Unchanged),
+	}, nil
+}
+
+// MoveFilesByFilter implements generated.StrictServerInterface
+func (h *StrictHandlers) MoveFilesByFilter(
+	ctx context.Context,
+	request generated.MoveFilesByFilterRequestObject,
+) (generated.MoveFilesByFilterResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.MoveFilesByFilter401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.MoveFilesByFilter400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validateMoveFilesByFilterRequest(request.Body); resp != nil {
+		return generated.MoveFilesByFilter400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	var targetFolderID *uint
+	if request.Body.TargetFolderId != nil {
+		fid := uint(*request.Body.TargetFolderId)
+		targetFolderID = &fid
+	}
+
+	result, err := h.fileService.MoveFilesByFilter(userID, fileFilterToOptions(request.Body.Filter), targetFolderID)
+	if err != nil {
+		return generated.MoveFilesByFilter400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+	h.placement.PlaceFiles(ctx, userID, result.Moved, targetFolderID)
+
+	return generated.MoveFilesByFilter200JSONResponse{
+		Message:        "Files moved successfully",
+		MovedCount:     len(result.Moved),
+		UnchangedCount: len(result.Unchanged),
 	}, nil
 }
 
+// ReclassifyFiles implements generated.StrictServerInterface
+func (h *StrictHandlers) ReclassifyFiles(
+	ctx context.Context,
+	request generated.ReclassifyFilesRequestObject,
+) (generated.ReclassifyFilesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ReclassifyFiles401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	// Without a filter every file is checked
+	opts := services.FileListOptions{AllFolders: true}
+	if request.Body != nil && request.Body.Filter != nil {
+		if resp := validateReclassifyFilesRequest(request.Body); resp != nil {
+			return generated.ReclassifyFiles400JSONResponse{BadRequestJSONResponse: *resp}, nil
+		}
+		opts = fileFilterToOptions(*request.Body.Filter)
+	}
+
+	result, err := h.fileService.ReclassifyFiles(userID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := generated.ReclassifyFiles200JSONResponse{
+		UnchangedCount: result.Unchanged,
+		ChangedByType:  map[string]int{},
+		ChangedFileIds: []int{},
+	}
+	for fileType, ids := range result.Changed {
+		resp.ChangedByType[string(fileType)] = len(ids)
+		resp.ChangedFileIds = append(resp.ChangedFileIds, uintsToInts(ids)...)
+	}
+	slices.Sort(resp.ChangedFileIds)
+	resp.ChangedCount = len(resp.ChangedFileIds)
+	return resp, nil
+}
+
+// ClearFileEmbedding implements generated.StrictServerInterface
+func (h *StrictHandlers) ClearFileEmbedding(
+	ctx context.Context,
+	request generated.ClearFileEmbeddingRequestObject,
+) (generated.ClearFileEmbeddingResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ClearFileEmbedding401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	ownerID, err := h.fileOwner(userID, uint(request.Id), true)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == "" {
+		return generated.ClearFileEmbedding404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	fileID := uint(request.Id)
+	if err := h.embeddingService.DeleteFileEmbedding(ownerID, fileID); err != nil {
+		return nil, err
+	}
+	if err := h.fileService.SetFileHasEmbedding(ownerID, fileID, false); err != nil {
+		return nil, err
+	}
+
+	file, err := h.fileService.GetFileByID(ownerID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return generated.ClearFileEmbedding404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	return generated.ClearFileEmbedding200JSONResponse(fileModelToGenerated(ctx, file)), nil
+}
+
+const (
+	// defaultProcessWaitSeconds is how long ProcessFile waits with wait=true
+	defaultProcessWaitSeconds = 60
+	// maxProcessWaitSeconds caps wait_timeout
+	maxProcessWaitSeconds = 300
+)
+
 // ProcessFile implements generated.StrictServerInterface
 func (h *StrictHandlers) ProcessFile(
 	ctx context.Context,
@@ -320,6 +905,15 @@ func (h *StrictHandlers) ProcessFile(
 		return generated.ProcessFile400JSONResponse{BadRequestJSONResponse: badRequest("File is already being processed")}, nil
 	}
 
+	waitTimeout := defaultProcessWaitSeconds
+	if request.Params.WaitTimeout != nil {
+		waitTimeout = *request.Params.WaitTimeout
+		if waitTimeout < 1 || waitTimeout > maxProcessWaitSeconds {
+			return generated.ProcessFile400JSONResponse{BadRequestJSONResponse: badRequest(
+				fmt.Sprintf("wait_timeout must be between 1 and %d seconds", maxProcessWaitSeconds))}, nil
+		}
+	}
+
 	// Update status to processing
 	if err := h.fileService.UpdateFileProcessingStatus(userID, file.ID, models.FileStatusProcessing, ""); err != nil {
 		return nil, err
@@ -328,19 +922,168 @@ func (h *StrictHandlers) ProcessFile(
 	// Extract auth token for invoice processing
 	authToken, _ := utils.GetRawAuthToken(ctx)
 
-	// Start async processing
-	go h.processFileAsync(userID, file.ID, authToken)
+	overrides := processingModels{
+		summaryModel: deref(request.Params.SummaryModel),
+		agentModel:   deref(request.Params.AgentModel),
+	}
 
-	return generated.ProcessFile202JSONResponse{
-		Message: "File processing started",
-		Status:  generated.Processing,
-	}, nil
+	if !deref(request.Params.Wait) {
+		// Start async processing
+		go h.processFileAsync(userID, file.ID, authToken, overrides)
+
+		return generated.ProcessFile202JSONResponse{
+			Message: "File processing started",
+			Status:  generated.Processing,
+		}, nil
+	}
+
+	// Processing runs detached so it keeps going if the wait times out
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.processFileAsync(userID, file.ID, authToken, overrides)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Duration(waitTimeout) * time.Second):
+		return generated.ProcessFile408JSONResponse{
+			Error: fmt.Sprintf("Processing did not finish within %d seconds and continues in the background", waitTimeout),
+		}, nil
+	}
+
+	processed, err := h.fileService.GetFileByID(userID, file.ID)
+	if err != nil {
+		return nil, err
+	}
+	if processed == nil {
+		return generated.ProcessFile400JSONResponse{BadRequestJSONResponse: badRequest("File not found")}, nil
+	}
+	return generated.ProcessFile200JSONResponse(fileModelToGenerated(ctx, processed)), nil
+}
+
+// CancelFilesProcessing implements generated.StrictServerInterface
+func (h *StrictHandlers) CancelFilesProcessing(
+	ctx context.Context,
+	request generated.CancelFilesProcessingRequestObject,
+) (generated.CancelFilesProcessingResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CancelFilesProcessing401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil || len(request.Body.FileIds) == 0 {
+		return generated.CancelFilesProcessing400JSONResponse{BadRequestJSONResponse: badRequest("file_ids is required")}, nil
+	}
+
+	fileIDs := uniqueFileIDs(request.Body.FileIds)
+	transitioned, err := h.fileService.CancelProcessing(userID, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.CancelFilesProcessing200JSONResponse(statusTransitionResult(len(fileIDs), transitioned)), nil
+}
+
+// RetryFilesProcessing implements generated.StrictServerInterface
+func (h *StrictHandlers) RetryFilesProcessing(
+	ctx context.Context,
+	request generated.RetryFilesProcessingRequestObject,
+) (generated.RetryFilesProcessingResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RetryFilesProcessing401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil || len(request.Body.FileIds) == 0 {
+		return generated.RetryFilesProcessing400JSONResponse{BadRequestJSONResponse: badRequest("file_ids is required")}, nil
+	}
+
+	authToken, _ := utils.GetRawAuthToken(ctx)
+
+	// Claim each file individually so only files that actually left the
+	// failed or needs_review status are processed
+	fileIDs := uniqueFileIDs(request.Body.FileIds)
+	transitioned := 0
+	for _, fileID := range fileIDs {
+		changed, err := h.fileService.TransitionStatus(userID, []uint{fileID}, models.FileStatusFailed, models.FileStatusProcessing)
+		if err == nil && changed == 0 {
+			changed, err = h.fileService.TransitionStatus(userID, []uint{fileID}, models.FileStatusNeedsReview, models.FileStatusProcessing)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if changed == 0 {
+			continue
+		}
+		transitioned++
+		go h.processFileAsync(userID, fileID, authToken, processingModels{})
+	}
+
+	return generated.RetryFilesProcessing200JSONResponse(statusTransitionResult(len(fileIDs), transitioned)), nil
+}
+
+// GetProcessingErrorSummary implements generated.StrictServerInterface
+func (h *StrictHandlers) GetProcessingErrorSummary(
+	ctx context.Context,
+	request generated.GetProcessingErrorSummaryRequestObject,
+) (generated.GetProcessingErrorSummaryResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetProcessingErrorSummary401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	groups, err := h.fileService.SummarizeProcessingErrors(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]generated.ProcessingErrorGroup, len(groups))
+	for i, group := range groups {
+		data[i] = generated.ProcessingErrorGroup{Message: group.Message, Count: group.Count}
+	}
+	return generated.GetProcessingErrorSummary200JSONResponse{Data: data}, nil
+}
+
+// uniqueFileIDs converts request IDs to uints without duplicates
+func uniqueFileIDs(ids []int) []uint {
+	seen := make(map[int]bool, len(ids))
+	fileIDs := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			fileIDs = append(fileIDs, uint(id))
+		}
+	}
+	return fileIDs
+}
+
+func statusTransitionResult(requested, transitioned int) generated.StatusTransitionResult {
+	return generated.StatusTransitionResult{
+		Requested:    requested,
+		Transitioned: transitioned,
+		Skipped:      requested - transitioned,
+	}
 }
 
 // processFileAsync handles file processing in a background goroutine
-func (h *StrictHandlers) processFileAsync(userID string, fileID uint, authToken string) {
+func (h *StrictHandlers) processFileAsync(userID string, fileID uint, authToken string, overrides processingModels) {
 	ctx := context.Background()
 
+	// Hold the run while an operator has paused processing
+	if err := h.processingGate.Wait(ctx); err != nil {
+		return
+	}
+
+	// Runs handed to an async parser are counted when their callback finishes them
+	start := time.Now()
+	awaitingParser := false
+	defer func() {
+		if !awaitingParser {
+			recordProcessingMetrics(h.fileService, userID, fileID, start)
+		}
+	}()
+
 	// Get file
 	file, err := h.fileService.GetFileByID(userID, fileID)
 	if err != nil || file == nil {
@@ -355,6 +1098,26 @@ func (h *StrictHandlers) processFileAsync(userID string, fileID uint, authToken
 		return
 	}
 
+	// Clients often send a wrong or generic MIME type; correct it from the
+	// file's leading bytes before the file type is used
+	if detected, err := h.contentParserService.DetectContentType(ctx, downloadURL); err != nil {
+		log.Printf("[Processing] File %d: failed to detect content type: %v", fileID, err)
+	} else if err := h.fileService.ApplyDetectedMimeType(userID, file, detected); err != nil {
+		log.Printf("[Processing] File %d: failed to store detected content type: %v", fileID, err)
+	}
+
+	// Encrypted files can't be parsed; fail early with a clear reason
+	if encrypted, err := h.contentParserService.DetectEncryption(ctx, downloadURL); err == nil && encrypted {
+		h.fileService.FailFileProcessing(userID, fileID, models.ProcessingErrorFileEncrypted, services.ErrFileEncrypted.Error())
+		return
+	}
+
+	// Async parsers post the content back; ParserCallback resumes the run
+	if h.contentParserService.IsAsync() {
+		awaitingParser = submitParseJob(ctx, h.parserJobService, h.contentParserService, h.fileService, userID, fileID, downloadURL, overrides) == nil
+		return
+	}
+
 	// Parse content using content parser service
 	parsedContent, err := h.contentParserService.ParseFileContent(ctx, downloadURL)
 	if err != nil {
@@ -362,25 +1125,55 @@ func (h *StrictHandlers) processFileAsync(userID string, fileID uint, authToken
 		return
 	}
 
+	h.completeProcessing(ctx, userID, file, downloadURL, authToken, parsedContent.TextContent, overrides)
+}
+
+// completeProcessing runs the steps after parsing: summary, entities, invoice,
+// agent, embedding and auto-tagging. downloadURL and authToken are only used
+// for invoice processing, which is skipped without a token.
+func (h *StrictHandlers) completeProcessing(ctx context.Context, userID string, file *models.File, downloadURL, authToken, content string, overrides processingModels) {
+	fileID := file.ID
+
+	// Almost no text would only produce a useless summary and embedding; keep
+	// what was extracted and flag the file for review instead
+	if h.contentParserService.IsContentTooShort(content, file.FileType) {
+		if err := h.fileService.UpdateFileContent(userID, fileID, content, "", false, file.FileType); err != nil {
+			log.Printf("[Processing] File %d: failed to store short content: %v", fileID, err)
+		}
+		h.fileService.FlagFileForReview(userID, fileID, models.ProcessingErrorContentTooShort, services.ErrContentTooShort.Error())
+		return
+	}
+
 	// Generate summary from the text content using AI
-	summary, err := h.summaryService.GenerateSummary(ctx, parsedContent.TextContent, 500)
-	if err != nil {
-		// Fall back to simple truncation if AI summary fails
-		summary = services.GenerateSummary(parsedContent.TextContent, 500)
+	summary, err := h.summaryService.GenerateSummary(ctx, content, 500, overrides.summaryModel)
+	summaryIsFallback := err != nil
+	if summaryIsFallback {
+		// Fall back to an excerpt if AI summary fails
+		summary = h.summaryService.FallbackSummary(content)
 	}
 
-	// Detect file type from content (especially for invoice detection)
+	// Detect file type from content (especially for invoice detection), unless
+	// the user chose the type
 	detectedFileType := file.FileType
-	if models.IsInvoiceContent(parsedContent.TextContent) {
+	if !file.FileTypeSetByUser && models.IsInvoiceContent(content) {
 		detectedFileType = models.FileTypeInvoice
 	}
 
 	// Update file with parsed content
-	if err := h.fileService.UpdateFileContent(userID, fileID, parsedContent.TextContent, summary, detectedFileType); err != nil {
+	if err := h.fileService.UpdateFileContent(userID, fileID, content, summary, summaryIsFallback, detectedFileType); err != nil {
 		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusFailed, "Failed to update content: "+err.Error())
 		return
 	}
 
+	// Extract key entities when enabled (best-effort)
+	if entities, err := h.summaryService.ExtractEntities(ctx, content, overrides.summaryModel); err != nil {
+		log.Printf("[Processing] File %d: entity extraction failed: %v", fileID, err)
+	} else if entities != nil {
+		if err := h.fileService.UpdateFileEntities(userID, fileID, entities); err != nil {
+			log.Printf("[Processing] File %d: failed to store entities: %v", fileID, err)
+		}
+	}
+
 	// Process invoice via external API if file is detected as invoice
 	if detectedFileType == models.FileTypeInvoice && h.invoiceService != nil && h.invoiceService.IsEnabled() && authToken != "" {
 		log.Printf("[Invoice] Processing file %d as invoice", fileID)
@@ -407,7 +1200,7 @@ func (h *StrictHandlers) processFileAsync(userID string, fileID uint, authToken
 	}
 
 	// Run AI agent to organize file (best-effort, non-blocking errors)
-	if h.agentService != nil && h.agentService.IsEnabled() {
+	runAgent := func() {
 		// Create event channel for logging
 		eventChan := make(chan services.AgentEvent, 100)
 		go func() {
@@ -418,36 +1211,81 @@ func (h *StrictHandlers) processFileAsync(userID string, fileID uint, authToken
 					log.Printf("[Agent] File %d error: %s", fileID, event.Message)
 				case "tool_call":
 					log.Printf("[Agent] File %d: %s", fileID, event.Message)
+				case "tool_error":
+					log.Printf("[Agent] File %d tool error: %s", fileID, event.Message)
 				case "result":
 					log.Printf("[Agent] File %d completed: %s", fileID, event.Message)
 				}
 			}
 		}()
 
-		if err := h.agentService.ProcessFileWithAgent(ctx, userID, fileID, parsedContent.TextContent, summary, eventChan); err != nil {
+		if err := h.agentService.ProcessFileWithAgent(ctx, userID, fileID, content, summary, overrides.agentModel, eventChan); err != nil {
 			log.Printf("[Agent] File %d processing warning: %v", fileID, err)
 			// Don't fail the file processing, agent is best-effort
 		}
 	}
 
-	// Generate embedding
-	embedding, err := h.embeddingService.GenerateEmbedding(ctx, parsedContent.TextContent)
-	if err != nil {
+	// embedFile returns the file's embedding, or why it couldn't be stored
+	embedFile := func() ([]float32, string) {
+		// Reuse the stored embedding when the content hasn't changed
+		embedding, err := h.embeddingService.GetUnchangedFileEmbedding(userID, fileID, content)
+		if err != nil {
+			log.Printf("[Embedding] File %d: failed to check stored embedding: %v", fileID, err)
+		}
+		if embedding != nil {
+			log.Printf("[Embedding] File %d: content unchanged, skipped embedding", fileID)
+		} else {
+			// Generate embedding
+			embedding, err = h.embeddingService.GenerateEmbedding(ctx, content)
+			if err != nil {
+				return nil, "Embedding generation failed: " + err.Error()
+			}
+
+			// Store embedding
+			if err := h.embeddingService.StoreFileEmbedding(userID, fileID, embedding, content); err != nil {
+				return nil, "Embedding storage failed: " + err.Error()
+			}
+		}
+		if err := h.fileService.SetFileHasEmbedding(userID, fileID, true); err != nil {
+			log.Printf("[Embedding] File %d: failed to mark embedding: %v", fileID, err)
+		}
+		return embedding, ""
+	}
+
+	// The agent normally runs first; embedding first lets it find similar files
+	agentEnabled := h.agentService != nil && h.agentService.IsEnabled()
+	embedFirst := agentEnabled && h.agentService.Capabilities().EmbedBeforeAgent
+	if agentEnabled && !embedFirst {
+		runAgent()
+	}
+	embedding, embeddingFailure := embedFile()
+	if embedFirst {
+		runAgent()
+	}
+	if embeddingFailure != "" {
 		// Content parsed successfully but embedding failed - still mark as completed
-		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, "Embedding generation failed: "+err.Error())
+		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, embeddingFailure)
 		return
 	}
 
-	// Store embedding
-	if err := h.embeddingService.StoreFileEmbedding(userID, fileID, embedding); err != nil {
-		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, "Embedding storage failed: "+err.Error())
-		return
+	// Apply similar existing tags (best-effort)
+	if h.autoTagService != nil && h.autoTagService.IsEnabled() {
+		applied, err := applyAutoTags(ctx, h.autoTagService, h.placement, userID, fileID, embedding)
+		if err != nil {
+			log.Printf("[AutoTag] File %d warning: %v", fileID, err)
+		} else if len(applied) > 0 {
+			log.Printf("[AutoTag] File %d: applied %d tag(s)", fileID, len(applied))
+		}
 	}
 
-	// Mark as completed
-	h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, "")
+	// Mark as completed unless processing was canceled in the meantime
+	h.fileService.TransitionStatus(userID, []uint{fileID}, models.FileStatusProcessing, models.FileStatusCompleted)
 }
 
+// maskedContentMessage rejects downloads for tokens whose masked_fields hide
+// file content, since the document itself is the content
+const maskedContentMessage = "File content is not available to this token"
+
 // GetFileDownloadURL implements generated.StrictServerInterface
 func (h *StrictHandlers) GetFileDownloadURL(
 	ctx context.Context,
@@ -457,9 +1295,12 @@ func (h *StrictHandlers) GetFileDownloadURL(
 	if err != nil {
 		return generated.GetFileDownloadURL401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
+	if utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+		return generated.GetFileDownloadURL403JSONResponse{ForbiddenJSONResponse: forbidden(maskedContentMessage)}, nil
+	}
 
-	// Get file to verify ownership and get filename
-	file, err := h.fileService.GetFileByID(userID, uint(request.Id))
+	// Get file to verify access and get filename
+	file, err := h.readableFile(userID, uint(request.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -467,16 +1308,52 @@ func (h *StrictHandlers) GetFileDownloadURL(
 		return generated.GetFileDownloadURL404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
 	}
 
-	// Get presigned download URL
-	downloadURL, err := h.uploadService.GetPresignedDownloadURL(ctx, file.S3Key)
+	download, err := h.fileDownload(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	return generated.GetFileDownloadURL200JSONResponse(download), nil
+}
+
+// GetFileDownloadURLByPublicID implements generated.StrictServerInterface
+func (h *StrictHandlers) GetFileDownloadURLByPublicID(
+	ctx context.Context,
+	request generated.GetFileDownloadURLByPublicIDRequestObject,
+) (generated.GetFileDownloadURLByPublicIDResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetFileDownloadURLByPublicID401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+		return generated.GetFileDownloadURLByPublicID403JSONResponse{ForbiddenJSONResponse: forbidden(maskedContentMessage)}, nil
+	}
+
+	file, err := h.readableFileByPublicID(userID, request.PublicId)
 	if err != nil {
 		return nil, err
 	}
+	if file == nil {
+		return generated.GetFileDownloadURLByPublicID404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	download, err := h.fileDownload(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	return generated.GetFileDownloadURLByPublicID200JSONResponse(download), nil
+}
+
+// fileDownload creates a presigned download URL for the file
+func (h *StrictHandlers) fileDownload(ctx context.Context, file *models.File) (generated.FileDownloadResponse, error) {
+	downloadURL, err := h.uploadService.GetPresignedDownloadURL(ctx, file.S3Key)
+	if err != nil {
+		return generated.FileDownloadResponse{}, err
+	}
 
 	// Calculate expiration time (1 hour from now)
 	expiresAt := time.Now().Add(1 * time.Hour)
 
-	return generated.GetFileDownloadURL200JSONResponse{
+	return generated.FileDownloadResponse{
 		DownloadUrl: downloadURL,
 		Key:         file.S3Key,
 		Filename:    file.OriginalFilename,
@@ -484,6 +1361,60 @@ func (h *StrictHandlers) GetFileDownloadURL(
 	}, nil
 }
 
+// GetFileContentText implements generated.StrictServerInterface
+func (h *StrictHandlers) GetFileContentText(
+	ctx context.Context,
+	request generated.GetFileContentTextRequestObject,
+) (generated.GetFileContentTextResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetFileContentText401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+		return generated.GetFileContentText403JSONResponse{ForbiddenJSONResponse: forbidden(maskedContentMessage)}, nil
+	}
+
+	ownerID, err := h.fileOwner(userID, uint(request.Id), false)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == "" {
+		return generated.GetFileContentText404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	file, err := h.fileService.GetFileByID(ownerID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return generated.GetFileContentText404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+	if file.Content == "" {
+		return generated.GetFileContentText404JSONResponse{NotFoundJSONResponse: notFound("File has no extracted text yet")}, nil
+	}
+
+	return generated.GetFileContentText200TextplainCharsetUtf8Response{
+		Body:          strings.NewReader(file.Content),
+		ContentLength: int64(len(file.Content)),
+		Headers: generated.GetFileContentText200ResponseHeaders{
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": contentTextFilename(file)}),
+		},
+	}, nil
+}
+
+// contentTextFilename names the extracted text after the original file
+func contentTextFilename(file *models.File) string {
+	name := file.OriginalFilename
+	if name == "" {
+		name = file.Title
+	}
+	name = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	if name == "" || name == "." || name == "/" {
+		name = fmt.Sprintf("file-%d", file.ID)
+	}
+	return name + ".txt"
+}
+
 // AddTagsToFile implements generated.StrictServerInterface
 func (h *StrictHandlers) AddTagsToFile(
 	ctx context.Context,
@@ -504,7 +1435,8 @@ func (h *StrictHandlers) AddTagsToFile(
 		tagIDs[i] = uint(id)
 	}
 
-	if err := h.fileService.AddTagsToFile(userID, uint(request.Id), tagIDs); err != nil {
+	added, err := h.placement.AddTagsToFile(ctx, userID, uint(request.Id), tagIDs)
+	if err != nil {
 		return generated.AddTagsToFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
@@ -514,7 +1446,15 @@ func (h *StrictHandlers) AddTagsToFile(
 		return nil, err
 	}
 
-	return generated.AddTagsToFile200JSONResponse(fileModelToGenerated(updated)), nil
+	response := generated.AddTagsToFile200JSONResponse{
+		File:                 fileModelToGenerated(ctx, updated),
+		AddedTagIds:          uintsToInts(added.Added),
+		AlreadyPresentTagIds: uintsToInts(added.AlreadyPresent),
+	}
+	if added.MovedToFolder != nil {
+		response.MovedToFolderId = ptr(int(*added.MovedToFolder))
+	}
+	return response, nil
 }
 
 // RemoveTagsFromFile implements generated.StrictServerInterface
@@ -547,7 +1487,7 @@ func (h *StrictHandlers) RemoveTagsFromFile(
 		return nil, err
 	}
 
-	return generated.RemoveTagsFromFile200JSONResponse(fileModelToGenerated(updated)), nil
+	return generated.RemoveTagsFromFile200JSONResponse(fileModelToGenerated(ctx, updated)), nil
 }
 
 // UnlinkFileInvoice implements generated.StrictServerInterface
@@ -566,7 +1506,7 @@ func (h *StrictHandlers) UnlinkFileInvoice(
 	}
 
 	// Unlink the invoice from the file (verifies user ownership)
-	if err := h.fileService.UnlinkFileInvoiceByInvoiceID(userID, request.Params.InvoiceId); err != nil {
+	if err := h.fileService.UnlinkFileInvoiceByInvoiceID(userID, request.Params.InvoiceId, deref(request.Params.RemoveRelations)); err != nil {
 		return generated.UnlinkFileInvoice404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
@@ -583,6 +1523,9 @@ func (h *StrictHandlers) BatchDownloadFiles(
 	if err != nil {
 		return generated.BatchDownloadFiles401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
+	if utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+		return generated.BatchDownloadFiles403JSONResponse{ForbiddenJSONResponse: forbidden(maskedContentMessage)}, nil
+	}
 
 	if request.Body == nil || len(request.Body.FileIds) == 0 {
 		return generated.BatchDownloadFiles400JSONResponse{BadRequestJSONResponse: badRequest("file_ids is required")}, nil
@@ -603,6 +1546,28 @@ func (h *StrictHandlers) BatchDownloadFiles(
 		files = append(files, file)
 	}
 
+	entries := make([]zipEntry, len(files))
+	seen := make(map[string]int, len(files))
+	for i, file := range files {
+		entries[i] = zipEntry{path: uniqueZipPath(seen, zipPathSegment(file.OriginalFilename)), s3Key: file.S3Key}
+	}
+
+	// Return streaming response
+	return generated.BatchDownloadFiles200ApplicationzipResponse{
+		Body:          h.streamZip(ctx, entries),
+		ContentLength: 0, // Unknown length for streaming
+	}, nil
+}
+
+// zipEntry is a file to add to a streamed ZIP archive
+type zipEntry struct {
+	path  string // Path inside the archive
+	s3Key string
+}
+
+// streamZip returns a reader that produces a ZIP archive of the entries as it
+// is read. Files that can't be downloaded are skipped.
+func (h *StrictHandlers) streamZip(ctx context.Context, entries []zipEntry) io.Reader {
 	// Create a pipe for streaming
 	pr, pw := io.Pipe()
 
@@ -614,9 +1579,9 @@ func (h *StrictHandlers) BatchDownloadFiles(
 		defer pw.Close()
 		defer zipWriter.Close()
 
-		for _, file := range files {
+		for _, entry := range entries {
 			// Get presigned download URL
-			downloadURL, err := h.uploadService.GetPresignedDownloadURL(ctx, file.S3Key)
+			downloadURL, err := h.uploadService.GetPresignedDownloadURL(ctx, entry.s3Key)
 			if err != nil {
 				continue // Skip files that can't be downloaded
 			}
@@ -633,7 +1598,7 @@ func (h *StrictHandlers) BatchDownloadFiles(
 			}
 
 			// Create file in ZIP
-			w, err := zipWriter.Create(file.OriginalFilename)
+			w, err := zipWriter.Create(entry.path)
 			if err != nil {
 				resp.Body.Close()
 				continue
@@ -648,9 +1613,5 @@ func (h *StrictHandlers) BatchDownloadFiles(
 		}
 	}()
 
-	// Return streaming response
-	return generated.BatchDownloadFiles200ApplicationzipResponse{
-		Body:          pr,
-		ContentLength: 0, // Unknown length for streaming
-	}, nil
+	return pr
 }
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// isFileRelationConflict reports whether err describes a relation the user
+// can fix, as opposed to an internal failure
+func isFileRelationConflict(err error) bool {
+	return errors.Is(err, services.ErrFileRelationExists) ||
+		errors.Is(err, services.ErrFileRelationSelf) ||
+		errors.Is(err, services.ErrFileRelationFileNotFound)
+}
+
+// readableFileRelations lists a file's relations, leaving out related files a
+// member of a shared folder can't read
+func (h *StrictHandlers) readableFileRelations(userID string, file *models.File) ([]models.FileRelation, error) {
+	relations, err := h.fileService.ListFileRelations(file.UserID, file.ID)
+	if err != nil || userID == file.UserID {
+		return relations, err
+	}
+
+	readable := make([]models.FileRelation, 0, len(relations))
+	for _, relation := range relations {
+		ownerID, err := h.fileOwner(userID, relation.RelatedFileID, false)
+		if err != nil {
+			return nil, err
+		}
+		if ownerID != "" {
+			readable = append(readable, relation)
+		}
+	}
+	return readable, nil
+}
+
+// ListFileRelations implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFileRelations(
+	ctx context.Context,
+	request generated.ListFileRelationsRequestObject,
+) (generated.ListFileRelationsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFileRelations401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	file, err := h.readableFile(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return generated.ListFileRelations404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	relations, err := h.readableFileRelations(userID, file)
+	if err != nil {
+		return nil, err
+	}
+	return generated.ListFileRelations200JSONResponse{Data: fileRelationListToGenerated(ctx, relations)}, nil
+}
+
+// AddFileRelation implements generated.StrictServerInterface
+func (h *StrictHandlers) AddFileRelation(
+	ctx context.Context,
+	request generated.AddFileRelationRequestObject,
+) (generated.AddFileRelationResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.AddFileRelation401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.AddFileRelation400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validateAddFileRelationRequest(request.Body); resp != nil {
+		return generated.AddFileRelation400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	file, err := h.fileService.GetFileByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return generated.AddFileRelation404JSONResponse{NotFoundJSONResponse: notFound("File not found")}, nil
+	}
+
+	relation, err := h.fileService.AddFileRelation(userID, file.ID, uint(request.Body.RelatedFileId), deref(request.Body.RelationType))
+	if err != nil {
+		if isFileRelationConflict(err) {
+			return generated.AddFileRelation400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		return nil, err
+	}
+
+	return generated.AddFileRelation201JSONResponse(fileRelationModelToGenerated(ctx, relation)), nil
+}
+
+// RemoveFileRelation implements generated.StrictServerInterface
+func (h *StrictHandlers) RemoveFileRelation(
+	ctx context.Context,
+	request generated.RemoveFileRelationRequestObject,
+) (generated.RemoveFileRelationResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RemoveFileRelation401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	removed, err := h.fileService.RemoveFileRelation(userID, uint(request.Id), uint(request.RelatedFileId))
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return generated.RemoveFileRelation404JSONResponse{NotFoundJSONResponse: notFound("File relation not found")}, nil
+	}
+
+	return generated.RemoveFileRelation204Response{}, nil
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// aliasSourcePath returns the folder's current path when the request asks to
+// keep an alias, or "" otherwise. It must be read before the folder changes.
+func (h *StrictHandlers) aliasSourcePath(userID string, folderID uint, keepAlias *bool) (string, error) {
+	if keepAlias == nil || !*keepAlias {
+		return "", nil
+	}
+	return h.folderPathName(userID, folderID)
+}
+
+// keepFolderAlias records oldPath as an alias of the folder if the folder's
+// path has changed since it was read
+func (h *StrictHandlers) keepFolderAlias(userID string, folderID uint, oldPath string) error {
+	if oldPath == "" {
+		return nil
+	}
+	newPath, err := h.folderPathName(userID, folderID)
+	if err != nil {
+		return err
+	}
+	if newPath == oldPath {
+		return nil
+	}
+	_, err = h.folderService.AddFolderAlias(userID, folderID, oldPath)
+	return err
+}
+
+// ResolveFolderPath implements generated.StrictServerInterface
+func (h *StrictHandlers) ResolveFolderPath(
+	ctx context.Context,
+	request generated.ResolveFolderPathRequestObject,
+) (generated.ResolveFolderPathResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ResolveFolderPath401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	resolved, err := h.folderService.ResolveFolderPath(userID, request.Params.Path)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidFolderPath) {
+			return generated.ResolveFolderPath400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		return nil, err
+	}
+	if resolved == nil {
+		return generated.ResolveFolderPath404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, resolved.ID)
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.ResolveFolderPath404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	result := []generated.Folder{folderModelToGenerated(folder)}
+	if err := h.setChildCounts(userID, result); err != nil {
+		return nil, err
+	}
+	return generated.ResolveFolderPath200JSONResponse(result[0]), nil
+}
+
+// ListFolderAliases implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFolderAliases(
+	ctx context.Context,
+	request generated.ListFolderAliasesRequestObject,
+) (generated.ListFolderAliasesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFolderAliases401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.ListFolderAliases404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	aliases, err := h.folderService.ListFolderAliases(userID, folder.ID)
+	if err != nil {
+		return nil, err
+	}
+	return generated.ListFolderAliases200JSONResponse{Data: folderAliasListToGenerated(aliases)}, nil
+}
+
+// AddFolderAlias implements generated.StrictServerInterface
+func (h *StrictHandlers) AddFolderAlias(
+	ctx context.Context,
+	request generated.AddFolderAliasRequestObject,
+) (generated.AddFolderAliasResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.AddFolderAlias401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.AddFolderAlias400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validateAddFolderAliasRequest(request.Body); resp != nil {
+		return generated.AddFolderAlias400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.AddFolderAlias404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	alias, err := h.folderService.AddFolderAlias(userID, folder.ID, request.Body.Path)
+	if err != nil {
+		return nil, err
+	}
+	return generated.AddFolderAlias201JSONResponse(folderAliasToGenerated(alias)), nil
+}
+
+// RemoveFolderAlias implements generated.StrictServerInterface
+func (h *StrictHandlers) RemoveFolderAlias(
+	ctx context.Context,
+	request generated.RemoveFolderAliasRequestObject,
+) (generated.RemoveFolderAliasResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RemoveFolderAlias401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	removed, err := h.folderService.RemoveFolderAlias(userID, uint(request.Id), uint(request.AliasId))
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return generated.RemoveFolderAlias404JSONResponse{NotFoundJSONResponse: notFound("Folder alias not found")}, nil
+	}
+	return generated.RemoveFolderAlias204Response{}, nil
+}
@@ -2,12 +2,16 @@ package handlers
 
 import (
 	"context"
-	"strconv"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
 	"strings"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // ListFolders implements generated.StrictServerInterface
@@ -22,7 +26,7 @@ func (h *StrictHandlers) ListFolders(
 
 	opts := services.FolderListOptions{
 		Keyword: deref(request.Params.Keyword),
-		Limit:   derefInt(request.Params.Limit, 100),
+		Limit:   h.pagination.Folders.limit(request.Params.Limit),
 		Offset:  derefInt(request.Params.Offset, 0),
 	}
 
@@ -33,14 +37,29 @@ func (h *StrictHandlers) ListFolders(
 	}
 
 	// Parse tag IDs
-	if request.Params.TagIds != nil && *request.Params.TagIds != "" {
-		tagIDStrs := strings.Split(*request.Params.TagIds, ",")
-		for _, idStr := range tagIDStrs {
-			id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
-			if err == nil {
-				opts.TagIDs = append(opts.TagIDs, uint(id))
-			}
+	if request.Params.TagIds != nil {
+		tagIDs, err := services.ParseTagIDs(*request.Params.TagIds)
+		if err != nil {
+			var errs fieldErrors
+			errs.add("tag_ids", "%s", err.Error())
+			return generated.ListFolders400JSONResponse{BadRequestJSONResponse: *errs.response()}, nil
 		}
+		opts.TagIDs = tagIDs
+	}
+
+	// IDs only: skip loading full records for sync clients diffing their state
+	if request.Params.IdsOnly != nil && *request.Params.IdsOnly {
+		ids, total, err := h.folderService.ListFolderIDs(userID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return generated.ListFolders200JSONResponse{
+			Data:   []generated.Folder{},
+			Ids:    ptr(uintsToInts(ids)),
+			Total:  int(total),
+			Limit:  opts.Limit,
+			Offset: opts.Offset,
+		}, nil
 	}
 
 	folders, total, err := h.folderService.ListFolders(userID, opts)
@@ -48,14 +67,85 @@ func (h *StrictHandlers) ListFolders(
 		return nil, err
 	}
 
+	data := folderListToGenerated(folders)
+	if err := h.setChildCounts(userID, data); err != nil {
+		return nil, err
+	}
+
 	return generated.ListFolders200JSONResponse{
-		Data:   folderListToGenerated(folders),
+		Data:   data,
 		Total:  int(total),
 		Limit:  opts.Limit,
 		Offset: opts.Offset,
 	}, nil
 }
 
+// GetFolderContents implements generated.StrictServerInterface
+func (h *StrictHandlers) GetFolderContents(
+	ctx context.Context,
+	request generated.GetFolderContentsRequestObject,
+) (generated.GetFolderContentsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetFolderContents401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	folderID := uint(request.Id)
+	ownerID, err := h.folderOwner(userID, folderID, false)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == "" {
+		return generated.GetFolderContents404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	limit := h.pagination.Folders.limit(request.Params.Limit)
+	offset := derefInt(request.Params.Offset, 0)
+
+	folders, totalFolders, err := h.folderService.ListFolders(ownerID, services.FolderListOptions{
+		ParentID: &folderID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Files continue the page after the subfolders
+	fileOpts := services.FileListOptions{
+		FolderID: &folderID,
+		Limit:    limit - len(folders),
+		Offset:   max(offset-int(totalFolders), 0),
+	}
+	pageHasFiles := fileOpts.Limit > 0
+	if !pageHasFiles {
+		// Still query one row so total_files is reported
+		fileOpts.Limit = 1
+	}
+
+	files, totalFiles, err := h.fileService.ListFiles(ownerID, fileOpts)
+	if err != nil {
+		return nil, err
+	}
+	if !pageHasFiles {
+		files = nil
+	}
+
+	subfolders := folderListToGenerated(folders)
+	if err := h.setChildCounts(ownerID, subfolders); err != nil {
+		return nil, err
+	}
+
+	return generated.GetFolderContents200JSONResponse{
+		Folders:      subfolders,
+		Files:        fileListToGenerated(ctx, files),
+		TotalFolders: int(totalFolders),
+		TotalFiles:   int(totalFiles),
+		Limit:        limit,
+		Offset:       offset,
+	}, nil
+}
+
 // CreateFolder implements generated.StrictServerInterface
 func (h *StrictHandlers) CreateFolder(
 	ctx context.Context,
@@ -69,23 +159,38 @@ func (h *StrictHandlers) CreateFolder(
 	if request.Body == nil {
 		return generated.CreateFolder400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
 	}
+	if invalid := validateCreateFolderRequest(request.Body); invalid != nil {
+		return generated.CreateFolder400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
 
+	s3Prefix, _ := services.NormalizeS3Prefix(deref(request.Body.S3Prefix))
 	folder := &models.Folder{
 		Name:        request.Body.Name,
 		Description: deref(request.Body.Description),
+		S3Prefix:    s3Prefix,
 	}
 
+	// Subfolders of a shared folder belong to the folder's owner
+	ownerID := userID
 	if request.Body.ParentId != nil {
 		parentID := uint(*request.Body.ParentId)
 		folder.ParentID = &parentID
+
+		parentOwner, err := h.folderOwner(userID, parentID, true)
+		if err != nil {
+			return nil, err
+		}
+		if parentOwner != "" {
+			ownerID = parentOwner
+		}
 	}
 
-	if err := h.folderService.CreateFolder(userID, folder); err != nil {
+	if err := h.folderService.CreateFolder(ownerID, folder); err != nil {
 		return generated.CreateFolder400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
 	// Fetch folder with tags
-	created, err := h.folderService.GetFolderByID(userID, folder.ID)
+	created, err := h.folderService.GetFolderByID(ownerID, folder.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +208,15 @@ func (h *StrictHandlers) GetFolder(
 		return generated.GetFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	ownerID, err := h.folderOwner(userID, uint(request.Id), false)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == "" {
+		return generated.GetFolder404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(ownerID, uint(request.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +224,28 @@ func (h *StrictHandlers) GetFolder(
 		return generated.GetFolder404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
 	}
 
-	return generated.GetFolder200JSONResponse(folderModelToGenerated(folder)), nil
+	result := []generated.Folder{folderModelToGenerated(folder)}
+	if err := h.setChildCounts(ownerID, result); err != nil {
+		return nil, err
+	}
+	return generated.GetFolder200JSONResponse(result[0]), nil
+}
+
+// setChildCounts fills in the subfolder count of each folder
+func (h *StrictHandlers) setChildCounts(ownerID string, folders []generated.Folder) error {
+	ids := make([]uint, len(folders))
+	for i, folder := range folders {
+		ids[i] = uint(folder.Id)
+	}
+
+	counts, err := h.folderService.CountChildren(ownerID, ids)
+	if err != nil {
+		return err
+	}
+	for i := range folders {
+		folders[i].ChildCount = ptr(int(counts[uint(folders[i].Id)]))
+	}
+	return nil
 }
 
 // UpdateFolder implements generated.StrictServerInterface
@@ -127,6 +261,9 @@ func (h *StrictHandlers) UpdateFolder(
 	if request.Body == nil {
 		return generated.UpdateFolder400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
 	}
+	if invalid := validateUpdateFolderRequest(request.Body); invalid != nil {
+		return generated.UpdateFolder400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
 
 	// Get existing folder
 	existing, err := h.folderService.GetFolderByID(userID, uint(request.Id))
@@ -137,6 +274,11 @@ func (h *StrictHandlers) UpdateFolder(
 		return generated.UpdateFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
+	oldPath, err := h.aliasSourcePath(userID, existing.ID, request.Body.KeepAlias)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update fields
 	if request.Body.Name != nil {
 		existing.Name = *request.Body.Name
@@ -144,11 +286,18 @@ func (h *StrictHandlers) UpdateFolder(
 	if request.Body.Description != nil {
 		existing.Description = *request.Body.Description
 	}
+	if request.Body.S3Prefix != nil {
+		existing.S3Prefix, _ = services.NormalizeS3Prefix(*request.Body.S3Prefix)
+	}
 
 	if err := h.folderService.UpdateFolder(userID, existing); err != nil {
 		return generated.UpdateFolder400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
+	if err := h.keepFolderAlias(userID, existing.ID, oldPath); err != nil {
+		return nil, err
+	}
+
 	// Fetch updated folder
 	updated, err := h.folderService.GetFolderByID(userID, uint(request.Id))
 	if err != nil {
@@ -168,25 +317,234 @@ func (h *StrictHandlers) DeleteFolder(
 		return generated.DeleteFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	// Get all files in folder recursively before deletion (for S3 cleanup)
-	filesToCleanup, _ := h.fileService.GetFilesInFolderRecursive(userID, uint(request.Id))
+	excludeFolderIDs := parseIDList(deref(request.Params.ExcludeFolderIds))
 
-	// Delete folder from database (cascade deletes files in DB)
-	if err := h.folderService.DeleteFolder(userID, uint(request.Id)); err != nil {
+	// Soft-delete the folder tree. S3 objects and embeddings are kept so the
+	// folder can be restored.
+	if err := h.folderService.DeleteFolder(userID, uint(request.Id), excludeFolderIDs); err != nil {
+		if errors.Is(err, services.ErrExcludeDeletedFolder) {
+			return generated.DeleteFolder400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
 		return generated.DeleteFolder404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
-	// Clean up S3 objects and embeddings for all files (best effort)
-	for _, file := range filesToCleanup {
-		if file.S3Key != "" {
-			_ = h.uploadService.DeleteFile(ctx, file.S3Key)
+	return generated.DeleteFolder204Response{}, nil
+}
+
+// RestoreFolder implements generated.StrictServerInterface
+func (h *StrictHandlers) RestoreFolder(
+	ctx context.Context,
+	request generated.RestoreFolderRequestObject,
+) (generated.RestoreFolderResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RestoreFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	folder, err := h.folderService.RestoreFolder(userID, uint(request.Id))
+	if err != nil {
+		if errors.Is(err, services.ErrFolderNotDeleted) || errors.Is(err, services.ErrFolderDepthExceeded) {
+			return generated.RestoreFolder400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 		}
-		if file.HasEmbedding {
-			_ = h.embeddingService.DeleteFileEmbedding(userID, file.ID)
+		return nil, err
+	}
+	if folder == nil {
+		return generated.RestoreFolder404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	return generated.RestoreFolder200JSONResponse(folderModelToGenerated(folder)), nil
+}
+
+// GetFolderDeletePreview implements generated.StrictServerInterface
+func (h *StrictHandlers) GetFolderDeletePreview(
+	ctx context.Context,
+	request generated.GetFolderDeletePreviewRequestObject,
+) (generated.GetFolderDeletePreviewResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetFolderDeletePreview401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	folderID := uint(request.Id)
+	folder, err := h.folderService.GetFolderByID(userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.GetFolderDeletePreview404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	excludeFolderIDs := parseIDList(deref(request.Params.ExcludeFolderIds))
+	excluded := make(map[uint]bool, len(excludeFolderIDs))
+	for _, id := range excludeFolderIDs {
+		excluded[id] = true
+	}
+
+	subfolders, err := h.folderService.GetFolderTree(userID, &folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := h.fileService.GetFilesInFolderRecursive(userID, folderID, services.RecursiveFileOptions{ExcludeFolderIDs: excludeFolderIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	var totalSize int64
+	for _, file := range files {
+		totalSize += file.Size
+	}
+
+	return generated.GetFolderDeletePreview200JSONResponse{
+		FolderId:       int(folderID),
+		SubfolderCount: countFolderTree(subfolders, excluded),
+		FileCount:      len(files),
+		TotalSize:      totalSize,
+	}, nil
+}
+
+const (
+	// maxFolderDownloadFiles caps the number of files in a folder download
+	maxFolderDownloadFiles = 1000
+	// maxFolderDownloadBytes caps the total size of a folder download
+	maxFolderDownloadBytes = 2 << 30
+)
+
+// DownloadFolder implements generated.StrictServerInterface
+// This handler streams a ZIP file of the folder, preserving the subfolder layout
+func (h *StrictHandlers) DownloadFolder(
+	ctx context.Context,
+	request generated.DownloadFolderRequestObject,
+) (generated.DownloadFolderResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.DownloadFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+	if utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+		return generated.DownloadFolder403JSONResponse{ForbiddenJSONResponse: forbidden(maskedContentMessage)}, nil
+	}
+
+	folderID := uint(request.Id)
+	folder, err := h.folderService.GetFolderByID(userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.DownloadFolder404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	// Archive paths of the folder and, when recursive, its subfolders
+	folderPaths := map[uint]string{folderID: zipPathSegment(folder.Name)}
+
+	var files []models.File
+	if request.Params.Recursive != nil && *request.Params.Recursive {
+		files, err = h.fileService.GetFilesInFolderRecursive(userID, folderID, services.RecursiveFileOptions{
+			ExcludeFolderIDs: parseIDList(deref(request.Params.ExcludeFolderIds)),
+			ExcludeDirect:    deref(request.Params.ExcludeDirect),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		subfolders, err := h.folderService.GetFolderTree(userID, &folderID)
+		if err != nil {
+			return nil, err
+		}
+		addZipFolderPaths(folderPaths, folderPaths[folderID], subfolders)
+	} else {
+		files, _, err = h.fileService.ListFiles(userID, services.FileListOptions{
+			FolderID: &folderID,
+			Limit:    maxFolderDownloadFiles + 1,
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return generated.DeleteFolder204Response{}, nil
+	if len(files) > maxFolderDownloadFiles {
+		return generated.DownloadFolder400JSONResponse{
+			BadRequestJSONResponse: badRequest(fmt.Sprintf("Folder has more than %d files; download it in parts", maxFolderDownloadFiles)),
+		}, nil
+	}
+
+	var totalSize int64
+	for _, file := range files {
+		totalSize += file.Size
+	}
+	if totalSize > maxFolderDownloadBytes {
+		return generated.DownloadFolder400JSONResponse{
+			BadRequestJSONResponse: badRequest(fmt.Sprintf("Folder is larger than %d bytes; download it in parts", int64(maxFolderDownloadBytes))),
+		}, nil
+	}
+
+	seen := make(map[string]int)
+	entries := make([]zipEntry, len(files))
+	for i, file := range files {
+		dir := folderPaths[folderID]
+		if file.FolderID != nil {
+			if p, ok := folderPaths[*file.FolderID]; ok {
+				dir = p
+			}
+		}
+		entries[i] = zipEntry{
+			path:  uniqueZipPath(seen, path.Join(dir, zipPathSegment(file.OriginalFilename))),
+			s3Key: file.S3Key,
+		}
+	}
+
+	return generated.DownloadFolder200ApplicationzipResponse{
+		Body:          h.streamZip(ctx, entries),
+		ContentLength: 0, // Unknown length for streaming
+	}, nil
+}
+
+// addZipFolderPaths records the archive path of every folder in the tree
+func addZipFolderPaths(paths map[uint]string, parentPath string, folders []models.Folder) {
+	for _, folder := range folders {
+		folderPath := path.Join(parentPath, zipPathSegment(folder.Name))
+		paths[folder.ID] = folderPath
+		addZipFolderPaths(paths, folderPath, folder.Children)
+	}
+}
+
+// maxZipSegmentLength bounds each archive path segment in bytes. Many
+// filesystems and ZIP tools reject names over 255 bytes; the slack leaves room
+// for the " (n)" uniqueZipPath may add.
+const maxZipSegmentLength = 240
+
+// zipPathSegment makes a folder or file name safe to use as one archive path
+// segment, truncating long names but keeping their extension
+func zipPathSegment(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return services.TruncateFilename(name, maxZipSegmentLength)
+}
+
+// uniqueZipPath appends " (n)" before the extension when p is already in the archive
+func uniqueZipPath(seen map[string]int, p string) string {
+	count := seen[p]
+	seen[p] = count + 1
+	if count == 0 {
+		return p
+	}
+
+	ext := path.Ext(p)
+	return fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(p, ext), count, ext)
+}
+
+// countFolderTree counts all folders in a tree, including nested children,
+// leaving out excluded folders and their subtrees
+func countFolderTree(folders []models.Folder, excluded map[uint]bool) int {
+	count := 0
+	for _, folder := range folders {
+		if excluded[folder.ID] {
+			continue
+		}
+		count += 1 + countFolderTree(folder.Children, excluded)
+	}
+	return count
 }
 
 // MoveFolder implements generated.StrictServerInterface
@@ -209,9 +567,20 @@ func (h *StrictHandlers) MoveFolder(
 		newParentID = &pid
 	}
 
-	if err := h.folderService.MoveFolder(userID, uint(request.Id), newParentID); err != nil {
+	oldPath, err := h.aliasSourcePath(userID, uint(request.Id), request.Body.KeepAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, err := h.folderService.MoveFolder(userID, uint(request.Id), newParentID)
+	if err != nil {
 		return generated.MoveFolder400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
+	if moved {
+		if err := h.keepFolderAlias(userID, uint(request.Id), oldPath); err != nil {
+			return nil, err
+		}
+	}
 
 	// Fetch updated folder
 	updated, err := h.folderService.GetFolderByID(userID, uint(request.Id))
@@ -238,12 +607,228 @@ func (h *StrictHandlers) GetFolderTree(
 		parentID = &pid
 	}
 
+	order := services.FolderTreeSortName
+	if request.Params.Sort != nil {
+		order = services.FolderTreeSort(*request.Params.Sort)
+	}
+	var errs fieldErrors
+	errs.options(services.ValidateFolderTreeSort(order))
+	if resp := errs.response(); resp != nil {
+		return generated.GetFolderTree400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
 	folders, err := h.folderService.GetFolderTree(userID, parentID)
 	if err != nil {
 		return nil, err
 	}
 
-	return generated.GetFolderTree200JSONResponse(folderListToTreeGenerated(folders)), nil
+	withCounts := request.Params.WithCounts != nil && *request.Params.WithCounts
+	if !withCounts && order == services.FolderTreeSortName {
+		return generated.GetFolderTree200JSONResponse(folderListToTreeGenerated(folders)), nil
+	}
+
+	counts, err := h.folderService.CountTreeFiles(userID, folders)
+	if err != nil {
+		return nil, err
+	}
+	services.SortFolderTree(folders, counts, order)
+
+	tree := folderListToTreeGenerated(folders)
+	if withCounts {
+		setFolderTreeCounts(tree, counts)
+	}
+	return generated.GetFolderTree200JSONResponse(tree), nil
+}
+
+// ExpandFolderTree implements generated.StrictServerInterface
+func (h *StrictHandlers) ExpandFolderTree(
+	ctx context.Context,
+	request generated.ExpandFolderTreeRequestObject,
+) (generated.ExpandFolderTreeResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ExpandFolderTree401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.ExpandFolderTree400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if invalid := validateExpandFolderTreeRequest(request.Body); invalid != nil {
+		return generated.ExpandFolderTree400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+
+	seen := make(map[uint]bool, len(request.Body.FolderIds))
+	parentIDs := make([]uint, 0, len(request.Body.FolderIds))
+	for _, id := range request.Body.FolderIds {
+		if !seen[uint(id)] {
+			seen[uint(id)] = true
+			parentIDs = append(parentIDs, uint(id))
+		}
+	}
+
+	// The user's own folders expand with one query
+	expanded, err := h.folderService.ExpandFolders(userID, parentIDs)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[uint]string, len(parentIDs))
+	for id := range expanded {
+		owners[id] = userID
+	}
+
+	// Folders shared with the user expand in their owner's data
+	shared := make(map[string][]uint)
+	for _, id := range parentIDs {
+		if _, ok := expanded[id]; ok {
+			continue
+		}
+		ownerID, err := h.folderOwner(userID, id, false)
+		if err != nil {
+			return nil, err
+		}
+		if ownerID != "" {
+			shared[ownerID] = append(shared[ownerID], id)
+		}
+	}
+	for ownerID, ids := range shared {
+		branches, err := h.folderService.ExpandFolders(ownerID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for id, children := range branches {
+			expanded[id] = children
+			owners[id] = ownerID
+		}
+	}
+
+	var rootChildren []models.Folder
+	if deref(request.Body.IncludeRoot) {
+		if rootChildren, _, err = h.folderService.ListFolders(userID, services.FolderListOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Child counts take one query per owner
+	childIDs := make(map[string][]uint)
+	for _, child := range rootChildren {
+		childIDs[userID] = append(childIDs[userID], child.ID)
+	}
+	for id, children := range expanded {
+		for _, child := range children {
+			childIDs[owners[id]] = append(childIDs[owners[id]], child.ID)
+		}
+	}
+	counts := make(map[uint]int64)
+	for ownerID, ids := range childIDs {
+		ownerCounts, err := h.folderService.CountChildren(ownerID, ids)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(counts, ownerCounts)
+	}
+	branch := func(parentID *int, folders []models.Folder) generated.ExpandedFolder {
+		children := folderListToGenerated(folders)
+		for i := range children {
+			children[i].ChildCount = ptr(int(counts[uint(children[i].Id)]))
+		}
+		return generated.ExpandedFolder{ParentId: parentID, Children: children}
+	}
+
+	resp := generated.ExpandFolderTree200JSONResponse{
+		Data:        make([]generated.ExpandedFolder, 0, len(expanded)+1),
+		NotFoundIds: []int{},
+	}
+	if deref(request.Body.IncludeRoot) {
+		resp.Data = append(resp.Data, branch(nil, rootChildren))
+	}
+	for _, id := range parentIDs {
+		folders, ok := expanded[id]
+		if !ok {
+			resp.NotFoundIds = append(resp.NotFoundIds, int(id))
+			continue
+		}
+		resp.Data = append(resp.Data, branch(ptr(int(id)), folders))
+	}
+	return resp, nil
+}
+
+// ListFolderDescendants implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFolderDescendants(
+	ctx context.Context,
+	request generated.ListFolderDescendantsRequestObject,
+) (generated.ListFolderDescendantsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFolderDescendants401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	ownerID, err := h.folderOwner(userID, uint(request.Id), false)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == "" {
+		return generated.ListFolderDescendants404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	descendants, err := h.folderService.ListDescendants(ownerID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]generated.FolderDescendant, len(descendants))
+	for i := range descendants {
+		data[i] = generated.FolderDescendant{
+			Folder: folderModelToGenerated(&descendants[i].Folder),
+			Depth:  descendants[i].Depth,
+		}
+	}
+	return generated.ListFolderDescendants200JSONResponse{Data: data}, nil
+}
+
+// setFolderTreeCounts fills in the file counts of every node
+func setFolderTreeCounts(tree []generated.FolderTree, counts map[uint]services.FolderFileCounts) {
+	for i := range tree {
+		c := counts[uint(tree[i].Id)]
+		tree[i].FileCount = ptr(int(c.Direct))
+		tree[i].TotalFileCount = ptr(int(c.Total))
+		if tree[i].Children != nil {
+			setFolderTreeCounts(*tree[i].Children, counts)
+		}
+	}
+}
+
+// ListFolderTags implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFolderTags(
+	ctx context.Context,
+	request generated.ListFolderTagsRequestObject,
+) (generated.ListFolderTagsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFolderTags401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.ListFolderTags404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	counts, err := h.tagService.ListFolderTags(userID, folder.ID, deref(request.Params.Recursive))
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]generated.FolderTagCount, len(counts))
+	for i, count := range counts {
+		data[i] = generated.FolderTagCount{
+			Tag:       tagModelToGenerated(&count.Tag),
+			FileCount: int(count.FileCount),
+		}
+	}
+
+	return generated.ListFolderTags200JSONResponse{Data: data}, nil
 }
 
 // AddTagsToFolder implements generated.StrictServerInterface
@@ -311,3 +896,73 @@ func (h *StrictHandlers) RemoveTagsFromFolder(
 
 	return generated.RemoveTagsFromFolder200JSONResponse(folderModelToGenerated(updated)), nil
 }
+
+// AddFileLinks implements generated.StrictServerInterface
+func (h *StrictHandlers) AddFileLinks(
+	ctx context.Context,
+	request generated.AddFileLinksRequestObject,
+) (generated.AddFileLinksResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.AddFileLinks401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.AddFileLinks400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.AddFileLinks404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	// Convert file IDs
+	fileIDs := make([]uint, len(request.Body.FileIds))
+	for i, id := range request.Body.FileIds {
+		fileIDs[i] = uint(id)
+	}
+
+	if err := h.folderService.AddFileLinks(userID, folder.ID, fileIDs); err != nil {
+		return generated.AddFileLinks400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.AddFileLinks204Response{}, nil
+}
+
+// RemoveFileLinks implements generated.StrictServerInterface
+func (h *StrictHandlers) RemoveFileLinks(
+	ctx context.Context,
+	request generated.RemoveFileLinksRequestObject,
+) (generated.RemoveFileLinksResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RemoveFileLinks401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.RemoveFileLinks400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.RemoveFileLinks404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	// Convert file IDs
+	fileIDs := make([]uint, len(request.Body.FileIds))
+	for i, id := range request.Body.FileIds {
+		fileIDs[i] = uint(id)
+	}
+
+	if err := h.folderService.RemoveFileLinks(userID, folder.ID, fileIDs); err != nil {
+		return generated.RemoveFileLinks400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.RemoveFileLinks204Response{}, nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import "errors"
+
+// errUploadFolderNotFound is returned for an upload into a folder the user
+// can't write to
+var errUploadFolderNotFound = errors.New("folder not found")
+
+// uploadS3Prefix returns the key prefix for an upload into folderID. Objects
+// always live in the uploader's storage, so folders shared by other users
+// don't apply their prefix.
+func (h *StrictHandlers) uploadS3Prefix(userID string, folderID *int) (string, error) {
+	if folderID == nil {
+		return "", nil
+	}
+
+	ownerID, err := h.folderOwner(userID, uint(*folderID), true)
+	if err != nil {
+		return "", err
+	}
+	if ownerID == "" {
+		return "", errUploadFolderNotFound
+	}
+	if ownerID != userID {
+		return "", nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(*folderID))
+	if err != nil || folder == nil {
+		return "", err
+	}
+	return folder.S3Prefix, nil
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// isFoldingRuleConflict reports whether err describes a rule the user can
+// fix, as opposed to an internal failure
+func isFoldingRuleConflict(err error) bool {
+	return errors.Is(err, services.ErrFoldingRuleExists) ||
+		errors.Is(err, services.ErrFoldingRuleTagNotFound) ||
+		errors.Is(err, services.ErrFoldingRuleFolderNotFound)
+}
+
+// ListFoldingRules implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFoldingRules(
+	ctx context.Context,
+	request generated.ListFoldingRulesRequestObject,
+) (generated.ListFoldingRulesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFoldingRules401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	rules, err := h.tagService.ListFoldingRules(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]generated.FoldingRule, len(rules))
+	for i := range rules {
+		data[i] = foldingRuleModelToGenerated(&rules[i])
+	}
+	return generated.ListFoldingRules200JSONResponse{Data: data}, nil
+}
+
+// CreateFoldingRule implements generated.StrictServerInterface
+func (h *StrictHandlers) CreateFoldingRule(
+	ctx context.Context,
+	request generated.CreateFoldingRuleRequestObject,
+) (generated.CreateFoldingRuleResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CreateFoldingRule401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.CreateFoldingRule400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validateCreateFoldingRuleRequest(request.Body); resp != nil {
+		return generated.CreateFoldingRule400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	rule, err := h.tagService.CreateFoldingRule(userID, uint(request.Body.TagId), uint(request.Body.TargetFolderId))
+	if err != nil {
+		if isFoldingRuleConflict(err) {
+			return generated.CreateFoldingRule400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		return nil, err
+	}
+
+	return generated.CreateFoldingRule201JSONResponse(foldingRuleModelToGenerated(rule)), nil
+}
+
+// UpdateFoldingRule implements generated.StrictServerInterface
+func (h *StrictHandlers) UpdateFoldingRule(
+	ctx context.Context,
+	request generated.UpdateFoldingRuleRequestObject,
+) (generated.UpdateFoldingRuleResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.UpdateFoldingRule401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.UpdateFoldingRule400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validateUpdateFoldingRuleRequest(request.Body); resp != nil {
+		return generated.UpdateFoldingRule400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	rule, err := h.tagService.UpdateFoldingRule(userID, uint(request.Id), uint(request.Body.TargetFolderId))
+	if err != nil {
+		if isFoldingRuleConflict(err) {
+			return generated.UpdateFoldingRule400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		return nil, err
+	}
+	if rule == nil {
+		return generated.UpdateFoldingRule404JSONResponse{NotFoundJSONResponse: notFound("Folding rule not found")}, nil
+	}
+
+	return generated.UpdateFoldingRule200JSONResponse(foldingRuleModelToGenerated(rule)), nil
+}
+
+// DeleteFoldingRule implements generated.StrictServerInterface
+func (h *StrictHandlers) DeleteFoldingRule(
+	ctx context.Context,
+	request generated.DeleteFoldingRuleRequestObject,
+) (generated.DeleteFoldingRuleResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.DeleteFoldingRule401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	deleted, err := h.tagService.DeleteFoldingRule(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return generated.DeleteFoldingRule404JSONResponse{NotFoundJSONResponse: notFound("Folding rule not found")}, nil
+	}
+
+	return generated.DeleteFoldingRule204Response{}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/services"
@@ -31,6 +33,15 @@ type StrictHandlers struct {
 	summaryService       services.SummaryService
 	agentService         services.AgentService
 	invoiceService       services.InvoiceService
+	reembedService       services.ReembedService
+	autoTagService       services.AutoTagService
+	reassignService      services.ReassignService
+	statsService         services.StatsService
+	processingGate       *services.ProcessingGate
+	parserJobService     services.ParserJobService
+	placement            *services.FilePlacement
+	searchCache          *services.SearchCache
+	pagination           PaginationConfig
 }
 
 // NewStrictHandlers creates a new StrictHandlers instance
@@ -45,6 +56,13 @@ func NewStrictHandlers(
 	summaryService services.SummaryService,
 	agentService services.AgentService,
 	invoiceService services.InvoiceService,
+	reembedService services.ReembedService,
+	autoTagService services.AutoTagService,
+	reassignService services.ReassignService,
+	statsService services.StatsService,
+	processingGate *services.ProcessingGate,
+	parserJobService services.ParserJobService,
+	pagination PaginationConfig,
 ) *StrictHandlers {
 	return &StrictHandlers{
 		tagService:           tagService,
@@ -57,6 +75,15 @@ func NewStrictHandlers(
 		summaryService:       summaryService,
 		agentService:         agentService,
 		invoiceService:       invoiceService,
+		reembedService:       reembedService,
+		autoTagService:       autoTagService,
+		reassignService:      reassignService,
+		statsService:         statsService,
+		processingGate:       processingGate,
+		parserJobService:     parserJobService,
+		placement:            services.NewFilePlacement(fileService, folderService, uploadService),
+		searchCache:          services.NewSearchCache(services.DefaultSearchCacheSize, services.DefaultSearchCacheTTL),
+		pagination:           pagination.withDefaults(),
 	}
 }
 
@@ -91,6 +118,27 @@ func derefInt(p *int, defaultVal int) int {
 	return *p
 }
 
+// uintsToInts converts model IDs to the int IDs used by the generated types
+func uintsToInts(ids []uint) []int {
+	result := make([]int, len(ids))
+	for i, id := range ids {
+		result[i] = int(id)
+	}
+	return result
+}
+
+// parseIDList parses a comma-separated list of IDs, skipping invalid entries
+func parseIDList(value string) []uint {
+	var ids []uint
+	for _, idStr := range strings.Split(value, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
+		if err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids
+}
+
 // Error response helpers
 
 func unauthorized() generated.UnauthorizedJSONResponse {
@@ -105,6 +153,10 @@ func notFound(msg string) generated.NotFoundJSONResponse {
 	return generated.NotFoundJSONResponse{Error: msg}
 }
 
+func forbidden(msg string) generated.ForbiddenJSONResponse {
+	return generated.ForbiddenJSONResponse{Error: msg}
+}
+
 // GetAgentStatus returns the status of the AI agent service
 func (h *StrictHandlers) GetAgentStatus(
 	ctx context.Context,
@@ -116,6 +168,47 @@ func (h *StrictHandlers) GetAgentStatus(
 	}, nil
 }
 
+// GetAgentCapabilities returns the agent's configuration and tool descriptions
+func (h *StrictHandlers) GetAgentCapabilities(
+	ctx context.Context,
+	request generated.GetAgentCapabilitiesRequestObject,
+) (generated.GetAgentCapabilitiesResponseObject, error) {
+	if _, err := getUserID(ctx); err != nil {
+		return generated.GetAgentCapabilities401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if h.agentService == nil {
+		return generated.GetAgentCapabilities200JSONResponse{
+			FileTools:   []generated.AgentToolInfo{},
+			FolderTools: []generated.AgentToolInfo{},
+		}, nil
+	}
+
+	caps := h.agentService.Capabilities()
+	return generated.GetAgentCapabilities200JSONResponse{
+		Enabled:          caps.Enabled,
+		Model:            caps.Model,
+		MaxTurns:         caps.MaxTurns,
+		DryRunSupported:  caps.DryRunSupported,
+		EmbedBeforeAgent: ptr(caps.EmbedBeforeAgent),
+		FileTools:        agentToolsToGenerated(caps.FileTools),
+		FolderTools:      agentToolsToGenerated(caps.FolderTools),
+	}, nil
+}
+
+// agentToolsToGenerated converts agent tool descriptions to the generated type
+func agentToolsToGenerated(tools []services.AgentToolInfo) []generated.AgentToolInfo {
+	result := make([]generated.AgentToolInfo, len(tools))
+	for i, tool := range tools {
+		result[i] = generated.AgentToolInfo{
+			Name:        tool.Name,
+			Description: tool.Description,
+			Parameters:  tool.Parameters,
+		}
+	}
+	return result
+}
+
 // OrganizeFile triggers the AI agent to organize a file
 func (h *StrictHandlers) OrganizeFile(
 	ctx context.Context,
@@ -157,3 +250,13 @@ func (h *StrictHandlers) StreamAgentProgress(
 	// Return 503 as this strict handler cannot handle SSE
 	return nil, errors.New("SSE endpoints should use direct Fiber handlers")
 }
+
+// StreamFiles streams files as NDJSON (streaming endpoint - needs special handling)
+func (h *StrictHandlers) StreamFiles(
+	ctx context.Context,
+	request generated.StreamFilesRequestObject,
+) (generated.StreamFilesResponseObject, error) {
+	// The actual implementation is in FileStreamHandlers.StreamFiles, which
+	// writes directly to the connection instead of buffering the response
+	return nil, errors.New("streaming endpoints should use direct Fiber handlers")
+}
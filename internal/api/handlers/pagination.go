@@ -0,0 +1,56 @@
+package handlers
+
+const (
+	// DefaultListPageSize is the default limit of the file, folder and tag lists
+	DefaultListPageSize = 100
+	// DefaultMaxListPageSize is the largest limit the file, folder and tag lists accept
+	DefaultMaxListPageSize = 1000
+	// DefaultSearchPageSize is the default limit of search results
+	DefaultSearchPageSize = 20
+	// DefaultMaxSearchPageSize is the largest limit search accepts
+	DefaultMaxSearchPageSize = 100
+)
+
+// PageSize is the default and maximum number of items an endpoint returns
+type PageSize struct {
+	Default int
+	Max     int
+}
+
+// PaginationConfig holds the page sizes of the list endpoints.
+// Zero values fall back to the package defaults.
+type PaginationConfig struct {
+	Files   PageSize
+	Folders PageSize
+	Tags    PageSize
+	Search  PageSize
+}
+
+// withDefaults fills in unset page sizes
+func (c PaginationConfig) withDefaults() PaginationConfig {
+	c.Files = c.Files.withDefaults(DefaultListPageSize, DefaultMaxListPageSize)
+	c.Folders = c.Folders.withDefaults(DefaultListPageSize, DefaultMaxListPageSize)
+	c.Tags = c.Tags.withDefaults(DefaultListPageSize, DefaultMaxListPageSize)
+	c.Search = c.Search.withDefaults(DefaultSearchPageSize, DefaultMaxSearchPageSize)
+	return c
+}
+
+func (p PageSize) withDefaults(defaultSize, maxSize int) PageSize {
+	if p.Max <= 0 {
+		p.Max = maxSize
+	}
+	if p.Default <= 0 {
+		p.Default = defaultSize
+	}
+	p.Default = min(p.Default, p.Max)
+	return p
+}
+
+// limit returns the effective limit for a request: the default when unset or
+// not positive, clamped to the maximum
+func (p PageSize) limit(requested *int) int {
+	if requested == nil || *requested <= 0 {
+		return p.Default
+	}
+	return min(*requested, p.Max)
+}
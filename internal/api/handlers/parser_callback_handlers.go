@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// submitParseJob hands the file to the async content parser. The file fails
+// processing when the job can't be submitted.
+func submitParseJob(
+	ctx context.Context,
+	parserJobService services.ParserJobService,
+	contentParserService services.ContentParserService,
+	fileService services.FileService,
+	userID string,
+	fileID uint,
+	downloadURL string,
+	overrides processingModels,
+) error {
+	job, err := parserJobService.CreateJob(userID, fileID, overrides.summaryModel, overrides.agentModel)
+	if err != nil {
+		failParseJob(fileService, userID, fileID, "Failed to create parse job: "+err.Error())
+		return err
+	}
+
+	if err := contentParserService.SubmitParseJob(ctx, downloadURL, job.Token); err != nil {
+		// Drop the job so a late callback for it is rejected
+		if _, claimErr := parserJobService.ClaimJob(job.Token); claimErr != nil {
+			log.Printf("[Processing] File %d: failed to drop parse job: %v", fileID, claimErr)
+		}
+		failParseJob(fileService, userID, fileID, "Failed to submit parse job: "+err.Error())
+		return err
+	}
+	return nil
+}
+
+// failParseJob fails the processing of a file whose parse job couldn't be
+// started
+func failParseJob(fileService services.FileService, userID string, fileID uint, message string) {
+	if err := fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusFailed, message); err != nil {
+		log.Printf("[Processing] File %d: failed to mark processing failed: %v", fileID, err)
+	}
+}
+
+// ParserCallback implements generated.StrictServerInterface. It is called by
+// an async content parser and authenticated by the job token alone.
+func (h *StrictHandlers) ParserCallback(
+	ctx context.Context,
+	request generated.ParserCallbackRequestObject,
+) (generated.ParserCallbackResponseObject, error) {
+	if request.Body == nil {
+		return generated.ParserCallback400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validateParserCallbackRequest(request.Body); resp != nil {
+		return generated.ParserCallback400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	job, err := h.parserJobService.ClaimJob(request.Body.JobToken)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return generated.ParserCallback404JSONResponse{NotFoundJSONResponse: notFound("Parse job not found")}, nil
+	}
+	result := generated.ParserCallbackResult{FileId: int(job.FileID)}
+
+	// The run may have been canceled or swept while the parser worked
+	file, err := h.fileService.GetFileByID(job.UserID, job.FileID)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil || file.ProcessingStatus != models.FileStatusProcessing {
+		return generated.ParserCallback202JSONResponse(result), nil
+	}
+
+	if parseErr := deref(request.Body.Error); parseErr != "" {
+		h.fileService.UpdateFileProcessingStatus(job.UserID, job.FileID, models.FileStatusFailed, "Failed to parse content: "+parseErr)
+		recordProcessingMetrics(h.fileService, job.UserID, job.FileID, job.CreatedAt)
+		return generated.ParserCallback202JSONResponse(result), nil
+	}
+
+	go h.resumeProcessing(job, file, deref(request.Body.Content))
+
+	result.Resumed = true
+	return generated.ParserCallback202JSONResponse(result), nil
+}
+
+// resumeProcessing finishes a run with the content posted by an async parser.
+// The user's auth token isn't kept with the job, so there is no invoice step;
+// async mode is only enabled while invoice processing is off.
+func (h *StrictHandlers) resumeProcessing(job *models.ParserJob, file *models.File, content string) {
+	ctx := context.Background()
+
+	// Hold the run while an operator has paused processing
+	if err := h.processingGate.Wait(ctx); err != nil {
+		return
+	}
+
+	defer recordProcessingMetrics(h.fileService, job.UserID, job.FileID, job.CreatedAt)
+
+	overrides := processingModels{summaryModel: job.SummaryModel, agentModel: job.AgentModel}
+	h.completeProcessing(ctx, job.UserID, file, "", "", content, overrides)
+}
@@ -3,7 +3,6 @@ package handlers
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -12,6 +11,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
 	"github.com/rxtech-lab/invoice-management/internal/utils"
@@ -26,17 +26,25 @@ type ProcessingHandlers struct {
 	summaryService       services.SummaryService
 	agentService         services.AgentService
 	invoiceService       services.InvoiceService
+	autoTagService       services.AutoTagService
+	processingGate       *services.ProcessingGate
+	parserJobService     services.ParserJobService
+	placement            *services.FilePlacement
 }
 
 // NewProcessingHandlers creates a new ProcessingHandlers instance
 func NewProcessingHandlers(
 	fileService services.FileService,
+	folderService services.FolderService,
 	uploadService services.UploadService,
 	contentParserService services.ContentParserService,
 	embeddingService services.EmbeddingService,
 	summaryService services.SummaryService,
 	agentService services.AgentService,
 	invoiceService services.InvoiceService,
+	autoTagService services.AutoTagService,
+	processingGate *services.ProcessingGate,
+	parserJobService services.ParserJobService,
 ) *ProcessingHandlers {
 	return &ProcessingHandlers{
 		fileService:          fileService,
@@ -46,11 +54,25 @@ func NewProcessingHandlers(
 		summaryService:       summaryService,
 		agentService:         agentService,
 		invoiceService:       invoiceService,
+		autoTagService:       autoTagService,
+		processingGate:       processingGate,
+		parserJobService:     parserJobService,
+		placement:            services.NewFilePlacement(fileService, folderService, uploadService),
 	}
 }
 
+// processingModels overrides the configured AI models for a single processing run.
+// Empty fields fall back to the service defaults.
+type processingModels struct {
+	summaryModel string
+	agentModel   string
+}
+
+// streamProcessingTimeout bounds a streamed processing run
+const streamProcessingTimeout = 10 * time.Minute
+
 // StreamFileProcessing handles SSE streaming of file processing
-// GET /api/files/:id/process-stream
+// GET /api/files/:id/process-stream?summary_model=&agent_model=
 func (h *ProcessingHandlers) StreamFileProcessing(c *fiber.Ctx) error {
 	// Get authenticated user
 	user := c.Locals(middleware.AuthenticatedUserContextKey)
@@ -73,6 +95,16 @@ func (h *ProcessingHandlers) StreamFileProcessing(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid file ID"})
 	}
 
+	format, ok := parseEventStreamFormat(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be sse or ndjson"})
+	}
+
+	overrides := processingModels{
+		summaryModel: c.Query("summary_model"),
+		agentModel:   c.Query("agent_model"),
+	}
+
 	// Get file to verify ownership
 	file, err := h.fileService.GetFileByID(userID, uint(fileID))
 	if err != nil || file == nil {
@@ -89,15 +121,11 @@ func (h *ProcessingHandlers) StreamFileProcessing(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update status"})
 	}
 
-	// Set SSE headers
-	c.Set("Content-Type", "text/event-stream")
-	c.Set("Cache-Control", "no-cache")
-	c.Set("Connection", "keep-alive")
-	c.Set("Transfer-Encoding", "chunked")
-	c.Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	// Set streaming headers
+	format.setHeaders(c)
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), streamProcessingTimeout)
 
 	// Create event channel
 	eventChan := make(chan services.ProcessingEvent, 100)
@@ -105,7 +133,7 @@ func (h *ProcessingHandlers) StreamFileProcessing(c *fiber.Ctx) error {
 	// Run processing in goroutine
 	go func() {
 		defer close(eventChan)
-		h.processFileWithEvents(ctx, userID, uint(fileID), authToken, eventChan)
+		h.processFileWithEvents(ctx, userID, uint(fileID), authToken, overrides, eventChan)
 	}()
 
 	// Stream events to client
@@ -113,26 +141,26 @@ func (h *ProcessingHandlers) StreamFileProcessing(c *fiber.Ctx) error {
 		defer cancel()
 
 		// Send initial connection event
-		sendEvent(w, services.NewProcessingEvent("system", "connected", "Connected to processing stream", uint(fileID)))
+		format.write(w, services.NewProcessingEvent("system", "connected", "Connected to processing stream", uint(fileID)))
 
 		for {
 			select {
 			case event, ok := <-eventChan:
 				if !ok {
 					// Channel closed, processing complete
-					sendEvent(w, services.NewProcessingEvent("system", "done", "Processing complete", uint(fileID)))
+					format.write(w, services.NewProcessingEvent("system", "done", "Processing complete", uint(fileID)))
 					return
 				}
-				sendEvent(w, event)
+				format.write(w, event)
 
 				// If error, we're done
 				if event.Type == "error" {
-					sendEvent(w, services.NewProcessingEvent("system", "done", "Processing finished with error", uint(fileID)))
+					format.write(w, services.NewProcessingEvent("system", "done", "Processing finished with error", uint(fileID)))
 					return
 				}
 
 			case <-ctx.Done():
-				sendEvent(w, services.NewProcessingEvent("system", "error", "Request timeout", uint(fileID)))
+				format.write(w, services.NewProcessingEvent("system", "error", "Request timeout", uint(fileID)))
 				return
 			}
 		}
@@ -141,20 +169,63 @@ func (h *ProcessingHandlers) StreamFileProcessing(c *fiber.Ctx) error {
 	return nil
 }
 
-// sendEvent writes a single SSE event
-func sendEvent(w *bufio.Writer, event services.ProcessingEvent) {
-	data, err := json.Marshal(event)
+// applyAutoTags adds the user's existing tags that are similar to the file
+// embedding and returns the matches that were newly applied. A file folded
+// into another folder by the new tags is placed under that folder's prefix.
+func applyAutoTags(ctx context.Context, autoTagService services.AutoTagService, placement *services.FilePlacement, userID string, fileID uint, embedding []float32) ([]services.TagMatch, error) {
+	matches, err := autoTagService.MatchTags(ctx, userID, embedding)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+
+	tagIDs := make([]uint, len(matches))
+	for i, match := range matches {
+		tagIDs[i] = match.Tag.ID
+	}
+	result, err := placement.AddTagsToFile(ctx, userID, fileID, tagIDs)
 	if err != nil {
-		return
+		return nil, err
+	}
+
+	added := make(map[uint]bool, len(result.Added))
+	for _, id := range result.Added {
+		added[id] = true
 	}
-	fmt.Fprintf(w, "data: %s\n\n", data)
-	w.Flush()
+	var applied []services.TagMatch
+	for _, match := range matches {
+		if added[match.Tag.ID] {
+			applied = append(applied, match)
+		}
+	}
+	return applied, nil
 }
 
-// processFileWithEvents processes a file and emits events to the channel
-func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID string, fileID uint, authToken string, eventChan chan<- services.ProcessingEvent) {
-	var wg sync.WaitGroup
+// recordProcessingMetrics counts a finished processing run under the file's
+// final status and records how long it took
+func recordProcessingMetrics(fileService services.FileService, userID string, fileID uint, start time.Time) {
+	status := "unknown"
+	if file, err := fileService.GetFileByID(userID, fileID); err == nil && file != nil {
+		status = string(file.ProcessingStatus)
+	}
+	metrics.FilesProcessed.WithLabelValues(status).Inc()
+	metrics.ProcessingDuration.WithLabelValues(status).Observe(time.Since(start).Seconds())
+}
 
+// autoTagEventData describes auto-applied tags for a processing event
+func autoTagEventData(applied []services.TagMatch) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(applied))
+	for i, match := range applied {
+		data[i] = map[string]interface{}{
+			"tag_id": match.Tag.ID,
+			"name":   match.Tag.Name,
+			"score":  match.Score,
+		}
+	}
+	return data
+}
+
+// processFileWithEvents processes a file and emits events to the channel
+func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID string, fileID uint, authToken string, overrides processingModels, eventChan chan<- services.ProcessingEvent) {
 	emit := func(source, eventType, message string) {
 		select {
 		case eventChan <- services.NewProcessingEvent(source, eventType, message, fileID):
@@ -163,6 +234,32 @@ func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID s
 		}
 	}
 
+	// Hold the run while an operator has paused processing. A run still held
+	// when the stream ends keeps waiting and is processed once it resumes.
+	if state, err := h.processingGate.State(); err == nil && state.Paused {
+		emit("system", "status", "Processing is paused, waiting for it to resume...")
+	}
+	if err := h.processingGate.Wait(ctx); err != nil {
+		emit("system", "status", "Processing is still paused; the file will be processed once it resumes")
+		if err := h.processingGate.Wait(context.Background()); err != nil {
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), streamProcessingTimeout)
+		defer cancel()
+	}
+
+	// Runs handed to an async parser are counted when their callback finishes them
+	start := time.Now()
+	awaitingParser := false
+	defer func() {
+		if !awaitingParser {
+			recordProcessingMetrics(h.fileService, userID, fileID, start)
+		}
+	}()
+
+	var wg sync.WaitGroup
+
 	// Get file
 	emit("system", "status", "Loading file information...")
 	file, err := h.fileService.GetFileByID(userID, fileID)
@@ -181,6 +278,37 @@ func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID s
 		return
 	}
 
+	// Clients often send a wrong or generic MIME type; correct it from the
+	// file's leading bytes before the file type is used
+	if detected, err := h.contentParserService.DetectContentType(ctx, downloadURL); err != nil {
+		log.Printf("[Processing] File %d: failed to detect content type: %v", fileID, err)
+	} else if err := h.fileService.ApplyDetectedMimeType(userID, file, detected); err != nil {
+		log.Printf("[Processing] File %d: failed to store detected content type: %v", fileID, err)
+	}
+
+	// Encrypted files can't be parsed; fail early with a clear reason
+	emit("system", "status", "Checking for encryption...")
+	if encrypted, err := h.contentParserService.DetectEncryption(ctx, downloadURL); err == nil && encrypted {
+		event := services.NewProcessingEvent("system", "error", services.ErrFileEncrypted.Error(), fileID)
+		event.Data = map[string]string{"code": models.ProcessingErrorFileEncrypted}
+		eventChan <- event
+		h.fileService.FailFileProcessing(userID, fileID, models.ProcessingErrorFileEncrypted, services.ErrFileEncrypted.Error())
+		return
+	}
+
+	// Async parsers post the content back and the run finishes in the
+	// background, after this stream has ended
+	if h.contentParserService.IsAsync() {
+		emit("system", "status", "Submitting file to the content parser...")
+		if err := submitParseJob(ctx, h.parserJobService, h.contentParserService, h.fileService, userID, fileID, downloadURL, overrides); err != nil {
+			emit("system", "error", "Failed to submit parse job: "+err.Error())
+			return
+		}
+		awaitingParser = true
+		emit("system", "status", "Waiting for the content parser; processing continues in the background")
+		return
+	}
+
 	// Parse content
 	emit("system", "status", "Parsing file content...")
 	parsedContent, err := h.contentParserService.ParseFileContent(ctx, downloadURL)
@@ -191,29 +319,56 @@ func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID s
 	}
 	emit("system", "status", "Content parsed successfully")
 
+	// Almost no text would only produce a useless summary and embedding; keep
+	// what was extracted and flag the file for review instead
+	if h.contentParserService.IsContentTooShort(parsedContent.TextContent, file.FileType) {
+		if err := h.fileService.UpdateFileContent(userID, fileID, parsedContent.TextContent, "", false, file.FileType); err != nil {
+			log.Printf("[Processing] File %d: failed to store short content: %v", fileID, err)
+		}
+		event := services.NewProcessingEvent("system", "error", services.ErrContentTooShort.Error(), fileID)
+		event.Data = map[string]string{"code": models.ProcessingErrorContentTooShort}
+		eventChan <- event
+		h.fileService.FlagFileForReview(userID, fileID, models.ProcessingErrorContentTooShort, services.ErrContentTooShort.Error())
+		return
+	}
+
 	// Generate summary
 	emit("system", "status", "Generating summary...")
-	summary, err := h.summaryService.GenerateSummary(ctx, parsedContent.TextContent, 500)
-	if err != nil {
-		summary = services.GenerateSummary(parsedContent.TextContent, 500)
+	summary, err := h.summaryService.GenerateSummary(ctx, parsedContent.TextContent, 500, overrides.summaryModel)
+	summaryIsFallback := err != nil
+	if summaryIsFallback {
+		summary = h.summaryService.FallbackSummary(parsedContent.TextContent)
+		emit("system", "status", "Summary unavailable, using an excerpt: "+err.Error())
+	} else {
+		emit("system", "status", "Summary generated")
 	}
-	emit("system", "status", "Summary generated")
 
-	// Detect file type
+	// Detect file type, unless the user chose it
 	detectedFileType := file.FileType
-	if models.IsInvoiceContent(parsedContent.TextContent) {
+	if !file.FileTypeSetByUser && models.IsInvoiceContent(parsedContent.TextContent) {
 		detectedFileType = models.FileTypeInvoice
 		emit("system", "status", "Detected file as invoice")
 	}
 
 	// Update file with parsed content
 	emit("system", "status", "Saving file content...")
-	if err := h.fileService.UpdateFileContent(userID, fileID, parsedContent.TextContent, summary, detectedFileType); err != nil {
+	if err := h.fileService.UpdateFileContent(userID, fileID, parsedContent.TextContent, summary, summaryIsFallback, detectedFileType); err != nil {
 		emit("system", "error", "Failed to update content: "+err.Error())
 		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusFailed, "Failed to update content: "+err.Error())
 		return
 	}
 
+	// Extract key entities when enabled (best-effort)
+	if entities, err := h.summaryService.ExtractEntities(ctx, parsedContent.TextContent, overrides.summaryModel); err != nil {
+		emit("system", "status", "Entity extraction failed: "+err.Error())
+	} else if entities != nil {
+		if err := h.fileService.UpdateFileEntities(userID, fileID, entities); err != nil {
+			log.Printf("[Processing] File %d: failed to store entities: %v", fileID, err)
+		} else {
+			emit("system", "status", "Entities extracted")
+		}
+	}
+
 	// Process invoice if detected
 	log.Printf("[Invoice] File %d: type=%s, invoiceService=%v, enabled=%v, hasToken=%v",
 		fileID, detectedFileType, h.invoiceService != nil,
@@ -254,7 +409,7 @@ func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID s
 	}
 
 	// Run AI agent
-	if h.agentService != nil && h.agentService.IsEnabled() {
+	runAgent := func() {
 		emit("agent", "status", "Starting AI organization...")
 
 		// Create channel for agent events
@@ -272,7 +427,7 @@ func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID s
 			}
 		}()
 
-		err := h.agentService.ProcessFileWithAgent(ctx, userID, fileID, parsedContent.TextContent, summary, agentEventChan)
+		err := h.agentService.ProcessFileWithAgent(ctx, userID, fileID, parsedContent.TextContent, summary, overrides.agentModel, agentEventChan)
 		close(agentEventChan) // Ensure channel is closed so forwarding goroutine can exit
 		if err != nil {
 			log.Printf("[Agent] File %d processing warning: %v", fileID, err)
@@ -280,25 +435,71 @@ func (h *ProcessingHandlers) processFileWithEvents(ctx context.Context, userID s
 		}
 	}
 
-	// Generate embedding
-	emit("system", "status", "Generating embedding...")
-	embedding, err := h.embeddingService.GenerateEmbedding(ctx, parsedContent.TextContent)
-	if err != nil {
-		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, "Embedding generation failed: "+err.Error())
+	// embedFile returns the file's embedding, or why it couldn't be stored
+	embedFile := func() ([]float32, string) {
+		// Reuse the stored embedding when the content hasn't changed
+		embedding, err := h.embeddingService.GetUnchangedFileEmbedding(userID, fileID, parsedContent.TextContent)
+		if err != nil {
+			log.Printf("[Embedding] File %d: failed to check stored embedding: %v", fileID, err)
+		}
+		if embedding != nil {
+			emit("system", "status", "Embedding unchanged, skipped")
+		} else {
+			// Generate embedding
+			emit("system", "status", "Generating embedding...")
+			embedding, err = h.embeddingService.GenerateEmbedding(ctx, parsedContent.TextContent)
+			if err != nil {
+				return nil, "Embedding generation failed: " + err.Error()
+			}
+
+			// Store embedding
+			emit("system", "status", "Storing embedding...")
+			if err := h.embeddingService.StoreFileEmbedding(userID, fileID, embedding, parsedContent.TextContent); err != nil {
+				return nil, "Embedding storage failed: " + err.Error()
+			}
+		}
+		if err := h.fileService.SetFileHasEmbedding(userID, fileID, true); err != nil {
+			log.Printf("[Embedding] File %d: failed to mark embedding: %v", fileID, err)
+		}
+		return embedding, ""
+	}
+
+	// The agent normally runs first; embedding first lets it find similar files
+	agentEnabled := h.agentService != nil && h.agentService.IsEnabled()
+	embedFirst := agentEnabled && h.agentService.Capabilities().EmbedBeforeAgent
+	if agentEnabled && !embedFirst {
+		runAgent()
+	}
+	embedding, embeddingFailure := embedFile()
+	if embedFirst {
+		runAgent()
+	}
+	if embeddingFailure != "" {
+		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, embeddingFailure)
 		emit("system", "status", "Processing complete (embedding failed)")
+		wg.Wait()
 		return
 	}
 
-	// Store embedding
-	emit("system", "status", "Storing embedding...")
-	if err := h.embeddingService.StoreFileEmbedding(userID, fileID, embedding); err != nil {
-		h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, "Embedding storage failed: "+err.Error())
-		emit("system", "status", "Processing complete (embedding storage failed)")
-		return
+	// Apply similar existing tags (best-effort)
+	if h.autoTagService != nil && h.autoTagService.IsEnabled() {
+		emit("auto_tag", "status", "Matching existing tags...")
+		applied, err := applyAutoTags(ctx, h.autoTagService, h.placement, userID, fileID, embedding)
+		if err != nil {
+			log.Printf("[AutoTag] File %d warning: %v", fileID, err)
+			emit("auto_tag", "error", "Auto-tagging warning: "+err.Error())
+		} else {
+			event := services.NewProcessingEvent("auto_tag", "result", fmt.Sprintf("Auto-applied %d tag(s)", len(applied)), fileID)
+			event.Data = autoTagEventData(applied)
+			select {
+			case eventChan <- event:
+			default:
+			}
+		}
 	}
 
-	// Mark as completed
-	h.fileService.UpdateFileProcessingStatus(userID, fileID, models.FileStatusCompleted, "")
+	// Mark as completed unless processing was canceled in the meantime
+	h.fileService.TransitionStatus(userID, []uint{fileID}, models.FileStatusProcessing, models.FileStatusCompleted)
 	emit("system", "complete", "File processing completed successfully")
 
 	// Wait for all forwarding goroutines to complete before returning
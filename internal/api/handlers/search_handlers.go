@@ -1,13 +1,21 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // SearchFiles implements generated.StrictServerInterface
@@ -26,8 +34,10 @@ func (h *StrictHandlers) SearchFiles(
 	}
 
 	opts := services.SearchOptions{
-		Limit:  derefInt(request.Params.Limit, 20),
-		Offset: derefInt(request.Params.Offset, 0),
+		IncludeFolderName: deref(request.Params.IncludeFolderName),
+		Limit:             h.pagination.Search.limit(request.Params.Limit),
+		Offset:            derefInt(request.Params.Offset, 0),
+		SnippetLength:     derefInt(request.Params.SnippetLength, 0),
 	}
 
 	// Handle folder_id
@@ -36,6 +46,12 @@ func (h *StrictHandlers) SearchFiles(
 		opts.FolderID = &folderID
 	}
 
+	// Handle scope_folder_id
+	if request.Params.ScopeFolderId != nil {
+		scopeFolderID := uint(*request.Params.ScopeFolderId)
+		opts.RootFolderID = &scopeFolderID
+	}
+
 	// Handle file_type
 	if request.Params.FileType != nil {
 		ft := models.FileType(*request.Params.FileType)
@@ -43,14 +59,34 @@ func (h *StrictHandlers) SearchFiles(
 	}
 
 	// Parse tag IDs
-	if request.Params.TagIds != nil && *request.Params.TagIds != "" {
-		tagIDStrs := strings.Split(*request.Params.TagIds, ",")
-		for _, idStr := range tagIDStrs {
-			id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
-			if err == nil {
-				opts.TagIDs = append(opts.TagIDs, uint(id))
-			}
+	if request.Params.TagIds != nil {
+		tagIDs, err := services.ParseTagIDs(*request.Params.TagIds)
+		if err != nil {
+			var errs fieldErrors
+			errs.add("tag_ids", "%s", err.Error())
+			return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: *errs.response()}, nil
+		}
+		opts.TagIDs = tagIDs
+	}
+
+	// Parse tag boosts
+	if request.Params.BoostTagIds != nil && *request.Params.BoostTagIds != "" {
+		boosts, err := parseTagBoosts(*request.Params.BoostTagIds)
+		if err != nil {
+			var errs fieldErrors
+			errs.add("boost_tag_ids", "%s", err.Error())
+			return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: *errs.response()}, nil
+		}
+		opts.BoostTagIDs = boosts
+	}
+
+	// Recency boost, in days
+	if request.Params.RecencyHalfLifeDays != nil {
+		days := float64(*request.Params.RecencyHalfLifeDays)
+		if days < 0 {
+			return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: badRequest("recency_half_life_days must not be negative")}, nil
 		}
+		opts.RecencyHalfLife = time.Duration(days * float64(24*time.Hour))
 	}
 
 	// Determine search type
@@ -59,28 +95,204 @@ func (h *StrictHandlers) SearchFiles(
 		searchType = string(*request.Params.Type)
 	}
 
-	var results []services.SearchResult
-	var total int64
+	// Title-only searches always take the fulltext fast path
+	if request.Params.TitleOnly != nil && *request.Params.TitleOnly {
+		opts.TitleOnly = true
+		searchType = "fulltext"
+	}
+
+	if request.Params.Rerank != nil && *request.Params.Rerank {
+		if searchType != "hybrid" {
+			return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: badRequest("rerank requires type=hybrid")}, nil
+		}
+		opts.Rerank = true
+	}
+
+	if deref(request.Params.IncludeRawScores) {
+		if searchType != "hybrid" {
+			return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: badRequest("include_raw_scores requires type=hybrid")}, nil
+		}
+		opts.IncludeRawScores = true
+	}
+
+	asCSV := request.Params.Format != nil && *request.Params.Format == generated.Csv
+
+	cacheKey := services.SearchCacheKey(userID, query, searchType, opts)
+	if cached, ok := h.searchCache.Get(cacheKey); ok {
+		if asCSV {
+			return h.searchCSVResponse(ctx, userID, cached, "HIT")
+		}
+		return searchResponse(ctx, query, searchType, opts, cached, "HIT"), nil
+	}
+
+	var result services.CachedSearch
 
 	switch searchType {
 	case "semantic":
-		results, err = h.searchService.VectorSearch(ctx, userID, query, opts)
-		total = int64(len(results))
+		result.Results, err = h.searchService.VectorSearch(ctx, userID, query, opts)
+		result.Total = int64(len(result.Results))
 	case "hybrid":
-		results, err = h.searchService.HybridSearch(ctx, userID, query, opts)
-		total = int64(len(results))
+		var hybrid *services.HybridSearchResult
+		if hybrid, err = h.searchService.HybridSearch(ctx, userID, query, opts); err == nil {
+			result.Results = hybrid.Results
+			result.Total = int64(len(hybrid.Results))
+			result.VectorUnavailable = hybrid.VectorUnavailable
+			result.RerankUnavailable = hybrid.RerankUnavailable
+		}
 	default: // fulltext
-		results, total, err = h.searchService.FullTextSearch(userID, query, opts)
+		result.Results, result.Total, err = h.searchService.FullTextSearch(userID, query, opts)
 	}
 
+	if errors.Is(err, services.ErrRerankNotConfigured) {
+		var errs fieldErrors
+		errs.add("rerank", "%s", err.Error())
+		return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: *errs.response()}, nil
+	}
+	if errors.Is(err, services.ErrEmbeddingUnavailable) {
+		return nil, fiber.NewError(fiber.StatusServiceUnavailable, "Semantic search is unavailable")
+	}
 	if err != nil {
-		return generated.SearchFiles400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		return nil, err
 	}
 
-	return generated.SearchFiles200JSONResponse{
-		Data:       searchResultListToGenerated(results),
-		Total:      int(total),
+	// Degraded results aren't cached so searches recover with the gateway
+	if !result.VectorUnavailable && !result.RerankUnavailable {
+		h.searchCache.Set(cacheKey, result)
+	}
+
+	if asCSV {
+		return h.searchCSVResponse(ctx, userID, result, "MISS")
+	}
+	return searchResponse(ctx, query, searchType, opts, result, "MISS"), nil
+}
+
+func searchResponse(ctx context.Context, query, searchType string, opts services.SearchOptions, result services.CachedSearch, cacheStatus string) generated.SearchFiles200JSONResponse {
+	body := generated.SearchResponse{
+		Data:       searchResultListToGenerated(ctx, result.Results),
+		Total:      int(result.Total),
 		Query:      query,
 		SearchType: searchType,
+		Limit:      opts.Limit,
+		Offset:     opts.Offset,
+	}
+	if result.VectorUnavailable {
+		body.VectorSearchUnavailable = ptr(true)
+	}
+	if result.RerankUnavailable {
+		body.RerankUnavailable = ptr(true)
+	}
+	return generated.SearchFiles200JSONResponse{
+		Body:    body,
+		Headers: generated.SearchFiles200ResponseHeaders{XSearchCache: cacheStatus},
+	}
+}
+
+// searchCSVResponse renders the results as CSV
+func (h *StrictHandlers) searchCSVResponse(ctx context.Context, userID string, result services.CachedSearch, cacheStatus string) (generated.SearchFilesResponseObject, error) {
+	var buf bytes.Buffer
+	if err := writeSearchResultsCSV(&buf, result.Results, !utils.IsFieldMasked(ctx, utils.MaskFieldContent), func(folderID uint) (string, error) {
+		return h.folderPathName(userID, folderID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return generated.SearchFiles200TextcsvResponse{
+		Body:          &buf,
+		Headers:       generated.SearchFiles200ResponseHeaders{XSearchCache: cacheStatus},
+		ContentLength: int64(buf.Len()),
 	}, nil
 }
+
+// folderPathName returns the slash-separated path of folder names from the root
+func (h *StrictHandlers) folderPathName(userID string, folderID uint) (string, error) {
+	path, err := h.folderService.GetFolderPath(userID, folderID)
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, len(path))
+	for i, folder := range path {
+		names[i] = folder.Name
+	}
+	return strings.Join(names, "/"), nil
+}
+
+// writeSearchResultsCSV writes one row per result, leaving the snippet column
+// empty unless withSnippets is set. folderPath resolves each folder once; files
+// at the root have an empty folder_path.
+func writeSearchResultsCSV(w io.Writer, results []services.SearchResult, withSnippets bool, folderPath func(folderID uint) (string, error)) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"id", "title", "file_type", "folder_path", "score", "snippet"}); err != nil {
+		return err
+	}
+
+	paths := make(map[uint]string)
+	for _, result := range results {
+		file := result.File
+		path := ""
+		if file.FolderID != nil {
+			cached, ok := paths[*file.FolderID]
+			if !ok {
+				var err error
+				if cached, err = folderPath(*file.FolderID); err != nil {
+					return err
+				}
+				paths[*file.FolderID] = cached
+			}
+			path = cached
+		}
+		snippet := ""
+		if withSnippets {
+			snippet = result.Snippet
+		}
+
+		if err := writer.Write([]string{
+			strconv.FormatUint(uint64(file.ID), 10),
+			csvText(file.Title),
+			string(file.FileType),
+			csvText(path),
+			strconv.FormatFloat(result.Score, 'f', -1, 64),
+			csvText(snippet),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvText prefixes user text that a spreadsheet would run as a formula with a
+// quote, so the cell shows the text instead
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// parseTagBoosts parses "id[:weight],..." into tag boost weights
+func parseTagBoosts(value string) (map[uint]float64, error) {
+	boosts := make(map[uint]float64)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		idStr, weightStr, hasWeight := strings.Cut(part, ":")
+		id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boost tag ID: %s", idStr)
+		}
+
+		weight := services.DefaultTagBoost
+		if hasWeight {
+			weight, err = strconv.ParseFloat(strings.TrimSpace(weightStr), 64)
+			if err != nil || weight <= 0 {
+				return nil, fmt.Errorf("invalid boost weight for tag %d: %s", id, weightStr)
+			}
+		}
+		boosts[uint(id)] = weight
+	}
+	return boosts, nil
+}
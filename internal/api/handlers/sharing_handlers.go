@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// folderOwner returns the user whose data the folder lives in when userID owns
+// it or it was shared with them, or "" when the folder isn't accessible. With
+// write, read-only shares don't count.
+func (h *StrictHandlers) folderOwner(userID string, folderID uint, write bool) (string, error) {
+	access, err := h.folderService.ResolveFolderAccess(userID, folderID)
+	if err != nil || access == nil || (write && !access.CanWrite()) {
+		return "", err
+	}
+	return access.OwnerID, nil
+}
+
+// fileOwner is folderOwner for a file, which is shared through its folder
+func (h *StrictHandlers) fileOwner(userID string, fileID uint, write bool) (string, error) {
+	access, err := h.folderService.ResolveFileAccess(userID, fileID)
+	if err != nil || access == nil || (write && !access.CanWrite()) {
+		return "", err
+	}
+	return access.OwnerID, nil
+}
+
+// ListSharedFolders implements generated.StrictServerInterface
+func (h *StrictHandlers) ListSharedFolders(
+	ctx context.Context,
+	request generated.ListSharedFoldersRequestObject,
+) (generated.ListSharedFoldersResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListSharedFolders401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	shared, err := h.folderService.ListSharedFolders(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]generated.SharedFolder, len(shared))
+	for i, item := range shared {
+		data[i] = generated.SharedFolder{
+			Folder: folderModelToGenerated(&item.Folder),
+			Role:   generated.FolderRole(item.Role),
+		}
+	}
+
+	return generated.ListSharedFolders200JSONResponse{Data: data}, nil
+}
+
+// ListFolderMembers implements generated.StrictServerInterface
+func (h *StrictHandlers) ListFolderMembers(
+	ctx context.Context,
+	request generated.ListFolderMembersRequestObject,
+) (generated.ListFolderMembersResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListFolderMembers401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	members, err := h.folderService.ListFolderMembers(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if members == nil {
+		return generated.ListFolderMembers404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	data := make([]generated.FolderMember, len(members))
+	for i := range members {
+		data[i] = folderMemberToGenerated(&members[i])
+	}
+
+	return generated.ListFolderMembers200JSONResponse{Data: data}, nil
+}
+
+// ShareFolder implements generated.StrictServerInterface
+func (h *StrictHandlers) ShareFolder(
+	ctx context.Context,
+	request generated.ShareFolderRequestObject,
+) (generated.ShareFolderResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ShareFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.ShareFolder400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	var errs fieldErrors
+	errs.required("user_id", request.Body.UserId)
+	if !models.FolderRole(request.Body.Role).IsValid() {
+		errs.add("role", "role must be one of read, write")
+	}
+	if resp := errs.response(); resp != nil {
+		return generated.ShareFolder400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	member, err := h.folderService.ShareFolder(userID, uint(request.Id), request.Body.UserId, models.FolderRole(request.Body.Role))
+	if errors.Is(err, services.ErrShareWithOwner) || errors.Is(err, services.ErrInvalidFolderRole) {
+		return generated.ShareFolder400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return generated.ShareFolder404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	return generated.ShareFolder200JSONResponse(folderMemberToGenerated(member)), nil
+}
+
+// UnshareFolder implements generated.StrictServerInterface
+func (h *StrictHandlers) UnshareFolder(
+	ctx context.Context,
+	request generated.UnshareFolderRequestObject,
+) (generated.UnshareFolderResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.UnshareFolder401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	folder, err := h.folderService.GetFolderByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return generated.UnshareFolder404JSONResponse{NotFoundJSONResponse: notFound("Folder not found")}, nil
+	}
+
+	if err := h.folderService.UnshareFolder(userID, folder.ID, request.UserId); err != nil {
+		return nil, err
+	}
+
+	return generated.UnshareFolder204Response{}, nil
+}
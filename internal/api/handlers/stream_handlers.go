@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// FileStreamHandlers handles streaming (NDJSON) file exports
+type FileStreamHandlers struct {
+	fileService services.FileService
+}
+
+// NewFileStreamHandlers creates a new FileStreamHandlers instance
+func NewFileStreamHandlers(fileService services.FileService) *FileStreamHandlers {
+	return &FileStreamHandlers{fileService: fileService}
+}
+
+// StreamFiles writes every matching file as one JSON object per line
+// GET /api/files/stream
+func (h *FileStreamHandlers) StreamFiles(c *fiber.Ctx) error {
+	// Get authenticated user
+	user := c.Locals(middleware.AuthenticatedUserContextKey)
+	if user == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Unauthorized"})
+	}
+	authenticatedUser := user.(*utils.AuthenticatedUser)
+	userID := authenticatedUser.Sub
+
+	opts := services.FileListOptions{
+		Keyword:        c.Query("keyword"),
+		AllFolders:     c.QueryBool("all_folders", false),
+		IncludeLinked:  c.QueryBool("include_linked", false),
+		Entity:         c.Query("entity"),
+		EntityType:     c.Query("entity_type"),
+		EntityDateFrom: c.Query("entity_date_from"),
+		EntityDateTo:   c.Query("entity_date_to"),
+	}
+	if err := opts.ValidateEntityFilters(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if folderIDStr := c.Query("folder_id"); folderIDStr != "" {
+		folderID, err := strconv.ParseUint(folderIDStr, 10, 32)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid folder_id"})
+		}
+		fid := uint(folderID)
+		opts.FolderID = &fid
+	}
+
+	if fileType := c.Query("file_type"); fileType != "" {
+		opts.FileTypes = []models.FileType{models.FileType(fileType)}
+	}
+
+	exts, err := services.ParseFileExtensions(c.Query("ext"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	opts.Extensions = exts
+
+	if status := c.Query("status"); status != "" {
+		s := models.FileProcessingStatus(status)
+		opts.Status = &s
+	}
+
+	tagIDs, err := services.ParseTagIDs(c.Query("tag_ids"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	opts.TagIDs = tagIDs
+
+	c.Set("Content-Type", "application/x-ndjson")
+	c.Set("Transfer-Encoding", "chunked")
+	c.Set("X-Accel-Buffering", "no") // Disable nginx buffering
+
+	// Carry the user so the converter applies the token's field masking
+	ctx := utils.WithAuthenticatedUser(context.Background(), authenticatedUser)
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		encoder := json.NewEncoder(w)
+		err := h.fileService.StreamFiles(userID, opts, func(file *models.File) error {
+			if err := encoder.Encode(fileModelToGenerated(ctx, file)); err != nil {
+				return err
+			}
+			return w.Flush()
+		})
+		if err != nil {
+			log.Printf("[Stream] Failed to stream files for user %s: %v", userID, err)
+		}
+	})
+
+	return nil
+}
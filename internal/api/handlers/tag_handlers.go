@@ -18,7 +18,7 @@ func (h *StrictHandlers) ListTags(
 	}
 
 	keyword := deref(request.Params.Keyword)
-	limit := derefInt(request.Params.Limit, 100)
+	limit := h.pagination.Tags.limit(request.Params.Limit)
 	offset := derefInt(request.Params.Offset, 0)
 
 	tags, total, err := h.tagService.ListTags(userID, keyword, limit, offset)
@@ -47,6 +47,9 @@ func (h *StrictHandlers) CreateTag(
 	if request.Body == nil {
 		return generated.CreateTag400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
 	}
+	if invalid := validateCreateTagRequest(request.Body); invalid != nil {
+		return generated.CreateTag400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
 
 	tag := &models.Tag{
 		Name:        request.Body.Name,
@@ -58,7 +61,90 @@ func (h *StrictHandlers) CreateTag(
 		return generated.CreateTag400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
-	return generated.CreateTag201JSONResponse(tagModelToGenerated(tag)), nil
+	created := tagModelToGenerated(tag)
+	response := generated.CreateTag201JSONResponse{
+		Id:          created.Id,
+		UserId:      created.UserId,
+		Name:        created.Name,
+		Color:       created.Color,
+		Description: created.Description,
+		Aliases:     created.Aliases,
+		CreatedAt:   created.CreatedAt,
+		UpdatedAt:   created.UpdatedAt,
+	}
+
+	// Suggestions are best-effort and never block creation
+	if similar, err := h.tagService.FindSimilarTags(userID, tag.Name, tag.ID); err == nil && len(similar) > 0 {
+		response.SimilarExistingTags = ptr(tagListToGenerated(similar))
+	}
+
+	return response, nil
+}
+
+// CreateTags implements generated.StrictServerInterface
+func (h *StrictHandlers) CreateTags(
+	ctx context.Context,
+	request generated.CreateTagsRequestObject,
+) (generated.CreateTagsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CreateTags401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.CreateTags400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if invalid := validateBulkCreateTagsRequest(request.Body); invalid != nil {
+		return generated.CreateTags400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+
+	tags := make([]models.Tag, len(request.Body.Tags))
+	for i, t := range request.Body.Tags {
+		tags[i] = models.Tag{
+			Name:        t.Name,
+			Color:       deref(t.Color),
+			Description: deref(t.Description),
+		}
+	}
+
+	result, err := h.tagService.CreateTags(userID, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.CreateTags201JSONResponse{
+		Created: tagListToGenerated(result.Created),
+		Skipped: tagListToGenerated(result.Skipped),
+	}, nil
+}
+
+// DeleteTags implements generated.StrictServerInterface
+func (h *StrictHandlers) DeleteTags(
+	ctx context.Context,
+	request generated.DeleteTagsRequestObject,
+) (generated.DeleteTagsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.DeleteTags401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.DeleteTags400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if invalid := validateBulkDeleteTagsRequest(request.Body); invalid != nil {
+		return generated.DeleteTags400JSONResponse{BadRequestJSONResponse: *invalid}, nil
+	}
+
+	ids := make([]uint, len(request.Body.TagIds))
+	for i, id := range request.Body.TagIds {
+		ids[i] = uint(id)
+	}
+
+	results, err := h.tagService.DeleteTags(userID, ids, request.Body.Force != nil && *request.Body.Force)
+	if err != nil {
+		return nil, err
+	}
+	return generated.DeleteTags200JSONResponse{Results: tagDeleteResultsToGenerated(results)}, nil
 }
 
 // GetTag implements generated.StrictServerInterface
@@ -145,3 +231,54 @@ func (h *StrictHandlers) DeleteTag(
 
 	return generated.DeleteTag204Response{}, nil
 }
+
+// AddTagAlias implements generated.StrictServerInterface
+func (h *StrictHandlers) AddTagAlias(
+	ctx context.Context,
+	request generated.AddTagAliasRequestObject,
+) (generated.AddTagAliasResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.AddTagAlias401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.AddTagAlias400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	tag, err := h.tagService.GetTagByID(userID, uint(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return generated.AddTagAlias404JSONResponse{NotFoundJSONResponse: notFound("Tag not found")}, nil
+	}
+
+	if _, err := h.tagService.AddTagAlias(userID, tag.ID, request.Body.Alias); err != nil {
+		return generated.AddTagAlias400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	updated, err := h.tagService.GetTagByID(userID, tag.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.AddTagAlias201JSONResponse(tagModelToGenerated(updated)), nil
+}
+
+// RemoveTagAlias implements generated.StrictServerInterface
+func (h *StrictHandlers) RemoveTagAlias(
+	ctx context.Context,
+	request generated.RemoveTagAliasRequestObject,
+) (generated.RemoveTagAliasResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RemoveTagAlias401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if err := h.tagService.RemoveTagAlias(userID, uint(request.Id), uint(request.AliasId)); err != nil {
+		return generated.RemoveTagAlias404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
+	}
+
+	return generated.RemoveTagAlias204Response{}, nil
+}
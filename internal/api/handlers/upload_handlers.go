@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"io"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 )
 
 // UploadFile implements generated.StrictServerInterface
@@ -17,6 +19,14 @@ func (h *StrictHandlers) UploadFile(
 		return generated.UploadFile401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
+	prefix, err := h.uploadS3Prefix(userID, request.Params.FolderId)
+	if errors.Is(err, errUploadFolderNotFound) {
+		return generated.UploadFile400JSONResponse{BadRequestJSONResponse: badRequest("Folder not found")}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	// Read file from multipart request
 	file, err := request.Body.NextPart()
 	if err != nil {
@@ -36,19 +46,27 @@ func (h *StrictHandlers) UploadFile(
 		return generated.UploadFile400JSONResponse{BadRequestJSONResponse: badRequest("Failed to read file")}, nil
 	}
 
+	// Correct a missing or wrong part type from the content
+	detected := services.SniffContentType(content)
+	contentType = services.ResolveMimeType(contentType, detected)
+
 	// Upload to S3 - returns the key
-	key, err := h.uploadService.UploadFile(ctx, userID, filename, content, contentType)
+	key, err := h.uploadService.UploadFile(ctx, userID, prefix, filename, content, contentType)
 	if err != nil {
 		return generated.UploadFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
 	// Return the S3 key - file record is created separately via POST /api/files
-	return generated.UploadFile201JSONResponse{
+	result := generated.UploadFile201JSONResponse{
 		Key:         key,
 		Filename:    filename,
 		Size:        len(content),
 		ContentType: contentType,
-	}, nil
+	}
+	if detected != "" {
+		result.DetectedContentType = &detected
+	}
+	return result, nil
 }
 
 // GetPresignedURL implements generated.StrictServerInterface
@@ -71,7 +89,15 @@ func (h *StrictHandlers) GetPresignedURL(
 		contentType = *request.Params.ContentType
 	}
 
-	uploadURL, key, err := h.uploadService.GetPresignedUploadURL(ctx, userID, filename, contentType)
+	prefix, err := h.uploadS3Prefix(userID, request.Params.FolderId)
+	if errors.Is(err, errUploadFolderNotFound) {
+		return generated.GetPresignedURL400JSONResponse{BadRequestJSONResponse: badRequest("Folder not found")}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	uploadURL, key, err := h.uploadService.GetPresignedUploadURL(ctx, userID, prefix, filename, contentType)
 	if err != nil {
 		return generated.GetPresignedURL400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
@@ -82,3 +108,53 @@ func (h *StrictHandlers) GetPresignedURL(
 		ContentType: contentType,
 	}, nil
 }
+
+// PresignUploadBatch implements generated.StrictServerInterface
+func (h *StrictHandlers) PresignUploadBatch(
+	ctx context.Context,
+	request generated.PresignUploadBatchRequestObject,
+) (generated.PresignUploadBatchResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.PresignUploadBatch401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil {
+		return generated.PresignUploadBatch400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if resp := validatePresignBatchRequest(request.Body); resp != nil {
+		return generated.PresignUploadBatch400JSONResponse{BadRequestJSONResponse: *resp}, nil
+	}
+
+	prefix, err := h.uploadS3Prefix(userID, request.Body.FolderId)
+	if errors.Is(err, errUploadFolderNotFound) {
+		return generated.PresignUploadBatch400JSONResponse{BadRequestJSONResponse: badRequest("Folder not found")}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]services.PresignRequest, len(request.Body.Files))
+	for i, file := range request.Body.Files {
+		requests[i] = services.PresignRequest{Filename: file.Filename, ContentType: "application/octet-stream"}
+		if file.ContentType != nil && *file.ContentType != "" {
+			requests[i].ContentType = *file.ContentType
+		}
+	}
+
+	uploads, err := services.PresignUploads(ctx, h.uploadService, userID, prefix, requests)
+	if err != nil {
+		return generated.PresignUploadBatch400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	data := make([]generated.PresignedUpload, len(uploads))
+	for i, upload := range uploads {
+		data[i] = generated.PresignedUpload{
+			Filename:    upload.Filename,
+			UploadUrl:   upload.UploadURL,
+			Key:         upload.Key,
+			ContentType: upload.ContentType,
+		}
+	}
+	return generated.PresignUploadBatch200JSONResponse{Data: data}, nil
+}
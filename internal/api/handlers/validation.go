@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// maxNameLength matches the varchar(255) title and name columns
+const maxNameLength = 255
+
+// maxRelationTypeLength matches the varchar(50) relation_type column
+const maxRelationTypeLength = 50
+
+// maxFolderPathLength matches the varchar(1024) folder alias path column
+const maxFolderPathLength = 1024
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// fieldErrors collects every problem with a request body so they can be
+// reported together instead of one at a time
+type fieldErrors []generated.FieldError
+
+func (e *fieldErrors) add(field, format string, args ...any) {
+	*e = append(*e, generated.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// name checks a required title or name
+func (e *fieldErrors) name(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		e.add(field, "%s is required", field)
+	} else if len(value) > maxNameLength {
+		e.add(field, "%s must be at most %d characters", field, maxNameLength)
+	}
+}
+
+func (e *fieldErrors) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		e.add(field, "%s is required", field)
+	}
+}
+
+func (e *fieldErrors) fileType(field string, value *generated.FileType) {
+	if value != nil && !models.FileType(*value).IsValid() {
+		e.add(field, "%s must be one of music, photo, video, document, invoice", field)
+	}
+}
+
+func (e *fieldErrors) positiveID(field string, value *int) {
+	if value != nil && *value <= 0 {
+		e.add(field, "%s must be a positive integer", field)
+	}
+}
+
+func (e *fieldErrors) processingHint(field string, value *string) {
+	if value != nil && utf8.RuneCountInString(*value) > services.MaxProcessingHintLength {
+		e.add(field, "%s must be at most %d characters", field, services.MaxProcessingHintLength)
+	}
+}
+
+// options records the errors of a services list option validator under the
+// fields they name
+func (e *fieldErrors) options(err error) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			e.options(err)
+		}
+		return
+	}
+	var optErr *services.OptionError
+	if errors.As(err, &optErr) {
+		e.add(optErr.Field, "%s", optErr.Message)
+		return
+	}
+	e.add("", "%s", err.Error())
+}
+
+// tag checks a tag to create, prefixing field names for tags in a list
+func (e *fieldErrors) tag(prefix string, body *generated.CreateTagRequest) {
+	e.name(prefix+"name", body.Name)
+	if body.Color != nil && *body.Color != "" && !hexColorPattern.MatchString(*body.Color) {
+		e.add(prefix+"color", "%scolor must be a hex color like #FF5733", prefix)
+	}
+}
+
+// response returns the 400 body listing every field error, or nil when the
+// request is valid
+func (e fieldErrors) response() *generated.BadRequestJSONResponse {
+	if len(e) == 0 {
+		return nil
+	}
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Message
+	}
+	errs := []generated.FieldError(e)
+	return &generated.BadRequestJSONResponse{
+		Error:  "Validation failed: " + strings.Join(messages, "; "),
+		Errors: &errs,
+	}
+}
+
+func validateCreateFileRequest(body *generated.CreateFileRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.name("title", body.Title)
+	errs.required("s3_key", body.S3Key)
+	errs.name("original_filename", body.OriginalFilename)
+	errs.fileType("file_type", body.FileType)
+	errs.positiveID("folder_id", body.FolderId)
+	if body.Size != nil && *body.Size < 0 {
+		errs.add("size", "size must not be negative")
+	}
+	errs.processingHint("processing_hint", body.ProcessingHint)
+	return errs.response()
+}
+
+func validateUpdateFileRequest(body *generated.UpdateFileRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if body.Title != nil {
+		errs.name("title", *body.Title)
+	}
+	errs.fileType("file_type", body.FileType)
+	errs.positiveID("folder_id", body.FolderId)
+	errs.processingHint("processing_hint", body.ProcessingHint)
+	return errs.response()
+}
+
+func (e *fieldErrors) s3Prefix(field string, value *string) {
+	if value == nil {
+		return
+	}
+	if _, err := services.NormalizeS3Prefix(*value); err != nil {
+		e.add(field, "%s", err.Error())
+	}
+}
+
+func validateCreateFolderRequest(body *generated.CreateFolderRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.name("name", body.Name)
+	errs.positiveID("parent_id", body.ParentId)
+	errs.s3Prefix("s3_prefix", body.S3Prefix)
+	return errs.response()
+}
+
+func validateUpdateFolderRequest(body *generated.UpdateFolderRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.s3Prefix("s3_prefix", body.S3Prefix)
+	return errs.response()
+}
+
+func validateCreateTagRequest(body *generated.CreateTagRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.tag("", body)
+	return errs.response()
+}
+
+func validateBulkCreateTagsRequest(body *generated.BulkCreateTagsRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if len(body.Tags) == 0 {
+		errs.add("tags", "tags must not be empty")
+	} else if len(body.Tags) > services.MaxBulkTags {
+		errs.add("tags", "tags must contain at most %d tags", services.MaxBulkTags)
+	}
+	for i := range body.Tags {
+		errs.tag(fmt.Sprintf("tags[%d].", i), &body.Tags[i])
+	}
+	return errs.response()
+}
+
+func validateBulkDeleteTagsRequest(body *generated.BulkDeleteTagsRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if len(body.TagIds) == 0 {
+		errs.add("tag_ids", "tag_ids must not be empty")
+	} else if len(body.TagIds) > services.MaxBulkTags {
+		errs.add("tag_ids", "tag_ids must contain at most %d tags", services.MaxBulkTags)
+	}
+	for i := range body.TagIds {
+		errs.positiveID(fmt.Sprintf("tag_ids[%d]", i), &body.TagIds[i])
+	}
+	return errs.response()
+}
+
+func validateCopyFileRequest(body *generated.CopyFileRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("folder_id", body.FolderId)
+	return errs.response()
+}
+
+func validateBatchDeleteFilesRequest(body *generated.BatchDeleteFilesRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if len(body.FileIds) == 0 {
+		errs.add("file_ids", "file_ids must not be empty")
+	} else if len(body.FileIds) > services.MaxBatchDeleteFiles {
+		errs.add("file_ids", "file_ids must contain at most %d files", services.MaxBatchDeleteFiles)
+	}
+	for i := range body.FileIds {
+		errs.positiveID(fmt.Sprintf("file_ids[%d]", i), &body.FileIds[i])
+	}
+	return errs.response()
+}
+
+func validateExpandFolderTreeRequest(body *generated.ExpandFolderTreeRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if len(body.FolderIds) == 0 && !deref(body.IncludeRoot) {
+		errs.add("folder_ids", "folder_ids must not be empty unless include_root is set")
+	} else if len(body.FolderIds) > services.MaxExpandFolders {
+		errs.add("folder_ids", "folder_ids must contain at most %d folders", services.MaxExpandFolders)
+	}
+	for i := range body.FolderIds {
+		errs.positiveID(fmt.Sprintf("folder_ids[%d]", i), &body.FolderIds[i])
+	}
+	return errs.response()
+}
+
+func validateListFilesGroupedParams(params generated.ListFilesGroupedParams) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("root_folder_id", params.RootFolderId)
+	if params.MaxDepth != nil && (*params.MaxDepth < 0 || *params.MaxDepth > services.MaxGroupedDepth) {
+		errs.add("max_depth", "max_depth must be between 0 and %d", services.MaxGroupedDepth)
+	}
+	if params.FilesPerFolder != nil && (*params.FilesPerFolder < 1 || *params.FilesPerFolder > services.MaxGroupedFilesPerFolder) {
+		errs.add("files_per_folder", "files_per_folder must be between 1 and %d", services.MaxGroupedFilesPerFolder)
+	}
+	return errs.response()
+}
+
+func validateParserCallbackRequest(body *generated.ParserCallbackRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if strings.TrimSpace(body.JobToken) == "" {
+		errs.add("job_token", "job_token is required")
+	}
+	return errs.response()
+}
+
+func validatePresignBatchRequest(body *generated.PresignBatchRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if len(body.Files) == 0 {
+		errs.add("files", "files must not be empty")
+	} else if len(body.Files) > services.MaxPresignBatch {
+		errs.add("files", "files must contain at most %d files", services.MaxPresignBatch)
+	}
+	for i, file := range body.Files {
+		if strings.TrimSpace(file.Filename) == "" {
+			errs.add(fmt.Sprintf("files[%d].filename", i), "filename is required")
+		}
+	}
+	errs.positiveID("folder_id", body.FolderId)
+	return errs.response()
+}
+
+func validateMoveFilesByFilterRequest(body *generated.MoveFilesByFilterRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("filter.folder_id", body.Filter.FolderId)
+	if body.Filter.FileTypes != nil {
+		for i := range *body.Filter.FileTypes {
+			errs.fileType("filter.file_types", &(*body.Filter.FileTypes)[i])
+		}
+	}
+	errs.positiveID("target_folder_id", body.TargetFolderId)
+	return errs.response()
+}
+
+func validateReclassifyFilesRequest(body *generated.ReclassifyFilesRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("filter.folder_id", body.Filter.FolderId)
+	if body.Filter.FileTypes != nil {
+		for i := range *body.Filter.FileTypes {
+			errs.fileType("filter.file_types", &(*body.Filter.FileTypes)[i])
+		}
+	}
+	return errs.response()
+}
+
+func validateCreateFoldingRuleRequest(body *generated.CreateFoldingRuleRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("tag_id", &body.TagId)
+	errs.positiveID("target_folder_id", &body.TargetFolderId)
+	return errs.response()
+}
+
+func validateUpdateFoldingRuleRequest(body *generated.UpdateFoldingRuleRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("target_folder_id", &body.TargetFolderId)
+	return errs.response()
+}
+
+func validateAddFileRelationRequest(body *generated.AddFileRelationRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	errs.positiveID("related_file_id", &body.RelatedFileId)
+	if body.RelationType != nil && len(*body.RelationType) > maxRelationTypeLength {
+		errs.add("relation_type", "relation_type must be at most %d characters", maxRelationTypeLength)
+	}
+	return errs.response()
+}
+
+func validateAddFolderAliasRequest(body *generated.AddFolderAliasRequest) *generated.BadRequestJSONResponse {
+	var errs fieldErrors
+	if services.NormalizeFolderPath(body.Path) == "" {
+		errs.add("path", "path must contain at least one folder name")
+	} else if len(body.Path) > maxFolderPathLength {
+		errs.add("path", "path must be at most %d characters", maxFolderPathLength)
+	}
+	return errs.response()
+}
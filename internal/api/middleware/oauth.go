@@ -130,6 +130,9 @@ func (a *OAuthAuthenticator) ExtractUser(claims jwt.MapClaims) *utils.Authentica
 		user.Scopes = extractStringSlice(claims, "scp")
 	}
 
+	// Extract fields to leave out of file responses (e.g. content, summary)
+	user.MaskedFields = extractStringSlice(claims, "masked_fields")
+
 	return user
 }
 
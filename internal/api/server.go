@@ -13,6 +13,7 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/api/handlers"
 	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
@@ -37,6 +38,13 @@ type APIServer struct {
 	summaryService         services.SummaryService
 	agentService           services.AgentService
 	invoiceService         services.InvoiceService
+	reembedService         services.ReembedService
+	autoTagService         services.AutoTagService
+	reassignService        services.ReassignService
+	statsService           services.StatsService
+	processingGate         *services.ProcessingGate
+	parserJobService       services.ParserJobService
+	pagination             handlers.PaginationConfig
 	mcpServer              *mcpserver.MCPServer
 	mcprouterAuthenticator *auth.ApikeyAuthenticator
 	oauthAuthenticator     *middleware.OAuthAuthenticator
@@ -57,6 +65,13 @@ func NewAPIServer(
 	summaryService services.SummaryService,
 	agentService services.AgentService,
 	invoiceService services.InvoiceService,
+	reembedService services.ReembedService,
+	autoTagService services.AutoTagService,
+	reassignService services.ReassignService,
+	statsService services.StatsService,
+	processingGate *services.ProcessingGate,
+	parserJobService services.ParserJobService,
+	pagination handlers.PaginationConfig,
 	mcpServer *mcpserver.MCPServer,
 ) *APIServer {
 	app := fiber.New(fiber.Config{
@@ -74,9 +89,9 @@ func NewAPIServer(
 				log.Printf("Validation error on %s %s: %s", c.Method(), c.Path(), errMsg)
 				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": errMsg})
 			}
-			// Default to 500 for unexpected errors
+			// Default to 500 for unexpected errors, keeping the detail in the log
 			log.Printf("Internal error on %s %s: %s", c.Method(), c.Path(), errMsg)
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": errMsg})
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Internal server error"})
 		},
 	})
 
@@ -131,6 +146,13 @@ func NewAPIServer(
 		summaryService:         summaryService,
 		agentService:           agentService,
 		invoiceService:         invoiceService,
+		reembedService:         reembedService,
+		autoTagService:         autoTagService,
+		reassignService:        reassignService,
+		statsService:           statsService,
+		processingGate:         processingGate,
+		parserJobService:       parserJobService,
+		pagination:             pagination,
 		mcpServer:              mcpServer,
 		mcprouterAuthenticator: mcprouterAuthenticator,
 		oauthAuthenticator:     oauthAuthenticator,
@@ -152,6 +174,9 @@ func (s *APIServer) SetupRoutes() {
 		return c.Send(assets.OpenAPISpec)
 	})
 
+	// Prometheus metrics (no auth required)
+	s.app.Get("/metrics", adaptor.HTTPHandler(promhttp.Handler()))
+
 	// Authentication check endpoint
 	s.app.Get("/authentication", func(c *fiber.Ctx) error {
 		user := c.Locals(middleware.AuthenticatedUserContextKey)
@@ -174,6 +199,13 @@ func (s *APIServer) SetupRoutes() {
 		s.summaryService,
 		s.agentService,
 		s.invoiceService,
+		s.reembedService,
+		s.autoTagService,
+		s.reassignService,
+		s.statsService,
+		s.processingGate,
+		s.parserJobService,
+		s.pagination,
 	)
 
 	// Create agent handlers for SSE streaming
@@ -182,26 +214,35 @@ func (s *APIServer) SetupRoutes() {
 	// Create processing handlers for unified file processing stream
 	processingHandlers := handlers.NewProcessingHandlers(
 		s.fileService,
+		s.folderService,
 		s.uploadService,
 		s.contentParserService,
 		s.embeddingService,
 		s.summaryService,
 		s.agentService,
 		s.invoiceService,
+		s.autoTagService,
+		s.processingGate,
+		s.parserJobService,
 	)
 
+	// Create stream handlers for NDJSON file export
+	fileStreamHandlers := handlers.NewFileStreamHandlers(s.fileService)
+
 	// Register SSE routes BEFORE generated handlers (custom routes take precedence)
 	// These routes require authentication via middleware already applied
 	s.app.Get("/api/files/:id/agent-stream", agentHandlers.StreamAgentProgress)
 	s.app.Post("/api/files/:id/organize", agentHandlers.TriggerAgentOrganize)
 	s.app.Get("/api/agent/status", agentHandlers.GetAgentStatus)
 	s.app.Get("/api/files/:id/process-stream", processingHandlers.StreamFileProcessing)
+	s.app.Get("/api/files/stream", fileStreamHandlers.StreamFiles)
 	// Folder agent routes
 	s.app.Get("/api/folders/:id/agent-stream", agentHandlers.StreamFolderAgentProgress)
 	s.app.Post("/api/folders/:id/organize", agentHandlers.TriggerFolderOrganize)
 
 	// Create strict handler wrapper (converts StrictServerInterface to ServerInterface)
-	strictHandler := generated.NewStrictHandler(strictHandlers, nil)
+	// Handler errors are mapped to HTTP errors without leaking their detail
+	strictHandler := generated.NewStrictHandler(strictHandlers, []generated.StrictMiddlewareFunc{handlers.MapErrors})
 
 	// Register all API routes using generated handlers
 	// Middleware checks authentication and passes user to Go context
@@ -209,8 +250,9 @@ func (s *APIServer) SetupRoutes() {
 		BaseURL: "",
 		Middlewares: []generated.MiddlewareFunc{
 			func(c *fiber.Ctx) error {
-				// Skip auth check for health endpoint
-				if c.Path() == "/health" {
+				// Skip auth check for the health endpoint and the content parser
+				// callback, which is authenticated by its job token
+				if c.Path() == "/health" || c.Path() == "/api/internal/parser-callback" {
 					return c.Next()
 				}
 
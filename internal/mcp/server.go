@@ -82,6 +82,9 @@ func (s *MCPServer) initializeTools(
 	createTagTool := tools.NewCreateTagTool(tagService)
 	srv.AddTool(createTagTool.GetTool(), createTagTool.GetHandler())
 
+	createTagsTool := tools.NewCreateTagsTool(tagService)
+	srv.AddTool(createTagsTool.GetTool(), createTagsTool.GetHandler())
+
 	listTagsTool := tools.NewListTagsTool(tagService)
 	srv.AddTool(listTagsTool.GetTool(), listTagsTool.GetHandler())
 
@@ -107,9 +110,12 @@ func (s *MCPServer) initializeTools(
 	updateFolderTool := tools.NewUpdateFolderTool(folderService)
 	srv.AddTool(updateFolderTool.GetTool(), updateFolderTool.GetHandler())
 
-	deleteFolderTool := tools.NewDeleteFolderTool(folderService, fileService, uploadService, embeddingService)
+	deleteFolderTool := tools.NewDeleteFolderTool(folderService)
 	srv.AddTool(deleteFolderTool.GetTool(), deleteFolderTool.GetHandler())
 
+	restoreFolderTool := tools.NewRestoreFolderTool(folderService)
+	srv.AddTool(restoreFolderTool.GetTool(), restoreFolderTool.GetHandler())
+
 	moveFolderTool := tools.NewMoveFolderTool(folderService)
 	srv.AddTool(moveFolderTool.GetTool(), moveFolderTool.GetHandler())
 
@@ -123,7 +129,8 @@ func (s *MCPServer) initializeTools(
 	srv.AddTool(removeTagsFromFolderTool.GetTool(), removeTagsFromFolderTool.GetHandler())
 
 	// File Tools
-	createFileTool := tools.NewCreateFileTool(fileService)
+	placement := services.NewFilePlacement(fileService, folderService, uploadService)
+	createFileTool := tools.NewCreateFileTool(fileService, embeddingService, placement)
 	srv.AddTool(createFileTool.GetTool(), createFileTool.GetHandler())
 
 	listFilesTool := tools.NewListFilesTool(fileService)
@@ -132,16 +139,16 @@ func (s *MCPServer) initializeTools(
 	getFileTool := tools.NewGetFileTool(fileService)
 	srv.AddTool(getFileTool.GetTool(), getFileTool.GetHandler())
 
-	updateFileTool := tools.NewUpdateFileTool(fileService)
+	updateFileTool := tools.NewUpdateFileTool(fileService, placement)
 	srv.AddTool(updateFileTool.GetTool(), updateFileTool.GetHandler())
 
 	deleteFileTool := tools.NewDeleteFileTool(fileService, uploadService, embeddingService, invoiceService)
 	srv.AddTool(deleteFileTool.GetTool(), deleteFileTool.GetHandler())
 
-	moveFilesTool := tools.NewMoveFilesTool(fileService)
+	moveFilesTool := tools.NewMoveFilesTool(placement)
 	srv.AddTool(moveFilesTool.GetTool(), moveFilesTool.GetHandler())
 
-	addTagsToFileTool := tools.NewAddTagsToFileTool(fileService)
+	addTagsToFileTool := tools.NewAddTagsToFileTool(fileService, placement)
 	srv.AddTool(addTagsToFileTool.GetTool(), addTagsToFileTool.GetHandler())
 
 	removeTagsFromFileTool := tools.NewRemoveTagsFromFileTool(fileService)
@@ -154,6 +161,9 @@ func (s *MCPServer) initializeTools(
 	getPresignedURLTool := tools.NewGetPresignedURLTool(uploadService)
 	srv.AddTool(getPresignedURLTool.GetTool(), getPresignedURLTool.GetHandler())
 
+	getPresignedURLsTool := tools.NewGetPresignedURLsTool(uploadService)
+	srv.AddTool(getPresignedURLsTool.GetTool(), getPresignedURLsTool.GetHandler())
+
 	// Search Tools
 	searchFilesTool := tools.NewSearchFilesTool(searchService)
 	srv.AddTool(searchFilesTool.GetTool(), searchFilesTool.GetHandler())
@@ -189,16 +199,19 @@ func getToolInstructions(category string) string {
 1. create_tag - Create a new tag
    Parameters: name (required), description, color
 
-2. list_tags - List all tags with optional search
+2. create_tags - Create several tags at once, skipping names that already exist
+   Parameters: tags (required, array of {name, description, color})
+
+3. list_tags - List all tags with optional search
    Parameters: keyword, limit, offset
 
-3. get_tag - Get a tag by ID
+4. get_tag - Get a tag by ID
    Parameters: tag_id (required)
 
-4. update_tag - Update an existing tag
+5. update_tag - Update an existing tag
    Parameters: tag_id (required), name, description, color
 
-5. delete_tag - Delete a tag
+6. delete_tag - Delete a tag
    Parameters: tag_id (required)`
 
 	case "folder":
@@ -216,19 +229,22 @@ func getToolInstructions(category string) string {
 4. update_folder - Update an existing folder
    Parameters: folder_id (required), name, description
 
-5. delete_folder - Delete a folder
+5. delete_folder - Delete a folder (can be restored)
+   Parameters: folder_id (required), exclude_folder_ids
+
+6. restore_folder - Restore a deleted folder and its contents
    Parameters: folder_id (required)
 
-6. move_folder - Move a folder to a new parent
+7. move_folder - Move a folder to a new parent
    Parameters: folder_id (required), parent_id
 
-7. get_folder_tree - Get folder tree structure
+8. get_folder_tree - Get folder tree structure
    Parameters: parent_id (optional)
 
-8. add_tags_to_folder - Add tags to a folder
+9. add_tags_to_folder - Add tags to a folder
    Parameters: folder_id (required), tag_ids (required)
 
-9. remove_tags_from_folder - Remove tags from a folder
+10. remove_tags_from_folder - Remove tags from a folder
    Parameters: folder_id (required), tag_ids (required)`
 
 	case "file":
@@ -282,15 +298,22 @@ func getToolInstructions(category string) string {
 
    Usage: Use this to get a URL for directly uploading files to S3.
    The returned URL can be used with PUT request to upload the file.
-   After upload, use the returned key as the s3_key when creating a file record.`
+   After upload, use the returned key as the s3_key when creating a file record.
+
+2. get_presigned_urls - Get presigned URLs for several files in one call
+   Parameters: files (required, array of {filename, content_type}, at most 100)
+
+   Usage: Use this instead of repeated get_presigned_url calls when uploading
+   many files, e.g. a whole folder. URLs are returned in the order given.`
 
 	case "all":
 		return `File Management MCP Tools Overview:
 
 This MCP server provides tools for managing files, folders, tags, and search.
 
-TAG MANAGEMENT (5 tools):
+TAG MANAGEMENT (6 tools):
 - create_tag: Create a new tag
+- create_tags: Create several tags at once
 - list_tags: List tags with search
 - get_tag: Get tag details
 - update_tag: Update a tag
@@ -321,8 +344,9 @@ FILE MANAGEMENT (9 tools):
 SEARCH (1 tool):
 - search_files: Search with fulltext, semantic, or hybrid mode
 
-FILE UPLOAD (1 tool):
+FILE UPLOAD (2 tools):
 - get_presigned_url: Get URL for file upload
+- get_presigned_urls: Get URLs for several uploads at once
 
 All tools require authentication. Files are user-scoped.`
 
@@ -0,0 +1,78 @@
+// Package metrics defines the Prometheus metrics served on /metrics
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "file_management"
+
+// AI services reported in the ai_request_* metrics
+const (
+	AIServiceEmbedding = "embedding"
+	AIServiceSummary   = "summary"
+	AIServiceAgent     = "agent"
+	AIServiceRerank    = "rerank"
+)
+
+var (
+	// FilesProcessed counts processing runs by the file's final status
+	FilesProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "files_processed_total",
+		Help:      "Files that finished processing, by final status.",
+	}, []string{"status"})
+
+	// ProcessingDuration measures whole processing runs, from parsing to the
+	// final status
+	ProcessingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "file_processing_duration_seconds",
+		Help:      "Time to process a file, by final status.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12), // 0.5s to ~17min
+	}, []string{"status"})
+
+	// AIRequestDuration measures calls to the embedding, summary, agent and
+	// rerank APIs
+	AIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "ai_request_duration_seconds",
+		Help:      "Latency of AI API calls, by service.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service"})
+
+	// AIRequestErrors counts failed calls to the AI APIs
+	AIRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ai_request_errors_total",
+		Help:      "Failed AI API calls, by service.",
+	}, []string{"service"})
+
+	// AgentTurns records how many model turns each agent run took
+	AgentTurns = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "agent_turns",
+		Help:      "Model turns per agent run, by target (file or folder).",
+		Buckets:   prometheus.LinearBuckets(1, 1, 15),
+	}, []string{"target"})
+
+	// SearchDuration measures search queries that weren't served from the cache
+	SearchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "search_duration_seconds",
+		Help:      "Search query latency, by mode.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"mode"})
+)
+
+// ObserveAIRequest records the latency of an AI API call started at start,
+// counting it as an error when err is set
+func ObserveAIRequest(service string, start time.Time, err error) {
+	AIRequestDuration.WithLabelValues(service).Observe(time.Since(start).Seconds())
+	if err != nil {
+		AIRequestErrors.WithLabelValues(service).Inc()
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -18,6 +19,15 @@ const (
 	FileTypeInvoice  FileType = "invoice" // Special: use for any invoice-like docs
 )
 
+// IsValid reports whether t is one of the known file types
+func (t FileType) IsValid() bool {
+	switch t {
+	case FileTypeMusic, FileTypePhoto, FileTypeVideo, FileTypeDocument, FileTypeInvoice:
+		return true
+	}
+	return false
+}
+
 // FileProcessingStatus represents the processing status of a file
 type FileProcessingStatus string
 
@@ -26,30 +36,57 @@ const (
 	FileStatusProcessing FileProcessingStatus = "processing"
 	FileStatusCompleted  FileProcessingStatus = "completed"
 	FileStatusFailed     FileProcessingStatus = "failed"
+	// FileStatusNeedsReview marks a file whose parse looked unusable, e.g.
+	// almost no text, so it can be re-uploaded or retried
+	FileStatusNeedsReview FileProcessingStatus = "needs_review"
 )
 
+// ProcessingErrorFileEncrypted is the processing error code for password-protected files
+const ProcessingErrorFileEncrypted = "file_encrypted"
+
+// ProcessingErrorContentTooShort is the processing error code for files whose
+// parsed text is shorter than the configured minimum
+const ProcessingErrorContentTooShort = "content_too_short"
+
+// ProcessingErrorCanceled is the processing error code for files whose processing was canceled
+const ProcessingErrorCanceled = "canceled"
+
 // File represents a file in the file management system
 type File struct {
-	ID               uint                 `gorm:"primaryKey" json:"id"`
-	UserID           string               `gorm:"index;not null;type:varchar(255)" json:"user_id"`
-	Title            string               `gorm:"not null;type:varchar(255)" json:"title"`
-	Summary          string               `gorm:"type:text" json:"summary"`
-	Content          string               `gorm:"type:text" json:"content"` // Parsed text content
-	FileType         FileType             `gorm:"type:varchar(20);default:'document'" json:"file_type"`
-	FolderID         *uint                `gorm:"index" json:"folder_id"`
-	Folder           *Folder              `gorm:"foreignKey:FolderID" json:"folder,omitempty"`
-	Tags             []Tag                `gorm:"many2many:file_tags" json:"tags,omitempty"`
-	S3Key            string               `gorm:"uniqueIndex;not null" json:"s3_key"`
-	OriginalFilename string               `gorm:"not null;type:varchar(255)" json:"original_filename"`
-	MimeType         string               `gorm:"type:varchar(255)" json:"mime_type"`
-	Size             int64                `json:"size"`
-	ProcessingStatus FileProcessingStatus `gorm:"type:varchar(20);default:'pending'" json:"processing_status"`
-	ProcessingError  string               `gorm:"type:text" json:"processing_error,omitempty"`
-	HasEmbedding     bool                 `gorm:"default:false" json:"has_embedding"`
-	InvoiceID        *int64               `gorm:"index" json:"invoice_id,omitempty"` // External invoice system ID
-	CreatedAt        time.Time            `json:"created_at"`
-	UpdatedAt        time.Time            `json:"updated_at"`
-	DeletedAt        gorm.DeletedAt       `gorm:"index" json:"-"`
+	ID                  uint                 `gorm:"primaryKey" json:"id"`
+	PublicID            string               `gorm:"uniqueIndex;type:varchar(36)" json:"public_id"` // Immutable UUID safe to expose in links
+	UserID              string               `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	Title               string               `gorm:"not null;type:varchar(255)" json:"title"`
+	Summary             string               `gorm:"type:text" json:"summary"`
+	Content             string               `gorm:"type:text" json:"content"`                   // Parsed text content
+	ProcessingHint      string               `gorm:"type:text" json:"processing_hint,omitempty"` // User instructions for the agent
+	FileType            FileType             `gorm:"type:varchar(20);default:'document'" json:"file_type"`
+	FileTypeSetByUser   bool                 `gorm:"default:false" json:"-"` // Chosen by the user, so reclassifying leaves it alone
+	FolderID            *uint                `gorm:"index" json:"folder_id"`
+	Folder              *Folder              `gorm:"foreignKey:FolderID" json:"folder,omitempty"`
+	Tags                []Tag                `gorm:"many2many:file_tags" json:"tags,omitempty"`
+	S3Key               string               `gorm:"uniqueIndex;not null" json:"s3_key"`
+	StorageConfigID     *uint                `gorm:"index" json:"-"` // Bucket the object was uploaded to; nil for the global bucket
+	OriginalFilename    string               `gorm:"not null;type:varchar(255)" json:"original_filename"`
+	MimeType            string               `gorm:"type:varchar(255)" json:"mime_type"`                    // Declared type, corrected from the file's content during processing
+	DeclaredMimeType    string               `gorm:"type:varchar(255)" json:"declared_mime_type,omitempty"` // Type the client sent when the file was created
+	DetectedMimeType    string               `gorm:"type:varchar(255)" json:"detected_mime_type,omitempty"` // Type sniffed from the file's leading bytes
+	Size                int64                `json:"size"`
+	WordCount           int                  `gorm:"default:0" json:"word_count"` // Words in the parsed content
+	CharCount           int                  `gorm:"default:0" json:"char_count"` // Characters in the parsed content
+	ProcessingStatus    FileProcessingStatus `gorm:"type:varchar(20);default:'pending'" json:"processing_status"`
+	ProcessingError     string               `gorm:"type:text" json:"processing_error,omitempty"`
+	ProcessingErrorCode string               `gorm:"type:varchar(50)" json:"processing_error_code,omitempty"`
+	ProcessingStartedAt *time.Time           `gorm:"index" json:"processing_started_at,omitempty"`
+	ProcessingEndedAt   *time.Time           `gorm:"index" json:"processing_ended_at,omitempty"` // When processing last completed or failed
+	HasEmbedding        bool                 `gorm:"default:false" json:"has_embedding"`
+	SummaryIsFallback   bool                 `gorm:"default:false" json:"summary_is_fallback"`         // Summary is a text excerpt because the AI summary failed
+	Entities            *FileEntities        `gorm:"type:text" json:"entities,omitempty"`              // Key entities extracted alongside the summary, when enabled
+	InvoiceID           *int64               `gorm:"index" json:"invoice_id,omitempty"`                // External invoice system ID
+	RelatedFiles        []FileRelation       `gorm:"foreignKey:FileID" json:"related_files,omitempty"` // Loaded only when getting a single file
+	CreatedAt           time.Time            `json:"created_at"`
+	UpdatedAt           time.Time            `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt       `gorm:"index" json:"-"`
 }
 
 // TableName specifies the table name for File
@@ -57,6 +94,14 @@ func (File) TableName() string {
 	return "files"
 }
 
+// BeforeCreate assigns the public ID of new files
+func (f *File) BeforeCreate(tx *gorm.DB) error {
+	if f.PublicID == "" {
+		f.PublicID = uuid.NewString()
+	}
+	return nil
+}
+
 // DetectFileTypeFromMimeType returns the initial file type based on MIME type
 func DetectFileTypeFromMimeType(mimeType string) FileType {
 	mimeType = strings.ToLower(mimeType)
@@ -121,3 +166,16 @@ func IsInvoiceContent(content string) bool {
 	// If 2 or more keywords match, consider it an invoice
 	return matchCount >= 2
 }
+
+// ClassifyContent returns the file type for a file's parsed content: invoice
+// when the content reads as an invoice, otherwise the current type, except that
+// a former invoice falls back to the type of its MIME type
+func ClassifyContent(current FileType, mimeType, content string) FileType {
+	if IsInvoiceContent(content) {
+		return FileTypeInvoice
+	}
+	if current == FileTypeInvoice {
+		return DetectFileTypeFromMimeType(mimeType)
+	}
+	return current
+}
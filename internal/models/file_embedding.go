@@ -10,6 +10,13 @@ type FileEmbedding struct {
 	// Embedding is stored as JSON text for GORM compatibility
 	// For Turso vector operations, use raw SQL with F32_BLOB
 	Embedding string `gorm:"type:text" json:"embedding"`
+	// Model and Dimensions record which embedding model produced the vector.
+	// Vectors from different models are not comparable.
+	Model      string `gorm:"index;type:varchar(255)" json:"model"`
+	Dimensions int    `json:"dimensions"`
+	// ContentHash is the SHA-256 of the text the vector was generated from, so
+	// reprocessing can skip the embedding call when the content is unchanged
+	ContentHash string `gorm:"type:varchar(64)" json:"content_hash"`
 }
 
 // TableName specifies the table name for FileEmbedding
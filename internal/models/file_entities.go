@@ -0,0 +1,62 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+)
+
+// EntityTypes are the kinds of entity extracted from a file, named as the
+// FileEntities JSON keys
+var EntityTypes = []string{"people", "organizations", "dates", "amounts"}
+
+// EntityAmount is a monetary amount mentioned in a file
+type EntityAmount struct {
+	Value    float64 `json:"value"`
+	Currency string  `json:"currency,omitempty"` // ISO 4217 code when known
+}
+
+// FileEntities holds the key entities extracted from a file's content. It is
+// stored as JSON so list filters can query it with SQLite's JSON functions.
+type FileEntities struct {
+	People        []string       `json:"people"`
+	Organizations []string       `json:"organizations"`
+	Dates         []string       `json:"dates"` // YYYY-MM-DD
+	Amounts       []EntityAmount `json:"amounts"`
+}
+
+// IsEmpty reports whether no entities were found
+func (e FileEntities) IsEmpty() bool {
+	return len(e.People) == 0 && len(e.Organizations) == 0 && len(e.Dates) == 0 && len(e.Amounts) == 0
+}
+
+// Value implements the driver.Valuer interface
+func (e FileEntities) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (e *FileEntities) Scan(value interface{}) error {
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	case nil:
+		*e = FileEntities{}
+		return nil
+	default:
+		return errors.New("type assertion to []byte failed")
+	}
+
+	if len(bytes) == 0 {
+		*e = FileEntities{}
+		return nil
+	}
+	return json.Unmarshal(bytes, e)
+}
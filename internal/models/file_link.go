@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// FileLink references a file from an additional folder without copying it.
+// The file still lives in its own FolderID; removing a link never deletes the file.
+type FileLink struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	FileID    uint      `gorm:"uniqueIndex:idx_file_links_file_folder;not null" json:"file_id"`
+	FolderID  uint      `gorm:"uniqueIndex:idx_file_links_file_folder;index;not null" json:"folder_id"`
+	UserID    string    `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for FileLink
+func (FileLink) TableName() string {
+	return "file_links"
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// FileRelationAttachment is the default relation type: the related file is a
+// supporting document of the file, e.g. a receipt attached to an invoice
+const FileRelationAttachment = "attachment"
+
+// FileRelation associates a primary file with a related file. Relations are
+// directional; removing one never deletes either file.
+type FileRelation struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	FileID        uint      `gorm:"uniqueIndex:idx_file_relations_pair;not null" json:"file_id"`
+	RelatedFileID uint      `gorm:"uniqueIndex:idx_file_relations_pair;index;not null" json:"related_file_id"`
+	RelatedFile   *File     `gorm:"foreignKey:RelatedFileID" json:"related_file,omitempty"`
+	RelationType  string    `gorm:"type:varchar(50);not null;default:'attachment'" json:"relation_type"`
+	UserID        string    `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for FileRelation
+func (FileRelation) TableName() string {
+	return "file_relations"
+}
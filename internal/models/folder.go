@@ -13,7 +13,8 @@ type Folder struct {
 	UserID      string         `gorm:"index;not null;type:varchar(255)" json:"user_id"`
 	Name        string         `gorm:"not null;type:varchar(255)" json:"name"`
 	Description string         `gorm:"type:text" json:"description"`
-	ParentID    *uint          `gorm:"index" json:"parent_id"` // nil = root folder
+	S3Prefix    string         `gorm:"type:varchar(255)" json:"s3_prefix,omitempty"` // Key prefix for objects of files in this folder
+	ParentID    *uint          `gorm:"index" json:"parent_id"`                       // nil = root folder
 	Parent      *Folder        `gorm:"foreignKey:ParentID" json:"parent,omitempty"`
 	Children    []Folder       `gorm:"foreignKey:ParentID" json:"children,omitempty"`
 	Tags        []Tag          `gorm:"many2many:folder_tags" json:"tags,omitempty"`
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// FolderAlias is a former path of a folder, recorded when the folder is moved
+// or renamed so references to the old path keep resolving to it
+type FolderAlias struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	FolderID  uint      `gorm:"index;not null" json:"folder_id"`
+	UserID    string    `gorm:"index:idx_folder_aliases_user_path;not null;type:varchar(255)" json:"user_id"`
+	Path      string    `gorm:"index:idx_folder_aliases_user_path;not null;type:varchar(1024)" json:"path"` // Slash-separated folder names from the root
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for FolderAlias
+func (FolderAlias) TableName() string {
+	return "folder_aliases"
+}
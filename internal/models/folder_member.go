@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// FolderRole is the access a folder member has
+type FolderRole string
+
+const (
+	FolderRoleOwner FolderRole = "owner" // Implicit; never stored on a FolderMember
+	FolderRoleRead  FolderRole = "read"
+	FolderRoleWrite FolderRole = "write"
+)
+
+// IsValid reports whether the role can be granted to a member
+func (r FolderRole) IsValid() bool {
+	return r == FolderRoleRead || r == FolderRoleWrite
+}
+
+// FolderMember grants another user access to a folder and all of its
+// subfolders and files. The folder and its contents stay owned by the folder's
+// UserID.
+type FolderMember struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	FolderID  uint       `gorm:"uniqueIndex:idx_folder_members_folder_user;not null" json:"folder_id"`
+	UserID    string     `gorm:"uniqueIndex:idx_folder_members_folder_user;index;not null;type:varchar(255)" json:"user_id"`
+	Role      FolderRole `gorm:"not null;type:varchar(16)" json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for FolderMember
+func (FolderMember) TableName() string {
+	return "folder_members"
+}
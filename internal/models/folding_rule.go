@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// FoldingRule moves a file into TargetFolderID when TagID is added to it. A
+// user has at most one rule per tag.
+type FoldingRule struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	UserID         string    `gorm:"uniqueIndex:idx_folding_rules_user_tag;not null;type:varchar(255)" json:"user_id"`
+	TagID          uint      `gorm:"uniqueIndex:idx_folding_rules_user_tag;not null" json:"tag_id"`
+	TargetFolderID uint      `gorm:"index;not null" json:"target_folder_id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for FoldingRule
+func (FoldingRule) TableName() string {
+	return "folding_rules"
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// JobLease records which server instance currently runs a background job.
+// A lease that hasn't been renewed by ExpiresAt can be taken by another instance.
+type JobLease struct {
+	Name      string    `gorm:"primaryKey;type:varchar(100)" json:"name"`
+	Holder    string    `gorm:"type:varchar(64)" json:"holder"` // Instance that holds the lease
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// TableName specifies the table name for JobLease
+func (JobLease) TableName() string {
+	return "job_leases"
+}
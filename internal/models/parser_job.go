@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// ParserJob is a file processing run waiting on an async content parser. The
+// parser calls back with Token; the job holds what is needed to resume the
+// run. A file has at most one job, so a callback for a superseded run is
+// rejected, as is one arriving after ExpiresAt.
+type ParserJob struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	Token        string    `gorm:"uniqueIndex;not null;type:varchar(64)" json:"-"`
+	UserID       string    `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	FileID       uint      `gorm:"uniqueIndex;not null" json:"file_id"`
+	SummaryModel string    `gorm:"type:varchar(255)" json:"summary_model,omitempty"`
+	AgentModel   string    `gorm:"type:varchar(255)" json:"agent_model,omitempty"`
+	ExpiresAt    time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for ParserJob
+func (ParserJob) TableName() string {
+	return "parser_jobs"
+}
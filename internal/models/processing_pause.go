@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ProcessingPause records whether an operator has paused file processing.
+// The table holds a single row shared by every server instance.
+type ProcessingPause struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	Paused    bool       `gorm:"not null;default:false" json:"paused"`
+	ResumedAt *time.Time `json:"resumed_at,omitempty"` // When processing was last resumed
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for ProcessingPause
+func (ProcessingPause) TableName() string {
+	return "processing_pause"
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// StorageConfig points a user's files at their own S3-compatible bucket.
+// Users without a StorageConfig use the globally configured bucket.
+//
+// A user's newest config receives their uploads; each file keeps the config
+// it was uploaded with. Move a user to another bucket by adding a config and
+// soft-deleting the old one, so their existing files stay readable. Editing a
+// config in place is only meant for rotating its credentials.
+type StorageConfig struct {
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	UserID          string         `gorm:"index:idx_storage_configs_owner;not null;type:varchar(255)" json:"user_id"`
+	Endpoint        string         `gorm:"type:varchar(512)" json:"endpoint"`
+	Bucket          string         `gorm:"not null;type:varchar(255)" json:"bucket"`
+	AccessKeyID     string         `gorm:"type:varchar(255)" json:"access_key_id"`
+	SecretAccessKey string         `gorm:"type:varchar(512)" json:"-"` // Encrypted with STORAGE_ENCRYPTION_KEY
+	Region          string         `gorm:"type:varchar(64)" json:"region"`
+	UsePathStyle    bool           `gorm:"default:false" json:"use_path_style"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName specifies the table name for StorageConfig
+func (StorageConfig) TableName() string {
+	return "storage_configs"
+}
@@ -13,6 +13,7 @@ type Tag struct {
 	Name        string         `gorm:"not null;type:varchar(255)" json:"name"`
 	Color       string         `gorm:"type:varchar(7)" json:"color"` // Hex color code (e.g., #FF5733)
 	Description string         `gorm:"type:text" json:"description"`
+	Aliases     []TagAlias     `gorm:"foreignKey:TagID" json:"aliases,omitempty"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
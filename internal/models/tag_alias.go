@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// TagAlias is an alternate name (synonym) for a tag. Tag searches match
+// aliases as well as names so existing tags can be found under other wording.
+type TagAlias struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	TagID     uint      `gorm:"index;not null" json:"tag_id"`
+	UserID    string    `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	Alias     string    `gorm:"not null;type:varchar(255)" json:"alias"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TagAlias
+func (TagAlias) TableName() string {
+	return "tag_aliases"
+}
@@ -0,0 +1,21 @@
+package models
+
+// TagEmbedding stores the vector embedding of a tag's name, description and aliases.
+// Used to auto-tag files whose embedding is similar to the tag.
+type TagEmbedding struct {
+	ID     uint   `gorm:"primaryKey" json:"id"`
+	TagID  uint   `gorm:"uniqueIndex;not null" json:"tag_id"`
+	UserID string `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	// Embedding is stored as JSON text, like FileEmbedding
+	Embedding string `gorm:"type:text" json:"embedding"`
+	// Source is the text that was embedded. The embedding is regenerated when
+	// the tag's text or the embedding model changes.
+	Source     string `gorm:"type:text" json:"source"`
+	Model      string `gorm:"type:varchar(255)" json:"model"`
+	Dimensions int    `json:"dimensions"`
+}
+
+// TableName specifies the table name for TagEmbedding
+func (TagEmbedding) TableName() string {
+	return "tag_embeddings"
+}
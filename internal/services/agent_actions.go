@@ -0,0 +1,184 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// DefaultAgentWebhookTimeout is how long a webhook delivery may take
+const DefaultAgentWebhookTimeout = 10 * time.Second
+
+// AgentActionType identifies a change the agent made on its own
+type AgentActionType string
+
+const (
+	AgentActionFileMoved     AgentActionType = "file.moved"
+	AgentActionFileTagged    AgentActionType = "file.tagged"
+	AgentActionFolderCreated AgentActionType = "folder.created"
+	AgentActionFolderTagged  AgentActionType = "folder.tagged"
+	AgentActionTagCreated    AgentActionType = "tag.created"
+)
+
+// AgentActionEvent describes a mutating agent tool execution. Before is nil for
+// created resources.
+type AgentActionEvent struct {
+	ID         string          `json:"id"`
+	Type       AgentActionType `json:"type"`
+	UserID     string          `json:"user_id"`
+	Tool       string          `json:"tool"`
+	Before     interface{}     `json:"before"`
+	After      interface{}     `json:"after"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// AgentFileState is the file state included in agent action events
+type AgentFileState struct {
+	ID       uint   `json:"id"`
+	Title    string `json:"title"`
+	FolderID *uint  `json:"folder_id"`
+	TagIDs   []uint `json:"tag_ids"`
+}
+
+// AgentFolderState is the folder state included in agent action events
+type AgentFolderState struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ParentID    *uint  `json:"parent_id"`
+	TagIDs      []uint `json:"tag_ids"`
+}
+
+// AgentTagState is the tag state included in agent action events
+type AgentTagState struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// AgentActionPublisher receives the changes the agent makes. Publish is called
+// synchronously from the agent loop and must not block.
+type AgentActionPublisher interface {
+	Publish(event AgentActionEvent)
+}
+
+// AgentWebhookConfig holds configuration for delivering agent actions to a webhook
+type AgentWebhookConfig struct {
+	URL     string
+	Secret  string // Signs the body with HMAC-SHA256 in X-Webhook-Signature when set
+	Timeout time.Duration
+}
+
+type webhookActionPublisher struct {
+	config AgentWebhookConfig
+	client *http.Client
+}
+
+// NewWebhookActionPublisher creates a publisher that POSTs each event as JSON
+// to the configured URL. Delivery is best-effort and happens in the background.
+func NewWebhookActionPublisher(config AgentWebhookConfig) AgentActionPublisher {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultAgentWebhookTimeout
+	}
+	return &webhookActionPublisher{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Publish delivers the event without blocking the caller
+func (p *webhookActionPublisher) Publish(event AgentActionEvent) {
+	go func() {
+		if err := p.deliver(event); err != nil {
+			log.Printf("[AgentWebhook] Failed to deliver %s event %s: %v", event.Type, event.ID, err)
+		}
+	}()
+}
+
+func (p *webhookActionPublisher) deliver(event AgentActionEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", string(event.Type))
+	if p.config.Secret != "" {
+		req.Header.Set("X-Webhook-Signature", "sha256="+signWebhookBody(p.config.Secret, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// signWebhookBody returns the hex HMAC-SHA256 of body
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newAgentActionEvent builds an event for a tool execution
+func newAgentActionEvent(actionType AgentActionType, userID, tool string, before, after interface{}) AgentActionEvent {
+	return AgentActionEvent{
+		ID:         uuid.New().String(),
+		Type:       actionType,
+		UserID:     userID,
+		Tool:       tool,
+		Before:     before,
+		After:      after,
+		OccurredAt: time.Now(),
+	}
+}
+
+func agentFileState(file *models.File) *AgentFileState {
+	state := &AgentFileState{ID: file.ID, Title: file.Title, FolderID: file.FolderID, TagIDs: []uint{}}
+	for _, tag := range file.Tags {
+		state.TagIDs = append(state.TagIDs, tag.ID)
+	}
+	return state
+}
+
+func agentFolderState(folder *models.Folder) *AgentFolderState {
+	state := &AgentFolderState{
+		ID:          folder.ID,
+		Name:        folder.Name,
+		Description: folder.Description,
+		ParentID:    folder.ParentID,
+		TagIDs:      []uint{},
+	}
+	for _, tag := range folder.Tags {
+		state.TagIDs = append(state.TagIDs, tag.ID)
+	}
+	return state
+}
+
+func agentTagState(tag *models.Tag) *AgentTagState {
+	return &AgentTagState{ID: tag.ID, Name: tag.Name, Color: tag.Color, Description: tag.Description}
+}
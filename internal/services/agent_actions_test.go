@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingActionPublisher struct {
+	events []AgentActionEvent
+}
+
+func (p *recordingActionPublisher) Publish(event AgentActionEvent) {
+	p.events = append(p.events, event)
+}
+
+func TestAgentActions_MoveFilePublishesBeforeAndAfter(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+	actions := &recordingActionPublisher{}
+	service.actions = actions
+
+	folder := &models.Folder{Name: "Invoices"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, folder))
+	file := &models.File{Title: "receipt", S3Key: "receipt.pdf", OriginalFilename: "receipt.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+
+	_, err := service.executeMoveFile(context.Background(), agentTestUserID, file.ID, map[string]interface{}{"folder_id": float64(folder.ID)}, nil)
+	require.NoError(t, err)
+	_, err = service.executeCreateTag(agentTestUserID, map[string]interface{}{"name": "Office Supplies"}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, actions.events, 2)
+
+	moved := actions.events[0]
+	assert.Equal(t, AgentActionFileMoved, moved.Type)
+	assert.Equal(t, "move_file", moved.Tool)
+	assert.Nil(t, moved.Before.(*AgentFileState).FolderID)
+	assert.Equal(t, folder.ID, *moved.After.(*AgentFileState).FolderID)
+
+	created := actions.events[1]
+	assert.Equal(t, AgentActionTagCreated, created.Type)
+	assert.Nil(t, created.Before)
+	assert.Equal(t, "office-supplies", created.After.(*AgentTagState).Name)
+}
+
+func TestAgentActions_MoveFilePlacesObjectUnderFolderPrefix(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+	uploadService := NewMockUploadService()
+	service.placement = NewFilePlacement(service.fileService, folderService, uploadService)
+
+	folder := &models.Folder{Name: "Invoices", S3Prefix: "invoices"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, folder))
+	key, err := uploadService.UploadFile(context.Background(), agentTestUserID, "", "receipt.pdf", []byte("pdf"), "application/pdf")
+	require.NoError(t, err)
+	file := &models.File{Title: "receipt", S3Key: key, OriginalFilename: "receipt.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+
+	_, err = service.executeMoveFile(context.Background(), agentTestUserID, file.ID, map[string]interface{}{"folder_id": float64(folder.ID)}, nil)
+	require.NoError(t, err)
+
+	moved, err := service.fileService.GetFileByID(agentTestUserID, file.ID)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(moved.S3Key, "files/"+agentTestUserID+"/invoices/"), moved.S3Key)
+}
+
+func TestWebhookActionPublisher_SignsBody(t *testing.T) {
+	var body []byte
+	var signature, eventType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get("X-Webhook-Signature")
+		eventType = r.Header.Get("X-Webhook-Event")
+	}))
+	defer server.Close()
+
+	publisher := NewWebhookActionPublisher(AgentWebhookConfig{URL: server.URL, Secret: "secret"}).(*webhookActionPublisher)
+	event := newAgentActionEvent(AgentActionFolderCreated, agentTestUserID, "create_folder", nil, &AgentFolderState{ID: 1, Name: "Invoices"})
+	require.NoError(t, publisher.deliver(event))
+
+	assert.Equal(t, "folder.created", eventType)
+	assert.Equal(t, "sha256="+signWebhookBody("secret", body), signature)
+
+	var received AgentActionEvent
+	require.NoError(t, json.Unmarshal(body, &received))
+	assert.Equal(t, event.ID, received.ID)
+	assert.Nil(t, received.Before)
+}
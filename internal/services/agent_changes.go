@@ -0,0 +1,125 @@
+package services
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// AgentTagRef identifies a tag in AgentChanges
+type AgentTagRef struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// AgentFolderRef identifies a folder in AgentChanges. ID 0 with an empty path
+// is the root.
+type AgentFolderRef struct {
+	ID   uint   `json:"id"`
+	Path string `json:"path"`
+}
+
+// AgentChanges lists what an agent run on a file actually changed. It is sent
+// as the data of the run's terminal event so clients can summarize the run
+// without parsing the agent's reply.
+type AgentChanges struct {
+	TagsAdded []AgentTagRef `json:"tags_added"`
+	// TagsAlreadyPresent are tags the agent asked to add that the file
+	// already had before the run, e.g. tags the user added manually. They are
+	// left as they are; the agent never removes tags.
+	TagsAlreadyPresent []AgentTagRef    `json:"tags_already_present"`
+	MovedTo            *AgentFolderRef  `json:"moved_to,omitempty"`
+	TagsCreated        []AgentTagRef    `json:"tags_created"`
+	FoldersCreated     []AgentFolderRef `json:"folders_created"`
+}
+
+func newAgentChanges() *AgentChanges {
+	return &AgentChanges{
+		TagsAdded:          []AgentTagRef{},
+		TagsAlreadyPresent: []AgentTagRef{},
+		TagsCreated:        []AgentTagRef{},
+		FoldersCreated:     []AgentFolderRef{},
+	}
+}
+
+// The record methods do nothing on a nil *AgentChanges, so tools shared with
+// runs that don't collect changes can pass nil
+
+func (c *AgentChanges) recordTagsAdded(tags []models.Tag) {
+	if c == nil {
+		return
+	}
+	for _, tag := range tags {
+		c.TagsAdded = append(c.TagsAdded, AgentTagRef{ID: tag.ID, Name: tag.Name})
+	}
+}
+
+// recordTagsAlreadyPresent skips tags the run added itself or already
+// recorded, so each tag is listed once
+func (c *AgentChanges) recordTagsAlreadyPresent(tags []models.Tag) {
+	if c == nil {
+		return
+	}
+	for _, tag := range tags {
+		if !hasTagRef(c.TagsAdded, tag.ID) && !hasTagRef(c.TagsAlreadyPresent, tag.ID) {
+			c.TagsAlreadyPresent = append(c.TagsAlreadyPresent, AgentTagRef{ID: tag.ID, Name: tag.Name})
+		}
+	}
+}
+
+func hasTagRef(refs []AgentTagRef, id uint) bool {
+	return slices.ContainsFunc(refs, func(ref AgentTagRef) bool { return ref.ID == id })
+}
+
+func (c *AgentChanges) recordTagCreated(tag *models.Tag) {
+	if c == nil {
+		return
+	}
+	c.TagsCreated = append(c.TagsCreated, AgentTagRef{ID: tag.ID, Name: tag.Name})
+}
+
+func (c *AgentChanges) recordMove(folder AgentFolderRef) {
+	if c == nil {
+		return
+	}
+	c.MovedTo = &folder
+}
+
+func (c *AgentChanges) recordFolderCreated(folder AgentFolderRef) {
+	if c == nil {
+		return
+	}
+	c.FoldersCreated = append(c.FoldersCreated, folder)
+}
+
+// folderRef returns the folder's ID and slash-separated path, or the root for
+// a nil folderID
+func (s *agentService) folderRef(userID string, folderID *uint) AgentFolderRef {
+	if folderID == nil {
+		return AgentFolderRef{}
+	}
+	ref := AgentFolderRef{ID: *folderID}
+	path, err := s.folderService.GetFolderPath(userID, *folderID)
+	if err != nil {
+		return ref
+	}
+	names := make([]string, len(path))
+	for i, folder := range path {
+		names[i] = folder.Name
+	}
+	ref.Path = strings.Join(names, "/")
+	return ref
+}
+
+// tagsByID returns the user's tags with the given IDs, in the order given
+func (s *agentService) tagsByID(userID string, ids []uint) []models.Tag {
+	tags := make([]models.Tag, 0, len(ids))
+	for _, id := range ids {
+		tag, err := s.tagService.GetTagByID(userID, id)
+		if err == nil && tag != nil {
+			tags = append(tags, *tag)
+		}
+	}
+	return tags
+}
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 )
 
@@ -19,11 +23,41 @@ type AgentConfig struct {
 	Model      string // AGENT_MODEL env var (default: gpt-4o-mini)
 	MaxTurns   int    // AGENT_MAX_TURNS env var (default: 10)
 	Enabled    bool   // AGENT_ENABLED env var (default: true)
+
+	// MaxContentChars bounds the summary and content sent in the file prompt.
+	// AGENT_MAX_CONTENT_CHARS env var (default: 5000); raise it for models with
+	// larger context windows.
+	MaxContentChars int
+
+	// SkipOrganizedMinTags lets OrganizeFile skip the model call for files that
+	// are already in a folder and have at least this many tags. 0 always runs
+	// the agent. AGENT_SKIP_ORGANIZED_MIN_TAGS env var (default: 1).
+	SkipOrganizedMinTags int
+
+	// EmbedBeforeAgent runs the embedding step of processing before the agent
+	// so find_similar_files can use the new file's embedding.
+	// AGENT_EMBED_FIRST env var (default: false).
+	EmbedBeforeAgent bool
 }
 
+// defaultSimilarFilesLimit is how many files find_similar_files returns when
+// the agent doesn't ask for a number
+const defaultSimilarFilesLimit = 5
+
+// defaultFolderSuggestionLimit is how many folders suggest_folder_for_tags
+// returns when the agent doesn't ask for a number
+const defaultFolderSuggestionLimit = 5
+
+// maxAgentListLimit caps the limit the agent can ask the list tools for, so
+// one call can't fill its context
+const maxAgentListLimit = 20
+
+// defaultAgentMaxContentChars is the file prompt budget when none is configured
+const defaultAgentMaxContentChars = 5000
+
 // AgentEvent represents a real-time status update from the agent
 type AgentEvent struct {
-	Type     string      `json:"type"`                // "status", "tool_call", "tool_result", "thinking", "result", "error"
+	Type     string      `json:"type"`                // "status", "tool_call", "tool_result", "tool_error", "thinking", "result", "error"
 	Message  string      `json:"message"`             // Human-readable status message
 	Data     interface{} `json:"data,omitempty"`      // Optional additional data
 	Tool     string      `json:"tool,omitempty"`      // Tool name if type is tool_call
@@ -34,20 +68,43 @@ type AgentEvent struct {
 // AgentService handles AI-powered file organization
 type AgentService interface {
 	// ProcessFileWithAgent runs the agent to tag and organize a file
+	// model overrides the configured model for this run when non-empty
 	// eventChan receives real-time status updates for SSE streaming
 	ProcessFileWithAgent(ctx context.Context, userID string, fileID uint,
-		content, summary string, eventChan chan<- AgentEvent) error
+		content, summary, model string, eventChan chan<- AgentEvent) error
 
 	// OrganizeFile lets user trigger AI to reorganize an existing file
 	OrganizeFile(ctx context.Context, userID string, fileID uint,
 		eventChan chan<- AgentEvent) error
 
 	// OrganizeFolder lets user trigger AI to organize all files in a folder
+	// excludeFolderIDs skips those subfolders when includeSubfolders is set
 	OrganizeFolder(ctx context.Context, userID string, folderID uint,
-		includeSubfolders bool, eventChan chan<- AgentEvent) error
+		includeSubfolders bool, excludeFolderIDs []uint, eventChan chan<- AgentEvent) error
 
 	// IsEnabled returns whether the agent is enabled
 	IsEnabled() bool
+
+	// Capabilities describes the agent's configuration and the tools it can call
+	Capabilities() AgentCapabilities
+}
+
+// AgentToolInfo describes a tool the agent can call
+type AgentToolInfo struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{}
+}
+
+// AgentCapabilities describes what the agent can do and how it is configured
+type AgentCapabilities struct {
+	Enabled          bool
+	Model            string
+	MaxTurns         int
+	DryRunSupported  bool
+	EmbedBeforeAgent bool            // Processing embeds files before running the agent
+	FileTools        []AgentToolInfo // Tools used when organizing a file
+	FolderTools      []AgentToolInfo // Tools used when organizing a folder
 }
 
 type agentService struct {
@@ -56,14 +113,21 @@ type agentService struct {
 	tagService    TagService
 	fileService   FileService
 	folderService FolderService
+	placement     *FilePlacement       // Moves files and places their objects under folder prefixes
+	searchService SearchService        // Optional, backs find_similar_files
+	actions       AgentActionPublisher // Optional, receives the changes the agent makes
 }
 
-// NewAgentService creates a new AgentService
+// NewAgentService creates a new AgentService. uploadService may be nil when
+// no storage is configured.
 func NewAgentService(
 	config AgentConfig,
 	tagService TagService,
 	fileService FileService,
 	folderService FolderService,
+	uploadService UploadService,
+	searchService SearchService,
+	actions AgentActionPublisher,
 ) AgentService {
 	if config.MaxTurns <= 0 {
 		config.MaxTurns = 10
@@ -71,12 +135,18 @@ func NewAgentService(
 	if config.Model == "" {
 		config.Model = "gpt-4o-mini"
 	}
+	if config.MaxContentChars <= 0 {
+		config.MaxContentChars = defaultAgentMaxContentChars
+	}
 	return &agentService{
 		config:        config,
 		client:        &http.Client{},
 		tagService:    tagService,
 		fileService:   fileService,
 		folderService: folderService,
+		placement:     NewFilePlacement(fileService, folderService, uploadService),
+		searchService: searchService,
+		actions:       actions,
 	}
 }
 
@@ -84,6 +154,35 @@ func (s *agentService) IsEnabled() bool {
 	return s.config.Enabled && s.config.GatewayURL != "" && s.config.APIKey != ""
 }
 
+// Capabilities describes the agent's configuration and the tools it can call
+func (s *agentService) Capabilities() AgentCapabilities {
+	return AgentCapabilities{
+		Enabled:          s.IsEnabled(),
+		Model:            s.config.Model,
+		MaxTurns:         s.config.MaxTurns,
+		EmbedBeforeAgent: s.config.EmbedBeforeAgent,
+		FileTools:        toolInfos(s.getTools()),
+		FolderTools:      toolInfos(s.getFolderTools()),
+	}
+}
+
+// toolInfos converts tool definitions to their public description
+func toolInfos(tools []toolDefinition) []AgentToolInfo {
+	infos := make([]AgentToolInfo, len(tools))
+	for i, tool := range tools {
+		infos[i] = AgentToolInfo{
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			Parameters: map[string]interface{}{
+				"type":       tool.Function.Parameters.Type,
+				"properties": tool.Function.Parameters.Properties,
+				"required":   tool.Function.Parameters.Required,
+			},
+		}
+	}
+	return infos
+}
+
 // Tool definitions for OpenAI-compatible function calling
 type toolDefinition struct {
 	Type     string         `json:"type"`
@@ -151,7 +250,7 @@ func (s *agentService) getTools() []toolDefinition {
 			Type: "function",
 			Function: functionSchema{
 				Name:        "search_tags",
-				Description: "Search for existing tags by keyword. Use this to find relevant tags before creating new ones.",
+				Description: "Search for existing tags by keyword. Matches tag names, descriptions and aliases (synonyms). Use this to find relevant tags before creating new ones.",
 				Parameters: parametersSchema{
 					Type: "object",
 					Properties: map[string]interface{}{
@@ -204,7 +303,7 @@ func (s *agentService) getTools() []toolDefinition {
 			Type: "function",
 			Function: functionSchema{
 				Name:        "add_tags_to_file",
-				Description: "Add one or more tags to the file being processed.",
+				Description: "Add one or more tags to the file being processed. Existing tags are never removed, and tags the file already has are reported as already applied instead of being added again.",
 				Parameters: parametersSchema{
 					Type: "object",
 					Properties: map[string]interface{}{
@@ -298,6 +397,44 @@ func (s *agentService) getTools() []toolDefinition {
 				},
 			},
 		},
+		{
+			Type: "function",
+			Function: functionSchema{
+				Name:        "find_similar_files",
+				Description: "Find the user's files whose content is most similar to the file being processed, with their folders. Files like this one are usually organized the same way, so use this to pick a folder.",
+				Parameters: parametersSchema{
+					Type: "object",
+					Properties: map[string]interface{}{
+						"limit": map[string]interface{}{
+							"type":        "integer",
+							"description": fmt.Sprintf("Maximum number of similar files to return (default %d, at most %d)", defaultSimilarFilesLimit, maxAgentListLimit),
+						},
+					},
+				},
+			},
+		},
+		{
+			Type: "function",
+			Function: functionSchema{
+				Name:        "suggest_folder_for_tags",
+				Description: "Find the folders where the user keeps files carrying the given tags, ranked by how many of the tags and files match. Use this after choosing tags to file the document the way similar files were filed.",
+				Parameters: parametersSchema{
+					Type: "object",
+					Properties: map[string]interface{}{
+						"tag_ids": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "integer"},
+							"description": "IDs of the tags to look up",
+						},
+						"limit": map[string]interface{}{
+							"type":        "integer",
+							"description": fmt.Sprintf("Maximum number of folders to return (default %d, at most %d)", defaultFolderSuggestionLimit, maxAgentListLimit),
+						},
+					},
+					Required: []string{"tag_ids"},
+				},
+			},
+		},
 	}
 }
 
@@ -316,6 +453,9 @@ Guidelines:
 - Consider the file type, content topics, and existing organization patterns
 - Explain your reasoning briefly before taking actions
 - When moving files, choose the most specific appropriate folder
+- Use find_similar_files to see where files like this one were put; prefer their folders when they fit
+- Once you know the file's tags, use suggest_folder_for_tags to see where the user files documents with those tags
+- Tags already on the file are kept: add_tags_to_file only adds, and tags the file already has are reported instead of added again
 
 Work efficiently - you have limited turns to complete the organization.`
 }
@@ -325,12 +465,15 @@ func (s *agentService) ProcessFileWithAgent(
 	ctx context.Context,
 	userID string,
 	fileID uint,
-	content, summary string,
+	content, summary, model string,
 	eventChan chan<- AgentEvent,
 ) error {
 	if !s.IsEnabled() {
 		return nil
 	}
+	if model == "" {
+		model = s.config.Model
+	}
 
 	// Get file info
 	file, err := s.fileService.GetFileByID(userID, fileID)
@@ -339,10 +482,13 @@ func (s *agentService) ProcessFileWithAgent(
 		return fmt.Errorf("failed to get file: %w", err)
 	}
 
-	// Truncate content for context window
-	truncatedContent := content
-	if len(truncatedContent) > 5000 {
-		truncatedContent = truncatedContent[:5000] + "..."
+	// Fit the summary and a content prefix into the context budget
+	summary, truncatedContent := budgetAgentPrompt(summary, content, s.config.MaxContentChars)
+
+	// The user's instructions for this file take precedence over the defaults
+	hintSection := ""
+	if file.ProcessingHint != "" {
+		hintSection = fmt.Sprintf("\n**User Instructions (follow these when choosing tags and folders):**\n%s\n", file.ProcessingHint)
 	}
 
 	// Build user prompt
@@ -352,13 +498,14 @@ func (s *agentService) ProcessFileWithAgent(
 - Title: %s
 - Type: %s
 - Current Folder: %s
+- Current Tags: %s
 
 **Summary:**
 %s
 
 **Content (truncated):**
 %s
-
+%s
 Please:
 1. First, list all existing tags to see what's available
 2. Search for and add relevant existing tags
@@ -369,8 +516,10 @@ Start by examining the existing tags and folder structure.`,
 		file.Title,
 		file.FileType,
 		getFolderName(file),
+		getTagNames(file),
 		summary,
 		truncatedContent,
+		hintSection,
 	)
 
 	// Initialize messages
@@ -385,10 +534,24 @@ Start by examining the existing tags and folder structure.`,
 		FileID:  fileID,
 	}
 
+	// Collect what the tools change for the terminal event
+	changes := newAgentChanges()
+
 	// Agent loop
+	turns := 0
+	defer func() { metrics.AgentTurns.WithLabelValues("file").Observe(float64(turns)) }()
 	for turn := 0; turn < s.config.MaxTurns; turn++ {
+		// Stop between turns once the client is gone. Each tool call is its
+		// own write, so changes already made stay consistent.
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("agent canceled: %w", err)
+		}
+		turns++
+
 		// Call LLM
-		response, err := s.callChatCompletions(ctx, messages)
+		start := time.Now()
+		response, err := s.callChatCompletions(ctx, model, messages)
+		metrics.ObserveAIRequest(metrics.AIServiceAgent, start, err)
 		if err != nil {
 			eventChan <- AgentEvent{Type: "error", Message: fmt.Sprintf("AI error: %v", err), FileID: fileID}
 			return fmt.Errorf("chat completion failed: %w", err)
@@ -418,17 +581,20 @@ Start by examining the existing tags and folder structure.`,
 				}
 
 				// Execute tool
-				result, err := s.executeTool(ctx, userID, fileID, tc)
+				result, err := s.executeTool(ctx, userID, fileID, tc, changes)
 				if err != nil {
 					result = fmt.Sprintf("Error: %v", err)
-				}
-
-				eventChan <- AgentEvent{
-					Type:    "tool_result",
-					Message: fmt.Sprintf("Result from %s", tc.Function.Name),
-					Tool:    tc.Function.Name,
-					FileID:  fileID,
-					Data:    result,
+					event := newToolErrorEvent(tc, err)
+					event.FileID = fileID
+					eventChan <- event
+				} else {
+					eventChan <- AgentEvent{
+						Type:    "tool_result",
+						Message: fmt.Sprintf("Result from %s", tc.Function.Name),
+						Tool:    tc.Function.Name,
+						FileID:  fileID,
+						Data:    result,
+					}
 				}
 
 				// Add tool result to messages
@@ -443,6 +609,7 @@ Start by examining the existing tags and folder structure.`,
 			eventChan <- AgentEvent{
 				Type:    "result",
 				Message: assistantMsg.Content,
+				Data:    changes,
 				FileID:  fileID,
 			}
 			return nil
@@ -452,6 +619,7 @@ Start by examining the existing tags and folder structure.`,
 				eventChan <- AgentEvent{
 					Type:    "result",
 					Message: assistantMsg.Content,
+					Data:    changes,
 					FileID:  fileID,
 				}
 			}
@@ -462,6 +630,7 @@ Start by examining the existing tags and folder structure.`,
 	eventChan <- AgentEvent{
 		Type:    "error",
 		Message: "Agent reached maximum turns without completing",
+		Data:    changes,
 		FileID:  fileID,
 	}
 	return fmt.Errorf("max turns exceeded")
@@ -480,7 +649,24 @@ func (s *agentService) OrganizeFile(
 		return fmt.Errorf("failed to get file: %w", err)
 	}
 
-	return s.ProcessFileWithAgent(ctx, userID, fileID, file.Content, file.Summary, eventChan)
+	if s.isAlreadyOrganized(file) {
+		eventChan <- AgentEvent{
+			Type:    "result",
+			Message: "No action needed: file is already in a folder and tagged",
+			Data:    map[string]interface{}{"skipped": true, "tag_count": len(file.Tags)},
+			FileID:  fileID,
+		}
+		return nil
+	}
+
+	return s.ProcessFileWithAgent(ctx, userID, fileID, file.Content, file.Summary, "", eventChan)
+}
+
+// isAlreadyOrganized reports whether a file is in a folder and tagged enough
+// that re-running the agent on it is unlikely to change anything
+func (s *agentService) isAlreadyOrganized(file *models.File) bool {
+	minTags := s.config.SkipOrganizedMinTags
+	return minTags > 0 && file.FolderID != nil && len(file.Tags) >= minTags
 }
 
 // OrganizeFolder lets user trigger AI to organize all files in a folder
@@ -489,6 +675,7 @@ func (s *agentService) OrganizeFolder(
 	userID string,
 	folderID uint,
 	includeSubfolders bool,
+	excludeFolderIDs []uint,
 	eventChan chan<- AgentEvent,
 ) error {
 	if !s.IsEnabled() {
@@ -505,7 +692,7 @@ func (s *agentService) OrganizeFolder(
 	// Get files in folder
 	var files []models.File
 	if includeSubfolders {
-		files, err = s.fileService.GetFilesInFolderRecursive(userID, folderID)
+		files, err = s.fileService.GetFilesInFolderRecursive(userID, folderID, RecursiveFileOptions{ExcludeFolderIDs: excludeFolderIDs})
 	} else {
 		opts := FileListOptions{
 			FolderID: &folderID,
@@ -543,9 +730,18 @@ func (s *agentService) OrganizeFolder(
 	}
 
 	// Agent loop
+	turns := 0
+	defer func() { metrics.AgentTurns.WithLabelValues("folder").Observe(float64(turns)) }()
 	for turn := 0; turn < s.config.MaxTurns; turn++ {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("agent canceled: %w", err)
+		}
+		turns++
+
 		// Call LLM with folder tools
+		start := time.Now()
 		response, err := s.callFolderChatCompletions(ctx, messages)
+		metrics.ObserveAIRequest(metrics.AIServiceAgent, start, err)
 		if err != nil {
 			eventChan <- AgentEvent{Type: "error", Message: fmt.Sprintf("AI error: %v", err), FolderID: folderID}
 			return fmt.Errorf("chat completion failed: %w", err)
@@ -575,17 +771,20 @@ func (s *agentService) OrganizeFolder(
 				}
 
 				// Execute tool
-				result, err := s.executeFolderTool(userID, folderID, tc)
+				result, err := s.executeFolderTool(ctx, userID, folderID, tc)
 				if err != nil {
 					result = fmt.Sprintf("Error: %v", err)
-				}
-
-				eventChan <- AgentEvent{
-					Type:     "tool_result",
-					Message:  fmt.Sprintf("Result from %s", tc.Function.Name),
-					Tool:     tc.Function.Name,
-					FolderID: folderID,
-					Data:     result,
+					event := newToolErrorEvent(tc, err)
+					event.FolderID = folderID
+					eventChan <- event
+				} else {
+					eventChan <- AgentEvent{
+						Type:     "tool_result",
+						Message:  fmt.Sprintf("Result from %s", tc.Function.Name),
+						Tool:     tc.Function.Name,
+						FolderID: folderID,
+						Data:     result,
+					}
 				}
 
 				// Add tool result to messages
@@ -685,7 +884,7 @@ func (s *agentService) getFolderTools() []toolDefinition {
 			Type: "function",
 			Function: functionSchema{
 				Name:        "search_tags",
-				Description: "Search for existing tags by keyword. Use this to find relevant tags before creating new ones.",
+				Description: "Search for existing tags by keyword. Matches tag names, descriptions and aliases (synonyms). Use this to find relevant tags before creating new ones.",
 				Parameters: parametersSchema{
 					Type: "object",
 					Properties: map[string]interface{}{
@@ -738,7 +937,7 @@ func (s *agentService) getFolderTools() []toolDefinition {
 			Type: "function",
 			Function: functionSchema{
 				Name:        "add_tags_to_file",
-				Description: "Add one or more tags to a specific file.",
+				Description: "Add one or more tags to a specific file. Existing tags are never removed, and tags the file already has are reported as already applied instead of being added again.",
 				Parameters: parametersSchema{
 					Type: "object",
 					Properties: map[string]interface{}{
@@ -1001,7 +1200,7 @@ func (s *agentService) callFolderChatCompletions(ctx context.Context, messages [
 }
 
 // executeFolderTool runs folder-specific tools
-func (s *agentService) executeFolderTool(userID string, folderID uint, tc toolCall) (string, error) {
+func (s *agentService) executeFolderTool(ctx context.Context, userID string, folderID uint, tc toolCall) (string, error) {
 	var args map[string]interface{}
 	if tc.Function.Arguments != "" {
 		if err := json.Unmarshal([]byte(tc.Function.Arguments), &args); err != nil {
@@ -1019,11 +1218,11 @@ func (s *agentService) executeFolderTool(userID string, folderID uint, tc toolCa
 	case "list_all_tags":
 		return s.executeListAllTags(userID)
 	case "create_tag":
-		return s.executeCreateTag(userID, args)
+		return s.executeCreateTag(userID, args, nil)
 	case "add_tags_to_file":
-		return s.executeAddTagsToFileByID(userID, args)
+		return s.executeAddTagsToFileByID(ctx, userID, args)
 	case "move_file_to_subfolder":
-		return s.executeMoveFileToSubfolder(userID, args)
+		return s.executeMoveFileToSubfolder(ctx, userID, args)
 	case "list_subfolders":
 		return s.executeListSubfolders(userID, folderID)
 	case "create_subfolder":
@@ -1110,40 +1309,31 @@ func (s *agentService) executeGetFileDetails(userID string, args map[string]inte
 		file.ID, file.Title, file.FileType, tags, summary), nil
 }
 
-func (s *agentService) executeAddTagsToFileByID(userID string, args map[string]interface{}) (string, error) {
+func (s *agentService) executeAddTagsToFileByID(ctx context.Context, userID string, args map[string]interface{}) (string, error) {
 	fileIDFloat, ok := args["file_id"].(float64)
 	if !ok {
 		return "", fmt.Errorf("file_id is required")
 	}
 	fileID := uint(fileIDFloat)
 
-	tagIDsRaw, ok := args["tag_ids"].([]interface{})
-	if !ok || len(tagIDsRaw) == 0 {
-		return "", fmt.Errorf("tag_ids is required and must be a non-empty array")
-	}
-
-	tagIDs := make([]uint, 0, len(tagIDsRaw))
-	for _, id := range tagIDsRaw {
-		switch v := id.(type) {
-		case float64:
-			tagIDs = append(tagIDs, uint(v))
-		case int:
-			tagIDs = append(tagIDs, uint(v))
-		}
-	}
-
-	if len(tagIDs) == 0 {
-		return "", fmt.Errorf("no valid tag IDs provided")
+	tagIDs, err := tagIDsArg(args)
+	if err != nil {
+		return "", err
 	}
 
-	if err := s.fileService.AddTagsToFile(userID, fileID, tagIDs); err != nil {
+	before := s.fileState(userID, fileID)
+	added, err := s.placement.AddTagsToFile(ctx, userID, fileID, tagIDs)
+	if err != nil {
 		return "", err
 	}
+	if len(added.Added) > 0 {
+		s.publishAction(AgentActionFileTagged, userID, "add_tags_to_file", before, s.fileState(userID, fileID))
+	}
 
-	return fmt.Sprintf("Successfully added %d tag(s) to file ID %d", len(tagIDs), fileID), nil
+	return formatTagAdditionResult(added, len(tagIDs), fmt.Sprintf("file ID %d", fileID)), nil
 }
 
-func (s *agentService) executeMoveFileToSubfolder(userID string, args map[string]interface{}) (string, error) {
+func (s *agentService) executeMoveFileToSubfolder(ctx context.Context, userID string, args map[string]interface{}) (string, error) {
 	fileIDFloat, ok := args["file_id"].(float64)
 	if !ok {
 		return "", fmt.Errorf("file_id is required")
@@ -1156,9 +1346,15 @@ func (s *agentService) executeMoveFileToSubfolder(userID string, args map[string
 	}
 	targetFolderID := uint(targetFolderIDFloat)
 
-	if err := s.fileService.MoveFiles(userID, []uint{fileID}, &targetFolderID); err != nil {
+	before := s.fileState(userID, fileID)
+	result, err := s.placement.MoveFiles(ctx, userID, []uint{fileID}, &targetFolderID)
+	if err != nil {
 		return "", err
 	}
+	if len(result.Unchanged) > 0 {
+		return fmt.Sprintf("File ID %d is already in folder ID %d", fileID, targetFolderID), nil
+	}
+	s.publishAction(AgentActionFileMoved, userID, "move_file_to_subfolder", before, s.fileState(userID, fileID))
 
 	// Get folder name for better feedback
 	folder, _ := s.folderService.GetFolderByID(userID, targetFolderID)
@@ -1204,36 +1400,29 @@ func (s *agentService) executeCreateSubfolder(userID string, parentFolderID uint
 		ParentID:    &parentFolderID,
 	}
 
+	if existing := s.findSiblingFolder(userID, folder.ParentID, folder.Name); existing != nil {
+		return fmt.Sprintf("Subfolder already exists: ID=%d, Name='%s'. Use this folder instead of creating a new one.", existing.ID, existing.Name), nil
+	}
+
 	if err := s.folderService.CreateFolder(userID, folder); err != nil {
-		return "", err
+		return "", s.describeCreateFolderError(userID, folder.ParentID, err)
 	}
+	s.publishAction(AgentActionFolderCreated, userID, "create_subfolder", nil, agentFolderState(folder))
 
 	return fmt.Sprintf("Created subfolder: ID=%d, Name='%s'", folder.ID, folder.Name), nil
 }
 
 func (s *agentService) executeAddTagsToFolderByID(userID string, folderID uint, args map[string]interface{}) (string, error) {
-	tagIDsRaw, ok := args["tag_ids"].([]interface{})
-	if !ok || len(tagIDsRaw) == 0 {
-		return "", fmt.Errorf("tag_ids is required and must be a non-empty array")
-	}
-
-	tagIDs := make([]uint, 0, len(tagIDsRaw))
-	for _, id := range tagIDsRaw {
-		switch v := id.(type) {
-		case float64:
-			tagIDs = append(tagIDs, uint(v))
-		case int:
-			tagIDs = append(tagIDs, uint(v))
-		}
-	}
-
-	if len(tagIDs) == 0 {
-		return "", fmt.Errorf("no valid tag IDs provided")
+	tagIDs, err := tagIDsArg(args)
+	if err != nil {
+		return "", err
 	}
 
+	before := s.folderState(userID, folderID)
 	if err := s.folderService.AddTagsToFolder(userID, folderID, tagIDs); err != nil {
 		return "", err
 	}
+	s.publishAction(AgentActionFolderTagged, userID, "add_tags_to_folder", before, s.folderState(userID, folderID))
 
 	return fmt.Sprintf("Successfully added %d tag(s) to the folder", len(tagIDs)), nil
 }
@@ -1284,9 +1473,13 @@ func (s *agentService) executeMoveCurrentFolder(userID string, folderID uint, ar
 		return "", fmt.Errorf("cannot move folder into itself")
 	}
 
-	if err := s.folderService.MoveFolder(userID, folderID, targetParentID); err != nil {
+	moved, err := s.folderService.MoveFolder(userID, folderID, targetParentID)
+	if err != nil {
 		return "", err
 	}
+	if !moved {
+		return "Folder is already in that location", nil
+	}
 
 	if targetParentID == nil {
 		return "Moved folder to root level", nil
@@ -1359,9 +1552,9 @@ func formatFolderToolCallMessage(toolName, args string) string {
 }
 
 // callChatCompletions makes the API request to the AI gateway
-func (s *agentService) callChatCompletions(ctx context.Context, messages []agentMessage) (*agentChatResponse, error) {
+func (s *agentService) callChatCompletions(ctx context.Context, model string, messages []agentMessage) (*agentChatResponse, error) {
 	reqBody := agentChatRequest{
-		Model:      s.config.Model,
+		Model:      model,
 		Messages:   messages,
 		Tools:      s.getTools(),
 		ToolChoice: "auto",
@@ -1408,8 +1601,8 @@ func (s *agentService) callChatCompletions(ctx context.Context, messages []agent
 	return &chatResp, nil
 }
 
-// executeTool runs the specified tool and returns the result
-func (s *agentService) executeTool(ctx context.Context, userID string, fileID uint, tc toolCall) (string, error) {
+// executeTool runs a file tool call, recording what it changes in changes
+func (s *agentService) executeTool(ctx context.Context, userID string, fileID uint, tc toolCall, changes *AgentChanges) (string, error) {
 	var args map[string]interface{}
 	if tc.Function.Arguments != "" {
 		if err := json.Unmarshal([]byte(tc.Function.Arguments), &args); err != nil {
@@ -1423,19 +1616,23 @@ func (s *agentService) executeTool(ctx context.Context, userID string, fileID ui
 	case "list_all_tags":
 		return s.executeListAllTags(userID)
 	case "create_tag":
-		return s.executeCreateTag(userID, args)
+		return s.executeCreateTag(userID, args, changes)
 	case "add_tags_to_file":
-		return s.executeAddTagsToFile(userID, fileID, args)
+		return s.executeAddTagsToFile(ctx, userID, fileID, args, changes)
 	case "get_folder_tree":
 		return s.executeGetFolderTree(userID)
 	case "list_folders":
 		return s.executeListFolders(userID, args)
 	case "move_file":
-		return s.executeMoveFile(userID, fileID, args)
+		return s.executeMoveFile(ctx, userID, fileID, args, changes)
 	case "get_file_info":
 		return s.executeGetFileInfo(userID, fileID)
 	case "create_folder":
-		return s.executeCreateFolder(userID, args)
+		return s.executeCreateFolder(userID, args, changes)
+	case "find_similar_files":
+		return s.executeFindSimilarFiles(userID, fileID, args)
+	case "suggest_folder_for_tags":
+		return s.executeSuggestFolderForTags(userID, args)
 	default:
 		return "", fmt.Errorf("unknown tool: %s", tc.Function.Name)
 	}
@@ -1460,6 +1657,13 @@ func (s *agentService) executeSearchTags(userID string, args map[string]interfac
 		if tag.Description != "" {
 			result += fmt.Sprintf(" (%s)", tag.Description)
 		}
+		if len(tag.Aliases) > 0 {
+			aliases := make([]string, len(tag.Aliases))
+			for i, a := range tag.Aliases {
+				aliases[i] = a.Alias
+			}
+			result += fmt.Sprintf(", Aliases: %s", strings.Join(aliases, ", "))
+		}
 		result += "\n"
 	}
 	return result, nil
@@ -1481,12 +1685,19 @@ func (s *agentService) executeListAllTags(userID string) (string, error) {
 		if tag.Description != "" {
 			result += fmt.Sprintf(" (%s)", tag.Description)
 		}
+		if len(tag.Aliases) > 0 {
+			aliases := make([]string, len(tag.Aliases))
+			for i, a := range tag.Aliases {
+				aliases[i] = a.Alias
+			}
+			result += fmt.Sprintf(", Aliases: %s", strings.Join(aliases, ", "))
+		}
 		result += "\n"
 	}
 	return result, nil
 }
 
-func (s *agentService) executeCreateTag(userID string, args map[string]interface{}) (string, error) {
+func (s *agentService) executeCreateTag(userID string, args map[string]interface{}, changes *AgentChanges) (string, error) {
 	name, ok := args["name"].(string)
 	if !ok || name == "" {
 		return "", fmt.Errorf("name is required")
@@ -1501,35 +1712,35 @@ func (s *agentService) executeCreateTag(userID string, args map[string]interface
 	if err := s.tagService.CreateTag(userID, tag); err != nil {
 		return "", err
 	}
+	s.publishAction(AgentActionTagCreated, userID, "create_tag", nil, agentTagState(tag))
+	changes.recordTagCreated(tag)
 
 	return fmt.Sprintf("Created tag: ID=%d, Name='%s'", tag.ID, tag.Name), nil
 }
 
-func (s *agentService) executeAddTagsToFile(userID string, fileID uint, args map[string]interface{}) (string, error) {
-	tagIDsRaw, ok := args["tag_ids"].([]interface{})
-	if !ok || len(tagIDsRaw) == 0 {
-		return "", fmt.Errorf("tag_ids is required and must be a non-empty array")
+func (s *agentService) executeAddTagsToFile(ctx context.Context, userID string, fileID uint, args map[string]interface{}, changes *AgentChanges) (string, error) {
+	tagIDs, err := tagIDsArg(args)
+	if err != nil {
+		return "", err
 	}
 
-	tagIDs := make([]uint, 0, len(tagIDsRaw))
-	for _, id := range tagIDsRaw {
-		switch v := id.(type) {
-		case float64:
-			tagIDs = append(tagIDs, uint(v))
-		case int:
-			tagIDs = append(tagIDs, uint(v))
-		}
+	before := s.fileState(userID, fileID)
+	added, err := s.placement.AddTagsToFile(ctx, userID, fileID, tagIDs)
+	if err != nil {
+		return "", err
 	}
-
-	if len(tagIDs) == 0 {
-		return "", fmt.Errorf("no valid tag IDs provided")
+	if len(added.Added) > 0 {
+		s.publishAction(AgentActionFileTagged, userID, "add_tags_to_file", before, s.fileState(userID, fileID))
+		changes.recordTagsAdded(s.tagsByID(userID, added.Added))
 	}
-
-	if err := s.fileService.AddTagsToFile(userID, fileID, tagIDs); err != nil {
-		return "", err
+	if len(added.AlreadyPresent) > 0 {
+		changes.recordTagsAlreadyPresent(s.tagsByID(userID, added.AlreadyPresent))
+	}
+	if added.MovedToFolder != nil {
+		changes.recordMove(s.folderRef(userID, added.MovedToFolder))
 	}
 
-	return fmt.Sprintf("Successfully added %d tag(s) to the file", len(tagIDs)), nil
+	return formatTagAdditionResult(added, len(tagIDs), "the file"), nil
 }
 
 func (s *agentService) executeGetFolderTree(userID string) (string, error) {
@@ -1581,16 +1792,23 @@ func (s *agentService) executeListFolders(userID string, args map[string]interfa
 	return result, nil
 }
 
-func (s *agentService) executeMoveFile(userID string, fileID uint, args map[string]interface{}) (string, error) {
+func (s *agentService) executeMoveFile(ctx context.Context, userID string, fileID uint, args map[string]interface{}, changes *AgentChanges) (string, error) {
 	var targetFolderID *uint
 	if folderID, ok := args["folder_id"].(float64); ok {
 		fid := uint(folderID)
 		targetFolderID = &fid
 	}
 
-	if err := s.fileService.MoveFiles(userID, []uint{fileID}, targetFolderID); err != nil {
+	before := s.fileState(userID, fileID)
+	result, err := s.placement.MoveFiles(ctx, userID, []uint{fileID}, targetFolderID)
+	if err != nil {
 		return "", err
 	}
+	if len(result.Unchanged) > 0 {
+		return "File is already in that folder", nil
+	}
+	s.publishAction(AgentActionFileMoved, userID, "move_file", before, s.fileState(userID, fileID))
+	changes.recordMove(s.folderRef(userID, targetFolderID))
 
 	if targetFolderID == nil {
 		return "Moved file to root folder", nil
@@ -1628,7 +1846,51 @@ func (s *agentService) executeGetFileInfo(userID string, fileID uint) (string, e
 	return result, nil
 }
 
-func (s *agentService) executeCreateFolder(userID string, args map[string]interface{}) (string, error) {
+func (s *agentService) executeFindSimilarFiles(userID string, fileID uint, args map[string]interface{}) (string, error) {
+	if s.searchService == nil {
+		return "Similar file search is not available", nil
+	}
+
+	results, err := s.searchService.SimilarFiles(userID, fileID, SearchOptions{Limit: limitArg(args, defaultSimilarFilesLimit)})
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "No similar files found (the file may not have an embedding yet)", nil
+	}
+
+	result := fmt.Sprintf("Found %d similar files:\n", len(results))
+	for _, r := range results {
+		result += fmt.Sprintf("- ID: %d, Title: %s, Folder: %s, Similarity: %.2f\n",
+			r.File.ID, r.File.Title, getFolderName(&r.File), r.Score)
+	}
+	return result, nil
+}
+
+func (s *agentService) executeSuggestFolderForTags(userID string, args map[string]interface{}) (string, error) {
+	tagIDs, err := tagIDsArg(args)
+	if err != nil {
+		return "", err
+	}
+
+	suggestions, err := s.folderService.SuggestFoldersForTags(userID, tagIDs, limitArg(args, defaultFolderSuggestionLimit))
+	if err != nil {
+		return "", err
+	}
+	if len(suggestions) == 0 {
+		return "No folders contain files with these tags yet", nil
+	}
+
+	result := "Folders holding files with these tags (best match first):\n"
+	for _, suggestion := range suggestions {
+		ref := s.folderRef(userID, &suggestion.Folder.ID)
+		result += fmt.Sprintf("- ID: %d, Path: %s, Matching tags: %d of %d, Files: %d\n",
+			ref.ID, ref.Path, suggestion.MatchedTags, len(tagIDs), suggestion.FileCount)
+	}
+	return result, nil
+}
+
+func (s *agentService) executeCreateFolder(userID string, args map[string]interface{}, changes *AgentChanges) (string, error) {
 	name, ok := args["name"].(string)
 	if !ok || name == "" {
 		return "", fmt.Errorf("name is required")
@@ -1644,13 +1906,83 @@ func (s *agentService) executeCreateFolder(userID string, args map[string]interf
 		folder.ParentID = &pid
 	}
 
+	if existing := s.findSiblingFolder(userID, folder.ParentID, folder.Name); existing != nil {
+		return fmt.Sprintf("Folder already exists: ID=%d, Name='%s'. Use this folder instead of creating a new one.", existing.ID, existing.Name), nil
+	}
+
 	if err := s.folderService.CreateFolder(userID, folder); err != nil {
-		return "", err
+		return "", s.describeCreateFolderError(userID, folder.ParentID, err)
 	}
+	s.publishAction(AgentActionFolderCreated, userID, "create_folder", nil, agentFolderState(folder))
+	changes.recordFolderCreated(s.folderRef(userID, &folder.ID))
 
 	return fmt.Sprintf("Created folder: ID=%d, Name='%s'", folder.ID, folder.Name), nil
 }
 
+// findSiblingFolder returns the folder under parentID whose name matches
+// name case-insensitively, or nil if there is none.
+func (s *agentService) findSiblingFolder(userID string, parentID *uint, name string) *models.Folder {
+	siblings, _, err := s.folderService.ListFolders(userID, FolderListOptions{ParentID: parentID, Limit: 100})
+	if err != nil {
+		return nil
+	}
+	for i := range siblings {
+		if strings.EqualFold(siblings[i].Name, name) {
+			return &siblings[i]
+		}
+	}
+	return nil
+}
+
+// describeCreateFolderError adds the available folders to a missing-parent
+// error so the model can pick a valid parent_id on its next call.
+func (s *agentService) describeCreateFolderError(userID string, parentID *uint, err error) error {
+	if !errors.Is(err, ErrParentFolderNotFound) || parentID == nil {
+		return err
+	}
+
+	folders, treeErr := s.folderService.GetFolderTree(userID, nil)
+	if treeErr != nil || len(folders) == 0 {
+		return fmt.Errorf("parent folder %d does not exist and no folders exist yet. Omit parent_id to create a root folder", *parentID)
+	}
+
+	return fmt.Errorf("parent folder %d does not exist. Available folders:\n%s", *parentID, formatFolderTree(folders, 0))
+}
+
+// fileState returns the file's state for an action event, or nil when no
+// publisher is configured or the file can't be loaded
+func (s *agentService) fileState(userID string, fileID uint) *AgentFileState {
+	if s.actions == nil {
+		return nil
+	}
+	file, err := s.fileService.GetFileByID(userID, fileID)
+	if err != nil || file == nil {
+		return nil
+	}
+	return agentFileState(file)
+}
+
+// folderState returns the folder's state for an action event, or nil when no
+// publisher is configured or the folder can't be loaded
+func (s *agentService) folderState(userID string, folderID uint) *AgentFolderState {
+	if s.actions == nil {
+		return nil
+	}
+	folder, err := s.folderService.GetFolderByID(userID, folderID)
+	if err != nil || folder == nil {
+		return nil
+	}
+	return agentFolderState(folder)
+}
+
+// publishAction reports a change made by the agent when a publisher is configured
+func (s *agentService) publishAction(actionType AgentActionType, userID, tool string, before, after interface{}) {
+	if s.actions == nil {
+		return
+	}
+	s.actions.Publish(newAgentActionEvent(actionType, userID, tool, before, after))
+}
+
 // Helper functions
 
 func getStringArg(args map[string]interface{}, key, defaultVal string) string {
@@ -1660,6 +1992,43 @@ func getStringArg(args map[string]interface{}, key, defaultVal string) string {
 	return defaultVal
 }
 
+// budgetAgentPrompt fits the summary and content into budget characters. The
+// summary comes first since it describes the whole file; the content prefix
+// gets whatever budget is left.
+func budgetAgentPrompt(summary, content string, budget int) (string, string) {
+	summary = truncateForPrompt(summary, budget)
+	content = truncateForPrompt(content, budget-utf8.RuneCountInString(summary))
+	return summary, content
+}
+
+// truncateForPrompt cuts s to at most limit characters, marking the cut with
+// "..."
+func truncateForPrompt(s string, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+	count := 0
+	for i := range s {
+		if count == limit {
+			return s[:i] + "..."
+		}
+		count++
+	}
+	return s
+}
+
+// getTagNames lists the file's tags for the agent prompt
+func getTagNames(file *models.File) string {
+	if len(file.Tags) == 0 {
+		return "None"
+	}
+	names := make([]string, len(file.Tags))
+	for i, tag := range file.Tags {
+		names[i] = fmt.Sprintf("%s (ID %d)", tag.Name, tag.ID)
+	}
+	return strings.Join(names, ", ")
+}
+
 func getFolderName(file *models.File) string {
 	if file.Folder != nil {
 		return file.Folder.Name
@@ -1681,6 +2050,72 @@ func formatFileTags(file *models.File) string {
 	return strings.Join(names, ", ")
 }
 
+func formatTagAdditionResult(result *TagAdditionResult, requested int, target string) string {
+	msg := fmt.Sprintf("Added %d tag(s) to %s", len(result.Added), target)
+	if len(result.Added) > 0 {
+		msg += fmt.Sprintf(" (IDs: %s)", joinIDs(result.Added))
+	}
+	if len(result.AlreadyPresent) > 0 {
+		msg += fmt.Sprintf("; %d tag(s) were already applied (IDs: %s)", len(result.AlreadyPresent), joinIDs(result.AlreadyPresent))
+	}
+	if skipped := requested - len(result.Added) - len(result.AlreadyPresent); skipped > 0 {
+		msg += fmt.Sprintf("; %d tag ID(s) did not match an existing tag", skipped)
+	}
+	if result.MovedToFolder != nil {
+		msg += fmt.Sprintf("; a folding rule moved the file to folder ID %d", *result.MovedToFolder)
+	}
+	return msg
+}
+
+// limitArg reads a list tool's optional limit, capped at maxAgentListLimit
+func limitArg(args map[string]interface{}, defaultLimit int) int {
+	l, ok := args["limit"].(float64)
+	if !ok || l <= 0 {
+		return defaultLimit
+	}
+	return min(int(l), maxAgentListLimit)
+}
+
+// tagIDsArg reads the tag_ids argument of a tagging tool. Repeated IDs are
+// dropped so each tag is requested once.
+func tagIDsArg(args map[string]interface{}) ([]uint, error) {
+	tagIDsRaw, ok := args["tag_ids"].([]interface{})
+	if !ok || len(tagIDsRaw) == 0 {
+		return nil, fmt.Errorf("tag_ids is required and must be a non-empty array")
+	}
+
+	seen := make(map[uint]bool, len(tagIDsRaw))
+	tagIDs := make([]uint, 0, len(tagIDsRaw))
+	for _, id := range tagIDsRaw {
+		var tagID uint
+		switch v := id.(type) {
+		case float64:
+			tagID = uint(v)
+		case int:
+			tagID = uint(v)
+		default:
+			continue
+		}
+		if !seen[tagID] {
+			seen[tagID] = true
+			tagIDs = append(tagIDs, tagID)
+		}
+	}
+
+	if len(tagIDs) == 0 {
+		return nil, fmt.Errorf("no valid tag IDs provided")
+	}
+	return tagIDs, nil
+}
+
+func joinIDs(ids []uint) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprintf("%d", id)
+	}
+	return strings.Join(parts, ", ")
+}
+
 func formatFolderTree(folders []models.Folder, depth int) string {
 	result := ""
 	indent := strings.Repeat("  ", depth)
@@ -1697,6 +2132,21 @@ func formatFolderTree(folders []models.Folder, depth int) string {
 	return result
 }
 
+// newToolErrorEvent builds the event sent to the user stream when a tool call fails,
+// so clients can show which action failed and why
+func newToolErrorEvent(tc toolCall, err error) AgentEvent {
+	return AgentEvent{
+		Type:    "tool_error",
+		Message: fmt.Sprintf("%s failed: %v", tc.Function.Name, err),
+		Tool:    tc.Function.Name,
+		Data: map[string]interface{}{
+			"tool":      tc.Function.Name,
+			"error":     err.Error(),
+			"arguments": tc.Function.Arguments,
+		},
+	}
+}
+
 func formatToolCallMessage(toolName, args string) string {
 	switch toolName {
 	case "search_tags":
@@ -1737,6 +2187,8 @@ func formatToolCallMessage(toolName, args string) string {
 			return fmt.Sprintf("Creating folder '%s'", name)
 		}
 		return "Creating new folder"
+	case "suggest_folder_for_tags":
+		return "Looking up folders used for these tags"
 	default:
 		return toolName
 	}
@@ -1752,19 +2204,19 @@ func NewMockAgentService() AgentService {
 }
 
 func (m *MockAgentService) ProcessFileWithAgent(ctx context.Context, userID string, fileID uint,
-	content, summary string, eventChan chan<- AgentEvent) error {
+	content, summary, model string, eventChan chan<- AgentEvent) error {
 	eventChan <- AgentEvent{Type: "status", Message: "Mock agent processing...", FileID: fileID}
-	eventChan <- AgentEvent{Type: "result", Message: "Mock organization complete", FileID: fileID}
+	eventChan <- AgentEvent{Type: "result", Message: "Mock organization complete", Data: newAgentChanges(), FileID: fileID}
 	return nil
 }
 
 func (m *MockAgentService) OrganizeFile(ctx context.Context, userID string, fileID uint,
 	eventChan chan<- AgentEvent) error {
-	return m.ProcessFileWithAgent(ctx, userID, fileID, "", "", eventChan)
+	return m.ProcessFileWithAgent(ctx, userID, fileID, "", "", "", eventChan)
 }
 
 func (m *MockAgentService) OrganizeFolder(ctx context.Context, userID string, folderID uint,
-	includeSubfolders bool, eventChan chan<- AgentEvent) error {
+	includeSubfolders bool, excludeFolderIDs []uint, eventChan chan<- AgentEvent) error {
 	eventChan <- AgentEvent{Type: "status", Message: "Mock agent processing folder...", FolderID: folderID}
 	eventChan <- AgentEvent{Type: "result", Message: "Mock folder organization complete", FolderID: folderID}
 	return nil
@@ -1773,3 +2225,15 @@ func (m *MockAgentService) OrganizeFolder(ctx context.Context, userID string, fo
 func (m *MockAgentService) IsEnabled() bool {
 	return m.enabled
 }
+
+func (m *MockAgentService) Capabilities() AgentCapabilities {
+	return AgentCapabilities{
+		Enabled:  m.enabled,
+		Model:    "mock-agent",
+		MaxTurns: 10,
+		FileTools: []AgentToolInfo{
+			{Name: "add_tags_to_file", Description: "Mock tool", Parameters: map[string]interface{}{"type": "object"}},
+		},
+		FolderTools: []AgentToolInfo{},
+	}
+}
@@ -0,0 +1,399 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const agentTestUserID = "agent-test-user"
+
+func newTestAgentService(t *testing.T) (*agentService, FolderService) {
+	db := newTestDB(t)
+	folderService := NewFolderService(db, FolderConfig{})
+	service := NewAgentService(AgentConfig{}, NewTagService(db), NewFileService(db, FileConfig{}), folderService, nil, nil, nil)
+	return service.(*agentService), folderService
+}
+
+func TestExecuteCreateFolder_MissingParentListsFolders(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, &models.Folder{Name: "Invoices"}))
+
+	_, err := service.executeCreateFolder(agentTestUserID, map[string]interface{}{
+		"name":      "2024",
+		"parent_id": float64(999),
+	}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parent folder 999 does not exist")
+	assert.Contains(t, err.Error(), "Invoices")
+}
+
+func TestExecuteCreateFolder_ReturnsExistingSibling(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+	existing := &models.Folder{Name: "Invoices"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, existing))
+
+	result, err := service.executeCreateFolder(agentTestUserID, map[string]interface{}{
+		"name": "invoices",
+	}, nil)
+
+	require.NoError(t, err)
+	assert.Contains(t, result, "Folder already exists")
+
+	_, total, err := folderService.ListFolders(agentTestUserID, FolderListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestProcessFileWithAgent_EmitsToolErrorEvent(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Write([]byte(`{"choices": [{"finish_reason": "tool_calls", "message": {"role": "assistant",
+				"tool_calls": [{"id": "call_1", "type": "function",
+					"function": {"name": "move_file", "arguments": "{\"folder_id\": 999}"}}]}}]}`))
+			return
+		}
+		w.Write([]byte(`{"choices": [{"finish_reason": "stop", "message": {"role": "assistant", "content": "done"}}]}`))
+	}))
+	defer server.Close()
+
+	service, _ := newTestAgentService(t)
+	service.config = AgentConfig{GatewayURL: server.URL, APIKey: "key", MaxTurns: 3, Enabled: true}
+
+	file := &models.File{Title: "Invoice", S3Key: "files/invoice.pdf", OriginalFilename: "invoice.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+
+	eventChan := make(chan AgentEvent, 100)
+	require.NoError(t, service.ProcessFileWithAgent(context.Background(), agentTestUserID, file.ID, "content", "summary", "", eventChan))
+	close(eventChan)
+
+	var toolErrors, toolResults []AgentEvent
+	for event := range eventChan {
+		switch event.Type {
+		case "tool_error":
+			toolErrors = append(toolErrors, event)
+		case "tool_result":
+			toolResults = append(toolResults, event)
+		}
+	}
+
+	require.Len(t, toolErrors, 1)
+	assert.Empty(t, toolResults)
+	assert.Equal(t, "move_file", toolErrors[0].Tool)
+	assert.Equal(t, file.ID, toolErrors[0].FileID)
+	assert.Contains(t, toolErrors[0].Message, "move_file failed")
+	assert.Equal(t, "move_file", toolErrors[0].Data.(map[string]interface{})["tool"])
+}
+
+func TestProcessFileWithAgent_ModelOverride(t *testing.T) {
+	var requestedModels []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req agentChatRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		requestedModels = append(requestedModels, req.Model)
+		w.Write([]byte(`{"choices": [{"finish_reason": "stop", "message": {"role": "assistant", "content": "done"}}]}`))
+	}))
+	defer server.Close()
+
+	service, _ := newTestAgentService(t)
+	service.config = AgentConfig{GatewayURL: server.URL, APIKey: "key", Model: "default-model", MaxTurns: 3, Enabled: true}
+
+	file := &models.File{Title: "Contract", S3Key: "files/contract.pdf", OriginalFilename: "contract.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+
+	eventChan := make(chan AgentEvent, 100)
+	require.NoError(t, service.ProcessFileWithAgent(context.Background(), agentTestUserID, file.ID, "content", "summary", "better-model", eventChan))
+	require.NoError(t, service.ProcessFileWithAgent(context.Background(), agentTestUserID, file.ID, "content", "summary", "", eventChan))
+
+	assert.Equal(t, []string{"better-model", "default-model"}, requestedModels)
+}
+
+func TestCapabilities_ListsToolsAndConfig(t *testing.T) {
+	service, _ := newTestAgentService(t)
+	service.config.Enabled = true
+	service.config.GatewayURL = "http://gateway"
+	service.config.APIKey = "key"
+
+	caps := service.Capabilities()
+
+	assert.True(t, caps.Enabled)
+	assert.Equal(t, "gpt-4o-mini", caps.Model)
+	assert.Equal(t, 10, caps.MaxTurns)
+	assert.False(t, caps.DryRunSupported)
+
+	names := make([]string, len(caps.FileTools))
+	for i, tool := range caps.FileTools {
+		names[i] = tool.Name
+		assert.NotEmpty(t, tool.Description)
+		assert.Equal(t, "object", tool.Parameters["type"])
+	}
+	assert.Contains(t, names, "move_file")
+	assert.Contains(t, names, "create_folder")
+	assert.NotEmpty(t, caps.FolderTools)
+}
+
+func TestBudgetAgentPrompt(t *testing.T) {
+	summary, content := budgetAgentPrompt("short summary", "full content", 100)
+	assert.Equal(t, "short summary", summary)
+	assert.Equal(t, "full content", content)
+
+	// The summary is kept whole and the content gets the rest of the budget
+	summary, content = budgetAgentPrompt("summary", "0123456789", 12)
+	assert.Equal(t, "summary", summary)
+	assert.Equal(t, "01234...", content)
+
+	summary, content = budgetAgentPrompt("a long summary", "content", 6)
+	assert.Equal(t, "a long...", summary)
+	assert.Empty(t, content)
+
+	// The budget counts characters, not bytes
+	_, content = budgetAgentPrompt("", "héllo", 2)
+	assert.Equal(t, "hé...", content)
+	summary, content = budgetAgentPrompt("résumé", "çà", 8)
+	assert.Equal(t, "résumé", summary)
+	assert.Equal(t, "çà", content)
+}
+
+func TestNewAgentService_DefaultContentBudget(t *testing.T) {
+	service, _ := newTestAgentService(t)
+	assert.Equal(t, defaultAgentMaxContentChars, service.config.MaxContentChars)
+}
+
+func TestOrganizeFile_SkipsOrganizedFiles(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Write([]byte(`{"choices": [{"finish_reason": "stop", "message": {"role": "assistant", "content": "done"}}]}`))
+	}))
+	defer server.Close()
+
+	service, folderService := newTestAgentService(t)
+	service.config = AgentConfig{GatewayURL: server.URL, APIKey: "key", MaxTurns: 3, Enabled: true, SkipOrganizedMinTags: 1}
+
+	folder := &models.Folder{Name: "Invoices"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, folder))
+	tag := &models.Tag{Name: "Finance"}
+	require.NoError(t, service.tagService.CreateTag(agentTestUserID, tag))
+
+	organized := &models.File{Title: "Invoice", S3Key: "files/invoice.pdf", OriginalFilename: "invoice.pdf", FolderID: &folder.ID}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, organized))
+	_, err := service.fileService.AddTagsToFile(agentTestUserID, organized.ID, []uint{tag.ID})
+	require.NoError(t, err)
+
+	eventChan := make(chan AgentEvent, 100)
+	require.NoError(t, service.OrganizeFile(context.Background(), agentTestUserID, organized.ID, eventChan))
+	assert.Equal(t, int32(0), calls.Load())
+	event := <-eventChan
+	assert.Equal(t, "result", event.Type)
+	assert.Contains(t, event.Message, "No action needed")
+
+	// Untagged files still go to the agent
+	untagged := &models.File{Title: "Receipt", S3Key: "files/receipt.pdf", OriginalFilename: "receipt.pdf", FolderID: &folder.ID}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, untagged))
+	require.NoError(t, service.OrganizeFile(context.Background(), agentTestUserID, untagged.ID, eventChan))
+	assert.Equal(t, int32(1), calls.Load())
+
+	// 0 disables the check
+	service.config.SkipOrganizedMinTags = 0
+	require.NoError(t, service.OrganizeFile(context.Background(), agentTestUserID, organized.ID, eventChan))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestProcessFileWithAgent_IncludesProcessingHint(t *testing.T) {
+	var prompts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req agentChatRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		prompts = append(prompts, req.Messages[1].Content)
+		w.Write([]byte(`{"choices": [{"finish_reason": "stop", "message": {"role": "assistant", "content": "done"}}]}`))
+	}))
+	defer server.Close()
+
+	service, _ := newTestAgentService(t)
+	service.config = AgentConfig{GatewayURL: server.URL, APIKey: "key", MaxTurns: 3, Enabled: true, MaxContentChars: 5000}
+
+	hinted := &models.File{Title: "ACME Invoice", S3Key: "files/acme.pdf", OriginalFilename: "acme.pdf",
+		ProcessingHint: "Always file ACME invoices under Clients/ACME"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, hinted))
+	plain := &models.File{Title: "Receipt", S3Key: "files/receipt.pdf", OriginalFilename: "receipt.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, plain))
+
+	eventChan := make(chan AgentEvent, 100)
+	require.NoError(t, service.ProcessFileWithAgent(context.Background(), agentTestUserID, hinted.ID, "content", "summary", "", eventChan))
+	require.NoError(t, service.ProcessFileWithAgent(context.Background(), agentTestUserID, plain.ID, "content", "summary", "", eventChan))
+
+	require.Len(t, prompts, 2)
+	assert.Contains(t, prompts[0], "User Instructions")
+	assert.Contains(t, prompts[0], "Always file ACME invoices under Clients/ACME")
+	assert.NotContains(t, prompts[1], "User Instructions")
+}
+
+func TestProcessFileWithAgent_StopsWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		// The client goes away while the model is answering
+		cancel()
+		w.Write([]byte(`{"choices": [{"finish_reason": "tool_calls", "message": {"role": "assistant",
+			"tool_calls": [{"id": "call_1", "type": "function",
+				"function": {"name": "list_all_tags", "arguments": "{}"}}]}}]}`))
+	}))
+	defer server.Close()
+
+	service, _ := newTestAgentService(t)
+	service.config = AgentConfig{GatewayURL: server.URL, APIKey: "key", MaxTurns: 5, Enabled: true, MaxContentChars: 5000}
+
+	file := &models.File{Title: "Invoice", S3Key: "files/invoice.pdf", OriginalFilename: "invoice.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+
+	eventChan := make(chan AgentEvent, 100)
+	err := service.ProcessFileWithAgent(ctx, agentTestUserID, file.ID, "content", "summary", "", eventChan)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, int32(1), calls.Load(), "no further turns after cancellation")
+}
+
+func TestExecuteTool_RecordsChanges(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+	finance := &models.Folder{Name: "Finance"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, finance))
+	file := &models.File{Title: "invoice", S3Key: "invoice.pdf", OriginalFilename: "invoice.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+
+	changes := newAgentChanges()
+	run := func(name, arguments string) string {
+		tc := toolCall{ID: name, Type: "function", Function: functionCall{Name: name, Arguments: arguments}}
+		result, err := service.executeTool(context.Background(), agentTestUserID, file.ID, tc, changes)
+		require.NoError(t, err)
+		return result
+	}
+
+	run("create_folder", fmt.Sprintf(`{"name": "2024", "parent_id": %d}`, finance.ID))
+	require.Len(t, changes.FoldersCreated, 1)
+	assert.Equal(t, "Finance/2024", changes.FoldersCreated[0].Path)
+	year := changes.FoldersCreated[0].ID
+
+	run("create_tag", `{"name": "Acme"}`)
+	require.Len(t, changes.TagsCreated, 1)
+	assert.Equal(t, "acme", changes.TagsCreated[0].Name)
+
+	run("add_tags_to_file", fmt.Sprintf(`{"tag_ids": [%d]}`, changes.TagsCreated[0].ID))
+	run("add_tags_to_file", fmt.Sprintf(`{"tag_ids": [%d]}`, changes.TagsCreated[0].ID))
+	assert.Equal(t, changes.TagsCreated, changes.TagsAdded, "tags already on the file are not added twice")
+
+	assert.Nil(t, changes.MovedTo)
+	run("move_file", fmt.Sprintf(`{"folder_id": %d}`, year))
+	assert.Equal(t, &AgentFolderRef{ID: year, Path: "Finance/2024"}, changes.MovedTo)
+
+	// Reading tools change nothing
+	run("list_all_tags", "")
+	assert.Len(t, changes.TagsAdded, 1)
+}
+
+func TestExecuteAddTagsToFile_KeepsManualTags(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+	finance := &models.Folder{Name: "Finance"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, finance))
+	manual := &models.Tag{Name: "manual"}
+	vendor := &models.Tag{Name: "vendor"}
+	require.NoError(t, service.tagService.CreateTag(agentTestUserID, manual))
+	require.NoError(t, service.tagService.CreateTag(agentTestUserID, vendor))
+	file := &models.File{Title: "invoice", S3Key: "invoice.pdf", OriginalFilename: "invoice.pdf"}
+	require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+	_, err := service.fileService.AddTagsToFile(agentTestUserID, file.ID, []uint{manual.ID})
+	require.NoError(t, err)
+
+	changes := newAgentChanges()
+	run := func(name, arguments string) string {
+		tc := toolCall{ID: name, Type: "function", Function: functionCall{Name: name, Arguments: arguments}}
+		result, err := service.executeTool(context.Background(), agentTestUserID, file.ID, tc, changes)
+		require.NoError(t, err)
+		return result
+	}
+
+	result := run("add_tags_to_file", fmt.Sprintf(`{"tag_ids": [%d, %d, %d]}`, manual.ID, vendor.ID, vendor.ID))
+	assert.NotContains(t, result, "did not match")
+	run("add_tags_to_file", fmt.Sprintf(`{"tag_ids": [%d]}`, vendor.ID))
+	run("move_file", fmt.Sprintf(`{"folder_id": %d}`, finance.ID))
+
+	assert.Equal(t, []AgentTagRef{{ID: vendor.ID, Name: "vendor"}}, changes.TagsAdded)
+	assert.Equal(t, []AgentTagRef{{ID: manual.ID, Name: "manual"}}, changes.TagsAlreadyPresent,
+		"tags added earlier in the run are not reported as already present")
+
+	moved, err := service.fileService.GetFileByID(agentTestUserID, file.ID)
+	require.NoError(t, err)
+	assert.Len(t, moved.Tags, 2, "moving the file keeps its manual tag")
+}
+
+func TestExecuteSuggestFolderForTags(t *testing.T) {
+	service, folderService := newTestAgentService(t)
+
+	invoices := &models.Folder{Name: "Invoices"}
+	receipts := &models.Folder{Name: "Receipts"}
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, invoices))
+	require.NoError(t, folderService.CreateFolder(agentTestUserID, receipts))
+	acme := &models.Tag{Name: "acme"}
+	billing := &models.Tag{Name: "billing"}
+	require.NoError(t, service.tagService.CreateTag(agentTestUserID, acme))
+	require.NoError(t, service.tagService.CreateTag(agentTestUserID, billing))
+
+	files := 0
+	addFile := func(folderID *uint, tagIDs ...uint) {
+		files++
+		key := fmt.Sprintf("doc-%d.pdf", files)
+		file := &models.File{Title: "doc", S3Key: key, OriginalFilename: key, FolderID: folderID}
+		require.NoError(t, service.fileService.CreateFile(agentTestUserID, file))
+		_, err := service.fileService.AddTagsToFile(agentTestUserID, file.ID, tagIDs)
+		require.NoError(t, err)
+	}
+	// Receipts holds more tagged files, but only Invoices matches both tags
+	addFile(&invoices.ID, acme.ID, billing.ID)
+	addFile(&receipts.ID, billing.ID)
+	addFile(&receipts.ID, billing.ID)
+	addFile(nil, acme.ID)
+
+	suggestions, err := folderService.SuggestFoldersForTags(agentTestUserID, []uint{acme.ID, billing.ID}, 5)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, invoices.ID, suggestions[0].Folder.ID)
+	assert.Equal(t, int64(2), suggestions[0].MatchedTags)
+	assert.Equal(t, receipts.ID, suggestions[1].Folder.ID)
+	assert.Equal(t, int64(2), suggestions[1].FileCount)
+
+	result, err := service.executeSuggestFolderForTags(agentTestUserID, map[string]interface{}{
+		"tag_ids": []interface{}{float64(acme.ID), float64(billing.ID)},
+		"limit":   float64(1),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, result, fmt.Sprintf("ID: %d, Path: Invoices, Matching tags: 2 of 2, Files: 1", invoices.ID))
+	assert.NotContains(t, result, "Receipts")
+
+	suggestions, err = folderService.SuggestFoldersForTags("someone-else", []uint{acme.ID}, 5)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+
+	_, err = service.executeSuggestFolderForTags(agentTestUserID, map[string]interface{}{})
+	assert.Error(t, err)
+}
+
+func TestLimitArg_ClampsToMax(t *testing.T) {
+	assert.Equal(t, 5, limitArg(map[string]interface{}{}, 5))
+	assert.Equal(t, 5, limitArg(map[string]interface{}{"limit": float64(-1)}, 5))
+	assert.Equal(t, 3, limitArg(map[string]interface{}{"limit": float64(3)}, 5))
+	assert.Equal(t, maxAgentListLimit, limitArg(map[string]interface{}{"limit": float64(10000)}, 5))
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultAutoTagThreshold is the minimum cosine similarity for a tag to be applied
+	DefaultAutoTagThreshold = 0.8
+	// DefaultAutoTagMaxTags is the maximum number of tags applied to a file
+	DefaultAutoTagMaxTags = 3
+)
+
+// AutoTagConfig holds configuration for similarity-based auto-tagging
+type AutoTagConfig struct {
+	Enabled   bool
+	Threshold float64 // Minimum cosine similarity between file and tag embeddings
+	MaxTags   int     // Maximum number of tags applied per file
+}
+
+// TagMatch is an existing tag whose embedding is similar to a file's
+type TagMatch struct {
+	Tag   models.Tag
+	Score float64
+}
+
+// AutoTagService matches files to existing tags by embedding similarity.
+// It is a deterministic alternative to letting the agent pick tags.
+type AutoTagService interface {
+	IsEnabled() bool
+	// MatchTags returns the user's tags whose embedding is at least as similar
+	// to the file embedding as the threshold, best match first
+	MatchTags(ctx context.Context, userID string, fileEmbedding []float32) ([]TagMatch, error)
+}
+
+type autoTagService struct {
+	db               *gorm.DB
+	embeddingService EmbeddingService
+	config           AutoTagConfig
+}
+
+// NewAutoTagService creates a new AutoTagService
+func NewAutoTagService(db *gorm.DB, embeddingService EmbeddingService, config AutoTagConfig) AutoTagService {
+	if config.Threshold <= 0 {
+		config.Threshold = DefaultAutoTagThreshold
+	}
+	if config.MaxTags <= 0 {
+		config.MaxTags = DefaultAutoTagMaxTags
+	}
+	return &autoTagService{
+		db:               db,
+		embeddingService: embeddingService,
+		config:           config,
+	}
+}
+
+// IsEnabled returns whether auto-tagging is enabled
+func (s *autoTagService) IsEnabled() bool {
+	return s.config.Enabled
+}
+
+// MatchTags scores every tag of the user against the file embedding
+func (s *autoTagService) MatchTags(ctx context.Context, userID string, fileEmbedding []float32) ([]TagMatch, error) {
+	var tags []models.Tag
+	if err := s.db.Preload("Aliases").Where("user_id = ?", userID).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	var matches []TagMatch
+	for _, tag := range tags {
+		embedding, err := s.tagEmbedding(ctx, userID, tag)
+		if err != nil {
+			return nil, err
+		}
+		if score := cosineSimilarity(fileEmbedding, embedding); score >= s.config.Threshold {
+			matches = append(matches, TagMatch{Tag: tag, Score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > s.config.MaxTags {
+		matches = matches[:s.config.MaxTags]
+	}
+	return matches, nil
+}
+
+// tagEmbedding returns the stored embedding for the tag, regenerating it when
+// the tag's text or the embedding model has changed since it was stored
+func (s *autoTagService) tagEmbedding(ctx context.Context, userID string, tag models.Tag) ([]float32, error) {
+	source := tagEmbeddingSource(tag)
+	model, _ := s.embeddingService.ActiveModel()
+
+	var stored models.TagEmbedding
+	err := s.db.Where("tag_id = ?", tag.ID).Limit(1).Find(&stored).Error
+	if err != nil {
+		return nil, err
+	}
+	if stored.ID != 0 && stored.Source == source && stored.Model == model {
+		return parseEmbedding(stored.Embedding)
+	}
+
+	embedding, err := s.embeddingService.GenerateEmbedding(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed tag %q: %w", tag.Name, err)
+	}
+	embJSON, err := json.Marshal(embedding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal embedding: %w", err)
+	}
+
+	stored.TagID = tag.ID
+	stored.UserID = userID
+	stored.Embedding = string(embJSON)
+	stored.Source = source
+	stored.Model = model
+	stored.Dimensions = len(embedding)
+	if err := s.db.Save(&stored).Error; err != nil {
+		return nil, err
+	}
+	return embedding, nil
+}
+
+// tagEmbeddingSource builds the text embedded for a tag
+func tagEmbeddingSource(tag models.Tag) string {
+	parts := []string{tag.Name}
+	if tag.Description != "" {
+		parts = append(parts, tag.Description)
+	}
+	for _, alias := range tag.Aliases {
+		parts = append(parts, alias.Alias)
+	}
+	return strings.Join(parts, "\n")
+}
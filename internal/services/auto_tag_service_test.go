@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const autoTagTestUserID = "auto-tag-test-user"
+
+// keywordEmbeddingService embeds text as a vector over a fixed set of keywords
+type keywordEmbeddingService struct {
+	EmbeddingService
+	keywords []string
+	calls    int
+}
+
+func (s *keywordEmbeddingService) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	s.calls++
+	embedding := make([]float32, len(s.keywords))
+	for i, keyword := range s.keywords {
+		if strings.Contains(strings.ToLower(text), keyword) {
+			embedding[i] = 1
+		}
+	}
+	return embedding, nil
+}
+
+func TestMatchTags_AppliesThresholdAndCachesTagEmbeddings(t *testing.T) {
+	db := newTestDB(t)
+
+	embeddings := &keywordEmbeddingService{
+		EmbeddingService: NewMockEmbeddingService(),
+		keywords:         []string{"invoice", "receipt", "travel"},
+	}
+	service := NewAutoTagService(db, embeddings, AutoTagConfig{Enabled: true, Threshold: 0.5})
+
+	invoices := &models.Tag{UserID: autoTagTestUserID, Name: "Invoices", Aliases: []models.TagAlias{{UserID: autoTagTestUserID, Alias: "receipt"}}}
+	travel := &models.Tag{UserID: autoTagTestUserID, Name: "Travel"}
+	require.NoError(t, db.Create(invoices).Error)
+	require.NoError(t, db.Create(travel).Error)
+
+	fileEmbedding, err := embeddings.GenerateEmbedding(context.Background(), "Invoice and receipt for office chairs")
+	require.NoError(t, err)
+
+	matches, err := service.MatchTags(context.Background(), autoTagTestUserID, fileEmbedding)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, invoices.ID, matches[0].Tag.ID)
+	assert.InDelta(t, 1.0, matches[0].Score, 1e-6)
+
+	// Tag embeddings are stored and reused until the tag changes
+	calls := embeddings.calls
+	_, err = service.MatchTags(context.Background(), autoTagTestUserID, fileEmbedding)
+	require.NoError(t, err)
+	assert.Equal(t, calls, embeddings.calls)
+
+	require.NoError(t, db.Model(travel).Update("description", "Invoices from trips").Error)
+	matches, err = service.MatchTags(context.Background(), autoTagTestUserID, fileEmbedding)
+	require.NoError(t, err)
+	assert.Equal(t, calls+1, embeddings.calls)
+	assert.Len(t, matches, 2)
+}
+
+func TestTagEdits_DropStaleTagEmbeddings(t *testing.T) {
+	db := newTestDB(t)
+
+	embeddings := &keywordEmbeddingService{
+		EmbeddingService: NewMockEmbeddingService(),
+		keywords:         []string{"invoice", "travel"},
+	}
+	service := NewAutoTagService(db, embeddings, AutoTagConfig{Enabled: true, Threshold: 0.5})
+	tagService := NewTagService(db)
+
+	tag := &models.Tag{Name: "Travel"}
+	require.NoError(t, tagService.CreateTag(autoTagTestUserID, tag))
+	fileEmbedding, err := embeddings.GenerateEmbedding(context.Background(), "Invoice for office chairs")
+	require.NoError(t, err)
+
+	storedEmbeddings := func() int64 {
+		var count int64
+		require.NoError(t, db.Model(&models.TagEmbedding{}).Where("tag_id = ?", tag.ID).Count(&count).Error)
+		return count
+	}
+	match := func() []TagMatch {
+		matches, err := service.MatchTags(context.Background(), autoTagTestUserID, fileEmbedding)
+		require.NoError(t, err)
+		return matches
+	}
+
+	assert.Empty(t, match())
+	require.EqualValues(t, 1, storedEmbeddings())
+
+	// A color change leaves the embedding alone
+	require.NoError(t, tagService.UpdateTag(autoTagTestUserID, &models.Tag{ID: tag.ID, Name: "Travel", Color: "#FF0000"}))
+	assert.EqualValues(t, 1, storedEmbeddings())
+
+	// A rename drops it and the next match embeds the new name
+	require.NoError(t, tagService.UpdateTag(autoTagTestUserID, &models.Tag{ID: tag.ID, Name: "Invoices"}))
+	assert.EqualValues(t, 0, storedEmbeddings())
+	matches := match()
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Invoices", matches[0].Tag.Name)
+
+	alias, err := tagService.AddTagAlias(autoTagTestUserID, tag.ID, "bills")
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, storedEmbeddings())
+	match()
+	require.NoError(t, tagService.RemoveTagAlias(autoTagTestUserID, tag.ID, alias.ID))
+	assert.EqualValues(t, 0, storedEmbeddings())
+
+	match()
+	_, err = tagService.DeleteTags(autoTagTestUserID, []uint{tag.ID}, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, storedEmbeddings())
+}
@@ -4,18 +4,42 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"path"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
 )
 
 // ContentParserConfig holds configuration for the content parser service
 type ContentParserConfig struct {
 	EndpointURL string // e.g., https://your-python-service/convert
 	APIKey      string // ADMIN_API_KEY for authentication
+	AuthHeader  string // CONTENT_PARSER_AUTH_HEADER env var (default: X-Api-Key)
+	AuthScheme  string // CONTENT_PARSER_AUTH_SCHEME env var, e.g. "Bearer" (default: none, raw key)
+	// MinContentChars is the fewest characters of parsed text a document
+	// needs to complete processing (CONTENT_PARSER_MIN_CONTENT_CHARS, 0 disables)
+	MinContentChars int
+	// CallbackURL switches the parser to async mode: files are submitted as
+	// jobs and the parser posts the result to this URL
+	// (CONTENT_PARSER_CALLBACK_URL, e.g. https://host/api/internal/parser-callback)
+	CallbackURL string
 }
 
+// ErrContentTooShort is reported when a document's parsed text is too short to
+// be a usable parse, e.g. a blank or unreadable scan
+var ErrContentTooShort = errors.New("parsed content is too short to be useful; re-upload a clearer copy or retry processing")
+
+// DefaultMinContentChars is the minimum parsed text length used when
+// CONTENT_PARSER_MIN_CONTENT_CHARS is unset
+const DefaultMinContentChars = 20
+
 // ParsedContent represents the result of content parsing
 type ParsedContent struct {
 	TextContent string `json:"content"`
@@ -24,6 +48,20 @@ type ParsedContent struct {
 // ContentParserService handles file content parsing via external Python service
 type ContentParserService interface {
 	ParseFileContent(ctx context.Context, fileURL string) (*ParsedContent, error)
+	DetectEncryption(ctx context.Context, fileURL string) (bool, error)
+	// DetectContentType sniffs the file's media type from its leading bytes,
+	// returning "" when they don't identify one
+	DetectContentType(ctx context.Context, fileURL string) (string, error)
+	// IsContentTooShort reports whether parsed text is too short to be a
+	// usable parse of a file of the given type. Only documents and invoices
+	// are expected to carry text.
+	IsContentTooShort(content string, fileType models.FileType) bool
+	// IsAsync reports whether files are parsed via SubmitParseJob instead of
+	// ParseFileContent
+	IsAsync() bool
+	// SubmitParseJob asks an async parser to parse the file and post the
+	// result, tagged with jobToken, to the callback URL
+	SubmitParseJob(ctx context.Context, fileURL, jobToken string) error
 }
 
 type contentParserService struct {
@@ -33,15 +71,21 @@ type contentParserService struct {
 
 // NewContentParserService creates a new ContentParserService
 func NewContentParserService(config ContentParserConfig) ContentParserService {
+	if config.AuthHeader == "" {
+		config.AuthHeader = "X-Api-Key"
+	}
 	return &contentParserService{
 		config: config,
 		client: &http.Client{},
 	}
 }
 
-// convertRequest is the request body for the convert API
+// convertRequest is the request body for the convert API. Async jobs also
+// carry where and with which token to post the result.
 type convertRequest struct {
-	File string `json:"file"`
+	File        string `json:"file"`
+	CallbackURL string `json:"callback_url,omitempty"`
+	JobToken    string `json:"job_token,omitempty"`
 }
 
 // ParseFileContent parses file content from a URL using the Python service
@@ -66,7 +110,7 @@ func (s *contentParserService) ParseFileContent(ctx context.Context, fileURL str
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Api-Key", s.config.APIKey)
+	req.Header.Set(s.config.AuthHeader, s.authHeaderValue())
 
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -91,6 +135,70 @@ func (s *contentParserService) ParseFileContent(ctx context.Context, fileURL str
 	return &parsed, nil
 }
 
+func (s *contentParserService) IsAsync() bool {
+	return s.config.CallbackURL != ""
+}
+
+// SubmitParseJob submits the file to the convert API as an async job. The
+// parser acknowledges with 200 or 202 and posts the result later.
+func (s *contentParserService) SubmitParseJob(ctx context.Context, fileURL, jobToken string) error {
+	if fileURL == "" {
+		return fmt.Errorf("file URL cannot be empty")
+	}
+	if s.config.CallbackURL == "" {
+		return fmt.Errorf("content parser callback URL is not configured")
+	}
+
+	jsonBody, err := json.Marshal(convertRequest{
+		File:        fileURL,
+		CallbackURL: s.config.CallbackURL,
+		JobToken:    jobToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := strings.TrimSuffix(s.config.EndpointURL, "/") + "/convert"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(s.config.AuthHeader, s.authHeaderValue())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("content parser error (status %d): %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *contentParserService) IsContentTooShort(content string, fileType models.FileType) bool {
+	return isContentTooShort(content, fileType, s.config.MinContentChars)
+}
+
+func isContentTooShort(content string, fileType models.FileType, minChars int) bool {
+	if minChars <= 0 || (fileType != models.FileTypeDocument && fileType != models.FileTypeInvoice) {
+		return false
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(content)) < minChars
+}
+
+// authHeaderValue returns the API key, prefixed with the auth scheme if one is configured
+func (s *contentParserService) authHeaderValue() string {
+	if s.config.AuthScheme == "" {
+		return s.config.APIKey
+	}
+	return s.config.AuthScheme + " " + s.config.APIKey
+}
+
 // MockContentParserService is a mock implementation for TESTING ONLY.
 // Do not use in production. Production code requires proper CONTENT_PARSER_ENDPOINT
 // environment variable.
@@ -102,12 +210,46 @@ func NewMockContentParserService() ContentParserService {
 }
 
 func (m *MockContentParserService) ParseFileContent(ctx context.Context, fileURL string) (*ParsedContent, error) {
+	// URLs containing "blank" stand in for scans the parser extracts almost nothing from
+	if strings.Contains(fileURL, "blank") {
+		return &ParsedContent{TextContent: "~ ."}, nil
+	}
+
 	// Return mock content based on URL
 	return &ParsedContent{
 		TextContent: fmt.Sprintf("Mock parsed content from: %s\n\nThis is sample text content for testing purposes.", fileURL),
 	}, nil
 }
 
+// DetectEncryption treats URLs containing "encrypted" as password-protected files
+func (m *MockContentParserService) DetectEncryption(ctx context.Context, fileURL string) (bool, error) {
+	return strings.Contains(fileURL, "encrypted"), nil
+}
+
+// DetectContentType guesses the media type from the extension in the URL path
+func (m *MockContentParserService) DetectContentType(ctx context.Context, fileURL string) (string, error) {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return "", err
+	}
+	return mediaType(mime.TypeByExtension(path.Ext(u.Path))), nil
+}
+
+// IsContentTooShort applies DefaultMinContentChars
+func (m *MockContentParserService) IsContentTooShort(content string, fileType models.FileType) bool {
+	return isContentTooShort(content, fileType, DefaultMinContentChars)
+}
+
+// IsAsync is false; the mock always parses synchronously
+func (m *MockContentParserService) IsAsync() bool {
+	return false
+}
+
+// SubmitParseJob accepts every job without calling back
+func (m *MockContentParserService) SubmitParseJob(ctx context.Context, fileURL, jobToken string) error {
+	return nil
+}
+
 // GenerateSummary creates a summary from the content
 // This is a simple implementation - in production, you might use an LLM
 func GenerateSummary(content string, maxLength int) string {
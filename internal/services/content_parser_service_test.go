@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFileContent_DefaultAuthHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/convert", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"content": "parsed"}`))
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{EndpointURL: server.URL, APIKey: "secret"})
+	parsed, err := service.ParseFileContent(context.Background(), "https://example.com/file.pdf")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "parsed", parsed.TextContent)
+}
+
+func TestParseFileContent_BearerAuthScheme(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-Api-Key"))
+		w.Write([]byte(`{"content": "parsed"}`))
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{
+		EndpointURL: server.URL,
+		APIKey:      "secret",
+		AuthHeader:  "Authorization",
+		AuthScheme:  "Bearer",
+	})
+	_, err := service.ParseFileContent(context.Background(), "https://example.com/file.pdf")
+
+	assert.NoError(t, err)
+}
+
+func TestIsContentTooShort(t *testing.T) {
+	service := NewContentParserService(ContentParserConfig{MinContentChars: 10})
+
+	assert.True(t, service.IsContentTooShort("  ~ .  ", models.FileTypeDocument))
+	assert.True(t, service.IsContentTooShort("", models.FileTypeInvoice))
+	assert.False(t, service.IsContentTooShort("Invoice #1042 total 12.00", models.FileTypeDocument))
+	// Photos and other media aren't expected to carry text
+	assert.False(t, service.IsContentTooShort("", models.FileTypePhoto))
+
+	disabled := NewContentParserService(ContentParserConfig{})
+	assert.False(t, disabled.IsContentTooShort("", models.FileTypeDocument))
+}
+
+func TestSubmitParseJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/convert", r.URL.Path)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "https://example.com/file.pdf", body["file"])
+		assert.Equal(t, "https://files.example.com/api/internal/parser-callback", body["callback_url"])
+		assert.Equal(t, "job-token", body["job_token"])
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{
+		EndpointURL: server.URL,
+		CallbackURL: "https://files.example.com/api/internal/parser-callback",
+	})
+	assert.True(t, service.IsAsync())
+	assert.NoError(t, service.SubmitParseJob(context.Background(), "https://example.com/file.pdf", "job-token"))
+
+	assert.False(t, NewContentParserService(ContentParserConfig{EndpointURL: server.URL}).IsAsync())
+}
+
+func TestSubmitParseJob_Rejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("busy"))
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{EndpointURL: server.URL, CallbackURL: "https://files.example.com/cb"})
+	err := service.SubmitParseJob(context.Background(), "https://example.com/file.pdf", "job-token")
+
+	assert.ErrorContains(t, err, "status 503")
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// contentTypeSampleSize is how many leading bytes http.DetectContentType
+// considers
+const contentTypeSampleSize = 512
+
+// genericMimeType is what clients send, and what sniffing reports, when the
+// type is unknown
+const genericMimeType = "application/octet-stream"
+
+// SniffContentType returns the media type recognised from a file's leading
+// bytes, without parameters, or "" when the bytes don't identify a type
+func SniffContentType(head []byte) string {
+	if len(head) == 0 {
+		return ""
+	}
+	if len(head) > contentTypeSampleSize {
+		head = head[:contentTypeSampleSize]
+	}
+	detected := mediaType(http.DetectContentType(head))
+	if detected == genericMimeType {
+		return ""
+	}
+	return detected
+}
+
+// ResolveMimeType picks the MIME type to store from the one a client declared
+// and the one sniffed from the file. Sniffing only sees the container of many
+// formats (a .docx is a ZIP, a CSV is plain text), so a declared type is only
+// replaced when it is missing or generic, or when the sniffed type is a
+// reliably recognised image, PDF, audio or video format of a different type.
+func ResolveMimeType(declared, detected string) string {
+	declared = strings.TrimSpace(declared)
+	if detected == "" {
+		return declared
+	}
+	if declared == "" || mediaType(declared) == genericMimeType {
+		return detected
+	}
+	if mediaType(declared) == detected {
+		return declared
+	}
+
+	switch {
+	case strings.HasPrefix(detected, "image/"), detected == "application/pdf":
+		return detected
+	case strings.HasPrefix(detected, "audio/"), strings.HasPrefix(detected, "video/"):
+		// Containers like MP4 and WebM hold either, so keep a declared audio
+		// or video type
+		if isAudioOrVideo(mediaType(declared)) {
+			return declared
+		}
+		return detected
+	}
+	return declared
+}
+
+func isAudioOrVideo(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "audio/") || strings.HasPrefix(mimeType, "video/")
+}
+
+// mediaType strips parameters such as charset from a MIME type and lowercases it
+func mediaType(mimeType string) string {
+	if parsed, _, err := mime.ParseMediaType(mimeType); err == nil {
+		return parsed
+	}
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
+
+// DetectContentType downloads the start of the file and sniffs its media type
+func (s *contentParserService) DetectContentType(ctx context.Context, fileURL string) (string, error) {
+	head, _, err := s.fetchRange(ctx, fileURL, fmt.Sprintf("bytes=0-%d", contentTypeSampleSize-1))
+	if err != nil {
+		return "", err
+	}
+	return SniffContentType(head), nil
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestSniffContentType(t *testing.T) {
+	assert.Equal(t, "image/png", SniffContentType(pngHeader))
+	assert.Equal(t, "application/pdf", SniffContentType([]byte("%PDF-1.7\n1 0 obj")))
+	assert.Equal(t, "text/plain", SniffContentType([]byte("plain notes")))
+	assert.Equal(t, "", SniffContentType([]byte{0x00, 0x01, 0x02, 0x03}))
+	assert.Equal(t, "", SniffContentType(nil))
+}
+
+func TestResolveMimeType(t *testing.T) {
+	tests := []struct {
+		name     string
+		declared string
+		detected string
+		want     string
+	}{
+		{name: "nothing detected", declared: "application/pdf", detected: "", want: "application/pdf"},
+		{name: "missing declared type", declared: "", detected: "image/png", want: "image/png"},
+		{name: "generic declared type", declared: "application/octet-stream", detected: "application/pdf", want: "application/pdf"},
+		{name: "matching types", declared: "Image/PNG", detected: "image/png", want: "Image/PNG"},
+		{name: "wrong image type", declared: "application/msword", detected: "image/jpeg", want: "image/jpeg"},
+		{name: "office document sniffed as zip", declared: "application/vnd.openxmlformats-officedocument.wordprocessingml.document", detected: "application/zip", want: "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{name: "csv sniffed as text", declared: "text/csv", detected: "text/plain", want: "text/csv"},
+		{name: "audio in a video container", declared: "audio/mp4", detected: "video/mp4", want: "audio/mp4"},
+		{name: "video declared as document", declared: "application/pdf", detected: "video/mp4", want: "video/mp4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveMimeType(tt.declared, tt.detected))
+		})
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{0}, 4*contentTypeSampleSize)...)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bytes=0-511", r.Header.Get("Range"))
+		http.ServeContent(w, r, "upload.bin", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{})
+	detected, err := service.DetectContentType(context.Background(), server.URL)
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", detected)
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	_ "github.com/tursodatabase/libsql-client-go/libsql"
 	"gorm.io/driver/sqlite"
@@ -109,16 +110,59 @@ func (s *dbService) GetDB() *gorm.DB {
 
 // migrate runs database migrations for file management models
 func (s *dbService) migrate() error {
+	// Files created before storage_config_id existed were read from their
+	// owner's storage config, whichever it was at the time
+	backfillStorageConfigs := s.db.Migrator().HasTable(&models.File{}) &&
+		!s.db.Migrator().HasColumn(&models.File{}, "storage_config_id")
+
+	// storage_configs.user_id was unique before configs were kept for the
+	// files uploaded with them
+	if s.db.Migrator().HasIndex(&models.StorageConfig{}, "idx_storage_configs_user_id") {
+		if err := s.db.Migrator().DropIndex(&models.StorageConfig{}, "idx_storage_configs_user_id"); err != nil {
+			return err
+		}
+	}
+
 	// Run GORM AutoMigrate for standard models
 	if err := s.db.AutoMigrate(
 		&models.Tag{},
+		&models.TagAlias{},
 		&models.Folder{},
 		&models.File{},
 		&models.FileEmbedding{},
+		&models.TagEmbedding{},
+		&models.FileLink{},
+		&models.FileRelation{},
+		&models.StorageConfig{},
+		&models.FolderMember{},
+		&models.FolderAlias{},
+		&models.FoldingRule{},
+		&models.ParserJob{},
+		&models.ProcessingPause{},
+		&models.JobLease{},
 	); err != nil {
 		return err
 	}
 
+	if err := s.backfillFilePublicIDs(); err != nil {
+		return err
+	}
+	if err := s.backfillFileContentCounts(); err != nil {
+		return err
+	}
+
+	if backfillStorageConfigs {
+		if err := s.db.Exec(`
+			UPDATE files SET storage_config_id = (
+				SELECT MAX(storage_configs.id) FROM storage_configs
+				WHERE storage_configs.user_id = files.user_id AND storage_configs.deleted_at IS NULL
+			)
+			WHERE storage_config_id IS NULL
+		`).Error; err != nil {
+			return err
+		}
+	}
+
 	// Create vector index for Turso (if supported)
 	// This is a no-op for standard SQLite
 	s.db.Exec(`
@@ -129,6 +173,53 @@ func (s *dbService) migrate() error {
 	return nil
 }
 
+// backfillFilePublicIDs assigns public IDs to files created before the column
+// existed
+func (s *dbService) backfillFilePublicIDs() error {
+	var ids []uint
+	if err := s.db.Unscoped().Model(&models.File{}).
+		Where("public_id IS NULL OR public_id = ''").
+		Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := s.db.Unscoped().Model(&models.File{}).
+			Where("id = ?", id).
+			UpdateColumn("public_id", uuid.NewString()).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillFileContentCounts sets the word and character counts of files
+// parsed before the columns existed. Non-empty content always has characters,
+// so files that still need counts are the ones with a zero char_count.
+func (s *dbService) backfillFileContentCounts() error {
+	for {
+		var files []models.File
+		if err := s.db.Unscoped().Select("id", "content").
+			Where("content <> '' AND char_count = 0").
+			Limit(fileStreamBatchSize).
+			Find(&files).Error; err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return nil
+		}
+
+		for _, file := range files {
+			words, chars := contentCounts(file.Content)
+			if err := s.db.Unscoped().Model(&models.File{}).
+				Where("id = ?", file.ID).
+				UpdateColumns(map[string]any{"word_count": words, "char_count": chars}).Error; err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Close closes the database connection
 func (s *dbService) Close() error {
 	sqlDB, err := s.db.DB()
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newTestDB returns a migrated in-memory database that is closed when the test ends
+func newTestDB(t *testing.T) *gorm.DB {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	return dbService.GetDB()
+}
+
+func TestMigrate_BackfillsFilePublicIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "files.db")
+	dbService, err := NewSqliteDBService(path)
+	require.NoError(t, err)
+
+	file := &models.File{UserID: "user-1", Title: "Old", S3Key: "files/user-1/old.pdf", OriginalFilename: "old.pdf"}
+	require.NoError(t, dbService.GetDB().Create(file).Error)
+	assert.Len(t, file.PublicID, 36)
+
+	// Simulate a file created before the column existed
+	require.NoError(t, dbService.GetDB().Exec("UPDATE files SET public_id = NULL").Error)
+	require.NoError(t, dbService.Close())
+
+	dbService, err = NewSqliteDBService(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	var reloaded models.File
+	require.NoError(t, dbService.GetDB().First(&reloaded, file.ID).Error)
+	assert.Len(t, reloaded.PublicID, 36)
+}
+
+func TestMigrate_BackfillsFileContentCounts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "files.db")
+	dbService, err := NewSqliteDBService(path)
+	require.NoError(t, err)
+
+	parsed := &models.File{UserID: "user-1", Title: "Parsed", S3Key: "files/user-1/parsed.pdf", OriginalFilename: "parsed.pdf", Content: "three short words"}
+	empty := &models.File{UserID: "user-1", Title: "Empty", S3Key: "files/user-1/empty.pdf", OriginalFilename: "empty.pdf"}
+	require.NoError(t, dbService.GetDB().Create(parsed).Error)
+	require.NoError(t, dbService.GetDB().Create(empty).Error)
+
+	// Simulate files parsed before the counts were stored
+	require.NoError(t, dbService.GetDB().Exec("UPDATE files SET word_count = 0, char_count = 0").Error)
+	require.NoError(t, dbService.Close())
+
+	dbService, err = NewSqliteDBService(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	var reloaded models.File
+	require.NoError(t, dbService.GetDB().First(&reloaded, parsed.ID).Error)
+	assert.Equal(t, 3, reloaded.WordCount)
+	assert.Equal(t, 17, reloaded.CharCount)
+	var reloadedEmpty models.File
+	require.NoError(t, dbService.GetDB().First(&reloadedEmpty, empty.ID).Error)
+	assert.Zero(t, reloadedEmpty.CharCount)
+}
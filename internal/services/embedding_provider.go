@@ -0,0 +1,196 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Embedding provider names accepted in EmbeddingConfig.Provider
+const (
+	EmbeddingProviderOpenAI = "openai"
+	EmbeddingProviderOllama = "ollama"
+)
+
+// EmbeddingProvider turns text into a vector using a specific backend API
+type EmbeddingProvider interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+}
+
+// NewEmbeddingProvider returns the provider selected by config.Provider. An
+// empty provider selects the OpenAI-compatible API.
+func NewEmbeddingProvider(config EmbeddingConfig) (EmbeddingProvider, error) {
+	switch strings.ToLower(config.Provider) {
+	case "", EmbeddingProviderOpenAI:
+		return NewOpenAIEmbeddingProvider(config), nil
+	case EmbeddingProviderOllama:
+		return NewOllamaEmbeddingProvider(config), nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %q", config.Provider)
+	}
+}
+
+// postEmbeddingJSON sends a JSON request to an embedding API and returns the
+// response body when the request succeeded
+func postEmbeddingJSON(ctx context.Context, client *http.Client, url, apiKey string, payload interface{}) ([]byte, error) {
+	jsonBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding API error (status %d): %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// openAIEmbeddingProvider calls an OpenAI-style /embeddings endpoint, such as
+// the Vercel AI Gateway
+type openAIEmbeddingProvider struct {
+	config EmbeddingConfig
+	client *http.Client
+}
+
+// NewOpenAIEmbeddingProvider creates a provider for OpenAI-compatible APIs
+func NewOpenAIEmbeddingProvider(config EmbeddingConfig) EmbeddingProvider {
+	return &openAIEmbeddingProvider{
+		config: config,
+		client: &http.Client{},
+	}
+}
+
+// embeddingRequest is the request body for the embeddings API
+type embeddingRequest struct {
+	Input      string `json:"input"`
+	Model      string `json:"model"`
+	Dimensions int    `json:"dimensions,omitempty"`
+}
+
+// embeddingResponse is the response from the embeddings API
+type embeddingResponse struct {
+	Data []struct {
+		Embedding []float32 `json:"embedding"`
+		Index     int       `json:"index"`
+	} `json:"data"`
+	Model string `json:"model"`
+	Usage struct {
+		PromptTokens int `json:"prompt_tokens"`
+		TotalTokens  int `json:"total_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error"`
+}
+
+func (p *openAIEmbeddingProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	reqBody := embeddingRequest{
+		Input: text,
+		Model: p.config.Model,
+	}
+	if p.config.Dimensions > 0 {
+		reqBody.Dimensions = p.config.Dimensions
+	}
+
+	url := fmt.Sprintf("%s/embeddings", strings.TrimSuffix(p.config.GatewayURL, "/"))
+	body, err := postEmbeddingJSON(ctx, p.client, url, p.config.APIKey, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var embResp embeddingResponse
+	if err := json.Unmarshal(body, &embResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if embResp.Error != nil {
+		return nil, fmt.Errorf("embedding API error: %s", embResp.Error.Message)
+	}
+
+	if len(embResp.Data) == 0 {
+		return nil, errors.New("no embedding data in response")
+	}
+
+	return embResp.Data[0].Embedding, nil
+}
+
+// ollamaEmbeddingProvider calls the /api/embed endpoint of a self-hosted
+// Ollama server
+type ollamaEmbeddingProvider struct {
+	config EmbeddingConfig
+	client *http.Client
+}
+
+// NewOllamaEmbeddingProvider creates a provider for Ollama servers. The API key
+// is optional and only sent when set, e.g. for servers behind a proxy.
+func NewOllamaEmbeddingProvider(config EmbeddingConfig) EmbeddingProvider {
+	return &ollamaEmbeddingProvider{
+		config: config,
+		client: &http.Client{},
+	}
+}
+
+// ollamaEmbedRequest is the request body for Ollama's /api/embed
+type ollamaEmbedRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+}
+
+// ollamaEmbedResponse is the response from Ollama's /api/embed
+type ollamaEmbedResponse struct {
+	Model      string      `json:"model"`
+	Embeddings [][]float32 `json:"embeddings"`
+	Error      string      `json:"error"`
+}
+
+func (p *ollamaEmbeddingProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	url := fmt.Sprintf("%s/api/embed", strings.TrimSuffix(p.config.GatewayURL, "/"))
+	body, err := postEmbeddingJSON(ctx, p.client, url, p.config.APIKey, ollamaEmbedRequest{
+		Model: p.config.Model,
+		Input: text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var embResp ollamaEmbedResponse
+	if err := json.Unmarshal(body, &embResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if embResp.Error != "" {
+		return nil, fmt.Errorf("embedding API error: %s", embResp.Error)
+	}
+
+	if len(embResp.Embeddings) == 0 {
+		return nil, errors.New("no embedding data in response")
+	}
+
+	return embResp.Embeddings[0], nil
+}
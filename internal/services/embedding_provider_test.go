@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEmbeddingProvider_SelectsByName(t *testing.T) {
+	provider, err := NewEmbeddingProvider(EmbeddingConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &openAIEmbeddingProvider{}, provider)
+
+	provider, err = NewEmbeddingProvider(EmbeddingConfig{Provider: "Ollama"})
+	require.NoError(t, err)
+	assert.IsType(t, &ollamaEmbeddingProvider{}, provider)
+
+	_, err = NewEmbeddingProvider(EmbeddingConfig{Provider: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestOllamaEmbeddingProvider_GeneratesEmbedding(t *testing.T) {
+	var got ollamaEmbedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/embed", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.Write([]byte(`{"model": "nomic-embed-text", "embeddings": [[0.5, 0.25, 0]]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := EmbeddingConfig{Provider: EmbeddingProviderOllama, GatewayURL: server.URL + "/", Model: "nomic-embed-text"}
+	provider, err := NewEmbeddingProvider(config)
+	require.NoError(t, err)
+
+	embedding, err := NewEmbeddingServiceWithProvider(newTestDB(t), config, provider).
+		GenerateEmbedding(context.Background(), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.5, 0.25, 0}, embedding)
+	assert.Equal(t, ollamaEmbedRequest{Model: "nomic-embed-text", Input: "hello"}, got)
+}
@@ -1,73 +1,85 @@
 package services
 
 import (
-	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
 )
 
 // EmbeddingConfig holds configuration for the embedding service
 type EmbeddingConfig struct {
+	Provider   string // openai (default) or ollama, see NewEmbeddingProvider
 	GatewayURL string // e.g., https://ai-gateway.vercel.sh/v1
 	APIKey     string // AI Gateway API key
 	Model      string // e.g., openai/text-embedding-3-small
-	Dimensions int    // e.g., 1536
+	Dimensions int    // e.g., 1536; 0 keeps the model's native size
+}
+
+// knownEmbeddingDimensions holds the native vector size of common embedding
+// models, keyed by model name without a provider prefix
+var knownEmbeddingDimensions = map[string]int{
+	"text-embedding-3-small": 1536,
+	"text-embedding-3-large": 3072,
+	"text-embedding-ada-002": 1536,
+}
+
+// DefaultEmbeddingDimensions returns the vector size of a known embedding
+// model, accepting gateway names like "openai/text-embedding-3-small". Other
+// models, such as those served by Ollama, return 0 so vectors keep whatever
+// size the model produces.
+func DefaultEmbeddingDimensions(model string) int {
+	if i := strings.LastIndex(model, "/"); i >= 0 {
+		model = model[i+1:]
+	}
+	return knownEmbeddingDimensions[model]
 }
 
 // EmbeddingService handles embedding generation and storage
 type EmbeddingService interface {
 	GenerateEmbedding(ctx context.Context, text string) ([]float32, error)
-	StoreFileEmbedding(userID string, fileID uint, embedding []float32) error
+	// StoreFileEmbedding stores the embedding generated from content
+	StoreFileEmbedding(userID string, fileID uint, embedding []float32, content string) error
 	GetFileEmbedding(userID string, fileID uint) ([]float32, error)
+	// GetUnchangedFileEmbedding returns the stored embedding when it was
+	// generated by the active model from the same content, or nil
+	GetUnchangedFileEmbedding(userID string, fileID uint, content string) ([]float32, error)
 	DeleteFileEmbedding(userID string, fileID uint) error
+	// ActiveModel returns the model and dimensions used for new embeddings
+	ActiveModel() (string, int)
+	// IsConfigured reports whether an embedding endpoint is set up
+	IsConfigured() bool
 }
 
 type embeddingService struct {
-	db     *gorm.DB
-	config EmbeddingConfig
-	client *http.Client
+	db       *gorm.DB
+	config   EmbeddingConfig
+	provider EmbeddingProvider
 }
 
-// NewEmbeddingService creates a new EmbeddingService
+// NewEmbeddingService creates a new EmbeddingService backed by the
+// OpenAI-compatible provider
 func NewEmbeddingService(db *gorm.DB, config EmbeddingConfig) EmbeddingService {
-	return &embeddingService{
-		db:     db,
-		config: config,
-		client: &http.Client{},
-	}
-}
-
-// embeddingRequest is the request body for the embeddings API
-type embeddingRequest struct {
-	Input      string `json:"input"`
-	Model      string `json:"model"`
-	Dimensions int    `json:"dimensions,omitempty"`
+	return NewEmbeddingServiceWithProvider(db, config, NewOpenAIEmbeddingProvider(config))
 }
 
-// embeddingResponse is the response from the embeddings API
-type embeddingResponse struct {
-	Data []struct {
-		Embedding []float32 `json:"embedding"`
-		Index     int       `json:"index"`
-	} `json:"data"`
-	Model string `json:"model"`
-	Usage struct {
-		PromptTokens int `json:"prompt_tokens"`
-		TotalTokens  int `json:"total_tokens"`
-	} `json:"usage"`
-	Error *struct {
-		Message string `json:"message"`
-		Type    string `json:"type"`
-	} `json:"error"`
+// NewEmbeddingServiceWithProvider creates an EmbeddingService that generates
+// vectors with the given provider
+func NewEmbeddingServiceWithProvider(db *gorm.DB, config EmbeddingConfig, provider EmbeddingProvider) EmbeddingService {
+	return &embeddingService{
+		db:       db,
+		config:   config,
+		provider: provider,
+	}
 }
 
 // GenerateEmbedding generates an embedding for the given text
@@ -81,71 +93,30 @@ func (s *embeddingService) GenerateEmbedding(ctx context.Context, text string) (
 		text = text[:8000]
 	}
 
-	reqBody := embeddingRequest{
-		Input: text,
-		Model: s.config.Model,
-	}
-	if s.config.Dimensions > 0 {
-		reqBody.Dimensions = s.config.Dimensions
-	}
-
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	url := fmt.Sprintf("%s/embeddings", strings.TrimSuffix(s.config.GatewayURL, "/"))
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+s.config.APIKey)
-
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("embedding API error (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	var embResp embeddingResponse
-	if err := json.Unmarshal(body, &embResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	if embResp.Error != nil {
-		return nil, fmt.Errorf("embedding API error: %s", embResp.Error.Message)
-	}
-
-	if len(embResp.Data) == 0 {
-		return nil, errors.New("no embedding data in response")
-	}
-
-	return embResp.Data[0].Embedding, nil
+	start := time.Now()
+	embedding, err := s.provider.Embed(ctx, text)
+	metrics.ObserveAIRequest(metrics.AIServiceEmbedding, start, err)
+	return embedding, err
 }
 
 // StoreFileEmbedding stores an embedding for a file
-func (s *embeddingService) StoreFileEmbedding(userID string, fileID uint, embedding []float32) error {
+func (s *embeddingService) StoreFileEmbedding(userID string, fileID uint, embedding []float32, content string) error {
+	defer markFilesChanged()
+
 	// Convert embedding to JSON string
 	embJSON, err := json.Marshal(embedding)
 	if err != nil {
 		return fmt.Errorf("failed to marshal embedding: %w", err)
 	}
 
+	contentHash := embeddingContentHash(content)
 	fileEmbedding := models.FileEmbedding{
-		FileID:    fileID,
-		UserID:    userID,
-		Embedding: string(embJSON),
+		FileID:      fileID,
+		UserID:      userID,
+		Embedding:   string(embJSON),
+		Model:       s.config.Model,
+		Dimensions:  len(embedding),
+		ContentHash: contentHash,
 	}
 
 	// Upsert: update if exists, create if not
@@ -158,7 +129,12 @@ func (s *embeddingService) StoreFileEmbedding(userID string, fileID uint, embedd
 		// Record already exists, update it
 		return s.db.Model(&models.FileEmbedding{}).
 			Where("file_id = ?", fileID).
-			Update("embedding", string(embJSON)).Error
+			Updates(map[string]interface{}{
+				"embedding":    string(embJSON),
+				"model":        s.config.Model,
+				"dimensions":   len(embedding),
+				"content_hash": contentHash,
+			}).Error
 	}
 
 	return nil
@@ -183,23 +159,69 @@ func (s *embeddingService) GetFileEmbedding(userID string, fileID uint) ([]float
 	return embedding, nil
 }
 
+// GetUnchangedFileEmbedding returns the stored embedding if it was generated
+// by the active model from content, or nil when it needs regenerating
+func (s *embeddingService) GetUnchangedFileEmbedding(userID string, fileID uint, content string) ([]float32, error) {
+	if content == "" {
+		return nil, nil
+	}
+
+	var fileEmbedding models.FileEmbedding
+	err := s.db.Where("file_id = ? AND user_id = ?", fileID, userID).Limit(1).Find(&fileEmbedding).Error
+	if err != nil || fileEmbedding.ID == 0 {
+		return nil, err
+	}
+	if fileEmbedding.Model != s.config.Model || fileEmbedding.ContentHash != embeddingContentHash(content) {
+		return nil, nil
+	}
+	if s.config.Dimensions > 0 && fileEmbedding.Dimensions != s.config.Dimensions {
+		return nil, nil
+	}
+
+	var embedding []float32
+	if err := json.Unmarshal([]byte(fileEmbedding.Embedding), &embedding); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal embedding: %w", err)
+	}
+	return embedding, nil
+}
+
+// embeddingContentHash returns the hex SHA-256 of the embedded text
+func embeddingContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
 // DeleteFileEmbedding deletes the embedding for a file
 func (s *embeddingService) DeleteFileEmbedding(userID string, fileID uint) error {
+	defer markFilesChanged()
+
 	result := s.db.Where("file_id = ? AND user_id = ?", fileID, userID).Delete(&models.FileEmbedding{})
 	return result.Error
 }
 
+// ActiveModel returns the configured embedding model and dimensions
+func (s *embeddingService) ActiveModel() (string, int) {
+	return s.config.Model, s.config.Dimensions
+}
+
+// IsConfigured reports whether an embedding endpoint is set
+func (s *embeddingService) IsConfigured() bool {
+	return s.config.GatewayURL != ""
+}
+
 // MockEmbeddingService is a mock implementation for TESTING ONLY.
 // Do not use in production. Production code requires proper AI_GATEWAY_URL
 // and AI_GATEWAY_API_KEY environment variables.
 type MockEmbeddingService struct {
 	embeddings map[uint][]float32
+	contents   map[uint]string
 }
 
 // NewMockEmbeddingService creates a mock embedding service for TESTING ONLY.
 func NewMockEmbeddingService() EmbeddingService {
 	return &MockEmbeddingService{
 		embeddings: make(map[uint][]float32),
+		contents:   make(map[uint]string),
 	}
 }
 
@@ -212,8 +234,9 @@ func (m *MockEmbeddingService) GenerateEmbedding(ctx context.Context, text strin
 	return embedding, nil
 }
 
-func (m *MockEmbeddingService) StoreFileEmbedding(userID string, fileID uint, embedding []float32) error {
+func (m *MockEmbeddingService) StoreFileEmbedding(userID string, fileID uint, embedding []float32, content string) error {
 	m.embeddings[fileID] = embedding
+	m.contents[fileID] = content
 	return nil
 }
 
@@ -224,11 +247,27 @@ func (m *MockEmbeddingService) GetFileEmbedding(userID string, fileID uint) ([]f
 	return nil, nil
 }
 
+func (m *MockEmbeddingService) GetUnchangedFileEmbedding(userID string, fileID uint, content string) ([]float32, error) {
+	if emb, ok := m.embeddings[fileID]; ok && content != "" && m.contents[fileID] == content {
+		return emb, nil
+	}
+	return nil, nil
+}
+
 func (m *MockEmbeddingService) DeleteFileEmbedding(userID string, fileID uint) error {
 	delete(m.embeddings, fileID)
+	delete(m.contents, fileID)
 	return nil
 }
 
+func (m *MockEmbeddingService) ActiveModel() (string, int) {
+	return "mock-embedding", 1536
+}
+
+func (m *MockEmbeddingService) IsConfigured() bool {
+	return true
+}
+
 // EmbeddingToString converts an embedding to a string representation for Turso vector operations
 func EmbeddingToString(embedding []float32) string {
 	parts := make([]string, len(embedding))
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUnchangedFileEmbedding(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	service := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+	file := createCompletedTestFile(t, db, "invoice")
+
+	embedding, err := service.GetUnchangedFileEmbedding(reembedTestUserID, file.ID, file.Content)
+	require.NoError(t, err)
+	assert.Nil(t, embedding, "no embedding stored yet")
+
+	require.NoError(t, service.StoreFileEmbedding(reembedTestUserID, file.ID, []float32{1, 0, 0}, file.Content))
+
+	embedding, err = service.GetUnchangedFileEmbedding(reembedTestUserID, file.ID, file.Content)
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1, 0, 0}, embedding)
+
+	embedding, err = service.GetUnchangedFileEmbedding(reembedTestUserID, file.ID, "edited content")
+	require.NoError(t, err)
+	assert.Nil(t, embedding, "content changed")
+
+	newModel := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "new-model"})
+	embedding, err = newModel.GetUnchangedFileEmbedding(reembedTestUserID, file.ID, file.Content)
+	require.NoError(t, err)
+	assert.Nil(t, embedding, "stored vector is from another model")
+}
+
+func TestDefaultEmbeddingDimensions(t *testing.T) {
+	assert.Equal(t, 1536, DefaultEmbeddingDimensions("openai/text-embedding-3-small"))
+	assert.Equal(t, 3072, DefaultEmbeddingDimensions("text-embedding-3-large"))
+	// Models of unknown size keep their native vectors
+	assert.Zero(t, DefaultEmbeddingDimensions("nomic-embed-text"))
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrFileEncrypted is reported when a file is password-protected and can't be parsed
+var ErrFileEncrypted = errors.New("file is password-protected")
+
+// encryptionSampleSize is how many bytes are read from each end of a file
+// when looking for encryption markers
+const encryptionSampleSize = 64 * 1024
+
+var (
+	pdfSignature = []byte("%PDF-")
+	zipSignature = []byte("PK\x03\x04")
+	// OLE compound file, used by legacy Office files and password-protected OOXML
+	cfbSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+	// "EncryptedPackage" as UTF-16LE, the stream holding an encrypted OOXML document
+	cfbEncryptedPackage = []byte("E\x00n\x00c\x00r\x00y\x00p\x00t\x00e\x00d\x00P\x00a\x00c\x00k\x00a\x00g\x00e\x00")
+)
+
+// IsEncryptedContent reports whether the start (head) and end (tail) of a file
+// carry a known encryption marker: a PDF encryption dictionary, an encrypted ZIP
+// entry, or a password-protected Office document
+func IsEncryptedContent(head, tail []byte) bool {
+	switch {
+	case bytes.HasPrefix(head, pdfSignature):
+		// The /Encrypt entry lives in the trailer, which is at the end of the
+		// file (or near the start for linearized PDFs)
+		return bytes.Contains(head, []byte("/Encrypt")) || bytes.Contains(tail, []byte("/Encrypt"))
+	case bytes.HasPrefix(head, zipSignature):
+		// Bit 0 of the general purpose flags marks the first entry as encrypted
+		return len(head) > 6 && head[6]&0x01 != 0
+	case bytes.HasPrefix(head, cfbSignature):
+		return bytes.Contains(head, cfbEncryptedPackage) || bytes.Contains(tail, cfbEncryptedPackage)
+	}
+	return false
+}
+
+// DetectEncryption downloads the start and end of the file and checks them for
+// encryption markers, so encrypted files can be rejected before parsing
+func (s *contentParserService) DetectEncryption(ctx context.Context, fileURL string) (bool, error) {
+	head, complete, err := s.fetchRange(ctx, fileURL, fmt.Sprintf("bytes=0-%d", encryptionSampleSize-1))
+	if err != nil {
+		return false, err
+	}
+
+	tail := head
+	if !complete {
+		tail, _, err = s.fetchRange(ctx, fileURL, fmt.Sprintf("bytes=-%d", encryptionSampleSize))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return IsEncryptedContent(head, tail), nil
+}
+
+// fetchRange reads a byte range of the file. complete is true when the returned
+// bytes are the whole file, e.g. because the server ignored the range.
+func (s *contentParserService) fetchRange(ctx context.Context, fileURL, byteRange string) (data []byte, complete bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Range", byteRange)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		data, err = io.ReadAll(io.LimitReader(resp.Body, encryptionSampleSize))
+		complete = resp.ContentLength >= 0 && resp.ContentLength < encryptionSampleSize
+	case http.StatusOK:
+		// Range not supported; only the start of the file is available
+		data, err = io.ReadAll(io.LimitReader(resp.Body, encryptionSampleSize))
+		complete = true
+	case http.StatusRequestedRangeNotSatisfiable:
+		// Empty file
+		return nil, true, nil
+	default:
+		return nil, false, fmt.Errorf("failed to download file (status %d)", resp.StatusCode)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, complete, nil
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEncryptedContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		head      []byte
+		tail      []byte
+		encrypted bool
+	}{
+		{name: "plain pdf", head: []byte("%PDF-1.7\n1 0 obj"), tail: []byte("trailer\n<< /Root 1 0 R >>\n%%EOF"), encrypted: false},
+		{name: "encrypted pdf", head: []byte("%PDF-1.7\n1 0 obj"), tail: []byte("trailer\n<< /Root 1 0 R /Encrypt 5 0 R >>\n%%EOF"), encrypted: true},
+		{name: "linearized encrypted pdf", head: []byte("%PDF-1.7\ntrailer << /Encrypt 9 0 R >>"), tail: nil, encrypted: true},
+		{name: "plain zip", head: []byte("PK\x03\x04\x14\x00\x00\x00"), encrypted: false},
+		{name: "encrypted zip", head: []byte("PK\x03\x04\x14\x00\x01\x00"), encrypted: true},
+		{name: "legacy office", head: append(append([]byte{}, cfbSignature...), []byte("W\x00o\x00r\x00d\x00")...), encrypted: false},
+		{name: "protected ooxml", head: append(append([]byte{}, cfbSignature...), cfbEncryptedPackage...), encrypted: true},
+		{name: "plain text mentioning Encrypt", head: []byte("notes about /Encrypt"), encrypted: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.encrypted, IsEncryptedContent(tt.head, tt.tail))
+		})
+	}
+}
+
+func TestDetectEncryption_ReadsTrailer(t *testing.T) {
+	// Large enough that the trailer is only in the tail range
+	content := []byte("%PDF-1.7\n")
+	content = append(content, bytes.Repeat([]byte("x"), 2*encryptionSampleSize)...)
+	content = append(content, []byte("trailer\n<< /Encrypt 5 0 R >>\n%%EOF")...)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "file.pdf", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{})
+	encrypted, err := service.DetectEncryption(context.Background(), server.URL)
+
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+}
+
+func TestDetectEncryption_SmallPlainFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "file.pdf", time.Time{}, bytes.NewReader([]byte("%PDF-1.7\ntrailer << /Root 1 0 R >>")))
+	}))
+	defer server.Close()
+
+	service := NewContentParserService(ContentParserConfig{})
+	encrypted, err := service.DetectEncryption(context.Background(), server.URL)
+
+	require.NoError(t, err)
+	assert.False(t, encrypted)
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// maxEntitiesPerType bounds how many entities of each type are stored
+const maxEntitiesPerType = 50
+
+// recordEntitiesTool is the function the model is made to call with the
+// entities it found
+const recordEntitiesTool = "record_entities"
+
+// entityArgs are the arguments of a record_entities call
+type entityArgs struct {
+	People        []string              `json:"people"`
+	Organizations []string              `json:"organizations"`
+	Dates         []string              `json:"dates"`
+	Amounts       []models.EntityAmount `json:"amounts"`
+}
+
+// entityDateLayouts are the date formats accepted from the model, which is
+// asked for YYYY-MM-DD but doesn't always comply
+var entityDateLayouts = []string{"2006-01-02", "2006/01/02", time.RFC3339, "January 2, 2006", "Jan 2, 2006", "2 January 2006"}
+
+func newRecordEntitiesTool() chatTool {
+	stringList := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":        "array",
+			"description": description,
+			"items":       map[string]interface{}{"type": "string"},
+		}
+	}
+
+	var tool chatTool
+	tool.Type = "function"
+	tool.Function.Name = recordEntitiesTool
+	tool.Function.Description = "Record the key entities mentioned in the document"
+	tool.Function.Parameters = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"people":        stringList("Full names of people"),
+			"organizations": stringList("Names of companies and other organizations"),
+			"dates":         stringList("Significant dates such as issue, due or signing dates, as YYYY-MM-DD"),
+			"amounts": map[string]interface{}{
+				"type":        "array",
+				"description": "Monetary amounts such as totals, fees and prices",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"value":    map[string]interface{}{"type": "number"},
+						"currency": map[string]interface{}{"type": "string", "description": "ISO 4217 code, e.g. USD"},
+					},
+					"required": []string{"value"},
+				},
+			},
+		},
+		"required": []string{"people", "organizations", "dates", "amounts"},
+	}
+	return tool
+}
+
+// ExtractEntities asks the model to call record_entities with the entities in
+// content
+func (s *summaryService) ExtractEntities(ctx context.Context, content string, model string) (*models.FileEntities, error) {
+	if !s.config.ExtractEntities || content == "" {
+		return nil, nil
+	}
+	if model == "" {
+		model = s.config.Model
+	}
+
+	// Truncate content if too long (to fit in context window)
+	if len(content) > 15000 {
+		content = content[:15000]
+	}
+
+	var toolChoice struct {
+		Type     string `json:"type"`
+		Function struct {
+			Name string `json:"name"`
+		} `json:"function"`
+	}
+	toolChoice.Type = "function"
+	toolChoice.Function.Name = recordEntitiesTool
+
+	start := time.Now()
+	chatResp, err := s.send(ctx, chatRequest{
+		Model: model,
+		Messages: []chatMessage{
+			{
+				Role: "user",
+				Content: fmt.Sprintf(`Extract the key entities from the following document content and record them.
+Only include entities that appear in the document. Use empty lists for types that don't appear.
+
+Document content:
+%s`, content),
+			},
+		},
+		Tools:      []chatTool{newRecordEntitiesTool()},
+		ToolChoice: toolChoice,
+	})
+	metrics.ObserveAIRequest(metrics.AIServiceSummary, start, err)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, call := range chatResp.Choices[0].Message.ToolCalls {
+		if call.Function.Name != recordEntitiesTool {
+			continue
+		}
+		var args entityArgs
+		if err := json.Unmarshal([]byte(call.Function.Arguments), &args); err != nil {
+			return nil, fmt.Errorf("failed to parse entities: %w", err)
+		}
+		return normalizeEntities(args), nil
+	}
+	return nil, fmt.Errorf("model did not record any entities")
+}
+
+// normalizeEntities trims and de-duplicates the extracted entities, converts
+// dates to YYYY-MM-DD, dropping ones that can't be parsed, and caps each list
+func normalizeEntities(args entityArgs) *models.FileEntities {
+	entities := &models.FileEntities{
+		People:        uniqueStrings(args.People),
+		Organizations: uniqueStrings(args.Organizations),
+		Dates:         []string{},
+		Amounts:       []models.EntityAmount{},
+	}
+
+	var dates []string
+	for _, date := range args.Dates {
+		if parsed, ok := parseEntityDate(date); ok {
+			dates = append(dates, parsed)
+		}
+	}
+	entities.Dates = uniqueStrings(dates)
+
+	seen := make(map[models.EntityAmount]bool)
+	for _, amount := range args.Amounts {
+		amount.Currency = strings.ToUpper(strings.TrimSpace(amount.Currency))
+		if amount.Value == 0 || seen[amount] || len(entities.Amounts) == maxEntitiesPerType {
+			continue
+		}
+		seen[amount] = true
+		entities.Amounts = append(entities.Amounts, amount)
+	}
+	return entities
+}
+
+// uniqueStrings trims values and drops blanks and case-insensitive
+// duplicates, keeping the first spelling, up to maxEntitiesPerType values
+func uniqueStrings(values []string) []string {
+	result := []string{}
+	seen := make(map[string]bool)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		key := strings.ToLower(value)
+		if value == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, value)
+		if len(result) == maxEntitiesPerType {
+			break
+		}
+	}
+	return result
+}
+
+func parseEntityDate(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range entityDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed.Format("2006-01-02"), true
+		}
+	}
+	return "", false
+}
+
+// entityMatchCondition matches files with an extracted entity under the JSON
+// path given as the first argument whose text matches the LIKE pattern given
+// as the second
+const entityMatchCondition = "EXISTS (SELECT 1 FROM json_tree(files.entities, ?) AS entity WHERE entity.type = 'text' AND entity.value LIKE ?)"
+
+// applyEntityFilters narrows a files query by its extracted entities, which
+// are read with SQLite's JSON functions. Filters must have been validated.
+func applyEntityFilters(query *gorm.DB, opts FileListOptions) *gorm.DB {
+	if opts.Entity != "" {
+		root := "$"
+		if opts.EntityType != "" {
+			root = "$." + opts.EntityType
+		}
+		query = query.Where(entityMatchCondition, root, "%"+opts.Entity+"%")
+	}
+	if opts.EntityDateFrom != "" || opts.EntityDateTo != "" {
+		from, to := opts.EntityDateFrom, opts.EntityDateTo
+		if to == "" {
+			to = "9999-12-31"
+		}
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM json_each(files.entities, '$.dates') AS entity_date WHERE entity_date.value >= ? AND entity_date.value <= ?)",
+			from, to,
+		)
+	}
+	return query
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"log"
+)
+
+// FilePlacement keeps stored objects under the key prefix of the folder their
+// file lives in
+type FilePlacement struct {
+	fileService   FileService
+	folderService FolderService
+	uploadService UploadService
+}
+
+// NewFilePlacement creates a new FilePlacement. uploadService may be nil when
+// no storage is configured, in which case placement does nothing.
+func NewFilePlacement(fileService FileService, folderService FolderService, uploadService UploadService) *FilePlacement {
+	return &FilePlacement{
+		fileService:   fileService,
+		folderService: folderService,
+		uploadService: uploadService,
+	}
+}
+
+// PlaceFiles copies the objects of files that were created in or moved to a
+// folder with a key prefix under that prefix, then removes the old objects.
+// It is best effort: the files stay usable under their old keys when a copy
+// fails.
+func (p *FilePlacement) PlaceFiles(ctx context.Context, ownerID string, fileIDs []uint, folderID *uint) {
+	if p == nil || p.uploadService == nil || folderID == nil || len(fileIDs) == 0 {
+		return
+	}
+
+	folder, err := p.folderService.GetFolderByID(ownerID, *folderID)
+	if err != nil || folder == nil || folder.S3Prefix == "" {
+		return
+	}
+
+	for _, fileID := range fileIDs {
+		file, err := p.fileService.GetFileByID(ownerID, fileID)
+		if err != nil || file == nil {
+			continue
+		}
+		newKey, ok := PrefixedObjectKey(file.S3Key, ownerID, folder.S3Prefix)
+		if !ok {
+			continue
+		}
+
+		if err := p.uploadService.CopyFile(ctx, file.S3Key, newKey); err != nil {
+			log.Printf("[S3Prefix] File %d: failed to copy %s to %s: %v", fileID, file.S3Key, newKey, err)
+			continue
+		}
+		if err := p.fileService.UpdateFileS3Key(ownerID, fileID, newKey); err != nil {
+			log.Printf("[S3Prefix] File %d: failed to store key %s: %v", fileID, newKey, err)
+			_ = p.uploadService.DeleteFile(ctx, newKey)
+			continue
+		}
+		if referenced, err := p.fileService.IsS3KeyReferenced(file.S3Key); err == nil && !referenced {
+			_ = p.uploadService.DeleteFile(ctx, file.S3Key)
+		}
+	}
+}
+
+// MoveFiles moves files to a folder and places their objects under the
+// folder's key prefix. Every move should go through here rather than
+// FileService.MoveFiles so stored objects follow their files.
+func (p *FilePlacement) MoveFiles(ctx context.Context, ownerID string, fileIDs []uint, targetFolderID *uint) (*MoveResult, error) {
+	result, err := p.fileService.MoveFiles(ownerID, fileIDs, targetFolderID)
+	if err != nil {
+		return nil, err
+	}
+	p.PlaceFiles(ctx, ownerID, result.Moved, targetFolderID)
+	return result, nil
+}
+
+// AddTagsToFile tags a file and, when a folding rule moved it, places its
+// object under the new folder's key prefix
+func (p *FilePlacement) AddTagsToFile(ctx context.Context, ownerID string, fileID uint, tagIDs []uint) (*TagAdditionResult, error) {
+	result, err := p.fileService.AddTagsToFile(ownerID, fileID, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	p.PlaceFiles(ctx, ownerID, []uint{fileID}, result.MovedToFolder)
+	return result, nil
+}
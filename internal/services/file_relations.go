@@ -0,0 +1,96 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrFileRelationExists is returned when the two files are already related
+	ErrFileRelationExists = errors.New("the files are already related")
+	// ErrFileRelationSelf is returned when relating a file to itself
+	ErrFileRelationSelf = errors.New("a file can't be related to itself")
+	// ErrFileRelationFileNotFound is returned when the related file doesn't
+	// exist or belongs to another user
+	ErrFileRelationFileNotFound = errors.New("related file not found")
+)
+
+// ListFileRelations returns the file's relations with their related files
+// (without content), oldest first
+func (s *fileService) ListFileRelations(userID string, fileID uint) ([]models.FileRelation, error) {
+	relations := []models.FileRelation{}
+	err := s.db.Preload("RelatedFile", func(db *gorm.DB) *gorm.DB {
+		return db.Omit("content")
+	}).
+		Where("file_id = ? AND user_id = ?", fileID, userID).
+		// Files in a deleted folder keep their relations until it is purged
+		Where("related_file_id IN (?)", s.db.Model(&models.File{}).Select("id")).
+		Order("id ASC").
+		Find(&relations).Error
+	return relations, err
+}
+
+// AddFileRelation relates the file to another of the user's files. The file
+// itself must have been checked by the caller.
+func (s *fileService) AddFileRelation(userID string, fileID, relatedFileID uint, relationType string) (*models.FileRelation, error) {
+	if fileID == relatedFileID {
+		return nil, ErrFileRelationSelf
+	}
+	if relationType == "" {
+		relationType = models.FileRelationAttachment
+	}
+
+	var count int64
+	if err := s.db.Model(&models.File{}).Where("id = ? AND user_id = ?", relatedFileID, userID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, ErrFileRelationFileNotFound
+	}
+
+	if err := s.db.Model(&models.FileRelation{}).
+		Where("file_id = ? AND related_file_id = ?", fileID, relatedFileID).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrFileRelationExists
+	}
+
+	relation := &models.FileRelation{
+		FileID:        fileID,
+		RelatedFileID: relatedFileID,
+		RelationType:  relationType,
+		UserID:        userID,
+	}
+	if err := s.db.Create(relation).Error; err != nil {
+		return nil, err
+	}
+	return relation, nil
+}
+
+// RemoveFileRelation removes a relation and reports whether it existed. Both
+// files are kept.
+func (s *fileService) RemoveFileRelation(userID string, fileID, relatedFileID uint) (bool, error) {
+	result := s.db.Where("file_id = ? AND related_file_id = ? AND user_id = ?", fileID, relatedFileID, userID).
+		Delete(&models.FileRelation{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// HasFileRelations reports whether the file is related to any other file
+func (s *fileService) HasFileRelations(fileID uint) (bool, error) {
+	var count int64
+	if err := s.db.Model(&models.FileRelation{}).
+		Where("file_id = ? OR related_file_id = ?", fileID, fileID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// deleteFileRelations removes every relation from or to the files
+func deleteFileRelations(tx *gorm.DB, fileIDs []uint) error {
+	return tx.Where("file_id IN ? OR related_file_id IN ?", fileIDs, fileIDs).Delete(&models.FileRelation{}).Error
+}
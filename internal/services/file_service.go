@@ -2,23 +2,197 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
 )
 
+// fileStreamBatchSize is the number of files StreamFiles loads per query
+const fileStreamBatchSize = 100
+
+// fileChangedAtColumn is when a file last changed: its deletion time for
+// soft-deleted files, its update time otherwise
+const fileChangedAtColumn = "COALESCE(files.deleted_at, files.updated_at)"
+
+// FileSortFields are the fields files can be sorted by
+var FileSortFields = []string{"created_at", "updated_at", "title", "size", "word_count", "char_count"}
+
+// SortOrders are the accepted sort directions
+var SortOrders = []string{"asc", "desc"}
+
+// ErrDuplicateS3Key is returned when the user already has a file referencing the same S3 key
+var ErrDuplicateS3Key = errors.New("a file with this s3_key already exists")
+
+// ErrInvalidExtensions is returned by ParseFileExtensions for a token that
+// isn't a plain file extension
+var ErrInvalidExtensions = errors.New("ext must be a comma-separated list of file extensions such as docx,xlsx")
+
+// ParseFileExtensions parses a comma-separated ext filter into lowercase
+// extensions without the leading dot. Blank entries are skipped; anything
+// other than letters and digits is an error, which also keeps LIKE wildcards
+// out of the filter.
+func ParseFileExtensions(value string) ([]string, error) {
+	var exts []string
+	for _, token := range strings.Split(value, ",") {
+		ext := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(token), "."))
+		if ext == "" {
+			continue
+		}
+		if strings.IndexFunc(ext, func(r rune) bool { return (r < 'a' || r > 'z') && (r < '0' || r > '9') }) >= 0 {
+			return nil, fmt.Errorf("%w: invalid extension %q", ErrInvalidExtensions, strings.TrimSpace(token))
+		}
+		if !slices.Contains(exts, ext) {
+			exts = append(exts, ext)
+		}
+	}
+	return exts, nil
+}
+
 // FileListOptions contains options for listing files
 type FileListOptions struct {
-	Keyword    string
-	FolderID   *uint
-	AllFolders bool // When true, search across all folders (ignores FolderID)
-	TagIDs     []uint
-	FileTypes  []models.FileType
-	Status     *models.FileProcessingStatus
-	SortBy     string // "created_at", "title", "size", "updated_at"
-	SortOrder  string // "asc", "desc"
-	Limit      int
-	Offset     int
+	Keyword           string
+	IncludeFolderName bool // When true, Keyword also matches the name of the file's folder
+	FolderID          *uint
+	AllFolders        bool // When true, search across all folders (ignores FolderID)
+	IncludeLinked     bool // When true with FolderID, also include files linked into the folder
+	TagIDs            []uint
+	FileTypes         []models.FileType
+	Extensions        []string // Lowercase extensions without the dot, matched against OriginalFilename
+	Status            *models.FileProcessingStatus
+	ErrorContains     string     // Substring of the processing error
+	MinWordCount      *int       // Only files with at least this many words
+	MaxWordCount      *int       // Only files with at most this many words
+	MinSize           *int64     // Only files of at least this many bytes
+	MaxSize           *int64     // Only files of at most this many bytes
+	CreatedAfter      *time.Time // Only files created at or after this time
+	CreatedBefore     *time.Time // Only files created at or before this time
+	UpdatedAfter      *time.Time // Only files updated at or after this time
+	UpdatedBefore     *time.Time // Only files updated at or before this time
+	Entity            string     // Substring of an extracted entity, matched case-insensitively
+	EntityType        string     // One of models.EntityTypes; restricts Entity to that type
+	EntityDateFrom    string     // Only files with an extracted date on or after this YYYY-MM-DD date
+	EntityDateTo      string     // Only files with an extracted date on or before this YYYY-MM-DD date
+	SortBy            string     // One of FileSortFields, defaults to "created_at"
+	SortOrder         string     // One of SortOrders, defaults to "desc"
+	Limit             int
+	Offset            int
+}
+
+// OptionError reports an invalid list option along with the request field it
+// came from
+type OptionError struct {
+	Field   string
+	Message string
+}
+
+func (e *OptionError) Error() string {
+	return e.Message
+}
+
+// ValidateSort reports an error naming the valid options for each of SortBy
+// and SortOrder that is set to a value files can't be sorted by. The errors
+// are *OptionError values, joined when both are invalid.
+func (opts FileListOptions) ValidateSort() error {
+	var errs []error
+	if opts.SortBy != "" && !slices.Contains(FileSortFields, opts.SortBy) {
+		errs = append(errs, &OptionError{Field: "sort_by", Message: fmt.Sprintf("sort_by must be one of %s", strings.Join(FileSortFields, ", "))})
+	}
+	if opts.SortOrder != "" && !slices.Contains(SortOrders, opts.SortOrder) {
+		errs = append(errs, &OptionError{Field: "sort_order", Message: fmt.Sprintf("sort_order must be one of %s", strings.Join(SortOrders, ", "))})
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateSizeRange reports an error for each negative size bound, checking
+// MinSize before MaxSize, and for a MinSize above MaxSize. The errors are
+// *OptionError values, joined when there are several.
+func (opts FileListOptions) ValidateSizeRange() error {
+	var errs []error
+	if opts.MinSize != nil && *opts.MinSize < 0 {
+		errs = append(errs, &OptionError{Field: "min_size", Message: "min_size must not be negative"})
+	}
+	if opts.MaxSize != nil && *opts.MaxSize < 0 {
+		errs = append(errs, &OptionError{Field: "max_size", Message: "max_size must not be negative"})
+	} else if opts.MinSize != nil && opts.MaxSize != nil && *opts.MinSize > *opts.MaxSize {
+		errs = append(errs, &OptionError{Field: "max_size", Message: "max_size must not be less than min_size"})
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateDateRange reports an error for a CreatedBefore earlier than
+// CreatedAfter and for an UpdatedBefore earlier than UpdatedAfter. The errors
+// are *OptionError values, joined when there are several.
+func (opts FileListOptions) ValidateDateRange() error {
+	var errs []error
+	if opts.CreatedAfter != nil && opts.CreatedBefore != nil && opts.CreatedBefore.Before(*opts.CreatedAfter) {
+		errs = append(errs, &OptionError{Field: "created_before", Message: "created_before must not be earlier than created_after"})
+	}
+	if opts.UpdatedAfter != nil && opts.UpdatedBefore != nil && opts.UpdatedBefore.Before(*opts.UpdatedAfter) {
+		errs = append(errs, &OptionError{Field: "updated_before", Message: "updated_before must not be earlier than updated_after"})
+	}
+	return errors.Join(errs...)
+}
+
+// RecursiveFileOptions narrows the files GetFilesInFolderRecursive returns
+type RecursiveFileOptions struct {
+	ExcludeFolderIDs []uint // Subfolders whose subtrees are skipped
+	ExcludeDirect    bool   // When true, skip files directly in the folder itself
+}
+
+// ValidateEntityFilters reports an error for an unknown entity type and for
+// each date bound that isn't YYYY-MM-DD. The errors are *OptionError values,
+// joined when there are several.
+func (opts FileListOptions) ValidateEntityFilters() error {
+	var errs []error
+	if opts.EntityType != "" && !slices.Contains(models.EntityTypes, opts.EntityType) {
+		errs = append(errs, &OptionError{Field: "entity_type", Message: fmt.Sprintf("entity_type must be one of %s", strings.Join(models.EntityTypes, ", "))})
+	}
+	for _, bound := range []struct{ field, value string }{
+		{"entity_date_from", opts.EntityDateFrom},
+		{"entity_date_to", opts.EntityDateTo},
+	} {
+		if _, err := time.Parse("2006-01-02", bound.value); bound.value != "" && err != nil {
+			errs = append(errs, &OptionError{Field: bound.field, Message: bound.field + " must be a date in YYYY-MM-DD format"})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MoveResult reports the outcome of moving files
+type MoveResult struct {
+	Moved     []uint // File IDs moved to the target folder
+	Unchanged []uint // File IDs already in the target folder
+}
+
+// MaxBatchDeleteFiles is the most files one DeleteFiles call should delete
+const MaxBatchDeleteFiles = 500
+
+// MaxProcessingHintLength is the most characters a file's processing hint,
+// which is added to the agent prompt, may have
+const MaxProcessingHintLength = 2000
+
+// DeleteFilesResult reports the outcome of deleting files in bulk
+type DeleteFilesResult struct {
+	Deleted  []models.File // Files removed, for cleaning up their S3 objects
+	NotFound []uint        // Requested IDs that aren't the user's files
+}
+
+// TagAdditionResult reports the outcome of adding tags to a file
+type TagAdditionResult struct {
+	Added          []uint // Tag IDs newly applied to the file
+	AlreadyPresent []uint // Tag IDs the file already had
+	MovedToFolder  *uint  // Folder a folding rule moved the file to, if any
+}
+
+// ProcessingErrorGroup counts failed files sharing a normalized error message
+type ProcessingErrorGroup struct {
+	Message string // Normalized message; usable as FileListOptions.ErrorContains
+	Count   int64
 }
 
 // FileService handles file-related operations
@@ -27,41 +201,145 @@ type FileService interface {
 	CreateFile(userID string, file *models.File) error
 	GetFileByID(userID string, id uint) (*models.File, error)
 	GetFileByS3Key(userID string, s3Key string) (*models.File, error)
+	// ResolvePublicID returns the ID of the file with the public ID, or 0.
+	// Callers check access to the file separately.
+	ResolvePublicID(publicID string) (uint, error)
+	GetFileAssociations(userID string, id uint) (*models.File, error)
+	// IsS3KeyReferenced reports whether any file still references the S3 object
+	IsS3KeyReferenced(s3Key string) (bool, error)
 	ListFiles(userID string, opts FileListOptions) ([]models.File, int64, error)
+	ListFileIDs(userID string, opts FileListOptions) ([]uint, int64, error)
+	StreamFiles(userID string, opts FileListOptions, fn func(file *models.File) error) error
+	ListChangedSince(userID string, since time.Time, afterID uint, limit int) ([]models.File, error)
 	UpdateFile(userID string, file *models.File) error
 	DeleteFile(userID string, id uint) error
+	// CopyFile creates a copy of the file in targetFolderID whose object is
+	// stored under s3Key
+	CopyFile(userID string, fileID uint, targetFolderID *uint, s3Key string) (*models.File, error)
+	// DeleteFiles deletes the user's files among fileIDs in one transaction
+	DeleteFiles(userID string, fileIDs []uint) (*DeleteFilesResult, error)
 
 	// Move operations
-	MoveFiles(userID string, fileIDs []uint, targetFolderID *uint) error
+	MoveFiles(userID string, fileIDs []uint, targetFolderID *uint) (*MoveResult, error)
+	MoveFilesByFilter(userID string, opts FileListOptions, targetFolderID *uint) (*MoveResult, error)
+	// ReclassifyFiles re-derives the type of matching files from their stored
+	// content without parsing them again
+	ReclassifyFiles(userID string, opts FileListOptions) (*ReclassifyResult, error)
 
 	// Tag operations
-	AddTagsToFile(userID string, fileID uint, tagIDs []uint) error
+	AddTagsToFile(userID string, fileID uint, tagIDs []uint) (*TagAdditionResult, error)
 	RemoveTagsFromFile(userID string, fileID uint, tagIDs []uint) error
 
+	// Relation operations
+	ListFileRelations(userID string, fileID uint) ([]models.FileRelation, error)
+	AddFileRelation(userID string, fileID, relatedFileID uint, relationType string) (*models.FileRelation, error)
+	RemoveFileRelation(userID string, fileID, relatedFileID uint) (bool, error)
+	// HasFileRelations reports whether the file is related to any other file,
+	// in either direction
+	HasFileRelations(fileID uint) (bool, error)
+
 	// Content operations
-	UpdateFileContent(userID string, fileID uint, content, summary string, fileType models.FileType) error
+	UpdateFileContent(userID string, fileID uint, content, summary string, summaryIsFallback bool, fileType models.FileType) error
 	UpdateFileProcessingStatus(userID string, fileID uint, status models.FileProcessingStatus, errMsg string) error
+	FailFileProcessing(userID string, fileID uint, errCode, errMsg string) error
+	// FlagFileForReview ends processing with the needs_review status, for
+	// parses that succeeded but look unusable
+	FlagFileForReview(userID string, fileID uint, errCode, errMsg string) error
+	SummarizeProcessingErrors(userID string) ([]ProcessingErrorGroup, error)
+	// TransitionStatus moves the user's files that are currently in from to to
+	// and returns how many changed
+	TransitionStatus(userID string, ids []uint, from, to models.FileProcessingStatus) (int, error)
+	// CancelProcessing fails the user's files that are currently processing
+	// as canceled and returns how many changed
+	CancelProcessing(userID string, ids []uint) (int, error)
+	ResetStaleProcessingFiles(startedBefore time.Time) (int64, error)
 	SetFileHasEmbedding(userID string, fileID uint, hasEmbedding bool) error
+	UpdateFileS3Key(userID string, fileID uint, s3Key string) error
+	// ApplyDetectedMimeType stores the MIME type sniffed from the file's
+	// content and corrects its MIME type and file type when the declared ones
+	// were wrong. file is updated in place.
+	ApplyDetectedMimeType(userID string, file *models.File, detected string) error
 	UpdateFileInvoiceID(userID string, fileID uint, invoiceID int64) error
-	UnlinkFileInvoiceByInvoiceID(userID string, invoiceID int64) error
+	// UpdateFileEntities stores the entities extracted from the file's content
+	UpdateFileEntities(userID string, fileID uint, entities *models.FileEntities) error
+	// UnlinkFileInvoiceByInvoiceID clears the invoice from the file linked to
+	// it, and with removeRelations also removes that file's relations
+	UnlinkFileInvoiceByInvoiceID(userID string, invoiceID int64, removeRelations bool) error
 
 	// Folder operations
-	GetFilesInFolderRecursive(userID string, folderID uint) ([]models.File, error)
+	GetFilesInFolderRecursive(userID string, folderID uint, opts RecursiveFileOptions) ([]models.File, error)
+	// ListFilesByFolder returns the newest perFolder files directly in each
+	// folder and how many it holds. Folder ID 0 stands for the root.
+	ListFilesByFolder(userID string, folderIDs []uint, perFolder int) (map[uint]FolderFiles, error)
+}
+
+// Bounds of a grouped file listing: how many folder levels below its root it
+// descends and how many files it embeds per folder
+const (
+	DefaultGroupedDepth          = 3
+	MaxGroupedDepth              = 10
+	DefaultGroupedFilesPerFolder = 50
+	MaxGroupedFilesPerFolder     = 200
+)
+
+// FolderFiles is the start of a folder's direct files, newest first
+type FolderFiles struct {
+	Files []models.File
+	Total int64 // All files directly in the folder
+}
+
+// FileConfig holds file service configuration
+type FileConfig struct {
+	MaxFilesPerFolder int // Most files a folder can hold directly (FOLDER_MAX_FILES, 0 = unlimited); the root is unlimited
 }
 
+// ErrFolderFull is returned when creating or moving files would put more
+// files in a folder than the configured maximum
+var ErrFolderFull = errors.New("folder file limit reached")
+
+// ErrCopyFolderNotFound is returned when copying a file into a folder that
+// doesn't exist or belongs to another user
+var ErrCopyFolderNotFound = errors.New("folder not found")
+
 type fileService struct {
-	db *gorm.DB
+	db     *gorm.DB
+	config FileConfig
 }
 
 // NewFileService creates a new FileService
-func NewFileService(db *gorm.DB) FileService {
-	return &fileService{db: db}
+func NewFileService(db *gorm.DB, config FileConfig) FileService {
+	return &fileService{db: db, config: config}
+}
+
+// checkFolderCapacity returns ErrFolderFull when the folder holds more files
+// than the configured maximum. Callers add their files first and check in the
+// same transaction, so the failed check rolls the addition back: SQLite lets
+// one transaction write at a time, so the count can't miss a concurrent one.
+func (s *fileService) checkFolderCapacity(tx *gorm.DB, userID string, folderID *uint) error {
+	if s.config.MaxFilesPerFolder <= 0 || folderID == nil {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&models.File{}).Where("folder_id = ? AND user_id = ?", *folderID, userID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > int64(s.config.MaxFilesPerFolder) {
+		return fmt.Errorf("%w: folders can hold at most %d files; move files into subfolders", ErrFolderFull, s.config.MaxFilesPerFolder)
+	}
+	return nil
 }
 
 // CreateFile creates a new file record
 func (s *fileService) CreateFile(userID string, file *models.File) error {
+	defer markFilesChanged()
+
 	file.UserID = userID
 
+	if file.DeclaredMimeType == "" {
+		file.DeclaredMimeType = file.MimeType
+	}
+
 	// Set initial file type from MIME type if not already set
 	if file.FileType == "" {
 		file.FileType = models.DetectFileTypeFromMimeType(file.MimeType)
@@ -78,7 +356,32 @@ func (s *fileService) CreateFile(userID string, file *models.File) error {
 		}
 	}
 
-	return s.db.Create(file).Error
+	// Two records sharing one object would lose data when either is deleted
+	var count int64
+	if err := s.db.Model(&models.File{}).Where("s3_key = ? AND user_id = ?", file.S3Key, userID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrDuplicateS3Key
+	}
+
+	// The object was just uploaded to the user's current bucket; keep reading
+	// it from there even if the user later moves to another one
+	configID, err := CurrentStorageConfigID(s.db, userID)
+	if err != nil {
+		return err
+	}
+	file.StorageConfigID = configID
+
+	// Imported files arrive with their content already extracted
+	file.WordCount, file.CharCount = contentCounts(file.Content)
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(file).Error; err != nil {
+			return err
+		}
+		return s.checkFolderCapacity(tx, userID, file.FolderID)
+	})
 }
 
 // GetFileByID retrieves a file by ID with folder and tags
@@ -96,6 +399,23 @@ func (s *fileService) GetFileByID(userID string, id uint) (*models.File, error)
 	return &file, nil
 }
 
+// GetFileAssociations retrieves a file's tags and folder without loading
+// content or summary
+func (s *fileService) GetFileAssociations(userID string, id uint) (*models.File, error) {
+	var file models.File
+	err := s.db.Select("id", "user_id", "folder_id").
+		Preload("Tags").Preload("Folder").
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&file).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &file, nil
+}
+
 // GetFileByS3Key retrieves a file by its S3 key
 func (s *fileService) GetFileByS3Key(userID string, s3Key string) (*models.File, error) {
 	var file models.File
@@ -111,16 +431,147 @@ func (s *fileService) GetFileByS3Key(userID string, s3Key string) (*models.File,
 	return &file, nil
 }
 
+// IsS3KeyReferenced reports whether any non-deleted file references the S3 key
+func (s *fileService) IsS3KeyReferenced(s3Key string) (bool, error) {
+	var count int64
+	if err := s.db.Model(&models.File{}).Where("s3_key = ?", s3Key).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (s *fileService) ResolvePublicID(publicID string) (uint, error) {
+	var file models.File
+	if err := s.db.Select("id").Where("public_id = ?", publicID).Limit(1).Find(&file).Error; err != nil {
+		return 0, err
+	}
+	return file.ID, nil
+}
+
 // ListFiles lists files with filtering options
 func (s *fileService) ListFiles(userID string, opts FileListOptions) ([]models.File, int64, error) {
 	var files []models.File
 	var total int64
 
+	// Count total before pagination
+	if err := s.filteredFilesQuery(userID, opts).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := s.pagedFilesQuery(userID, opts)
+	if err := query.Preload("Tags").Preload("Folder").Find(&files).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return files, total, nil
+}
+
+// ListFileIDs returns the IDs of the files ListFiles would return, selecting
+// only the ID column and skipping tag and folder preloading
+func (s *fileService) ListFileIDs(userID string, opts FileListOptions) ([]uint, int64, error) {
+	var total int64
+	if err := s.filteredFilesQuery(userID, opts).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := []uint{}
+	if err := s.pagedFilesQuery(userID, opts).Pluck("files.id", &ids).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
+
+// pagedFilesQuery applies sorting and pagination to the filtered files query
+func (s *fileService) pagedFilesQuery(userID string, opts FileListOptions) *gorm.DB {
+	query := s.filteredFilesQuery(userID, opts)
+
+	// Sorting
+	sortBy := "created_at"
+	if slices.Contains(FileSortFields, opts.SortBy) {
+		sortBy = opts.SortBy
+	}
+	sortOrder := "DESC"
+	if opts.SortOrder == "asc" {
+		sortOrder = "ASC"
+	}
+
+	// Apply pagination
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		query = query.Offset(opts.Offset)
+	}
+
+	return query.Order(sortBy + " " + sortOrder)
+}
+
+// StreamFiles calls fn for every file matching the filter options, in ID
+// order. Files are loaded in fixed-size batches using the last seen ID as a
+// cursor, so memory use does not grow with the number of files. Sorting and
+// pagination options are ignored.
+func (s *fileService) StreamFiles(userID string, opts FileListOptions, fn func(file *models.File) error) error {
+	var lastID uint
+	for {
+		var batch []models.File
+		err := s.filteredFilesQuery(userID, opts).
+			Where("files.id > ?", lastID).
+			Preload("Tags").Preload("Folder").
+			Order("files.id ASC").
+			Limit(fileStreamBatchSize).
+			Find(&batch).Error
+		if err != nil {
+			return err
+		}
+
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < fileStreamBatchSize {
+			return nil
+		}
+		lastID = batch[len(batch)-1].ID
+	}
+}
+
+// ListChangedSince returns up to limit of the user's files created, updated or
+// deleted after since, oldest change first. Soft-deleted files are included
+// with DeletedAt set. When afterID is set, files that changed at exactly since
+// are included if their ID is greater, so the change time and ID of the last
+// file returned continue the listing.
+func (s *fileService) ListChangedSince(userID string, since time.Time, afterID uint, limit int) ([]models.File, error) {
+	// Timestamps are stored in local time
+	since = since.Local()
+
+	query := s.db.Unscoped().Model(&models.File{}).Where("user_id = ?", userID)
+	if afterID > 0 {
+		query = query.Where(fileChangedAtColumn+" > ? OR ("+fileChangedAtColumn+" = ? AND files.id > ?)", since, since, afterID)
+	} else {
+		query = query.Where(fileChangedAtColumn+" > ?", since)
+	}
+
+	files := []models.File{}
+	err := query.Preload("Tags").
+		Order(fileChangedAtColumn + " ASC").Order("files.id ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// filteredFilesQuery builds the base files query for the given filter options
+func (s *fileService) filteredFilesQuery(userID string, opts FileListOptions) *gorm.DB {
 	query := s.db.Model(&models.File{}).Where("user_id = ?", userID)
 
 	// Filter by folder (skip if AllFolders is true)
 	if !opts.AllFolders {
-		if opts.FolderID != nil {
+		if opts.FolderID != nil && opts.IncludeLinked {
+			linked := s.db.Model(&models.FileLink{}).Select("file_id").Where("folder_id = ?", *opts.FolderID)
+			query = query.Where("(folder_id = ? OR files.id IN (?))", *opts.FolderID, linked)
+		} else if opts.FolderID != nil {
 			query = query.Where("folder_id = ?", *opts.FolderID)
 		} else {
 			query = query.Where("folder_id IS NULL")
@@ -130,7 +581,11 @@ func (s *fileService) ListFiles(userID string, opts FileListOptions) ([]models.F
 	// Keyword search
 	if opts.Keyword != "" {
 		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("title LIKE ? OR summary LIKE ? OR content LIKE ?", searchPattern, searchPattern, searchPattern)
+		match := s.db.Where("title LIKE ? OR summary LIKE ? OR content LIKE ?", searchPattern, searchPattern, searchPattern)
+		if opts.IncludeFolderName {
+			match = match.Or("folder_id IN (?)", matchingFolderIDs(s.db, userID, searchPattern))
+		}
+		query = query.Where(match)
 	}
 
 	// Filter by file types
@@ -138,78 +593,67 @@ func (s *fileService) ListFiles(userID string, opts FileListOptions) ([]models.F
 		query = query.Where("file_type IN ?", opts.FileTypes)
 	}
 
+	// Filter by the extension of the original filename
+	if len(opts.Extensions) > 0 {
+		match := s.db
+		for _, ext := range opts.Extensions {
+			match = match.Or("LOWER(original_filename) LIKE ?", "%."+ext)
+		}
+		query = query.Where(match)
+	}
+
 	// Filter by processing status
 	if opts.Status != nil {
 		query = query.Where("processing_status = ?", *opts.Status)
 	}
 
-	// Filter by tags
-	if len(opts.TagIDs) > 0 {
-		query = query.Joins("JOIN file_tags ON file_tags.file_id = files.id").
-			Where("file_tags.tag_id IN ?", opts.TagIDs).
-			Group("files.id")
+	// Filter by processing error
+	if opts.ErrorContains != "" {
+		query = query.Where(`processing_error LIKE ? ESCAPE '\'`, likeContainsPattern(opts.ErrorContains))
 	}
-
-	// Count total before pagination
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Build new query for results with preloading
-	query = s.db.Model(&models.File{}).Where("user_id = ?", userID)
-	if !opts.AllFolders {
-		if opts.FolderID != nil {
-			query = query.Where("folder_id = ?", *opts.FolderID)
-		} else {
-			query = query.Where("folder_id IS NULL")
-		}
+	if opts.MinWordCount != nil {
+		query = query.Where("word_count >= ?", *opts.MinWordCount)
 	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("title LIKE ? OR summary LIKE ? OR content LIKE ?", searchPattern, searchPattern, searchPattern)
+	if opts.MaxWordCount != nil {
+		query = query.Where("word_count <= ?", *opts.MaxWordCount)
 	}
-	if len(opts.FileTypes) > 0 {
-		query = query.Where("file_type IN ?", opts.FileTypes)
+	if opts.MinSize != nil {
+		query = query.Where("size >= ?", *opts.MinSize)
 	}
-	if opts.Status != nil {
-		query = query.Where("processing_status = ?", *opts.Status)
+	if opts.MaxSize != nil {
+		query = query.Where("size <= ?", *opts.MaxSize)
 	}
+	query = applyTimeRange(query, "files.created_at", opts.CreatedAfter, opts.CreatedBefore)
+	query = applyTimeRange(query, "files.updated_at", opts.UpdatedAfter, opts.UpdatedBefore)
+	query = applyEntityFilters(query, opts)
+
+	// Filter by tags
 	if len(opts.TagIDs) > 0 {
 		query = query.Joins("JOIN file_tags ON file_tags.file_id = files.id").
 			Where("file_tags.tag_id IN ?", opts.TagIDs).
 			Group("files.id")
 	}
 
-	// Sorting
-	sortBy := "created_at"
-	if opts.SortBy != "" {
-		switch opts.SortBy {
-		case "title", "size", "created_at", "updated_at":
-			sortBy = opts.SortBy
-		}
-	}
-	sortOrder := "DESC"
-	if opts.SortOrder == "asc" {
-		sortOrder = "ASC"
-	}
+	return query
+}
 
-	// Apply pagination
-	if opts.Limit > 0 {
-		query = query.Limit(opts.Limit)
+// applyTimeRange bounds column to [after, before], either end optional
+func applyTimeRange(query *gorm.DB, column string, after, before *time.Time) *gorm.DB {
+	// Timestamps are stored as text carrying whatever offset they were written
+	// with, so compare instants via julianday rather than as strings
+	if after != nil {
+		query = query.Where("julianday("+column+") >= julianday(?)", after.UTC())
 	}
-	if opts.Offset > 0 {
-		query = query.Offset(opts.Offset)
-	}
-
-	if err := query.Preload("Tags").Preload("Folder").Order(sortBy + " " + sortOrder).Find(&files).Error; err != nil {
-		return nil, 0, err
+	if before != nil {
+		query = query.Where("julianday("+column+") <= julianday(?)", before.UTC())
 	}
-
-	return files, total, nil
+	return query
 }
 
 // UpdateFile updates a file's metadata
 func (s *fileService) UpdateFile(userID string, file *models.File) error {
+	defer markFilesChanged()
+
 	// Verify ownership
 	existing, err := s.GetFileByID(userID, file.ID)
 	if err != nil {
@@ -221,12 +665,19 @@ func (s *fileService) UpdateFile(userID string, file *models.File) error {
 
 	// Update only allowed fields
 	updates := map[string]any{
-		"title":     file.Title,
-		"summary":   file.Summary,
-		"file_type": file.FileType,
+		"title":                 file.Title,
+		"summary":               file.Summary,
+		"file_type":             file.FileType,
+		"file_type_set_by_user": file.FileTypeSetByUser,
+		"processing_hint":       file.ProcessingHint,
+	}
+	// A summary written by hand is no longer a fallback excerpt
+	if file.Summary != existing.Summary {
+		updates["summary_is_fallback"] = false
 	}
 
 	// Only update folder_id if provided
+	folderChanged := false
 	if file.FolderID != nil {
 		// Validate the folder exists
 		var folder models.Folder
@@ -236,14 +687,25 @@ func (s *fileService) UpdateFile(userID string, file *models.File) error {
 			}
 			return err
 		}
+		folderChanged = !sameFolder(existing.FolderID, file.FolderID)
 		updates["folder_id"] = file.FolderID
 	}
 
-	return s.db.Model(&models.File{}).Where("id = ? AND user_id = ?", file.ID, userID).Updates(updates).Error
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.File{}).Where("id = ? AND user_id = ?", file.ID, userID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if !folderChanged {
+			return nil
+		}
+		return s.checkFolderCapacity(tx, userID, file.FolderID)
+	})
 }
 
 // DeleteFile deletes a file
 func (s *fileService) DeleteFile(userID string, id uint) error {
+	defer markFilesChanged()
+
 	return s.db.Transaction(func(tx *gorm.DB) error {
 		// Verify ownership
 		var file models.File
@@ -254,63 +716,345 @@ func (s *fileService) DeleteFile(userID string, id uint) error {
 			return err
 		}
 
-		// Clear file_tags associations
-		if err := tx.Exec("DELETE FROM file_tags WHERE file_id = ?", id).Error; err != nil {
+		return deleteFileRows(tx, []uint{id})
+	})
+}
+
+// CopyFile creates a copy of the file in targetFolderID (nil for the root)
+// with its parsed content, tags and embedding. s3Key is where the caller
+// copied the file's object, since files don't share objects. The copy isn't
+// linked to the file's invoice or relations. A copy of a file still being
+// processed is itself left processing, for the caller to process on its own.
+// Returns nil when the file doesn't exist.
+func (s *fileService) CopyFile(userID string, fileID uint, targetFolderID *uint, s3Key string) (*models.File, error) {
+	defer markFilesChanged()
+
+	source, err := s.GetFileByID(userID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, nil
+	}
+	if targetFolderID != nil {
+		var count int64
+		if err := s.db.Model(&models.Folder{}).Where("id = ? AND user_id = ?", *targetFolderID, userID).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, ErrCopyFolderNotFound
+		}
+	}
+
+	copied := *source
+	copied.ID = 0
+	copied.PublicID = ""
+	copied.S3Key = s3Key
+	copied.FolderID = targetFolderID
+	copied.Folder = nil
+	copied.InvoiceID = nil
+	copied.RelatedFiles = nil
+	copied.CreatedAt = time.Time{}
+	copied.UpdatedAt = time.Time{}
+	if copied.ProcessingStatus == models.FileStatusProcessing {
+		now := time.Now()
+		copied.ProcessingStartedAt = &now
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var embedding models.FileEmbedding
+		if err := tx.Where("file_id = ?", source.ID).Limit(1).Find(&embedding).Error; err != nil {
 			return err
 		}
+		copied.HasEmbedding = embedding.ID != 0
 
-		// Delete file embedding if exists
-		if err := tx.Where("file_id = ?", id).Delete(&models.FileEmbedding{}).Error; err != nil {
+		if err := tx.Create(&copied).Error; err != nil {
+			return err
+		}
+		if err := s.checkFolderCapacity(tx, userID, targetFolderID); err != nil {
 			return err
 		}
+		if embedding.ID == 0 {
+			return nil
+		}
+		embedding.ID = 0
+		embedding.FileID = copied.ID
+		return tx.Create(&embedding).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetFileByID(userID, copied.ID)
+}
 
-		// Delete the file
-		return tx.Delete(&file).Error
+// DeleteFiles deletes the user's files among fileIDs along with their tags,
+// embeddings, links and relations. Either every file is deleted or, when any
+// delete fails, none is. IDs that aren't the user's files are reported in
+// NotFound rather than failing the batch.
+func (s *fileService) DeleteFiles(userID string, fileIDs []uint) (*DeleteFilesResult, error) {
+	defer markFilesChanged()
+
+	result := &DeleteFilesResult{Deleted: []models.File{}, NotFound: []uint{}}
+	if len(fileIDs) == 0 {
+		return result, nil
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var files []models.File
+		if err := tx.Where("id IN ? AND user_id = ?", fileIDs, userID).Find(&files).Error; err != nil {
+			return err
+		}
+		found := make(map[uint]bool, len(files))
+		ids := make([]uint, len(files))
+		for i, file := range files {
+			found[file.ID] = true
+			ids[i] = file.ID
+		}
+		for _, id := range fileIDs {
+			if !found[id] && !slices.Contains(result.NotFound, id) {
+				result.NotFound = append(result.NotFound, id)
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := deleteFileRows(tx, ids); err != nil {
+			return err
+		}
+		result.Deleted = files
+		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteFileRows deletes the files and the rows that reference them
+func deleteFileRows(tx *gorm.DB, ids []uint) error {
+	// Clear file_tags associations
+	if err := tx.Exec("DELETE FROM file_tags WHERE file_id IN ?", ids).Error; err != nil {
+		return err
+	}
+
+	// Delete file embeddings if they exist
+	if err := tx.Where("file_id IN ?", ids).Delete(&models.FileEmbedding{}).Error; err != nil {
+		return err
+	}
+
+	// Remove links to the files from other folders
+	if err := tx.Where("file_id IN ?", ids).Delete(&models.FileLink{}).Error; err != nil {
+		return err
+	}
+
+	// Remove relations from and to the files
+	if err := deleteFileRelations(tx, ids); err != nil {
+		return err
+	}
+
+	// Delete the files
+	return tx.Where("id IN ?", ids).Delete(&models.File{}).Error
 }
 
 // MoveFiles moves multiple files to a target folder
-func (s *fileService) MoveFiles(userID string, fileIDs []uint, targetFolderID *uint) error {
+func (s *fileService) MoveFiles(userID string, fileIDs []uint, targetFolderID *uint) (*MoveResult, error) {
 	// Validate target folder if specified
 	if targetFolderID != nil {
 		var folder models.Folder
 		if err := s.db.Where("id = ? AND user_id = ?", *targetFolderID, userID).First(&folder).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errors.New("target folder not found")
+				return nil, errors.New("target folder not found")
 			}
+			return nil, err
+		}
+	}
+
+	var files []models.File
+	if err := s.db.Select("id", "folder_id").Where("id IN ? AND user_id = ?", fileIDs, userID).Find(&files).Error; err != nil {
+		return nil, err
+	}
+
+	// Files already in the target folder are reported but not written
+	result := &MoveResult{Moved: []uint{}, Unchanged: []uint{}}
+	for _, file := range files {
+		if sameFolder(file.FolderID, targetFolderID) {
+			result.Unchanged = append(result.Unchanged, file.ID)
+		} else {
+			result.Moved = append(result.Moved, file.ID)
+		}
+	}
+	if len(result.Moved) == 0 {
+		return result, nil
+	}
+
+	defer markFilesChanged()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.File{}).
+			Where("id IN ? AND user_id = ?", result.Moved, userID).
+			Update("folder_id", targetFolderID).Error; err != nil {
+			return err
+		}
+		return s.checkFolderCapacity(tx, userID, targetFolderID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MoveFilesByFilter moves every file matching the filter options to the target
+// folder in one transaction. Sorting and pagination options are ignored.
+func (s *fileService) MoveFilesByFilter(userID string, opts FileListOptions, targetFolderID *uint) (*MoveResult, error) {
+	var result *MoveResult
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		txService := &fileService{db: tx, config: s.config}
+
+		fileIDs := []uint{}
+		if err := txService.filteredFilesQuery(userID, opts).Pluck("files.id", &fileIDs).Error; err != nil {
+			return err
+		}
+
+		var err error
+		result, err = txService.MoveFiles(userID, fileIDs, targetFolderID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReclassifyResult reports the files ReclassifyFiles checked
+type ReclassifyResult struct {
+	Changed   map[models.FileType][]uint // Changed files by their new type
+	Unchanged int
+}
+
+// ReclassifyFiles runs models.ClassifyContent over the stored content of every
+// file matching the filter, in one transaction. Files without content or
+// whose type the user chose are skipped, and files linked to an invoice stay
+// invoices. Sorting and pagination options are ignored.
+func (s *fileService) ReclassifyFiles(userID string, opts FileListOptions) (*ReclassifyResult, error) {
+	result := &ReclassifyResult{Changed: map[models.FileType][]uint{}}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		txService := &fileService{db: tx, config: s.config}
+
+		fileIDs := []uint{}
+		if err := txService.filteredFilesQuery(userID, opts).
+			Where("files.content <> '' AND files.file_type_set_by_user = ?", false).
+			Pluck("files.id", &fileIDs).Error; err != nil {
 			return err
 		}
+
+		// Content can be large; load it a batch at a time
+		for start := 0; start < len(fileIDs); start += fileStreamBatchSize {
+			end := min(start+fileStreamBatchSize, len(fileIDs))
+			var files []models.File
+			if err := tx.Select("id", "file_type", "mime_type", "content", "invoice_id").
+				Where("id IN ?", fileIDs[start:end]).
+				Find(&files).Error; err != nil {
+				return err
+			}
+			for _, file := range files {
+				fileType := models.ClassifyContent(file.FileType, file.MimeType, file.Content)
+				if file.InvoiceID != nil {
+					fileType = models.FileTypeInvoice
+				}
+				if fileType == file.FileType {
+					result.Unchanged++
+					continue
+				}
+				result.Changed[fileType] = append(result.Changed[fileType], file.ID)
+			}
+		}
+
+		for fileType, ids := range result.Changed {
+			for start := 0; start < len(ids); start += fileStreamBatchSize {
+				end := min(start+fileStreamBatchSize, len(ids))
+				if err := tx.Model(&models.File{}).
+					Where("id IN ? AND user_id = ?", ids[start:end], userID).
+					Update("file_type", fileType).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	if len(result.Changed) > 0 {
+		markFilesChanged()
+	}
+	return result, nil
+}
 
-	// Update files
-	return s.db.Model(&models.File{}).
-		Where("id IN ? AND user_id = ?", fileIDs, userID).
-		Update("folder_id", targetFolderID).Error
+// sameFolder reports whether two folder IDs refer to the same folder, where nil is the root
+func sameFolder(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
 
-// AddTagsToFile adds tags to a file
-func (s *fileService) AddTagsToFile(userID string, fileID uint, tagIDs []uint) error {
+// AddTagsToFile adds tags to a file. Tags the file already has are left
+// untouched and reported in AlreadyPresent; unknown tag IDs are ignored. When
+// a folding rule matches a newly added tag the file moves to its folder.
+func (s *fileService) AddTagsToFile(userID string, fileID uint, tagIDs []uint) (*TagAdditionResult, error) {
+	defer markFilesChanged()
+
 	// Verify file exists
 	file, err := s.GetFileByID(userID, fileID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if file == nil {
-		return errors.New("file not found")
+		return nil, errors.New("file not found")
 	}
 
 	// Get tags and verify they belong to user
 	var tags []models.Tag
 	if err := s.db.Where("id IN ? AND user_id = ?", tagIDs, userID).Find(&tags).Error; err != nil {
-		return err
+		return nil, err
+	}
+
+	existing := make(map[uint]bool, len(file.Tags))
+	for _, tag := range file.Tags {
+		existing[tag.ID] = true
+	}
+
+	result := &TagAdditionResult{Added: []uint{}, AlreadyPresent: []uint{}}
+	var newTags []models.Tag
+	for _, tag := range tags {
+		if existing[tag.ID] {
+			result.AlreadyPresent = append(result.AlreadyPresent, tag.ID)
+			continue
+		}
+		existing[tag.ID] = true
+		newTags = append(newTags, tag)
+		result.Added = append(result.Added, tag.ID)
+	}
+
+	if len(newTags) == 0 {
+		return result, nil
 	}
 
 	// Add tags using association
-	return s.db.Model(file).Association("Tags").Append(tags)
+	if err := s.db.Model(file).Association("Tags").Append(newTags); err != nil {
+		return nil, err
+	}
+
+	if result.MovedToFolder, err = s.applyFoldingRules(userID, file, result.Added); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // RemoveTagsFromFile removes tags from a file
 func (s *fileService) RemoveTagsFromFile(userID string, fileID uint, tagIDs []uint) error {
+	defer markFilesChanged()
+
 	// Verify file exists
 	file, err := s.GetFileByID(userID, fileID)
 	if err != nil {
@@ -330,18 +1074,30 @@ func (s *fileService) RemoveTagsFromFile(userID string, fileID uint, tagIDs []ui
 	return s.db.Model(file).Association("Tags").Delete(tags)
 }
 
-// UpdateFileContent updates a file's parsed content, summary, and file type
-func (s *fileService) UpdateFileContent(userID string, fileID uint, content, summary string, fileType models.FileType) error {
+// contentCounts returns the number of words and characters in content
+func contentCounts(content string) (words, chars int) {
+	return len(strings.Fields(content)), utf8.RuneCountInString(content)
+}
+
+// UpdateFileContent updates a file's parsed content, its word and character
+// counts, summary, and file type. A file type the user chose is kept.
+// summaryIsFallback marks a summary that is a text excerpt because the AI
+// summary was unavailable.
+func (s *fileService) UpdateFileContent(userID string, fileID uint, content, summary string, summaryIsFallback bool, fileType models.FileType) error {
+	defer markFilesChanged()
+
 	// Check if content looks like an invoice
 	if fileType == models.FileTypeDocument && models.IsInvoiceContent(content) {
 		fileType = models.FileTypeInvoice
 	}
 
 	updates := map[string]any{
-		"content":   content,
-		"summary":   summary,
-		"file_type": fileType,
+		"content":             content,
+		"summary":             summary,
+		"summary_is_fallback": summaryIsFallback,
+		"file_type":           gorm.Expr("CASE WHEN file_type_set_by_user THEN file_type ELSE ? END", fileType),
 	}
+	updates["word_count"], updates["char_count"] = contentCounts(content)
 
 	result := s.db.Model(&models.File{}).
 		Where("id = ? AND user_id = ?", fileID, userID).
@@ -358,10 +1114,14 @@ func (s *fileService) UpdateFileContent(userID string, fileID uint, content, sum
 
 // UpdateFileProcessingStatus updates a file's processing status
 func (s *fileService) UpdateFileProcessingStatus(userID string, fileID uint, status models.FileProcessingStatus, errMsg string) error {
+	defer markFilesChanged()
+
 	updates := map[string]any{
-		"processing_status": status,
-		"processing_error":  errMsg,
+		"processing_status":     status,
+		"processing_error":      errMsg,
+		"processing_error_code": "",
 	}
+	stampProcessingTimes(updates, status)
 
 	result := s.db.Model(&models.File{}).
 		Where("id = ? AND user_id = ?", fileID, userID).
@@ -376,8 +1136,159 @@ func (s *fileService) UpdateFileProcessingStatus(userID string, fileID uint, sta
 	return nil
 }
 
+// FailFileProcessing marks a file as failed with a machine-readable error code
+func (s *fileService) FailFileProcessing(userID string, fileID uint, errCode, errMsg string) error {
+	return s.endProcessing(userID, fileID, models.FileStatusFailed, errCode, errMsg)
+}
+
+func (s *fileService) FlagFileForReview(userID string, fileID uint, errCode, errMsg string) error {
+	return s.endProcessing(userID, fileID, models.FileStatusNeedsReview, errCode, errMsg)
+}
+
+// endProcessing moves a file to a final status with a machine-readable error code
+func (s *fileService) endProcessing(userID string, fileID uint, status models.FileProcessingStatus, errCode, errMsg string) error {
+	defer markFilesChanged()
+
+	result := s.db.Model(&models.File{}).
+		Where("id = ? AND user_id = ?", fileID, userID).
+		Updates(map[string]any{
+			"processing_status":     status,
+			"processing_error":      errMsg,
+			"processing_error_code": errCode,
+			"processing_ended_at":   time.Now(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("file not found")
+	}
+	return nil
+}
+
+// SummarizeProcessingErrors groups the user's failed files by normalized
+// error message, most common first
+func (s *fileService) SummarizeProcessingErrors(userID string) ([]ProcessingErrorGroup, error) {
+	var rows []struct {
+		ProcessingError string
+		Count           int64
+	}
+	if err := s.db.Model(&models.File{}).
+		Select("processing_error, COUNT(*) AS count").
+		Where("user_id = ? AND processing_status = ?", userID, models.FileStatusFailed).
+		Group("processing_error").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64)
+	for _, row := range rows {
+		counts[normalizeProcessingError(row.ProcessingError)] += row.Count
+	}
+
+	groups := make([]ProcessingErrorGroup, 0, len(counts))
+	for msg, count := range counts {
+		groups = append(groups, ProcessingErrorGroup{Message: msg, Count: count})
+	}
+	slices.SortFunc(groups, func(a, b ProcessingErrorGroup) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(a.Message, b.Message)
+	})
+	return groups, nil
+}
+
+// likeContainsPattern returns a LIKE pattern, used with ESCAPE '\', that
+// matches value literally anywhere in a column
+func likeContainsPattern(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+	return "%" + escaped + "%"
+}
+
+// normalizeProcessingError reduces an error to its leading context so that
+// failures differing only in details group together, e.g. "Failed to get
+// download URL: dial tcp 10.0.0.1:443: i/o timeout" becomes "Failed to get
+// download URL". The result stays a substring of the original message.
+func normalizeProcessingError(msg string) string {
+	msg = strings.TrimSpace(msg)
+	if i := strings.Index(msg, ": "); i > 0 {
+		msg = strings.TrimSpace(msg[:i])
+	}
+	return msg
+}
+
+// TransitionStatus atomically moves files from one processing status to another
+// and clears the previous error. The status check and the update are a single
+// statement, so files whose status changed concurrently are skipped rather than
+// overwritten.
+func (s *fileService) TransitionStatus(userID string, ids []uint, from, to models.FileProcessingStatus) (int, error) {
+	return s.transitionStatus(userID, ids, from, to, "", "")
+}
+
+// CancelProcessing atomically moves processing files to failed with the
+// canceled error code, skipping files whose processing already ended
+func (s *fileService) CancelProcessing(userID string, ids []uint) (int, error) {
+	return s.transitionStatus(userID, ids, models.FileStatusProcessing, models.FileStatusFailed,
+		models.ProcessingErrorCanceled, "Processing was canceled")
+}
+
+func (s *fileService) transitionStatus(userID string, ids []uint, from, to models.FileProcessingStatus, errCode, errMsg string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	defer markFilesChanged()
+
+	updates := map[string]any{
+		"processing_status":     to,
+		"processing_error":      errMsg,
+		"processing_error_code": errCode,
+	}
+	stampProcessingTimes(updates, to)
+
+	result := s.db.Model(&models.File{}).
+		Where("id IN ? AND user_id = ? AND processing_status = ?", ids, userID, from).
+		Updates(updates)
+
+	return int(result.RowsAffected), result.Error
+}
+
+// ResetStaleProcessingFiles marks files that have been processing since before
+// startedBefore as failed, for every user. Files left in processing without a
+// start time predate the column; they are stale when they were last updated
+// before startedBefore.
+func (s *fileService) ResetStaleProcessingFiles(startedBefore time.Time) (int64, error) {
+	defer markFilesChanged()
+
+	result := s.db.Model(&models.File{}).
+		Where("processing_status = ?", models.FileStatusProcessing).
+		Where("processing_started_at < ? OR (processing_started_at IS NULL AND updated_at < ?)", startedBefore, startedBefore).
+		Updates(map[string]any{
+			"processing_status":   models.FileStatusFailed,
+			"processing_error":    "Processing was interrupted and timed out; trigger processing again to retry",
+			"processing_ended_at": time.Now(),
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+// stampProcessingTimes adds the start or end time of processing to updates
+// moving files to status
+func stampProcessingTimes(updates map[string]any, status models.FileProcessingStatus) {
+	switch status {
+	case models.FileStatusProcessing:
+		updates["processing_started_at"] = time.Now()
+		updates["processing_ended_at"] = nil
+	case models.FileStatusCompleted, models.FileStatusFailed, models.FileStatusNeedsReview:
+		updates["processing_ended_at"] = time.Now()
+	}
+}
+
 // SetFileHasEmbedding sets whether a file has an embedding
 func (s *fileService) SetFileHasEmbedding(userID string, fileID uint, hasEmbedding bool) error {
+	defer markFilesChanged()
+
 	result := s.db.Model(&models.File{}).
 		Where("id = ? AND user_id = ?", fileID, userID).
 		Update("has_embedding", hasEmbedding)
@@ -391,6 +1302,61 @@ func (s *fileService) SetFileHasEmbedding(userID string, fileID uint, hasEmbeddi
 	return nil
 }
 
+func (s *fileService) ApplyDetectedMimeType(userID string, file *models.File, detected string) error {
+	defer markFilesChanged()
+
+	// Files created before the declared type was kept still hold it in mime_type
+	declared := file.DeclaredMimeType
+	if declared == "" {
+		declared = file.MimeType
+	}
+	resolved := ResolveMimeType(declared, detected)
+
+	updates := map[string]any{"detected_mime_type": detected}
+	fileType := file.FileType
+	if resolved != file.MimeType {
+		updates["mime_type"] = resolved
+		// Only a file type derived from the old MIME type follows it; one the
+		// user chose or processing detected, like invoice, is kept
+		if !file.FileTypeSetByUser && file.FileType == models.DetectFileTypeFromMimeType(file.MimeType) {
+			fileType = models.DetectFileTypeFromMimeType(resolved)
+			updates["file_type"] = fileType
+		}
+	}
+
+	result := s.db.Model(&models.File{}).
+		Where("id = ? AND user_id = ?", file.ID, userID).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("file not found")
+	}
+
+	file.DetectedMimeType = detected
+	file.MimeType = resolved
+	file.FileType = fileType
+	return nil
+}
+
+// UpdateFileS3Key points a file at the object it was copied to
+func (s *fileService) UpdateFileS3Key(userID string, fileID uint, s3Key string) error {
+	defer markFilesChanged()
+
+	result := s.db.Model(&models.File{}).
+		Where("id = ? AND user_id = ?", fileID, userID).
+		Update("s3_key", s3Key)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("file not found")
+	}
+	return nil
+}
+
 // UpdateFileInvoiceID updates the invoice_id for a file
 func (s *fileService) UpdateFileInvoiceID(userID string, fileID uint, invoiceID int64) error {
 	result := s.db.Model(&models.File{}).
@@ -406,9 +1372,26 @@ func (s *fileService) UpdateFileInvoiceID(userID string, fileID uint, invoiceID
 	return nil
 }
 
+// UpdateFileEntities stores the entities extracted from the file's content
+func (s *fileService) UpdateFileEntities(userID string, fileID uint, entities *models.FileEntities) error {
+	defer markFilesChanged()
+
+	result := s.db.Model(&models.File{}).
+		Where("id = ? AND user_id = ?", fileID, userID).
+		Update("entities", entities)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("file not found")
+	}
+	return nil
+}
+
 // UnlinkFileInvoiceByInvoiceID removes the invoice_id association from a file by invoice_id
 // Verifies the file belongs to the specified user before unlinking
-func (s *fileService) UnlinkFileInvoiceByInvoiceID(userID string, invoiceID int64) error {
+func (s *fileService) UnlinkFileInvoiceByInvoiceID(userID string, invoiceID int64, removeRelations bool) error {
 	// First, find the file with this invoice_id that belongs to this user
 	var file models.File
 	err := s.db.Where("invoice_id = ? AND user_id = ?", invoiceID, userID).First(&file).Error
@@ -419,30 +1402,52 @@ func (s *fileService) UnlinkFileInvoiceByInvoiceID(userID string, invoiceID int6
 		return err
 	}
 
-	// Unlink the invoice
-	result := s.db.Model(&models.File{}).
-		Where("id = ? AND user_id = ?", file.ID, userID).
-		Update("invoice_id", nil)
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// Unlink the invoice
+		result := tx.Model(&models.File{}).
+			Where("id = ? AND user_id = ?", file.ID, userID).
+			Update("invoice_id", nil)
 
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return errors.New("file not found")
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("file not found")
+		}
+
+		if removeRelations {
+			return tx.Where("file_id = ? AND user_id = ?", file.ID, userID).Delete(&models.FileRelation{}).Error
+		}
+		return nil
+	})
+}
+
+// GetFilesInFolderRecursive returns all files in a folder and its subfolders,
+// skipping the subtrees of opts.ExcludeFolderIDs and, with opts.ExcludeDirect,
+// the folder's own files
+func (s *fileService) GetFilesInFolderRecursive(userID string, folderID uint, opts RecursiveFileOptions) ([]models.File, error) {
+	excluded := make(map[uint]bool, len(opts.ExcludeFolderIDs))
+	for _, id := range opts.ExcludeFolderIDs {
+		excluded[id] = true
 	}
-	return nil
+	return s.getFilesInFolderRecursive(userID, folderID, excluded, !opts.ExcludeDirect)
 }
 
-// GetFilesInFolderRecursive returns all files in a folder and its subfolders
-func (s *fileService) GetFilesInFolderRecursive(userID string, folderID uint) ([]models.File, error) {
+func (s *fileService) getFilesInFolderRecursive(userID string, folderID uint, excluded map[uint]bool, includeDirect bool) ([]models.File, error) {
+	if excluded[folderID] {
+		return nil, nil
+	}
+
 	var allFiles []models.File
 
 	// Get files directly in this folder
-	var files []models.File
-	if err := s.db.Where("folder_id = ? AND user_id = ?", folderID, userID).Find(&files).Error; err != nil {
-		return nil, err
+	if includeDirect {
+		var files []models.File
+		if err := s.db.Where("folder_id = ? AND user_id = ?", folderID, userID).Find(&files).Error; err != nil {
+			return nil, err
+		}
+		allFiles = append(allFiles, files...)
 	}
-	allFiles = append(allFiles, files...)
 
 	// Get all subfolders
 	var subfolders []models.Folder
@@ -452,7 +1457,7 @@ func (s *fileService) GetFilesInFolderRecursive(userID string, folderID uint) ([
 
 	// Recursively get files from subfolders
 	for _, subfolder := range subfolders {
-		subFiles, err := s.GetFilesInFolderRecursive(userID, subfolder.ID)
+		subFiles, err := s.getFilesInFolderRecursive(userID, subfolder.ID, excluded, true)
 		if err != nil {
 			return nil, err
 		}
@@ -461,3 +1466,61 @@ func (s *fileService) GetFilesInFolderRecursive(userID string, folderID uint) ([
 
 	return allFiles, nil
 }
+
+// ListFilesByFolder loads the files of all folders with one ranked query and
+// counts them with one aggregate query, so a tree of folders doesn't take a
+// query per folder
+func (s *fileService) ListFilesByFolder(userID string, folderIDs []uint, perFolder int) (map[uint]FolderFiles, error) {
+	grouped := make(map[uint]FolderFiles, len(folderIDs))
+	if len(folderIDs) == 0 {
+		return grouped, nil
+	}
+
+	inFolders := func() *gorm.DB {
+		cond := s.db.Where("folder_id IN ?", folderIDs)
+		if slices.Contains(folderIDs, 0) {
+			cond = cond.Or("folder_id IS NULL")
+		}
+		return cond
+	}
+
+	var counts []struct {
+		FolderID uint
+		Count    int64
+	}
+	if err := s.db.Model(&models.File{}).
+		Select("COALESCE(folder_id, 0) AS folder_id, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Where(inFolders()).
+		Group("COALESCE(folder_id, 0)").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range counts {
+		grouped[row.FolderID] = FolderFiles{Total: row.Count}
+	}
+
+	// Number each folder's files newest first and keep the first perFolder
+	ranked := s.db.Model(&models.File{}).
+		Select("id, ROW_NUMBER() OVER (PARTITION BY folder_id ORDER BY created_at DESC, id DESC) AS position").
+		Where("user_id = ?", userID).
+		Where(inFolders())
+	var files []models.File
+	if err := s.db.Where("id IN (?)", s.db.Table("(?) AS ranked", ranked).Select("id").Where("position <= ?", perFolder)).
+		Order("created_at DESC, id DESC").
+		Preload("Tags").
+		Preload("Folder").
+		Find(&files).Error; err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		var folderID uint
+		if file.FolderID != nil {
+			folderID = *file.FolderID
+		}
+		entry := grouped[folderID]
+		entry.Files = append(entry.Files, file)
+		grouped[folderID] = entry
+	}
+	return grouped, nil
+}
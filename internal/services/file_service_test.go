@@ -0,0 +1,492 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fileTestUserID = "file-test-user"
+
+func TestFolderFileLimit(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{MaxFilesPerFolder: 2})
+	folderService := NewFolderService(db, FolderConfig{})
+
+	full := &models.Folder{Name: "Full"}
+	other := &models.Folder{Name: "Other"}
+	require.NoError(t, folderService.CreateFolder(fileTestUserID, full))
+	require.NoError(t, folderService.CreateFolder(fileTestUserID, other))
+
+	newFile := func(name string, folderID *uint) *models.File {
+		return &models.File{Title: name, S3Key: name, OriginalFilename: name, FolderID: folderID}
+	}
+	require.NoError(t, fileService.CreateFile(fileTestUserID, newFile("a.pdf", &full.ID)))
+	require.NoError(t, fileService.CreateFile(fileTestUserID, newFile("b.pdf", &full.ID)))
+
+	err := fileService.CreateFile(fileTestUserID, newFile("c.pdf", &full.ID))
+	assert.ErrorIs(t, err, ErrFolderFull)
+
+	// The root has no limit
+	loose := []*models.File{newFile("d.pdf", nil), newFile("e.pdf", nil), newFile("f.pdf", nil)}
+	for _, file := range loose {
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+	}
+
+	_, err = fileService.MoveFiles(fileTestUserID, []uint{loose[0].ID}, &full.ID)
+	assert.ErrorIs(t, err, ErrFolderFull)
+
+	_, err = fileService.MoveFiles(fileTestUserID, []uint{loose[0].ID, loose[1].ID, loose[2].ID}, &other.ID)
+	assert.ErrorIs(t, err, ErrFolderFull)
+
+	// A rejected move leaves every file where it was
+	var inOther int64
+	require.NoError(t, db.Model(&models.File{}).Where("folder_id = ?", other.ID).Count(&inOther).Error)
+	assert.Zero(t, inOther)
+
+	result, err := fileService.MoveFiles(fileTestUserID, []uint{loose[0].ID, loose[1].ID}, &other.ID)
+	require.NoError(t, err)
+	assert.Len(t, result.Moved, 2)
+
+	loose[2].FolderID = &other.ID
+	assert.ErrorIs(t, fileService.UpdateFile(fileTestUserID, loose[2]), ErrFolderFull)
+	stored, err := fileService.GetFileByID(fileTestUserID, loose[2].ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.FolderID)
+}
+
+func TestGetFilesInFolderRecursive_ExcludeDirect(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+	chain := createFolderChain(t, NewFolderService(db, FolderConfig{}), "Finance", "2024", "Q1")
+	for i, folder := range chain {
+		name := fmt.Sprintf("file-%d.pdf", i)
+		require.NoError(t, fileService.CreateFile(folderTestUserID, &models.File{Title: name, S3Key: name, OriginalFilename: name, FolderID: &folder.ID}))
+	}
+
+	titles := func(files []models.File) []string {
+		var names []string
+		for _, file := range files {
+			names = append(names, file.Title)
+		}
+		return names
+	}
+
+	files, err := fileService.GetFilesInFolderRecursive(folderTestUserID, chain[0].ID, RecursiveFileOptions{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"file-0.pdf", "file-1.pdf", "file-2.pdf"}, titles(files))
+
+	// Files directly in nested subfolders are still included
+	files, err = fileService.GetFilesInFolderRecursive(folderTestUserID, chain[0].ID, RecursiveFileOptions{ExcludeDirect: true})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"file-1.pdf", "file-2.pdf"}, titles(files))
+
+	files, err = fileService.GetFilesInFolderRecursive(folderTestUserID, chain[0].ID, RecursiveFileOptions{
+		ExcludeFolderIDs: []uint{chain[2].ID},
+		ExcludeDirect:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"file-1.pdf"}, titles(files))
+}
+
+func TestListFiles_EntityFilters(t *testing.T) {
+	fileService := NewFileService(newTestDB(t), FileConfig{})
+	create := func(name string, entities *models.FileEntities) {
+		file := &models.File{Title: name, S3Key: name, OriginalFilename: name}
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+		if entities != nil {
+			require.NoError(t, fileService.UpdateFileEntities(fileTestUserID, file.ID, entities))
+		}
+	}
+	create("acme-2023.pdf", &models.FileEntities{Organizations: []string{"ACME Corp"}, Dates: []string{"2023-06-30"}})
+	create("acme-2024.pdf", &models.FileEntities{Organizations: []string{"ACME Corp"}, Dates: []string{"2024-02-01"}})
+	create("person.pdf", &models.FileEntities{People: []string{"Acme Smith"}, Dates: []string{"2024-05-01"}})
+	create("none.pdf", nil)
+
+	list := func(opts FileListOptions) []string {
+		require.NoError(t, opts.ValidateEntityFilters())
+		files, _, err := fileService.ListFiles(fileTestUserID, opts)
+		require.NoError(t, err)
+		var titles []string
+		for _, file := range files {
+			titles = append(titles, file.Title)
+		}
+		return titles
+	}
+
+	assert.ElementsMatch(t, []string{"acme-2023.pdf", "acme-2024.pdf", "person.pdf"}, list(FileListOptions{Entity: "acme"}))
+	assert.ElementsMatch(t, []string{"acme-2023.pdf", "acme-2024.pdf"}, list(FileListOptions{Entity: "acme", EntityType: "organizations"}))
+	assert.ElementsMatch(t, []string{"acme-2024.pdf"}, list(FileListOptions{Entity: "acme", EntityType: "organizations", EntityDateFrom: "2024-01-01"}))
+	assert.ElementsMatch(t, []string{"acme-2023.pdf"}, list(FileListOptions{EntityDateTo: "2023-12-31"}))
+	// Keys of the stored JSON don't match
+	assert.Empty(t, list(FileListOptions{Entity: "organizations"}))
+
+	assert.Error(t, FileListOptions{EntityType: "places"}.ValidateEntityFilters())
+	assert.Error(t, FileListOptions{EntityDateFrom: "2024"}.ValidateEntityFilters())
+}
+
+func TestCopyFile(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+	folderService := NewFolderService(db, FolderConfig{})
+	archive := &models.Folder{Name: "Archive"}
+	require.NoError(t, folderService.CreateFolder(fileTestUserID, archive))
+	tag := &models.Tag{Name: "finance"}
+	require.NoError(t, NewTagService(db).CreateTag(fileTestUserID, tag))
+
+	invoiceID := int64(42)
+	source := &models.File{
+		Title: "invoice", S3Key: "invoice.pdf", OriginalFilename: "invoice.pdf",
+		Content: "Invoice total", Summary: "An invoice", FileType: models.FileTypeInvoice,
+		ProcessingStatus: models.FileStatusCompleted, HasEmbedding: true, InvoiceID: &invoiceID,
+	}
+	require.NoError(t, fileService.CreateFile(fileTestUserID, source))
+	_, err := fileService.AddTagsToFile(fileTestUserID, source.ID, []uint{tag.ID})
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&models.FileEmbedding{FileID: source.ID, UserID: fileTestUserID, Embedding: "[1,0]"}).Error)
+
+	copied, err := fileService.CopyFile(fileTestUserID, source.ID, &archive.ID, "copy.pdf")
+	require.NoError(t, err)
+	assert.NotEqual(t, source.ID, copied.ID)
+	assert.NotEqual(t, source.PublicID, copied.PublicID)
+	assert.Equal(t, "copy.pdf", copied.S3Key)
+	assert.Equal(t, &archive.ID, copied.FolderID)
+	assert.Equal(t, "Invoice total", copied.Content)
+	assert.Equal(t, models.FileStatusCompleted, copied.ProcessingStatus)
+	assert.Nil(t, copied.InvoiceID)
+	require.Len(t, copied.Tags, 1)
+	assert.Equal(t, tag.ID, copied.Tags[0].ID)
+	assert.True(t, copied.HasEmbedding)
+	var embedding models.FileEmbedding
+	require.NoError(t, db.Where("file_id = ?", copied.ID).First(&embedding).Error)
+	assert.Equal(t, "[1,0]", embedding.Embedding)
+
+	// The source keeps its tags
+	original, err := fileService.GetFileByID(fileTestUserID, source.ID)
+	require.NoError(t, err)
+	assert.Len(t, original.Tags, 1)
+
+	// A copy of a file being processed is left processing for the caller to process
+	require.NoError(t, db.Model(source).Update("processing_status", models.FileStatusProcessing).Error)
+	copied, err = fileService.CopyFile(fileTestUserID, source.ID, nil, "copy-2.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusProcessing, copied.ProcessingStatus)
+	assert.NotNil(t, copied.ProcessingStartedAt)
+	assert.Nil(t, copied.FolderID)
+
+	missing, err := fileService.CopyFile("other-user", source.ID, nil, "copy-3.pdf")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+	missingFolder := uint(999)
+	_, err = fileService.CopyFile(fileTestUserID, source.ID, &missingFolder, "copy-3.pdf")
+	assert.ErrorIs(t, err, ErrCopyFolderNotFound)
+}
+
+func TestDeleteFiles(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+	tag := &models.Tag{Name: "finance"}
+	require.NoError(t, NewTagService(db).CreateTag(fileTestUserID, tag))
+	var ids []uint
+	for _, name := range []string{"a.pdf", "b.pdf", "keep.pdf"} {
+		file := &models.File{Title: name, S3Key: name, OriginalFilename: name}
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+		_, err := fileService.AddTagsToFile(fileTestUserID, file.ID, []uint{tag.ID})
+		require.NoError(t, err)
+		require.NoError(t, db.Create(&models.FileEmbedding{FileID: file.ID, UserID: fileTestUserID}).Error)
+		ids = append(ids, file.ID)
+	}
+	_, err := fileService.AddFileRelation(fileTestUserID, ids[0], ids[2], "")
+	require.NoError(t, err)
+
+	result, err := fileService.DeleteFiles(fileTestUserID, []uint{ids[0], ids[1], ids[1], 999})
+	require.NoError(t, err)
+	require.Len(t, result.Deleted, 2)
+	assert.ElementsMatch(t, []string{"a.pdf", "b.pdf"}, []string{result.Deleted[0].S3Key, result.Deleted[1].S3Key})
+	assert.Equal(t, []uint{999}, result.NotFound)
+
+	count := func(model any, query string, args ...any) int64 {
+		var n int64
+		require.NoError(t, db.Model(model).Where(query, args...).Count(&n).Error)
+		return n
+	}
+	assert.EqualValues(t, 1, count(&models.File{}, "user_id = ?", fileTestUserID))
+	assert.EqualValues(t, 1, count(&models.FileEmbedding{}, "user_id = ?", fileTestUserID))
+	assert.EqualValues(t, 0, count(&models.FileRelation{}, "file_id = ? OR related_file_id = ?", ids[2], ids[2]))
+	var tagged int64
+	require.NoError(t, db.Table("file_tags").Count(&tagged).Error)
+	assert.EqualValues(t, 1, tagged)
+
+	// Other users' files are not found
+	result, err = fileService.DeleteFiles("other-user", []uint{ids[2]})
+	require.NoError(t, err)
+	assert.Empty(t, result.Deleted)
+	assert.Equal(t, []uint{ids[2]}, result.NotFound)
+}
+
+func TestListFiles_Extensions(t *testing.T) {
+	fileService := NewFileService(newTestDB(t), FileConfig{})
+	for _, name := range []string{"report.docx", "Budget.XLSX", "notes.docx.pdf", "docx", "readme"} {
+		file := &models.File{Title: name, S3Key: name, OriginalFilename: name}
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+	}
+
+	list := func(value string) []string {
+		exts, err := ParseFileExtensions(value)
+		require.NoError(t, err)
+		files, _, err := fileService.ListFiles(fileTestUserID, FileListOptions{Extensions: exts})
+		require.NoError(t, err)
+		var titles []string
+		for _, file := range files {
+			titles = append(titles, file.Title)
+		}
+		return titles
+	}
+
+	assert.ElementsMatch(t, []string{"report.docx"}, list("docx"))
+	assert.ElementsMatch(t, []string{"report.docx", "Budget.XLSX"}, list(" .DOCX, xlsx,"))
+	assert.ElementsMatch(t, []string{"notes.docx.pdf"}, list("pdf"))
+	assert.Len(t, list(""), 5)
+}
+
+func TestListFiles_SizeRange(t *testing.T) {
+	fileService := NewFileService(newTestDB(t), FileConfig{})
+	for name, size := range map[string]int64{"small.mp3": 1 << 10, "medium.mp4": 50 << 20, "large.mov": 200 << 20} {
+		file := &models.File{Title: name, S3Key: name, OriginalFilename: name, Size: size}
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+	}
+
+	size := func(v int64) *int64 { return &v }
+	list := func(opts FileListOptions) []string {
+		require.NoError(t, opts.ValidateSizeRange())
+		files, total, err := fileService.ListFiles(fileTestUserID, opts)
+		require.NoError(t, err)
+		var titles []string
+		for _, file := range files {
+			titles = append(titles, file.Title)
+		}
+		assert.EqualValues(t, len(titles), total)
+		return titles
+	}
+
+	assert.ElementsMatch(t, []string{"large.mov"}, list(FileListOptions{MinSize: size(100 << 20)}))
+	assert.ElementsMatch(t, []string{"small.mp3", "medium.mp4"}, list(FileListOptions{MaxSize: size(50 << 20)}))
+	assert.ElementsMatch(t, []string{"medium.mp4"}, list(FileListOptions{MinSize: size(2 << 10), MaxSize: size(100 << 20)}))
+	assert.Len(t, list(FileListOptions{}), 3)
+
+	assert.Error(t, FileListOptions{MinSize: size(10), MaxSize: size(5)}.ValidateSizeRange())
+	assert.Error(t, FileListOptions{MinSize: size(-1)}.ValidateSizeRange())
+	assert.NoError(t, FileListOptions{MinSize: size(5), MaxSize: size(5)}.ValidateSizeRange())
+
+	// Both negative bounds are reported, min_size first
+	err := FileListOptions{MinSize: size(-1), MaxSize: size(-2)}.ValidateSizeRange()
+	assert.EqualError(t, err, "min_size must not be negative\nmax_size must not be negative")
+}
+
+func TestListFiles_DateRange(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+	march := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	for name, createdAt := range map[string]time.Time{
+		"february.pdf": march.AddDate(0, -1, 0),
+		"march.pdf":    march,
+		"april.pdf":    march.AddDate(0, 1, 0),
+	} {
+		file := &models.File{Title: name, S3Key: name, OriginalFilename: name}
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+		require.NoError(t, db.Model(file).UpdateColumns(map[string]any{"created_at": createdAt, "updated_at": createdAt.AddDate(0, 0, 1)}).Error)
+	}
+	// Written an hour before march under a +05:00 offset, so its stored text
+	// sorts after march's even though the instant is earlier
+	offset := &models.File{Title: "offset.pdf", S3Key: "offset.pdf", OriginalFilename: "offset.pdf"}
+	require.NoError(t, fileService.CreateFile(fileTestUserID, offset))
+	offsetAt := march.Add(-time.Hour).In(time.FixedZone("", 5*60*60))
+	require.NoError(t, db.Model(offset).UpdateColumns(map[string]any{"created_at": offsetAt, "updated_at": offsetAt}).Error)
+
+	at := func(v time.Time) *time.Time { return &v }
+	list := func(opts FileListOptions) []string {
+		files, total, err := fileService.ListFiles(fileTestUserID, opts)
+		require.NoError(t, err)
+		titles := []string{}
+		for _, file := range files {
+			titles = append(titles, file.Title)
+		}
+		opts.Limit = 1
+		_, pagedTotal, err := fileService.ListFiles(fileTestUserID, opts)
+		require.NoError(t, err)
+		assert.Equal(t, total, pagedTotal, "total counts every match before paging")
+		assert.EqualValues(t, len(titles), total)
+		return titles
+	}
+
+	monthStart := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0).Add(-time.Second)
+	assert.ElementsMatch(t, []string{"march.pdf", "offset.pdf"}, list(FileListOptions{CreatedAfter: &monthStart, CreatedBefore: &monthEnd}))
+	assert.ElementsMatch(t, []string{"march.pdf", "april.pdf"}, list(FileListOptions{CreatedAfter: at(march)}))
+	assert.ElementsMatch(t, []string{"february.pdf", "offset.pdf"}, list(FileListOptions{CreatedBefore: at(march.Add(-time.Second))}))
+	assert.ElementsMatch(t, []string{"april.pdf"}, list(FileListOptions{UpdatedAfter: at(march.AddDate(0, 0, 2))}))
+	assert.ElementsMatch(t, []string{"march.pdf"}, list(FileListOptions{CreatedAfter: &monthStart, Keyword: "march"}))
+	assert.Empty(t, list(FileListOptions{CreatedAfter: &monthStart, Keyword: "april", CreatedBefore: &monthEnd}))
+
+	assert.NoError(t, FileListOptions{CreatedAfter: at(march), CreatedBefore: at(march)}.ValidateDateRange())
+	err := FileListOptions{
+		CreatedAfter: at(march), CreatedBefore: at(march.Add(-time.Second)),
+		UpdatedAfter: at(march), UpdatedBefore: at(march.Add(-time.Second)),
+	}.ValidateDateRange()
+	assert.EqualError(t, err, "created_before must not be earlier than created_after\nupdated_before must not be earlier than updated_after")
+}
+
+func TestParseFileExtensions(t *testing.T) {
+	exts, err := ParseFileExtensions("docx, .XLSX,,docx")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"docx", "xlsx"}, exts)
+
+	for _, value := range []string{"%", "doc_", "tar.gz", "pdf,x y"} {
+		_, err := ParseFileExtensions(value)
+		assert.ErrorIs(t, err, ErrInvalidExtensions, value)
+	}
+}
+
+func TestListFilesByFolder(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+	folderService := NewFolderService(db, FolderConfig{})
+
+	busy := &models.Folder{Name: "Busy"}
+	empty := &models.Folder{Name: "Empty"}
+	require.NoError(t, folderService.CreateFolder(fileTestUserID, busy))
+	require.NoError(t, folderService.CreateFolder(fileTestUserID, empty))
+
+	newFile := func(name string, folderID *uint) {
+		require.NoError(t, fileService.CreateFile(fileTestUserID, &models.File{Title: name, S3Key: name, OriginalFilename: name, FolderID: folderID}))
+	}
+	for i := 0; i < 3; i++ {
+		newFile(fmt.Sprintf("busy-%d.pdf", i), &busy.ID)
+	}
+	newFile("loose.pdf", nil)
+	require.NoError(t, fileService.CreateFile("other-user", &models.File{Title: "theirs", S3Key: "theirs.pdf", OriginalFilename: "theirs.pdf"}))
+
+	grouped, err := fileService.ListFilesByFolder(fileTestUserID, []uint{0, busy.ID, empty.ID}, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), grouped[busy.ID].Total)
+	require.Len(t, grouped[busy.ID].Files, 2)
+	// Newest first
+	assert.Equal(t, "busy-2.pdf", grouped[busy.ID].Files[0].Title)
+	assert.Equal(t, "busy-1.pdf", grouped[busy.ID].Files[1].Title)
+
+	assert.Equal(t, int64(1), grouped[0].Total)
+	require.Len(t, grouped[0].Files, 1)
+	assert.Equal(t, "loose.pdf", grouped[0].Files[0].Title)
+
+	assert.Zero(t, grouped[empty.ID].Total)
+	assert.Empty(t, grouped[empty.ID].Files)
+
+	// Without the root, files without a folder are left out
+	grouped, err = fileService.ListFilesByFolder(fileTestUserID, []uint{busy.ID}, 5)
+	require.NoError(t, err)
+	assert.Len(t, grouped[busy.ID].Files, 3)
+	assert.NotContains(t, grouped, uint(0))
+}
+
+func TestReclassifyFiles(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+
+	newFile := func(name string, fileType models.FileType, content string) *models.File {
+		file := &models.File{Title: name, S3Key: name, OriginalFilename: name, MimeType: "application/pdf"}
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+		require.NoError(t, db.Model(file).Updates(map[string]interface{}{"file_type": fileType, "content": content}).Error)
+		return file
+	}
+	invoiceText := "Invoice number 42\nAmount due: $120.00\nDue date: 2024-05-01"
+	missed := newFile("missed.pdf", models.FileTypeDocument, invoiceText)
+	wrong := newFile("wrong.pdf", models.FileTypeInvoice, "Meeting notes about the roadmap")
+	linked := newFile("linked.pdf", models.FileTypeInvoice, "Scanned page")
+	require.NoError(t, fileService.UpdateFileInvoiceID(fileTestUserID, linked.ID, 7))
+	newFile("notes.pdf", models.FileTypeDocument, "Meeting notes")
+	newFile("empty.pdf", models.FileTypeInvoice, "")
+	chosen := newFile("chosen.pdf", models.FileTypeDocument, invoiceText)
+	require.NoError(t, db.Model(chosen).Update("file_type_set_by_user", true).Error)
+
+	result, err := fileService.ReclassifyFiles(fileTestUserID, FileListOptions{AllFolders: true})
+	require.NoError(t, err)
+	assert.Equal(t, []uint{missed.ID}, result.Changed[models.FileTypeInvoice])
+	assert.Equal(t, []uint{wrong.ID}, result.Changed[models.FileTypeDocument])
+	// The linked invoice and the unchanged notes; the file without content and
+	// the one typed by the user are skipped
+	assert.Equal(t, 2, result.Unchanged)
+
+	got, err := fileService.GetFileByID(fileTestUserID, missed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileTypeInvoice, got.FileType)
+	got, err = fileService.GetFileByID(fileTestUserID, wrong.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileTypeDocument, got.FileType)
+	// A type the user chose is left alone
+	got, err = fileService.GetFileByID(fileTestUserID, chosen.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileTypeDocument, got.FileType)
+
+	// Running it again finds nothing to change
+	result, err = fileService.ReclassifyFiles(fileTestUserID, FileListOptions{AllFolders: true})
+	require.NoError(t, err)
+	assert.Empty(t, result.Changed)
+	assert.Equal(t, 4, result.Unchanged)
+}
+
+func TestUserChosenFileTypeIsKept(t *testing.T) {
+	fileService := NewFileService(newTestDB(t), FileConfig{})
+
+	file := &models.File{
+		Title: "scan", S3Key: "scan.pdf", OriginalFilename: "scan.pdf", MimeType: "application/pdf",
+		FileType: models.FileTypeDocument, FileTypeSetByUser: true,
+	}
+	require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+
+	// Neither sniffing a new MIME type nor invoice-looking content changes it
+	require.NoError(t, fileService.ApplyDetectedMimeType(fileTestUserID, file, "image/png"))
+	require.NoError(t, fileService.UpdateFileContent(fileTestUserID, file.ID, "Invoice number 42\nAmount due: $120.00", "", false, models.FileTypeDocument))
+
+	got, err := fileService.GetFileByID(fileTestUserID, file.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", got.MimeType)
+	assert.Equal(t, models.FileTypeDocument, got.FileType)
+}
+
+func TestTransitionStatus_OnlyCancelMarksCanceled(t *testing.T) {
+	fileService := NewFileService(newTestDB(t), FileConfig{})
+
+	canceled := &models.File{Title: "canceled", S3Key: "canceled.pdf", OriginalFilename: "canceled.pdf"}
+	failed := &models.File{Title: "failed", S3Key: "failed.pdf", OriginalFilename: "failed.pdf"}
+	for _, file := range []*models.File{canceled, failed} {
+		require.NoError(t, fileService.CreateFile(fileTestUserID, file))
+		require.NoError(t, fileService.UpdateFileProcessingStatus(fileTestUserID, file.ID, models.FileStatusProcessing, ""))
+	}
+
+	changed, err := fileService.CancelProcessing(fileTestUserID, []uint{canceled.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	changed, err = fileService.TransitionStatus(fileTestUserID, []uint{failed.ID}, models.FileStatusProcessing, models.FileStatusFailed)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	got, err := fileService.GetFileByID(fileTestUserID, canceled.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusFailed, got.ProcessingStatus)
+	assert.Equal(t, models.ProcessingErrorCanceled, got.ProcessingErrorCode)
+
+	got, err = fileService.GetFileByID(fileTestUserID, failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusFailed, got.ProcessingStatus)
+	assert.Empty(t, got.ProcessingErrorCode)
+
+	// Files no longer processing aren't canceled
+	changed, err = fileService.CancelProcessing(fileTestUserID, []uint{failed.ID})
+	require.NoError(t, err)
+	assert.Zero(t, changed)
+}
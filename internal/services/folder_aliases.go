@@ -0,0 +1,126 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidFolderPath is returned for a folder path without any folder names
+var ErrInvalidFolderPath = errors.New("path must contain at least one folder name")
+
+// NormalizeFolderPath trims each slash-separated segment and drops empty ones,
+// so " /Projects//2024/ " becomes "Projects/2024"
+func NormalizeFolderPath(path string) string {
+	var names []string
+	for _, name := range strings.Split(path, "/") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "/")
+}
+
+// AddFolderAlias records path as a former path of the folder. An alias the
+// user already has for the same path is replaced, so the newest one wins.
+func (s *folderService) AddFolderAlias(userID string, folderID uint, path string) (*models.FolderAlias, error) {
+	path = NormalizeFolderPath(path)
+	if path == "" {
+		return nil, ErrInvalidFolderPath
+	}
+
+	alias := &models.FolderAlias{FolderID: folderID, UserID: userID, Path: path}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND path = ?", userID, path).Delete(&models.FolderAlias{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(alias).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alias, nil
+}
+
+// ListFolderAliases returns the folder's aliases, oldest first
+func (s *folderService) ListFolderAliases(userID string, folderID uint) ([]models.FolderAlias, error) {
+	aliases := []models.FolderAlias{}
+	err := s.db.Where("folder_id = ? AND user_id = ?", folderID, userID).Order("id ASC").Find(&aliases).Error
+	return aliases, err
+}
+
+// RemoveFolderAlias removes an alias and reports whether it existed
+func (s *folderService) RemoveFolderAlias(userID string, folderID, aliasID uint) (bool, error) {
+	result := s.db.Where("id = ? AND folder_id = ? AND user_id = ?", aliasID, folderID, userID).Delete(&models.FolderAlias{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ResolveFolderPath returns the folder at a slash-separated path of folder
+// names, or nil. Current names take precedence; otherwise the longest prefix
+// of the path recorded as an alias is followed and the rest of the path is
+// resolved from the folder it points to, so subfolders of a moved folder keep
+// resolving too.
+func (s *folderService) ResolveFolderPath(userID, path string) (*models.Folder, error) {
+	path = NormalizeFolderPath(path)
+	if path == "" {
+		return nil, ErrInvalidFolderPath
+	}
+	names := strings.Split(path, "/")
+
+	folder, err := s.walkFolderPath(userID, nil, names)
+	if err != nil || folder != nil {
+		return folder, err
+	}
+
+	for i := len(names); i > 0; i-- {
+		var alias models.FolderAlias
+		err := s.db.Where("user_id = ? AND path = ?", userID, strings.Join(names[:i], "/")).
+			Order("id DESC").
+			Limit(1).
+			Find(&alias).Error
+		if err != nil {
+			return nil, err
+		}
+		if alias.ID == 0 {
+			continue
+		}
+
+		aliased, err := s.GetFolderByID(userID, alias.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		if aliased == nil {
+			continue
+		}
+		if folder, err := s.walkFolderPath(userID, aliased, names[i:]); err != nil || folder != nil {
+			return folder, err
+		}
+	}
+	return nil, nil
+}
+
+// walkFolderPath follows folder names down from parent (nil for the root) and
+// returns the folder reached, or nil when a name doesn't match
+func (s *folderService) walkFolderPath(userID string, parent *models.Folder, names []string) (*models.Folder, error) {
+	current := parent
+	for _, name := range names {
+		query := s.db.Where("user_id = ? AND name = ?", userID, name)
+		if current == nil {
+			query = query.Where("parent_id IS NULL")
+		} else {
+			query = query.Where("parent_id = ?", current.ID)
+		}
+
+		var next models.Folder
+		if err := query.Order("id ASC").Limit(1).Find(&next).Error; err != nil {
+			return nil, err
+		}
+		if next.ID == 0 {
+			return nil, nil
+		}
+		current = &next
+	}
+	return current, nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultDeletedFolderRetention is how long a deleted folder can be restored before it is purged
+	DefaultDeletedFolderRetention = 30 * 24 * time.Hour
+	// DefaultFolderPurgeInterval is how often expired folders are looked for
+	DefaultFolderPurgeInterval = time.Hour
+	// FolderPurgerLease names the JobLease that picks the instance purging deleted folders
+	FolderPurgerLease = "folder_purger"
+)
+
+// FolderPurgerConfig holds configuration for the FolderPurger
+type FolderPurgerConfig struct {
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+// FolderPurger permanently removes folders that have been deleted longer than
+// the retention period, along with their files' stored objects
+type FolderPurger struct {
+	folderService FolderService
+	uploadService UploadService
+	lease         *JobLease
+	config        FolderPurgerConfig
+}
+
+// NewFolderPurger creates a new FolderPurger. uploadService may be nil when
+// no storage is configured. lease may be nil; when set, only the instance
+// holding it purges.
+func NewFolderPurger(folderService FolderService, uploadService UploadService, lease *JobLease, config FolderPurgerConfig) *FolderPurger {
+	if config.Retention <= 0 {
+		config.Retention = DefaultDeletedFolderRetention
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultFolderPurgeInterval
+	}
+
+	return &FolderPurger{
+		folderService: folderService,
+		uploadService: uploadService,
+		lease:         lease,
+		config:        config,
+	}
+}
+
+// Purge removes expired folders and deletes their files' objects, returning
+// the number of files removed
+func (p *FolderPurger) Purge(ctx context.Context) (int, error) {
+	if p.lease != nil {
+		held, err := p.lease.Acquire()
+		if err != nil || !held {
+			return 0, err
+		}
+	}
+
+	return p.folderService.PurgeDeletedFolders(time.Now().Add(-p.config.Retention), func(key string) error {
+		if p.uploadService == nil {
+			return nil
+		}
+		return p.uploadService.DeleteFile(ctx, key)
+	})
+}
+
+// Start purges once immediately and then on every interval until ctx is cancelled
+func (p *FolderPurger) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(p.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			if count, err := p.Purge(ctx); err != nil {
+				log.Printf("Failed to purge deleted folders: %v", err)
+			} else if count > 0 {
+				log.Printf("Purged %d file(s) from deleted folders", count)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -2,6 +2,11 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
@@ -16,27 +21,126 @@ type FolderListOptions struct {
 	Offset   int
 }
 
+// FolderTreeSort orders sibling folders in a folder tree
+type FolderTreeSort string
+
+const (
+	FolderTreeSortName      FolderTreeSort = "name"
+	FolderTreeSortFilesDesc FolderTreeSort = "files_desc"
+	FolderTreeSortFilesAsc  FolderTreeSort = "files_asc"
+)
+
+// FolderTreeSorts lists the orders a folder tree can be sorted in
+var FolderTreeSorts = []string{string(FolderTreeSortName), string(FolderTreeSortFilesDesc), string(FolderTreeSortFilesAsc)}
+
+// ValidateFolderTreeSort reports an error naming the valid options when order
+// isn't one of FolderTreeSorts
+func ValidateFolderTreeSort(order FolderTreeSort) error {
+	if !slices.Contains(FolderTreeSorts, string(order)) {
+		return &OptionError{Field: "sort", Message: fmt.Sprintf("sort must be one of %s", strings.Join(FolderTreeSorts, ", "))}
+	}
+	return nil
+}
+
+// FolderFileCounts holds the number of files in a folder
+type FolderFileCounts struct {
+	Direct int64 // Files directly in the folder
+	Total  int64 // Files in the folder and all of its subfolders
+}
+
+// FolderWithDepth is a descendant folder and how far below the listed folder
+// it is; direct subfolders have depth 1
+type FolderWithDepth struct {
+	Folder models.Folder
+	Depth  int
+}
+
+// DefaultMaxFolderDepth is the default limit on folder nesting
+const DefaultMaxFolderDepth = 20
+
+// FolderConfig holds configuration for the folder service
+type FolderConfig struct {
+	MaxDepth int // Maximum nesting depth; root folders have depth 1
+}
+
+// ErrFolderDepthExceeded is returned when creating or moving a folder would
+// nest it deeper than the configured maximum depth.
+var ErrFolderDepthExceeded = errors.New("maximum folder depth exceeded")
+
+// ErrExcludeDeletedFolder is returned when a delete excludes the folder being deleted
+var ErrExcludeDeletedFolder = errors.New("cannot exclude the folder being deleted")
+
+// ErrFolderNotDeleted is returned when restoring a folder that isn't deleted
+var ErrFolderNotDeleted = errors.New("folder is not deleted")
+
+// ErrParentFolderNotFound is returned when creating a folder under a parent
+// that does not exist or belongs to another user.
+var ErrParentFolderNotFound = errors.New("parent folder not found")
+
 // FolderService handles folder-related operations
 type FolderService interface {
 	CreateFolder(userID string, folder *models.Folder) error
 	GetFolderByID(userID string, id uint) (*models.Folder, error)
 	ListFolders(userID string, opts FolderListOptions) ([]models.Folder, int64, error)
+	ListFolderIDs(userID string, opts FolderListOptions) ([]uint, int64, error)
 	UpdateFolder(userID string, folder *models.Folder) error
-	DeleteFolder(userID string, id uint) error
-	MoveFolder(userID string, folderID uint, newParentID *uint) error
+	DeleteFolder(userID string, id uint, excludeFolderIDs []uint) error
+	RestoreFolder(userID string, id uint) (*models.Folder, error)
+	// PurgeDeletedFolders permanently removes folders soft-deleted before
+	// deletedBefore along with the files in them, calling deleteObject with
+	// each file's storage key first. Returns the number of files removed.
+	PurgeDeletedFolders(deletedBefore time.Time, deleteObject func(key string) error) (int, error)
+	// MoveFolder moves a folder under a new parent and reports whether it
+	// moved; a folder already under newParentID is left untouched
+	MoveFolder(userID string, folderID uint, newParentID *uint) (bool, error)
 	GetFolderTree(userID string, parentID *uint) ([]models.Folder, error)
+	// ExpandFolders returns the direct subfolders of each of the user's
+	// folders in parentIDs, ordered by name. IDs that aren't the user's
+	// folders are absent from the map.
+	ExpandFolders(userID string, parentIDs []uint) (map[uint][]models.Folder, error)
+	// ListDescendants returns every subfolder below the folder as a flat list,
+	// shallowest first
+	ListDescendants(userID string, folderID uint) ([]FolderWithDepth, error)
+	// CountTreeFiles returns the direct and recursive file counts of every folder in the tree
+	CountTreeFiles(userID string, tree []models.Folder) (map[uint]FolderFileCounts, error)
+	// CountChildren returns the number of direct subfolders of each folder;
+	// folders without subfolders are absent from the map
+	CountChildren(userID string, folderIDs []uint) (map[uint]int64, error)
+	// SuggestFoldersForTags returns the folders holding the most files that
+	// carry the given tags, best match first
+	SuggestFoldersForTags(userID string, tagIDs []uint, limit int) ([]FolderSuggestion, error)
 	AddTagsToFolder(userID string, folderID uint, tagIDs []uint) error
 	RemoveTagsFromFolder(userID string, folderID uint, tagIDs []uint) error
 	GetFolderPath(userID string, folderID uint) ([]models.Folder, error)
+	AddFileLinks(userID string, folderID uint, fileIDs []uint) error
+	RemoveFileLinks(userID string, folderID uint, fileIDs []uint) error
+
+	// Sharing operations
+	ShareFolder(ownerID string, folderID uint, memberID string, role models.FolderRole) (*models.FolderMember, error)
+	UnshareFolder(ownerID string, folderID uint, memberID string) error
+	ListFolderMembers(ownerID string, folderID uint) ([]models.FolderMember, error)
+	ListSharedFolders(userID string) ([]SharedFolder, error)
+	ResolveFolderAccess(userID string, folderID uint) (*FolderAccess, error)
+	ResolveFileAccess(userID string, fileID uint) (*FolderAccess, error)
+
+	// Alias operations
+	AddFolderAlias(userID string, folderID uint, path string) (*models.FolderAlias, error)
+	ListFolderAliases(userID string, folderID uint) ([]models.FolderAlias, error)
+	RemoveFolderAlias(userID string, folderID, aliasID uint) (bool, error)
+	ResolveFolderPath(userID, path string) (*models.Folder, error)
 }
 
 type folderService struct {
-	db *gorm.DB
+	db     *gorm.DB
+	config FolderConfig
 }
 
 // NewFolderService creates a new FolderService
-func NewFolderService(db *gorm.DB) FolderService {
-	return &folderService{db: db}
+func NewFolderService(db *gorm.DB, config FolderConfig) FolderService {
+	if config.MaxDepth <= 0 {
+		config.MaxDepth = DefaultMaxFolderDepth
+	}
+	return &folderService{db: db, config: config}
 }
 
 // CreateFolder creates a new folder
@@ -50,17 +154,21 @@ func (s *folderService) CreateFolder(userID string, folder *models.Folder) error
 			return err
 		}
 		if parent == nil {
-			return errors.New("parent folder not found")
+			return ErrParentFolderNotFound
+		}
+		if err := s.checkDepth(userID, parent.ID, 1); err != nil {
+			return err
 		}
 	}
 
 	return s.db.Create(folder).Error
 }
 
-// GetFolderByID retrieves a folder by ID with children and tags
+// GetFolderByID retrieves a folder by ID with its tags. Children aren't
+// loaded; use CountChildren or ListFolders for them.
 func (s *folderService) GetFolderByID(userID string, id uint) (*models.Folder, error) {
 	var folder models.Folder
-	err := s.db.Preload("Tags").Preload("Children").
+	err := s.db.Preload("Tags").
 		Where("id = ? AND user_id = ?", id, userID).
 		First(&folder).Error
 	if err != nil {
@@ -77,6 +185,36 @@ func (s *folderService) ListFolders(userID string, opts FolderListOptions) ([]mo
 	var folders []models.Folder
 	var total int64
 
+	// Count total before pagination
+	if err := s.filteredFoldersQuery(userID, opts).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.pagedFoldersQuery(userID, opts).Preload("Tags").Find(&folders).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return folders, total, nil
+}
+
+// ListFolderIDs returns the IDs of the folders ListFolders would return,
+// selecting only the ID column and skipping tag preloading
+func (s *folderService) ListFolderIDs(userID string, opts FolderListOptions) ([]uint, int64, error) {
+	var total int64
+	if err := s.filteredFoldersQuery(userID, opts).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := []uint{}
+	if err := s.pagedFoldersQuery(userID, opts).Pluck("folders.id", &ids).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
+
+// filteredFoldersQuery builds the base folders query for the given filter options
+func (s *folderService) filteredFoldersQuery(userID string, opts FolderListOptions) *gorm.DB {
 	query := s.db.Model(&models.Folder{}).Where("user_id = ?", userID)
 
 	// Filter by parent ID
@@ -100,27 +238,12 @@ func (s *folderService) ListFolders(userID string, opts FolderListOptions) ([]mo
 			Group("folders.id")
 	}
 
-	// Count total before pagination
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+	return query
+}
 
-	// Reset query for actual results with preloading
-	query = s.db.Model(&models.Folder{}).Where("user_id = ?", userID)
-	if opts.ParentID != nil {
-		query = query.Where("parent_id = ?", *opts.ParentID)
-	} else {
-		query = query.Where("parent_id IS NULL")
-	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("name LIKE ? OR description LIKE ?", searchPattern, searchPattern)
-	}
-	if len(opts.TagIDs) > 0 {
-		query = query.Joins("JOIN folder_tags ON folder_tags.folder_id = folders.id").
-			Where("folder_tags.tag_id IN ?", opts.TagIDs).
-			Group("folders.id")
-	}
+// pagedFoldersQuery applies ordering and pagination to the filtered folders query
+func (s *folderService) pagedFoldersQuery(userID string, opts FolderListOptions) *gorm.DB {
+	query := s.filteredFoldersQuery(userID, opts)
 
 	// Apply pagination
 	if opts.Limit > 0 {
@@ -130,15 +253,13 @@ func (s *folderService) ListFolders(userID string, opts FolderListOptions) ([]mo
 		query = query.Offset(opts.Offset)
 	}
 
-	if err := query.Preload("Tags").Order("name ASC").Find(&folders).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return folders, total, nil
+	return query.Order("name ASC")
 }
 
 // UpdateFolder updates a folder
 func (s *folderService) UpdateFolder(userID string, folder *models.Folder) error {
+	defer markFilesChanged()
+
 	// Verify ownership
 	existing, err := s.GetFolderByID(userID, folder.ID)
 	if err != nil {
@@ -152,13 +273,19 @@ func (s *folderService) UpdateFolder(userID string, folder *models.Folder) error
 	updates := map[string]interface{}{
 		"name":        folder.Name,
 		"description": folder.Description,
+		"s3_prefix":   folder.S3Prefix,
 	}
 
 	return s.db.Model(&models.Folder{}).Where("id = ? AND user_id = ?", folder.ID, userID).Updates(updates).Error
 }
 
-// DeleteFolder deletes a folder and all its contents recursively
-func (s *folderService) DeleteFolder(userID string, id uint) error {
+// DeleteFolder soft-deletes a folder together with its subfolders and files.
+// Subfolders in excludeFolderIDs are kept and moved up to the deleted folder's
+// parent. Everything removed by one call shares the same DeletedAt, which lets
+// RestoreFolder bring back exactly that tree.
+func (s *folderService) DeleteFolder(userID string, id uint, excludeFolderIDs []uint) error {
+	defer markFilesChanged()
+
 	return s.db.Transaction(func(tx *gorm.DB) error {
 		// Get folder to verify ownership
 		var folder models.Folder
@@ -169,94 +296,323 @@ func (s *folderService) DeleteFolder(userID string, id uint) error {
 			return err
 		}
 
-		// Recursively delete children folders
-		var children []models.Folder
-		if err := tx.Where("parent_id = ? AND user_id = ?", id, userID).Find(&children).Error; err != nil {
+		// Detach excluded subtrees so the recursive delete doesn't reach them
+		keep, err := excludedSubtreeRoots(tx, userID, id, excludeFolderIDs)
+		if err != nil {
 			return err
 		}
-		for _, child := range children {
-			if err := s.deleteFolderRecursive(tx, userID, child.ID); err != nil {
+		for _, keepID := range keep {
+			if err := tx.Model(&models.Folder{}).
+				Where("id = ? AND user_id = ?", keepID, userID).
+				Update("parent_id", folder.ParentID).Error; err != nil {
 				return err
 			}
 		}
 
-		// Delete files in this folder
-		if err := tx.Where("folder_id = ? AND user_id = ?", id, userID).Delete(&models.File{}).Error; err != nil {
+		folderIDs, err := subtreeFolderIDs(tx, userID, id)
+		if err != nil {
 			return err
 		}
 
-		// Clear folder_tags associations
-		if err := tx.Exec("DELETE FROM folder_tags WHERE folder_id = ?", id).Error; err != nil {
+		// Links and tag associations are kept so a restore brings them back;
+		// queries skip them while the folder or file is deleted
+		deletedAt := time.Now()
+		if err := tx.Model(&models.File{}).
+			Where("folder_id IN ? AND user_id = ?", folderIDs, userID).
+			Update("deleted_at", deletedAt).Error; err != nil {
 			return err
 		}
-
-		// Delete the folder
-		return tx.Delete(&folder).Error
+		return tx.Model(&models.Folder{}).
+			Where("id IN ? AND user_id = ?", folderIDs, userID).
+			Update("deleted_at", deletedAt).Error
 	})
 }
 
-// deleteFolderRecursive is a helper for recursive folder deletion
-func (s *folderService) deleteFolderRecursive(tx *gorm.DB, userID string, folderID uint) error {
-	// Get children
-	var children []models.Folder
-	if err := tx.Where("parent_id = ? AND user_id = ?", folderID, userID).Find(&children).Error; err != nil {
-		return err
+// subtreeFolderIDs returns the folder and all of its live descendants
+func subtreeFolderIDs(tx *gorm.DB, userID string, folderID uint) ([]uint, error) {
+	ids := []uint{folderID}
+	for level := ids; len(level) > 0; {
+		var children []uint
+		if err := tx.Model(&models.Folder{}).
+			Where("parent_id IN ? AND user_id = ?", level, userID).
+			Pluck("id", &children).Error; err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		level = children
 	}
+	return ids, nil
+}
 
-	// Delete children recursively
-	for _, child := range children {
-		if err := s.deleteFolderRecursive(tx, userID, child.ID); err != nil {
+// RestoreFolder revives a soft-deleted folder along with the subfolders and
+// files that were deleted with it. The folder is restored at the root when its
+// parent no longer exists. Returns nil when the folder doesn't exist.
+func (s *folderService) RestoreFolder(userID string, id uint) (*models.Folder, error) {
+	defer markFilesChanged()
+
+	var folder models.Folder
+	if err := s.db.Unscoped().Where("id = ? AND user_id = ?", id, userID).First(&folder).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !folder.DeletedAt.Valid {
+		return nil, ErrFolderNotDeleted
+	}
+	deletedAt := folder.DeletedAt.Time
+
+	// Collect the subfolders deleted in the same call, level by level
+	folderIDs := []uint{folder.ID}
+	height := 0
+	for level := folderIDs; len(level) > 0; {
+		height++
+		var children []models.Folder
+		if err := s.db.Unscoped().Select("id", "deleted_at").
+			Where("parent_id IN ? AND user_id = ? AND deleted_at IS NOT NULL", level, userID).
+			Find(&children).Error; err != nil {
+			return nil, err
+		}
+		level = nil
+		for _, child := range children {
+			if child.DeletedAt.Time.Equal(deletedAt) {
+				level = append(level, child.ID)
+			}
+		}
+		folderIDs = append(folderIDs, level...)
+	}
+
+	if folder.ParentID != nil {
+		parent, err := s.GetFolderByID(userID, *folder.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			folder.ParentID = nil
+		} else if err := s.checkDepth(userID, parent.ID, height); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var files []models.File
+		if err := tx.Unscoped().Select("id", "deleted_at").
+			Where("folder_id IN ? AND user_id = ? AND deleted_at IS NOT NULL", folderIDs, userID).
+			Find(&files).Error; err != nil {
 			return err
 		}
+		var fileIDs []uint
+		for _, file := range files {
+			if file.DeletedAt.Time.Equal(deletedAt) {
+				fileIDs = append(fileIDs, file.ID)
+			}
+		}
+		if len(fileIDs) > 0 {
+			if err := tx.Unscoped().Model(&models.File{}).
+				Where("id IN ?", fileIDs).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Unscoped().Model(&models.Folder{}).
+			Where("id IN ? AND user_id = ?", folderIDs, userID).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Folder{}).
+			Where("id = ?", folder.ID).
+			Update("parent_id", folder.ParentID).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Delete files in this folder
-	if err := tx.Where("folder_id = ? AND user_id = ?", folderID, userID).Delete(&models.File{}).Error; err != nil {
-		return err
+	return s.GetFolderByID(userID, id)
+}
+
+// PurgeDeletedFolders permanently removes folders soft-deleted before
+// deletedBefore, the files in them and everything that refers to either.
+// Objects are deleted while the rows still say which bucket they live in; a
+// failed delete leaves the rows for the next purge to retry.
+func (s *folderService) PurgeDeletedFolders(deletedBefore time.Time, deleteObject func(key string) error) (int, error) {
+	var folderIDs []uint
+	if err := s.db.Unscoped().Model(&models.Folder{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Pluck("id", &folderIDs).Error; err != nil {
+		return 0, err
+	}
+	if len(folderIDs) == 0 {
+		return 0, nil
+	}
+
+	var files []models.File
+	if err := s.db.Unscoped().Select("id", "s3_key").
+		Where("folder_id IN ? AND deleted_at IS NOT NULL", folderIDs).
+		Find(&files).Error; err != nil {
+		return 0, err
+	}
+	fileIDs := make([]uint, len(files))
+	for i, file := range files {
+		if err := deleteObject(file.S3Key); err != nil {
+			return 0, err
+		}
+		fileIDs[i] = file.ID
 	}
 
-	// Clear folder_tags associations
-	if err := tx.Exec("DELETE FROM folder_tags WHERE folder_id = ?", folderID).Error; err != nil {
-		return err
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if len(fileIDs) > 0 {
+			if err := deleteFileRows(tx, fileIDs); err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Where("id IN ?", fileIDs).Delete(&models.File{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("folder_id IN ?", folderIDs).Delete(&models.FileLink{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM folder_tags WHERE folder_id IN ?", folderIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("folder_id IN ?", folderIDs).Delete(&models.FolderMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("folder_id IN ?", folderIDs).Delete(&models.FolderAlias{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("target_folder_id IN ?", folderIDs).Delete(&models.FoldingRule{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id IN ?", folderIDs).Delete(&models.Folder{}).Error
+	})
+	if err != nil {
+		return 0, err
 	}
+	return len(files), nil
+}
 
-	// Delete the folder
-	return tx.Where("id = ? AND user_id = ?", folderID, userID).Delete(&models.Folder{}).Error
+// excludedSubtreeRoots returns the excluded folders that live inside folderID
+// and aren't nested in another excluded folder
+func excludedSubtreeRoots(tx *gorm.DB, userID string, folderID uint, excludeFolderIDs []uint) ([]uint, error) {
+	excluded := make(map[uint]bool, len(excludeFolderIDs))
+	for _, id := range excludeFolderIDs {
+		if id == folderID {
+			return nil, ErrExcludeDeletedFolder
+		}
+		excluded[id] = true
+	}
+
+	var roots []uint
+	for id := range excluded {
+		// Walk up until we reach folderID (inside) or the root (outside)
+		inside, nested := false, false
+		current := id
+		for {
+			var folder models.Folder
+			if err := tx.Select("id", "parent_id").Where("id = ? AND user_id = ?", current, userID).First(&folder).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					break
+				}
+				return nil, err
+			}
+			if folder.ParentID == nil {
+				break
+			}
+			if *folder.ParentID == folderID {
+				inside = true
+				break
+			}
+			if excluded[*folder.ParentID] {
+				nested = true
+			}
+			current = *folder.ParentID
+		}
+
+		if inside && !nested {
+			roots = append(roots, id)
+		}
+	}
+	return roots, nil
 }
 
 // MoveFolder moves a folder to a new parent
-func (s *folderService) MoveFolder(userID string, folderID uint, newParentID *uint) error {
+func (s *folderService) MoveFolder(userID string, folderID uint, newParentID *uint) (bool, error) {
+	defer markFilesChanged()
+
 	// Verify the folder exists and belongs to user
 	folder, err := s.GetFolderByID(userID, folderID)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if folder == nil {
-		return errors.New("folder not found")
+		return false, errors.New("folder not found")
+	}
+
+	// Nothing to do when the folder is already under the new parent
+	if sameFolder(folder.ParentID, newParentID) {
+		return false, nil
 	}
 
 	// Verify new parent exists if specified
 	if newParentID != nil {
 		parent, err := s.GetFolderByID(userID, *newParentID)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if parent == nil {
-			return errors.New("target parent folder not found")
+			return false, errors.New("target parent folder not found")
 		}
 
 		// Prevent moving a folder into itself or its descendants
 		if *newParentID == folderID {
-			return errors.New("cannot move a folder into itself")
+			return false, errors.New("cannot move a folder into itself")
 		}
 		if s.isDescendant(userID, *newParentID, folderID) {
-			return errors.New("cannot move a folder into its descendant")
+			return false, errors.New("cannot move a folder into its descendant")
+		}
+
+		// The whole subtree moves with the folder
+		subtree, err := s.GetFolderTree(userID, &folderID)
+		if err != nil {
+			return false, err
+		}
+		if err := s.checkDepth(userID, *newParentID, 1+folderTreeHeight(subtree)); err != nil {
+			return false, err
 		}
 	}
 
-	return s.db.Model(&models.Folder{}).
+	if err := s.db.Model(&models.Folder{}).
 		Where("id = ? AND user_id = ?", folderID, userID).
-		Update("parent_id", newParentID).Error
+		Update("parent_id", newParentID).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkDepth verifies that placing a subtree of the given height under
+// parentID stays within the maximum folder depth
+func (s *folderService) checkDepth(userID string, parentID uint, height int) error {
+	path, err := s.GetFolderPath(userID, parentID)
+	if err != nil {
+		return err
+	}
+	if len(path)+height > s.config.MaxDepth {
+		return fmt.Errorf("%w: folders can be nested at most %d levels deep", ErrFolderDepthExceeded, s.config.MaxDepth)
+	}
+	return nil
+}
+
+// folderTreeHeight returns the number of levels in a folder tree
+func folderTreeHeight(folders []models.Folder) int {
+	height := 0
+	for _, folder := range folders {
+		if h := 1 + folderTreeHeight(folder.Children); h > height {
+			height = h
+		}
+	}
+	return height
 }
 
 // isDescendant checks if potentialDescendant is a descendant of folderID
@@ -277,6 +633,37 @@ func (s *folderService) isDescendant(userID string, potentialDescendant, folderI
 	return s.isDescendant(userID, *folder.ParentID, folderID)
 }
 
+// ListDescendants loads the whole subtree in one recursive query. Siblings
+// are ordered by name within each depth.
+func (s *folderService) ListDescendants(userID string, folderID uint) ([]FolderWithDepth, error) {
+	var rows []struct {
+		models.Folder
+		Depth int
+	}
+	err := s.db.Raw(`
+		WITH RECURSIVE descendants(id, depth) AS (
+			SELECT id, 1 FROM folders WHERE parent_id = ? AND user_id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT folders.id, descendants.depth + 1 FROM folders
+			JOIN descendants ON folders.parent_id = descendants.id
+			WHERE folders.user_id = ? AND folders.deleted_at IS NULL
+		)
+		SELECT folders.*, descendants.depth AS depth FROM folders
+		JOIN descendants ON descendants.id = folders.id
+		ORDER BY descendants.depth, folders.name, folders.id`,
+		folderID, userID, userID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	descendants := make([]FolderWithDepth, len(rows))
+	for i, row := range rows {
+		descendants[i] = FolderWithDepth{Folder: row.Folder, Depth: row.Depth}
+	}
+	return descendants, nil
+}
+
 // GetFolderTree gets the folder tree structure starting from a parent
 func (s *folderService) GetFolderTree(userID string, parentID *uint) ([]models.Folder, error) {
 	var folders []models.Folder
@@ -304,6 +691,190 @@ func (s *folderService) GetFolderTree(userID string, parentID *uint) ([]models.F
 	return folders, nil
 }
 
+// CountChildren counts subfolders per parent with one aggregate query
+func (s *folderService) CountChildren(userID string, folderIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(folderIDs))
+	if len(folderIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ParentID uint
+		Count    int64
+	}
+	if err := s.db.Model(&models.Folder{}).
+		Select("parent_id, COUNT(*) AS count").
+		Where("user_id = ? AND parent_id IN ?", userID, folderIDs).
+		Group("parent_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	return counts, nil
+}
+
+// MaxExpandFolders is the most folders one ExpandFolders call should expand
+const MaxExpandFolders = 200
+
+// FolderSuggestion is a folder whose files carry some of the tags passed to
+// SuggestFoldersForTags
+type FolderSuggestion struct {
+	Folder models.Folder
+	// MatchedTags is how many of the tags appear on the folder's files
+	MatchedTags int64
+	// FileCount is how many of the folder's files carry at least one of the tags
+	FileCount int64
+}
+
+// ExpandFolders loads the children of all requested parents with one query,
+// so clients can build large trees one expanded branch at a time
+func (s *folderService) ExpandFolders(userID string, parentIDs []uint) (map[uint][]models.Folder, error) {
+	expanded := make(map[uint][]models.Folder, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return expanded, nil
+	}
+
+	var found []uint
+	if err := s.db.Model(&models.Folder{}).
+		Where("user_id = ? AND id IN ?", userID, parentIDs).
+		Pluck("id", &found).Error; err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return expanded, nil
+	}
+	for _, id := range found {
+		expanded[id] = []models.Folder{}
+	}
+
+	var children []models.Folder
+	if err := s.db.Where("user_id = ? AND parent_id IN ?", userID, found).
+		Order("name ASC, id ASC").
+		Preload("Tags").
+		Find(&children).Error; err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		expanded[*child.ParentID] = append(expanded[*child.ParentID], child)
+	}
+	return expanded, nil
+}
+
+// SuggestFoldersForTags ranks the user's folders by how many of the tags
+// their direct files carry, then by how many files carry them, so folders
+// where the user already files similarly tagged files come first
+func (s *folderService) SuggestFoldersForTags(userID string, tagIDs []uint, limit int) ([]FolderSuggestion, error) {
+	if len(tagIDs) == 0 {
+		return []FolderSuggestion{}, nil
+	}
+
+	var rows []struct {
+		FolderID    uint
+		MatchedTags int64
+		FileCount   int64
+	}
+	if err := s.db.Model(&models.File{}).
+		Select("files.folder_id, COUNT(DISTINCT file_tags.tag_id) AS matched_tags, COUNT(DISTINCT files.id) AS file_count").
+		Joins("JOIN file_tags ON file_tags.file_id = files.id").
+		Joins("JOIN folders ON folders.id = files.folder_id AND folders.deleted_at IS NULL").
+		Where("files.user_id = ? AND file_tags.tag_id IN ?", userID, tagIDs).
+		Group("files.folder_id").
+		Order("matched_tags DESC, file_count DESC, files.folder_id").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []FolderSuggestion{}, nil
+	}
+
+	folderIDs := make([]uint, len(rows))
+	for i, row := range rows {
+		folderIDs[i] = row.FolderID
+	}
+	var folders []models.Folder
+	if err := s.db.Where("user_id = ? AND id IN ?", userID, folderIDs).Find(&folders).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]models.Folder, len(folders))
+	for _, folder := range folders {
+		byID[folder.ID] = folder
+	}
+
+	suggestions := make([]FolderSuggestion, 0, len(rows))
+	for _, row := range rows {
+		folder, ok := byID[row.FolderID]
+		if !ok {
+			continue
+		}
+		suggestions = append(suggestions, FolderSuggestion{
+			Folder:      folder,
+			MatchedTags: row.MatchedTags,
+			FileCount:   row.FileCount,
+		})
+	}
+	return suggestions, nil
+}
+
+// CountTreeFiles counts files per folder with one aggregate query and sums
+// them up the tree for the recursive totals
+func (s *folderService) CountTreeFiles(userID string, tree []models.Folder) (map[uint]FolderFileCounts, error) {
+	var rows []struct {
+		FolderID uint
+		Count    int64
+	}
+	if err := s.db.Model(&models.File{}).
+		Select("folder_id, COUNT(*) AS count").
+		Where("user_id = ? AND folder_id IS NOT NULL", userID).
+		Group("folder_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	direct := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		direct[row.FolderID] = row.Count
+	}
+
+	counts := make(map[uint]FolderFileCounts)
+	var walk func(folders []models.Folder) int64
+	walk = func(folders []models.Folder) int64 {
+		var sum int64
+		for _, folder := range folders {
+			total := direct[folder.ID] + walk(folder.Children)
+			counts[folder.ID] = FolderFileCounts{Direct: direct[folder.ID], Total: total}
+			sum += total
+		}
+		return sum
+	}
+	walk(tree)
+
+	return counts, nil
+}
+
+// SortFolderTree reorders every level of the tree. File count sorts use the
+// recursive total and keep name order between folders with equal counts.
+func SortFolderTree(tree []models.Folder, counts map[uint]FolderFileCounts, order FolderTreeSort) {
+	switch order {
+	case FolderTreeSortFilesDesc:
+		sort.SliceStable(tree, func(i, j int) bool {
+			return counts[tree[i].ID].Total > counts[tree[j].ID].Total
+		})
+	case FolderTreeSortFilesAsc:
+		sort.SliceStable(tree, func(i, j int) bool {
+			return counts[tree[i].ID].Total < counts[tree[j].ID].Total
+		})
+	default:
+		return
+	}
+	for i := range tree {
+		SortFolderTree(tree[i].Children, counts, order)
+	}
+}
+
 // AddTagsToFolder adds tags to a folder
 func (s *folderService) AddTagsToFolder(userID string, folderID uint, tagIDs []uint) error {
 	// Verify folder exists
@@ -365,3 +936,66 @@ func (s *folderService) GetFolderPath(userID string, folderID uint) ([]models.Fo
 
 	return path, nil
 }
+
+// AddFileLinks makes files appear in an additional folder without moving them.
+// Files already linked or already living in the folder are skipped.
+func (s *folderService) AddFileLinks(userID string, folderID uint, fileIDs []uint) error {
+	defer markFilesChanged()
+
+	// Verify folder exists
+	folder, err := s.GetFolderByID(userID, folderID)
+	if err != nil {
+		return err
+	}
+	if folder == nil {
+		return errors.New("folder not found")
+	}
+
+	// Get files and verify they belong to user
+	var files []models.File
+	if err := s.db.Where("id IN ? AND user_id = ?", fileIDs, userID).Find(&files).Error; err != nil {
+		return err
+	}
+
+	var existing []uint
+	if err := s.db.Model(&models.FileLink{}).
+		Where("folder_id = ? AND file_id IN ?", folderID, fileIDs).
+		Pluck("file_id", &existing).Error; err != nil {
+		return err
+	}
+	linked := make(map[uint]bool, len(existing))
+	for _, id := range existing {
+		linked[id] = true
+	}
+
+	var links []models.FileLink
+	for _, file := range files {
+		if linked[file.ID] || (file.FolderID != nil && *file.FolderID == folderID) {
+			continue
+		}
+		linked[file.ID] = true
+		links = append(links, models.FileLink{FileID: file.ID, FolderID: folderID, UserID: userID})
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+	return s.db.Create(&links).Error
+}
+
+// RemoveFileLinks removes files' links from a folder. The files themselves are kept.
+func (s *folderService) RemoveFileLinks(userID string, folderID uint, fileIDs []uint) error {
+	defer markFilesChanged()
+
+	// Verify folder exists
+	folder, err := s.GetFolderByID(userID, folderID)
+	if err != nil {
+		return err
+	}
+	if folder == nil {
+		return errors.New("folder not found")
+	}
+
+	return s.db.Where("folder_id = ? AND user_id = ? AND file_id IN ?", folderID, userID, fileIDs).
+		Delete(&models.FileLink{}).Error
+}
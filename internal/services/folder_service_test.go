@@ -0,0 +1,286 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const folderTestUserID = "folder-test-user"
+
+func newTestFolderService(t *testing.T, maxDepth int) FolderService {
+	return NewFolderService(newTestDB(t), FolderConfig{MaxDepth: maxDepth})
+}
+
+// createFolderChain creates nested folders and returns them from the root down
+func createFolderChain(t *testing.T, service FolderService, names ...string) []*models.Folder {
+	var chain []*models.Folder
+	var parentID *uint
+	for _, name := range names {
+		folder := &models.Folder{Name: name, ParentID: parentID}
+		require.NoError(t, service.CreateFolder(folderTestUserID, folder))
+		chain = append(chain, folder)
+		parentID = &folder.ID
+	}
+	return chain
+}
+
+func TestCreateFolder_MaxDepth(t *testing.T) {
+	service := newTestFolderService(t, 3)
+	chain := createFolderChain(t, service, "a", "b", "c")
+
+	err := service.CreateFolder(folderTestUserID, &models.Folder{Name: "d", ParentID: &chain[2].ID})
+
+	assert.ErrorIs(t, err, ErrFolderDepthExceeded)
+}
+
+func TestMoveFolder_MaxDepthIncludesSubtree(t *testing.T) {
+	service := newTestFolderService(t, 3)
+	target := createFolderChain(t, service, "a", "b")
+	subtree := createFolderChain(t, service, "x", "y")
+
+	// x/y under a/b would be 4 levels deep
+	_, err := service.MoveFolder(folderTestUserID, subtree[0].ID, &target[1].ID)
+	assert.ErrorIs(t, err, ErrFolderDepthExceeded)
+
+	// x/y under a is exactly 3 levels deep
+	moved, err := service.MoveFolder(folderTestUserID, subtree[0].ID, &target[0].ID)
+	assert.NoError(t, err)
+	assert.True(t, moved)
+}
+
+func TestMoveFolder_SameParentIsNoOp(t *testing.T) {
+	service := newTestFolderService(t, 0)
+	chain := createFolderChain(t, service, "a", "b")
+
+	moved, err := service.MoveFolder(folderTestUserID, chain[1].ID, &chain[0].ID)
+	require.NoError(t, err)
+	assert.False(t, moved)
+
+	moved, err = service.MoveFolder(folderTestUserID, chain[0].ID, nil)
+	require.NoError(t, err)
+	assert.False(t, moved)
+}
+
+func TestNewFolderService_DefaultMaxDepth(t *testing.T) {
+	service := newTestFolderService(t, 0)
+
+	names := make([]string, DefaultMaxFolderDepth)
+	for i := range names {
+		names[i] = "level"
+	}
+	chain := createFolderChain(t, service, names...)
+
+	err := service.CreateFolder(folderTestUserID, &models.Folder{Name: "too deep", ParentID: &chain[len(chain)-1].ID})
+	assert.ErrorIs(t, err, ErrFolderDepthExceeded)
+}
+
+func TestDeleteFolder_ExcludeNestedFolders(t *testing.T) {
+	service := newTestFolderService(t, 0)
+	chain := createFolderChain(t, service, "projects", "archive", "2019")
+
+	// Excluding both archive and its child keeps them nested under one another
+	err := service.DeleteFolder(folderTestUserID, chain[0].ID, []uint{chain[2].ID, chain[1].ID})
+	require.NoError(t, err)
+
+	archive, err := service.GetFolderByID(folderTestUserID, chain[1].ID)
+	require.NoError(t, err)
+	require.NotNil(t, archive)
+	assert.Nil(t, archive.ParentID)
+
+	child, err := service.GetFolderByID(folderTestUserID, chain[2].ID)
+	require.NoError(t, err)
+	require.NotNil(t, child)
+	assert.Equal(t, chain[1].ID, *child.ParentID)
+
+	deleted, err := service.GetFolderByID(folderTestUserID, chain[0].ID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func TestRestoreFolder_RestoresTreeDeletedTogether(t *testing.T) {
+	db := newTestDB(t)
+	service := NewFolderService(db, FolderConfig{})
+
+	chain := createFolderChain(t, service, "projects", "2024", "q1")
+	kept := &models.File{UserID: folderTestUserID, Title: "kept", S3Key: "kept", OriginalFilename: "kept.pdf", FolderID: &chain[2].ID}
+	trashed := &models.File{UserID: folderTestUserID, Title: "trashed", S3Key: "trashed", OriginalFilename: "trashed.pdf", FolderID: &chain[2].ID}
+	require.NoError(t, db.Create(kept).Error)
+	require.NoError(t, db.Create(trashed).Error)
+
+	// A file deleted before the folder stays deleted after the restore
+	require.NoError(t, db.Delete(trashed).Error)
+	require.NoError(t, service.DeleteFolder(folderTestUserID, chain[1].ID, nil))
+
+	deleted, err := service.GetFolderByID(folderTestUserID, chain[2].ID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+
+	restored, err := service.RestoreFolder(folderTestUserID, chain[1].ID)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, chain[0].ID, *restored.ParentID)
+
+	child, err := service.GetFolderByID(folderTestUserID, chain[2].ID)
+	require.NoError(t, err)
+	assert.NotNil(t, child)
+
+	var fileIDs []uint
+	require.NoError(t, db.Model(&models.File{}).Where("folder_id = ?", chain[2].ID).Pluck("id", &fileIDs).Error)
+	assert.Equal(t, []uint{kept.ID}, fileIDs)
+
+	_, err = service.RestoreFolder(folderTestUserID, chain[1].ID)
+	assert.ErrorIs(t, err, ErrFolderNotDeleted)
+}
+
+func TestRestoreFolder_MovesToRootWhenParentDeleted(t *testing.T) {
+	service := newTestFolderService(t, 0)
+	chain := createFolderChain(t, service, "projects", "archive")
+
+	require.NoError(t, service.DeleteFolder(folderTestUserID, chain[0].ID, nil))
+
+	restored, err := service.RestoreFolder(folderTestUserID, chain[1].ID)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Nil(t, restored.ParentID)
+
+	missing, err := service.RestoreFolder(folderTestUserID, 9999)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestPurgeDeletedFolders_RemovesExpiredFoldersAndFiles(t *testing.T) {
+	db := newTestDB(t)
+	service := NewFolderService(db, FolderConfig{})
+
+	expired := createFolderChain(t, service, "old", "nested")
+	recent := createFolderChain(t, service, "recent")
+	inside := &models.File{UserID: folderTestUserID, Title: "inside", S3Key: "files/inside", OriginalFilename: "inside.pdf", FolderID: &expired[1].ID}
+	other := &models.File{UserID: folderTestUserID, Title: "other", S3Key: "files/other", OriginalFilename: "other.pdf"}
+	kept := &models.File{UserID: folderTestUserID, Title: "kept", S3Key: "files/kept", OriginalFilename: "kept.pdf", FolderID: &recent[0].ID}
+	for _, file := range []*models.File{inside, other, kept} {
+		require.NoError(t, db.Create(file).Error)
+	}
+	require.NoError(t, db.Create(&models.FileRelation{FileID: other.ID, RelatedFileID: inside.ID, UserID: folderTestUserID}).Error)
+
+	require.NoError(t, service.DeleteFolder(folderTestUserID, expired[0].ID, nil))
+	require.NoError(t, service.DeleteFolder(folderTestUserID, recent[0].ID, nil))
+	require.NoError(t, db.Unscoped().Model(&models.Folder{}).Where("id IN ?", []uint{expired[0].ID, expired[1].ID}).
+		Update("deleted_at", time.Now().Add(-48*time.Hour)).Error)
+
+	var deletedKeys []string
+	purged, err := service.PurgeDeletedFolders(time.Now().Add(-24*time.Hour), func(key string) error {
+		deletedKeys = append(deletedKeys, key)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Equal(t, []string{"files/inside"}, deletedKeys)
+
+	var count int64
+	require.NoError(t, db.Unscoped().Model(&models.File{}).Where("id = ?", inside.ID).Count(&count).Error)
+	assert.Zero(t, count)
+	require.NoError(t, db.Unscoped().Model(&models.Folder{}).Where("id IN ?", []uint{expired[0].ID, expired[1].ID}).Count(&count).Error)
+	assert.Zero(t, count)
+	require.NoError(t, db.Model(&models.FileRelation{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	// The recently deleted folder can still be restored
+	restored, err := service.RestoreFolder(folderTestUserID, recent[0].ID)
+	require.NoError(t, err)
+	assert.NotNil(t, restored)
+
+	// A failed object delete leaves the rows for the next purge
+	require.NoError(t, service.DeleteFolder(folderTestUserID, recent[0].ID, nil))
+	_, err = service.PurgeDeletedFolders(time.Now().Add(time.Hour), func(key string) error {
+		return errors.New("storage unavailable")
+	})
+	assert.Error(t, err)
+	require.NoError(t, db.Unscoped().Model(&models.File{}).Where("id = ?", kept.ID).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestFolderPurger_OnlyLeaseHolderPurges(t *testing.T) {
+	db := newTestDB(t)
+	service := NewFolderService(db, FolderConfig{})
+	config := FolderPurgerConfig{Retention: time.Hour}
+	first := NewFolderPurger(service, nil, NewJobLease(db, FolderPurgerLease, time.Minute), config)
+	second := NewFolderPurger(service, nil, NewJobLease(db, FolderPurgerLease, time.Minute), config)
+
+	// The first purge takes the lease
+	_, err := first.Purge(context.Background())
+	require.NoError(t, err)
+
+	folder := createFolderChain(t, service, "old")[0]
+	require.NoError(t, db.Create(&models.File{UserID: folderTestUserID, Title: "inside", S3Key: "files/inside", OriginalFilename: "inside.pdf", FolderID: &folder.ID}).Error)
+	require.NoError(t, service.DeleteFolder(folderTestUserID, folder.ID, nil))
+	require.NoError(t, db.Unscoped().Model(&models.Folder{}).Where("id = ?", folder.ID).
+		Update("deleted_at", time.Now().Add(-2*time.Hour)).Error)
+
+	purged, err := second.Purge(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+
+	purged, err = first.Purge(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
+
+func TestListDescendants_FlatWithDepth(t *testing.T) {
+	service := newTestFolderService(t, 0)
+	chain := createFolderChain(t, service, "root", "b", "deep")
+	sibling := &models.Folder{Name: "a", ParentID: &chain[0].ID}
+	require.NoError(t, service.CreateFolder(folderTestUserID, sibling))
+	deleted := &models.Folder{Name: "gone", ParentID: &chain[0].ID}
+	require.NoError(t, service.CreateFolder(folderTestUserID, deleted))
+	require.NoError(t, service.DeleteFolder(folderTestUserID, deleted.ID, nil))
+
+	descendants, err := service.ListDescendants(folderTestUserID, chain[0].ID)
+	require.NoError(t, err)
+
+	var names []string
+	var depths []int
+	for _, d := range descendants {
+		names = append(names, d.Folder.Name)
+		depths = append(depths, d.Depth)
+	}
+	assert.Equal(t, []string{"a", "b", "deep"}, names)
+	assert.Equal(t, []int{1, 1, 2}, depths)
+	assert.Equal(t, chain[1].ID, *descendants[2].Folder.ParentID)
+
+	// Another user's folder has no descendants for this user
+	descendants, err = service.ListDescendants("someone-else", chain[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, descendants)
+}
+
+func TestUpdateFolder_InvalidatesSearchCache(t *testing.T) {
+	service := newTestFolderService(t, 0)
+	folder := createFolderChain(t, service, "projects")[0]
+	cache := NewSearchCache(2, time.Minute)
+	cache.Set("a", CachedSearch{Total: 1})
+
+	require.NoError(t, service.UpdateFolder(folderTestUserID, &models.Folder{ID: folder.ID, Name: "archive"}))
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestMoveFolder_InvalidatesSearchCache(t *testing.T) {
+	service := newTestFolderService(t, 0)
+	chain := createFolderChain(t, service, "projects", "archive")
+	cache := NewSearchCache(2, time.Minute)
+	cache.Set("a", CachedSearch{Total: 1})
+
+	moved, err := service.MoveFolder(folderTestUserID, chain[1].ID, nil)
+	require.NoError(t, err)
+	assert.True(t, moved)
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrInvalidFolderRole is returned when sharing a folder with a role other
+	// than read or write
+	ErrInvalidFolderRole = errors.New("role must be read or write")
+	// ErrShareWithOwner is returned when a user shares a folder with themselves
+	ErrShareWithOwner = errors.New("cannot share a folder with its owner")
+)
+
+// FolderAccess describes how a user may access a folder
+type FolderAccess struct {
+	OwnerID string // User whose data the folder lives in
+	Role    models.FolderRole
+}
+
+// CanWrite reports whether the access allows changing the folder's contents
+func (a *FolderAccess) CanWrite() bool {
+	return a.Role == models.FolderRoleOwner || a.Role == models.FolderRoleWrite
+}
+
+// ShareFolder grants memberID access to the owner's folder and its subtree, or
+// updates the role of an existing member. Returns nil when the folder doesn't
+// exist.
+func (s *folderService) ShareFolder(ownerID string, folderID uint, memberID string, role models.FolderRole) (*models.FolderMember, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidFolderRole
+	}
+	if memberID == ownerID {
+		return nil, ErrShareWithOwner
+	}
+
+	folder, err := s.GetFolderByID(ownerID, folderID)
+	if err != nil || folder == nil {
+		return nil, err
+	}
+
+	member := models.FolderMember{FolderID: folderID, UserID: memberID, Role: role}
+	err = s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "folder_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(&member).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Where("folder_id = ? AND user_id = ?", folderID, memberID).First(&member).Error; err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// UnshareFolder revokes a member's access to the owner's folder
+func (s *folderService) UnshareFolder(ownerID string, folderID uint, memberID string) error {
+	folder, err := s.GetFolderByID(ownerID, folderID)
+	if err != nil {
+		return err
+	}
+	if folder == nil {
+		return errors.New("folder not found")
+	}
+
+	return s.db.Where("folder_id = ? AND user_id = ?", folderID, memberID).
+		Delete(&models.FolderMember{}).Error
+}
+
+// ListFolderMembers returns the users the owner's folder is shared with.
+// Returns nil when the folder doesn't exist.
+func (s *folderService) ListFolderMembers(ownerID string, folderID uint) ([]models.FolderMember, error) {
+	folder, err := s.GetFolderByID(ownerID, folderID)
+	if err != nil || folder == nil {
+		return nil, err
+	}
+
+	members := []models.FolderMember{}
+	err = s.db.Where("folder_id = ?", folderID).Order("created_at ASC").Find(&members).Error
+	return members, err
+}
+
+// SharedFolder is a folder another user shared, with the role granted
+type SharedFolder struct {
+	Folder models.Folder
+	Role   models.FolderRole
+}
+
+// ListSharedFolders returns the folders other users shared with userID
+func (s *folderService) ListSharedFolders(userID string) ([]SharedFolder, error) {
+	var members []models.FolderMember
+	if err := s.db.Where("user_id = ?", userID).Find(&members).Error; err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return []SharedFolder{}, nil
+	}
+
+	roles := make(map[uint]models.FolderRole, len(members))
+	folderIDs := make([]uint, len(members))
+	for i, member := range members {
+		roles[member.FolderID] = member.Role
+		folderIDs[i] = member.FolderID
+	}
+
+	var folders []models.Folder
+	if err := s.db.Preload("Tags").Where("id IN ?", folderIDs).Order("name ASC").Find(&folders).Error; err != nil {
+		return nil, err
+	}
+
+	shared := make([]SharedFolder, len(folders))
+	for i, folder := range folders {
+		shared[i] = SharedFolder{Folder: folder, Role: roles[folder.ID]}
+	}
+	return shared, nil
+}
+
+// ResolveFolderAccess reports how userID may access a folder: as its owner, or
+// through a share on the folder or any of its ancestors. Returns nil when the
+// user has no access or the folder doesn't exist.
+func (s *folderService) ResolveFolderAccess(userID string, folderID uint) (*FolderAccess, error) {
+	var folder models.Folder
+	if err := s.db.Select("id", "user_id", "parent_id").Where("id = ?", folderID).Limit(1).Find(&folder).Error; err != nil {
+		return nil, err
+	}
+	if folder.ID == 0 {
+		return nil, nil
+	}
+	if folder.UserID == userID {
+		return &FolderAccess{OwnerID: userID, Role: models.FolderRoleOwner}, nil
+	}
+
+	// A share on any ancestor covers the whole subtree
+	ancestorIDs := []uint{folder.ID}
+	for parentID := folder.ParentID; parentID != nil; {
+		var parent models.Folder
+		if err := s.db.Select("id", "parent_id").
+			Where("id = ? AND user_id = ?", *parentID, folder.UserID).
+			Limit(1).Find(&parent).Error; err != nil {
+			return nil, err
+		}
+		if parent.ID == 0 {
+			break
+		}
+		ancestorIDs = append(ancestorIDs, parent.ID)
+		parentID = parent.ParentID
+	}
+
+	var roles []models.FolderRole
+	if err := s.db.Model(&models.FolderMember{}).
+		Where("folder_id IN ? AND user_id = ?", ancestorIDs, userID).
+		Pluck("role", &roles).Error; err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	access := &FolderAccess{OwnerID: folder.UserID, Role: models.FolderRoleRead}
+	for _, role := range roles {
+		if role == models.FolderRoleWrite {
+			access.Role = models.FolderRoleWrite
+		}
+	}
+	return access, nil
+}
+
+// ResolveFileAccess reports how userID may access a file. Files of other
+// users are accessible through a share on the folder they live in.
+func (s *folderService) ResolveFileAccess(userID string, fileID uint) (*FolderAccess, error) {
+	var file models.File
+	if err := s.db.Select("id", "user_id", "folder_id").Where("id = ?", fileID).Limit(1).Find(&file).Error; err != nil {
+		return nil, err
+	}
+	if file.ID == 0 {
+		return nil, nil
+	}
+	if file.UserID == userID {
+		return &FolderAccess{OwnerID: userID, Role: models.FolderRoleOwner}, nil
+	}
+	if file.FolderID == nil {
+		return nil, nil
+	}
+
+	access, err := s.ResolveFolderAccess(userID, *file.FolderID)
+	if err != nil || access == nil || access.OwnerID != file.UserID {
+		return nil, err
+	}
+	return access, nil
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// JobLease lets a single server instance at a time run a background job. The
+// instance holding the lease keeps it by acquiring it again before the TTL
+// runs out; once it stops, another instance takes over.
+type JobLease struct {
+	db     *gorm.DB
+	name   string
+	holder string
+	ttl    time.Duration
+}
+
+// NewJobLease creates a lease on the named job for this instance
+func NewJobLease(db *gorm.DB, name string, ttl time.Duration) *JobLease {
+	return &JobLease{
+		db:     db,
+		name:   name,
+		holder: uuid.NewString(),
+		ttl:    ttl,
+	}
+}
+
+// Acquire takes or renews the lease and reports whether this instance holds it
+func (l *JobLease) Acquire() (bool, error) {
+	if err := l.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.JobLease{Name: l.name}).Error; err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	result := l.db.Model(&models.JobLease{}).
+		Where("name = ? AND (holder = ? OR expires_at < ?)", l.name, l.holder, now).
+		Updates(map[string]any{"holder": l.holder, "expires_at": now.Add(l.ttl)})
+	return result.RowsAffected == 1, result.Error
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobLease_OneHolderAtATime(t *testing.T) {
+	db := newTestDB(t)
+	first := NewJobLease(db, "sweep", time.Minute)
+	second := NewJobLease(db, "sweep", time.Minute)
+
+	held, err := first.Acquire()
+	require.NoError(t, err)
+	assert.True(t, held)
+	held, err = second.Acquire()
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	// The holder renews its own lease
+	held, err = first.Acquire()
+	require.NoError(t, err)
+	assert.True(t, held)
+
+	// An expired lease is taken over
+	require.NoError(t, db.Model(&models.JobLease{}).Where("name = ?", "sweep").
+		Update("expires_at", time.Now().Add(-time.Second)).Error)
+	held, err = second.Acquire()
+	require.NoError(t, err)
+	assert.True(t, held)
+	held, err = first.Acquire()
+	require.NoError(t, err)
+	assert.False(t, held)
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// DefaultParserJobTTL is how long an async content parser has to call back
+// before its job expires
+const DefaultParserJobTTL = DefaultProcessingTimeout
+
+// ParserJobService persists processing runs that are waiting on an async
+// content parser, keyed by the job token the parser calls back with
+type ParserJobService interface {
+	// CreateJob records a run for the file with a new token, replacing any
+	// job the file already had
+	CreateJob(userID string, fileID uint, summaryModel, agentModel string) (*models.ParserJob, error)
+	// ClaimJob removes and returns the job with the given token, or nil if
+	// there is none or it has expired. A token can be claimed only once.
+	ClaimJob(token string) (*models.ParserJob, error)
+	// ExpireJobs removes the jobs that expired before now and fails their
+	// files that are still processing, returning how many files it failed
+	ExpireJobs(now time.Time) (int64, error)
+}
+
+type parserJobService struct {
+	db  *gorm.DB
+	ttl time.Duration
+}
+
+// NewParserJobService creates a new ParserJobService whose jobs expire after
+// ttl (0 = DefaultParserJobTTL)
+func NewParserJobService(db *gorm.DB, ttl time.Duration) ParserJobService {
+	if ttl <= 0 {
+		ttl = DefaultParserJobTTL
+	}
+	return &parserJobService{db: db, ttl: ttl}
+}
+
+func (s *parserJobService) CreateJob(userID string, fileID uint, summaryModel, agentModel string) (*models.ParserJob, error) {
+	job := &models.ParserJob{
+		Token:        uuid.NewString(),
+		UserID:       userID,
+		FileID:       fileID,
+		SummaryModel: summaryModel,
+		AgentModel:   agentModel,
+		ExpiresAt:    time.Now().Add(s.ttl),
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("file_id = ?", fileID).Delete(&models.ParserJob{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(job).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *parserJobService) ClaimJob(token string) (*models.ParserJob, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var job models.ParserJob
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("token = ? AND expires_at > ?", token, time.Now()).First(&job).Error; err != nil {
+			return err
+		}
+		// Only the caller whose delete removes the row owns the job
+		result := tx.Delete(&models.ParserJob{}, job.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *parserJobService) ExpireJobs(now time.Time) (int64, error) {
+	var failed int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var jobs []models.ParserJob
+		if err := tx.Select("id", "file_id").Where("expires_at <= ?", now).Find(&jobs).Error; err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		jobIDs := make([]uint, len(jobs))
+		fileIDs := make([]uint, len(jobs))
+		for i, job := range jobs {
+			jobIDs[i] = job.ID
+			fileIDs[i] = job.FileID
+		}
+		if err := tx.Delete(&models.ParserJob{}, jobIDs).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.File{}).
+			Where("id IN ? AND processing_status = ?", fileIDs, models.FileStatusProcessing).
+			Updates(map[string]any{
+				"processing_status":   models.FileStatusFailed,
+				"processing_error":    "The content parser did not respond in time; trigger processing again to retry",
+				"processing_ended_at": now,
+			})
+		failed = result.RowsAffected
+		return result.Error
+	})
+	if failed > 0 {
+		markFilesChanged()
+	}
+	return failed, err
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParserJobService_ClaimJobOnce(t *testing.T) {
+	jobService := NewParserJobService(newTestDB(t), 0)
+
+	job, err := jobService.CreateJob("user-1", 7, "summary-model", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, job.Token)
+
+	claimed, err := jobService.ClaimJob(job.Token)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, "user-1", claimed.UserID)
+	assert.Equal(t, uint(7), claimed.FileID)
+	assert.Equal(t, "summary-model", claimed.SummaryModel)
+
+	claimed, err = jobService.ClaimJob(job.Token)
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+
+	claimed, err = jobService.ClaimJob("")
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+}
+
+func TestParserJobService_CreateJobReplacesPrevious(t *testing.T) {
+	jobService := NewParserJobService(newTestDB(t), 0)
+
+	first, err := jobService.CreateJob("user-1", 7, "", "")
+	require.NoError(t, err)
+	second, err := jobService.CreateJob("user-1", 7, "", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Token, second.Token)
+
+	// The superseded run's callback is rejected
+	claimed, err := jobService.ClaimJob(first.Token)
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+
+	claimed, err = jobService.ClaimJob(second.Token)
+	require.NoError(t, err)
+	assert.NotNil(t, claimed)
+}
+
+func TestParserJobService_ExpiredJobsFailTheirFiles(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+	jobService := NewParserJobService(db, time.Minute)
+
+	waiting := &models.File{Title: "waiting", S3Key: "waiting.pdf", OriginalFilename: "waiting.pdf"}
+	done := &models.File{Title: "done", S3Key: "done.pdf", OriginalFilename: "done.pdf"}
+	for _, file := range []*models.File{waiting, done} {
+		require.NoError(t, fileService.CreateFile("user-1", file))
+		require.NoError(t, fileService.UpdateFileProcessingStatus("user-1", file.ID, models.FileStatusProcessing, ""))
+	}
+	waitingJob, err := jobService.CreateJob("user-1", waiting.ID, "", "")
+	require.NoError(t, err)
+	_, err = jobService.CreateJob("user-1", done.ID, "", "")
+	require.NoError(t, err)
+	require.NoError(t, fileService.UpdateFileProcessingStatus("user-1", done.ID, models.FileStatusCompleted, ""))
+
+	// Nothing has expired yet
+	count, err := jobService.ExpireJobs(time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = jobService.ExpireJobs(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	got, err := fileService.GetFileByID("user-1", waiting.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusFailed, got.ProcessingStatus)
+	assert.NotEmpty(t, got.ProcessingError)
+
+	got, err = fileService.GetFileByID("user-1", done.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusCompleted, got.ProcessingStatus)
+
+	var remaining int64
+	require.NoError(t, db.Model(&models.ParserJob{}).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+
+	// A late callback for the expired job is rejected
+	claimed, err := jobService.ClaimJob(waitingJob.Token)
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+}
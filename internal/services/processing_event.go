@@ -3,8 +3,8 @@ package services
 // ProcessingEvent represents a real-time status update during file processing
 // This unified event type can represent events from different sources (content parsing, invoice, agent)
 type ProcessingEvent struct {
-	Type    string      `json:"type"`              // "status", "tool_call", "tool_result", "thinking", "result", "error", "invoice", "complete"
-	Source  string      `json:"source"`            // "system", "invoice", "agent" - identifies which service emitted the event
+	Type    string      `json:"type"`              // "status", "tool_call", "tool_result", "tool_error", "thinking", "result", "error", "invoice", "complete"
+	Source  string      `json:"source"`            // "system", "invoice", "agent", "auto_tag" - identifies which service emitted the event
 	Message string      `json:"message"`           // Human-readable status message
 	Data    interface{} `json:"data,omitempty"`    // Optional additional data
 	Tool    string      `json:"tool,omitempty"`    // Tool name if type is tool_call
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// processingPauseID is the ID of the single ProcessingPause row
+const processingPauseID = 1
+
+// DefaultProcessingGatePollInterval is how often held runs check whether
+// another instance has resumed processing
+const DefaultProcessingGatePollInterval = 5 * time.Second
+
+// ProcessingGate lets operators pause file processing, e.g. during an AI
+// gateway incident. Processing runs started while the gate is paused are
+// accepted and wait in Wait until it is resumed. The state is stored in the
+// database, so a pause applies to every server instance and survives restarts.
+type ProcessingGate struct {
+	db *gorm.DB
+	// pollInterval is how often Wait re-reads the state; replaced in tests
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// resumed is closed when this instance resumes processing, so its own
+	// held runs don't wait for the next poll
+	resumed chan struct{}
+	waiting int
+}
+
+// ProcessingGateState is a snapshot of a ProcessingGate
+type ProcessingGateState struct {
+	Paused bool
+	// Waiting is the number of processing runs held by the pause on this instance
+	Waiting int
+}
+
+// NewProcessingGate creates a ProcessingGate whose state is stored in db
+func NewProcessingGate(db *gorm.DB) *ProcessingGate {
+	return &ProcessingGate{
+		db:           db,
+		pollInterval: DefaultProcessingGatePollInterval,
+		resumed:      make(chan struct{}),
+	}
+}
+
+// load returns the stored pause state, which is open when nothing is stored
+func (g *ProcessingGate) load() (models.ProcessingPause, error) {
+	pause := models.ProcessingPause{ID: processingPauseID}
+	err := g.db.Where("id = ?", processingPauseID).Limit(1).Find(&pause).Error
+	return pause, err
+}
+
+// Pause holds processing runs that start from now on. Pausing a paused gate
+// does nothing.
+func (g *ProcessingGate) Pause() (ProcessingGateState, error) {
+	pause, err := g.load()
+	if err != nil {
+		return ProcessingGateState{}, err
+	}
+	if !pause.Paused {
+		pause.Paused = true
+		if err := g.db.Save(&pause).Error; err != nil {
+			return ProcessingGateState{}, err
+		}
+	}
+	return g.state(true), nil
+}
+
+// Resume releases every waiting processing run. Resuming an open gate does
+// nothing.
+func (g *ProcessingGate) Resume() (ProcessingGateState, error) {
+	pause, err := g.load()
+	if err != nil {
+		return ProcessingGateState{}, err
+	}
+	if pause.Paused {
+		now := time.Now()
+		pause.Paused = false
+		pause.ResumedAt = &now
+		if err := g.db.Save(&pause).Error; err != nil {
+			return ProcessingGateState{}, err
+		}
+	}
+
+	g.mu.Lock()
+	close(g.resumed)
+	g.resumed = make(chan struct{})
+	g.mu.Unlock()
+	return g.state(false), nil
+}
+
+// State returns whether the gate is paused and how many runs it holds
+func (g *ProcessingGate) State() (ProcessingGateState, error) {
+	pause, err := g.load()
+	if err != nil {
+		return ProcessingGateState{}, err
+	}
+	return g.state(pause.Paused), nil
+}
+
+func (g *ProcessingGate) state(paused bool) ProcessingGateState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return ProcessingGateState{Paused: paused, Waiting: g.waiting}
+}
+
+// Wait returns immediately while the gate is open. While it is paused, Wait
+// blocks until the gate is resumed or ctx is done, in which case it returns
+// ctx's error. Runs aren't held when the state can't be read.
+func (g *ProcessingGate) Wait(ctx context.Context) error {
+	if !g.isPaused() {
+		return nil
+	}
+
+	g.mu.Lock()
+	g.waiting++
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		g.waiting--
+		g.mu.Unlock()
+	}()
+
+	ticker := time.NewTicker(g.pollInterval)
+	defer ticker.Stop()
+	for {
+		g.mu.Lock()
+		resumed := g.resumed
+		g.mu.Unlock()
+
+		select {
+		case <-resumed:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if !g.isPaused() {
+			return nil
+		}
+	}
+}
+
+// isPaused reports whether the stored state is paused
+func (g *ProcessingGate) isPaused() bool {
+	pause, err := g.load()
+	if err != nil {
+		log.Printf("Failed to read the processing pause state: %v", err)
+		return false
+	}
+	return pause.Paused
+}
+
+// heldSince reports whether runs may have been held by the gate at any time
+// after since: it is paused now, or was resumed after since
+func (g *ProcessingGate) heldSince(since time.Time) (bool, error) {
+	pause, err := g.load()
+	if err != nil {
+		return false, err
+	}
+	return pause.Paused || (pause.ResumedAt != nil && pause.ResumedAt.After(since)), nil
+}
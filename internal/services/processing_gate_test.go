@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessingGate(t *testing.T) {
+	gate := NewProcessingGate(newTestDB(t))
+	require.NoError(t, gate.Wait(context.Background()))
+
+	_, err := gate.Pause()
+	require.NoError(t, err)
+	state, err := gate.Pause()
+	require.NoError(t, err)
+	assert.True(t, state.Paused)
+
+	released := make(chan error, 1)
+	go func() { released <- gate.Wait(context.Background()) }()
+
+	require.Eventually(t, func() bool {
+		state, err := gate.State()
+		return err == nil && state.Waiting == 1
+	}, time.Second, time.Millisecond)
+	select {
+	case <-released:
+		t.Fatal("Wait returned while the gate was paused")
+	default:
+	}
+
+	state, err = gate.Resume()
+	require.NoError(t, err)
+	assert.False(t, state.Paused)
+	require.NoError(t, <-released)
+	state, err = gate.State()
+	require.NoError(t, err)
+	assert.Equal(t, 0, state.Waiting)
+	_, err = gate.Resume()
+	require.NoError(t, err)
+}
+
+func TestProcessingGate_SharedBetweenInstances(t *testing.T) {
+	db := newTestDB(t)
+	operator := NewProcessingGate(db)
+	worker := NewProcessingGate(db)
+	worker.pollInterval = time.Millisecond
+
+	_, err := operator.Pause()
+	require.NoError(t, err)
+	state, err := worker.State()
+	require.NoError(t, err)
+	assert.True(t, state.Paused)
+
+	released := make(chan error, 1)
+	go func() { released <- worker.Wait(context.Background()) }()
+	require.Eventually(t, func() bool {
+		state, err := worker.State()
+		return err == nil && state.Waiting == 1
+	}, time.Second, time.Millisecond)
+
+	// The worker notices the resume on its next poll
+	_, err = operator.Resume()
+	require.NoError(t, err)
+	select {
+	case err := <-released:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Wait wasn't released by a resume on another instance")
+	}
+
+	// A restarted instance still sees a pause
+	_, err = operator.Pause()
+	require.NoError(t, err)
+	state, err = NewProcessingGate(db).State()
+	require.NoError(t, err)
+	assert.True(t, state.Paused)
+}
+
+func TestProcessingGate_WaitCancelled(t *testing.T) {
+	gate := NewProcessingGate(newTestDB(t))
+	_, err := gate.Pause()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, gate.Wait(ctx), context.Canceled)
+	state, err := gate.State()
+	require.NoError(t, err)
+	assert.Equal(t, 0, state.Waiting)
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultProcessingTimeout is how long a file may stay in processing before it is considered stuck
+	DefaultProcessingTimeout = 30 * time.Minute
+	// DefaultProcessingSweepInterval is how often stuck files are looked for
+	DefaultProcessingSweepInterval = 5 * time.Minute
+	// ProcessingSweeperLease names the JobLease that picks the instance sweeping stuck files
+	ProcessingSweeperLease = "processing_sweeper"
+)
+
+// ProcessingSweeperConfig holds configuration for the ProcessingSweeper
+type ProcessingSweeperConfig struct {
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// ProcessingSweeper recovers files left in the processing state, e.g. after
+// the server stopped while a file was being processed or an async content
+// parser never called back
+type ProcessingSweeper struct {
+	fileService FileService
+	parserJobs  ParserJobService
+	gate        *ProcessingGate
+	lease       *JobLease
+	config      ProcessingSweeperConfig
+}
+
+// NewProcessingSweeper creates a new ProcessingSweeper. parserJobs may be nil;
+// when set, expired parser jobs are removed and their files failed. gate may
+// be nil; when set, files held by a processing pause are not treated as stuck.
+// lease may be nil; when set, only the instance holding it sweeps.
+func NewProcessingSweeper(fileService FileService, parserJobs ParserJobService, gate *ProcessingGate, lease *JobLease, config ProcessingSweeperConfig) *ProcessingSweeper {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultProcessingTimeout
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultProcessingSweepInterval
+	}
+
+	return &ProcessingSweeper{
+		fileService: fileService,
+		parserJobs:  parserJobs,
+		gate:        gate,
+		lease:       lease,
+		config:      config,
+	}
+}
+
+// Sweep marks files whose parser job expired, or that have been processing
+// longer than the timeout, as failed. Timed out files are skipped while
+// processing is paused and for one timeout after it resumes, since held files
+// only start once the pause ends.
+func (s *ProcessingSweeper) Sweep() (int64, error) {
+	if s.lease != nil {
+		held, err := s.lease.Acquire()
+		if err != nil || !held {
+			return 0, err
+		}
+	}
+
+	var expired int64
+	if s.parserJobs != nil {
+		var err error
+		if expired, err = s.parserJobs.ExpireJobs(time.Now()); err != nil {
+			return 0, err
+		}
+	}
+
+	cutoff := time.Now().Add(-s.config.Timeout)
+	if s.gate != nil {
+		held, err := s.gate.heldSince(cutoff)
+		if err != nil || held {
+			return expired, err
+		}
+	}
+	reset, err := s.fileService.ResetStaleProcessingFiles(cutoff)
+	return expired + reset, err
+}
+
+// Start sweeps once immediately and then on every interval until ctx is cancelled
+func (s *ProcessingSweeper) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			if count, err := s.Sweep(); err != nil {
+				log.Printf("Failed to reset stale processing files: %v", err)
+			} else if count > 0 {
+				log.Printf("Reset %d file(s) stuck in processing", count)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
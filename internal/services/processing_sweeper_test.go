@@ -0,0 +1,122 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sweeperTestUserID = "sweeper-test-user"
+
+func TestProcessingSweeper_ResetsStaleFiles(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+
+	stale := &models.File{Title: "stale", S3Key: "stale.pdf", OriginalFilename: "stale.pdf"}
+	fresh := &models.File{Title: "fresh", S3Key: "fresh.pdf", OriginalFilename: "fresh.pdf"}
+	require.NoError(t, fileService.CreateFile(sweeperTestUserID, stale))
+	require.NoError(t, fileService.CreateFile(sweeperTestUserID, fresh))
+	require.NoError(t, fileService.UpdateFileProcessingStatus(sweeperTestUserID, stale.ID, models.FileStatusProcessing, ""))
+	require.NoError(t, fileService.UpdateFileProcessingStatus(sweeperTestUserID, fresh.ID, models.FileStatusProcessing, ""))
+
+	// Pretend the stale file started processing an hour ago
+	require.NoError(t, db.Model(&models.File{}).Where("id = ?", stale.ID).
+		Update("processing_started_at", time.Now().Add(-time.Hour)).Error)
+
+	sweeper := NewProcessingSweeper(fileService, nil, nil, nil, ProcessingSweeperConfig{Timeout: 30 * time.Minute})
+	count, err := sweeper.Sweep()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	got, err := fileService.GetFileByID(sweeperTestUserID, stale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusFailed, got.ProcessingStatus)
+	assert.NotEmpty(t, got.ProcessingError)
+
+	got, err = fileService.GetFileByID(sweeperTestUserID, fresh.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusProcessing, got.ProcessingStatus)
+	assert.NotNil(t, got.ProcessingStartedAt)
+}
+
+func TestProcessingSweeper_LegacyFilesNeedTimeout(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+
+	legacy := &models.File{Title: "legacy", S3Key: "legacy.pdf", OriginalFilename: "legacy.pdf"}
+	recent := &models.File{Title: "recent", S3Key: "recent.pdf", OriginalFilename: "recent.pdf"}
+	require.NoError(t, fileService.CreateFile(sweeperTestUserID, legacy))
+	require.NoError(t, fileService.CreateFile(sweeperTestUserID, recent))
+
+	// Files left in processing before start times were recorded
+	require.NoError(t, db.Exec("UPDATE files SET processing_status = ?, processing_started_at = NULL", models.FileStatusProcessing).Error)
+	require.NoError(t, db.Exec("UPDATE files SET updated_at = ? WHERE id = ?", time.Now().Add(-time.Hour), legacy.ID).Error)
+
+	sweeper := NewProcessingSweeper(fileService, nil, nil, nil, ProcessingSweeperConfig{Timeout: 30 * time.Minute})
+	count, err := sweeper.Sweep()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	got, err := fileService.GetFileByID(sweeperTestUserID, recent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusProcessing, got.ProcessingStatus)
+}
+
+func TestProcessingSweeper_OnlyLeaseHolderSweeps(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+
+	stale := &models.File{Title: "stale", S3Key: "stale.pdf", OriginalFilename: "stale.pdf"}
+	require.NoError(t, fileService.CreateFile(sweeperTestUserID, stale))
+	require.NoError(t, fileService.UpdateFileProcessingStatus(sweeperTestUserID, stale.ID, models.FileStatusProcessing, ""))
+	require.NoError(t, db.Model(&models.File{}).Where("id = ?", stale.ID).
+		Update("processing_started_at", time.Now().Add(-time.Hour)).Error)
+
+	config := ProcessingSweeperConfig{Timeout: 30 * time.Minute}
+	holder := NewJobLease(db, ProcessingSweeperLease, time.Minute)
+	_, err := holder.Acquire()
+	require.NoError(t, err)
+
+	other := NewProcessingSweeper(fileService, nil, nil, NewJobLease(db, ProcessingSweeperLease, time.Minute), config)
+	count, err := other.Sweep()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = NewProcessingSweeper(fileService, nil, nil, holder, config).Sweep()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestProcessingSweeper_SkipsWhilePaused(t *testing.T) {
+	db := newTestDB(t)
+	fileService := NewFileService(db, FileConfig{})
+
+	held := &models.File{Title: "held", S3Key: "held.pdf", OriginalFilename: "held.pdf"}
+	require.NoError(t, fileService.CreateFile(sweeperTestUserID, held))
+	require.NoError(t, fileService.UpdateFileProcessingStatus(sweeperTestUserID, held.ID, models.FileStatusProcessing, ""))
+	require.NoError(t, db.Model(&models.File{}).Where("id = ?", held.ID).
+		Update("processing_started_at", time.Now().Add(-time.Hour)).Error)
+
+	gate := NewProcessingGate(db)
+	sweeper := NewProcessingSweeper(fileService, nil, gate, nil, ProcessingSweeperConfig{Timeout: 30 * time.Minute})
+
+	_, err := gate.Pause()
+	require.NoError(t, err)
+	count, err := sweeper.Sweep()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	// Held files get a full timeout after the resume to finish
+	_, err = gate.Resume()
+	require.NoError(t, err)
+	count, err = sweeper.Sweep()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	got, err := fileService.GetFileByID(sweeperTestUserID, held.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusProcessing, got.ProcessingStatus)
+}
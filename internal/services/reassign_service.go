@@ -0,0 +1,384 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// ReassignResourceType is the kind of resource whose ownership is reassigned
+type ReassignResourceType string
+
+const (
+	ReassignResourceFile   ReassignResourceType = "file"
+	ReassignResourceFolder ReassignResourceType = "folder"
+)
+
+var (
+	// ErrReassignSameUser is returned when the source and target user are the same
+	ErrReassignSameUser = errors.New("from_user and to_user must be different")
+	// ErrReassignInvalidType is returned for an unknown resource type
+	ErrReassignInvalidType = errors.New("resource_type must be file or folder")
+	// ErrReassignNotFound is returned when a resource doesn't exist or isn't owned by from_user
+	ErrReassignNotFound = errors.New("one or more resources were not found for from_user")
+)
+
+// ReassignRequest describes resources to move from one user to another
+type ReassignRequest struct {
+	FromUserID   string
+	ToUserID     string
+	ResourceType ReassignResourceType
+	ResourceIDs  []uint
+}
+
+// ReassignResult reports what was moved to the new owner
+type ReassignResult struct {
+	Folders      int // Folders reassigned, including subfolders
+	Files        int // Files reassigned, including files in reassigned folders
+	TagsCreated  int // Tags created for the new owner to carry over file and folder tags
+	ObjectsMoved int // S3 objects moved to the new owner's key prefix
+}
+
+// ReassignService transfers ownership of files and folders between users
+type ReassignService interface {
+	// Reassign moves the resources, and for folders everything inside them, to
+	// the new owner. Reassigned folders and files whose folder stays behind are
+	// placed at the new owner's root.
+	Reassign(ctx context.Context, req ReassignRequest) (*ReassignResult, error)
+}
+
+type reassignService struct {
+	db            *gorm.DB
+	uploadService UploadService
+}
+
+// NewReassignService creates a new ReassignService
+func NewReassignService(db *gorm.DB, uploadService UploadService) ReassignService {
+	return &reassignService{
+		db:            db,
+		uploadService: uploadService,
+	}
+}
+
+// Reassign transfers ownership. S3 objects under the old owner's prefix are
+// copied first, the database is updated in one transaction and the old objects
+// are removed afterwards, so a failure never leaves files without an object.
+func (s *reassignService) Reassign(ctx context.Context, req ReassignRequest) (*ReassignResult, error) {
+	if req.FromUserID == req.ToUserID {
+		return nil, ErrReassignSameUser
+	}
+
+	folderIDs, fileIDs, err := s.resolveResources(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []models.File
+	if len(fileIDs) > 0 {
+		if err := s.db.Select("id", "s3_key").Where("id IN ?", fileIDs).Find(&files).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// Copy objects to the new owner's prefix
+	newKeys := make(map[string]string)
+	for _, file := range files {
+		newKey, ok := reassignedS3Key(file.S3Key, req.FromUserID, req.ToUserID)
+		if !ok || newKeys[file.S3Key] != "" {
+			continue
+		}
+		if err := s.uploadService.CopyFile(ctx, file.S3Key, newKey); err != nil {
+			s.deleteObjects(ctx, newKeys, false)
+			return nil, err
+		}
+		newKeys[file.S3Key] = newKey
+	}
+
+	result := &ReassignResult{Folders: len(folderIDs), Files: len(fileIDs), ObjectsMoved: len(newKeys)}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		created, err := s.reassignTags(tx, req, folderIDs, fileIDs)
+		if err != nil {
+			return err
+		}
+		result.TagsCreated = created
+		return s.reassignRows(tx, req, folderIDs, fileIDs, newKeys)
+	})
+	if err != nil {
+		s.deleteObjects(ctx, newKeys, false)
+		return nil, err
+	}
+	markFilesChanged()
+
+	s.deleteObjects(ctx, newKeys, true)
+	return result, nil
+}
+
+// resolveResources verifies ownership and expands folders to their subtrees
+func (s *reassignService) resolveResources(req ReassignRequest) ([]uint, []uint, error) {
+	ids := uniqueUints(req.ResourceIDs)
+
+	switch req.ResourceType {
+	case ReassignResourceFile:
+		var count int64
+		if err := s.db.Model(&models.File{}).Where("id IN ? AND user_id = ?", ids, req.FromUserID).Count(&count).Error; err != nil {
+			return nil, nil, err
+		}
+		if int(count) != len(ids) {
+			return nil, nil, ErrReassignNotFound
+		}
+		return nil, ids, nil
+
+	case ReassignResourceFolder:
+		var count int64
+		if err := s.db.Model(&models.Folder{}).Where("id IN ? AND user_id = ?", ids, req.FromUserID).Count(&count).Error; err != nil {
+			return nil, nil, err
+		}
+		if int(count) != len(ids) {
+			return nil, nil, ErrReassignNotFound
+		}
+
+		var folderIDs []uint
+		for _, id := range ids {
+			subtree, err := subtreeFolderIDs(s.db, req.FromUserID, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			folderIDs = append(folderIDs, subtree...)
+		}
+		folderIDs = uniqueUints(folderIDs)
+
+		var fileIDs []uint
+		if err := s.db.Model(&models.File{}).
+			Where("folder_id IN ? AND user_id = ?", folderIDs, req.FromUserID).
+			Pluck("id", &fileIDs).Error; err != nil {
+			return nil, nil, err
+		}
+		return folderIDs, fileIDs, nil
+	}
+
+	return nil, nil, ErrReassignInvalidType
+}
+
+// reassignTags points the moved files and folders at tags owned by the new
+// owner, reusing tags with the same name and creating the missing ones
+func (s *reassignService) reassignTags(tx *gorm.DB, req ReassignRequest, folderIDs, fileIDs []uint) (int, error) {
+	var tagIDs []uint
+	if len(fileIDs) > 0 {
+		var ids []uint
+		if err := tx.Table("file_tags").Where("file_id IN ?", fileIDs).Distinct().Pluck("tag_id", &ids).Error; err != nil {
+			return 0, err
+		}
+		tagIDs = append(tagIDs, ids...)
+	}
+	if len(folderIDs) > 0 {
+		var ids []uint
+		if err := tx.Table("folder_tags").Where("folder_id IN ?", folderIDs).Distinct().Pluck("tag_id", &ids).Error; err != nil {
+			return 0, err
+		}
+		tagIDs = append(tagIDs, ids...)
+	}
+	if len(tagIDs) == 0 {
+		return 0, nil
+	}
+
+	var tags []models.Tag
+	if err := tx.Where("id IN ? AND user_id = ?", uniqueUints(tagIDs), req.FromUserID).Find(&tags).Error; err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, tag := range tags {
+		var target models.Tag
+		if err := tx.Where("user_id = ? AND LOWER(name) = ?", req.ToUserID, strings.ToLower(tag.Name)).
+			Limit(1).Find(&target).Error; err != nil {
+			return 0, err
+		}
+		if target.ID == 0 {
+			target = models.Tag{
+				UserID:      req.ToUserID,
+				Name:        tag.Name,
+				Color:       tag.Color,
+				Description: tag.Description,
+			}
+			if err := tx.Create(&target).Error; err != nil {
+				return 0, err
+			}
+			created++
+		}
+
+		if len(fileIDs) > 0 {
+			if err := tx.Table("file_tags").
+				Where("tag_id = ? AND file_id IN ?", tag.ID, fileIDs).
+				Update("tag_id", target.ID).Error; err != nil {
+				return 0, err
+			}
+		}
+		if len(folderIDs) > 0 {
+			if err := tx.Table("folder_tags").
+				Where("tag_id = ? AND folder_id IN ?", tag.ID, folderIDs).
+				Update("tag_id", target.ID).Error; err != nil {
+				return 0, err
+			}
+		}
+	}
+	return created, nil
+}
+
+// reassignRows moves folders, files, embeddings and file links to the new owner
+func (s *reassignService) reassignRows(tx *gorm.DB, req ReassignRequest, folderIDs, fileIDs []uint, newKeys map[string]string) error {
+	movedFolders := make(map[uint]bool, len(folderIDs))
+	for _, id := range folderIDs {
+		movedFolders[id] = true
+	}
+	movedFiles := make(map[uint]bool, len(fileIDs))
+	for _, id := range fileIDs {
+		movedFiles[id] = true
+	}
+
+	if len(folderIDs) > 0 {
+		var folders []models.Folder
+		if err := tx.Select("id", "parent_id").Where("id IN ?", folderIDs).Find(&folders).Error; err != nil {
+			return err
+		}
+		var roots []uint
+		for _, folder := range folders {
+			if folder.ParentID == nil || !movedFolders[*folder.ParentID] {
+				roots = append(roots, folder.ID)
+			}
+		}
+		if len(roots) > 0 {
+			if err := tx.Model(&models.Folder{}).Where("id IN ?", roots).Update("parent_id", nil).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.Folder{}).Where("id IN ?", folderIDs).Update("user_id", req.ToUserID).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(fileIDs) > 0 {
+		updates := map[string]interface{}{"user_id": req.ToUserID}
+		if req.ResourceType == ReassignResourceFile {
+			// The file's folder stays with the old owner
+			updates["folder_id"] = nil
+		}
+		if err := tx.Model(&models.File{}).Where("id IN ?", fileIDs).Updates(updates).Error; err != nil {
+			return err
+		}
+		for oldKey, newKey := range newKeys {
+			if err := tx.Model(&models.File{}).
+				Where("id IN ? AND s3_key = ?", fileIDs, oldKey).
+				Update("s3_key", newKey).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.FileEmbedding{}).Where("file_id IN ?", fileIDs).Update("user_id", req.ToUserID).Error; err != nil {
+			return err
+		}
+
+		// Relations move when both files move and are removed when they
+		// would cross owners
+		var relations []models.FileRelation
+		if err := tx.Where("file_id IN ? OR related_file_id IN ?", fileIDs, fileIDs).Find(&relations).Error; err != nil {
+			return err
+		}
+		var keepRelations, dropRelations []uint
+		for _, relation := range relations {
+			if movedFiles[relation.FileID] && movedFiles[relation.RelatedFileID] {
+				keepRelations = append(keepRelations, relation.ID)
+			} else {
+				dropRelations = append(dropRelations, relation.ID)
+			}
+		}
+		if len(keepRelations) > 0 {
+			if err := tx.Model(&models.FileRelation{}).Where("id IN ?", keepRelations).Update("user_id", req.ToUserID).Error; err != nil {
+				return err
+			}
+		}
+		if len(dropRelations) > 0 {
+			if err := tx.Where("id IN ?", dropRelations).Delete(&models.FileRelation{}).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	// Links move with the resources when both the file and the folder move,
+	// and are removed when they would cross owners
+	var links []models.FileLink
+	query := tx.Where("user_id = ?", req.FromUserID)
+	switch {
+	case len(fileIDs) > 0 && len(folderIDs) > 0:
+		query = query.Where("file_id IN ? OR folder_id IN ?", fileIDs, folderIDs)
+	case len(fileIDs) > 0:
+		query = query.Where("file_id IN ?", fileIDs)
+	case len(folderIDs) > 0:
+		query = query.Where("folder_id IN ?", folderIDs)
+	default:
+		return nil
+	}
+	if err := query.Find(&links).Error; err != nil {
+		return err
+	}
+
+	var keep, drop []uint
+	for _, link := range links {
+		if movedFiles[link.FileID] && movedFolders[link.FolderID] {
+			keep = append(keep, link.ID)
+		} else {
+			drop = append(drop, link.ID)
+		}
+	}
+	if len(keep) > 0 {
+		if err := tx.Model(&models.FileLink{}).Where("id IN ?", keep).Update("user_id", req.ToUserID).Error; err != nil {
+			return err
+		}
+	}
+	if len(drop) > 0 {
+		return tx.Where("id IN ?", drop).Delete(&models.FileLink{}).Error
+	}
+	return nil
+}
+
+// deleteObjects removes either the copied or the original objects (best effort).
+// Originals still referenced by another file, including deleted ones, are kept.
+func (s *reassignService) deleteObjects(ctx context.Context, newKeys map[string]string, originals bool) {
+	for oldKey, newKey := range newKeys {
+		key := newKey
+		if originals {
+			key = oldKey
+			var count int64
+			if err := s.db.Unscoped().Model(&models.File{}).Where("s3_key = ?", oldKey).Count(&count).Error; err != nil || count > 0 {
+				continue
+			}
+		}
+		if err := s.uploadService.DeleteFile(ctx, key); err != nil {
+			log.Printf("[Reassign] Failed to delete object %s: %v", key, err)
+		}
+	}
+}
+
+// reassignedS3Key rewrites a user-scoped key ("files/<user>/...") to the new owner
+func reassignedS3Key(key, fromUserID, toUserID string) (string, bool) {
+	prefix := "files/" + fromUserID + "/"
+	if !strings.HasPrefix(key, prefix) {
+		return "", false
+	}
+	return "files/" + toUserID + "/" + strings.TrimPrefix(key, prefix), true
+}
+
+// uniqueUints returns ids without duplicates, keeping the first occurrence
+func uniqueUints(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// ReembedStatus represents the state of a re-embedding job
+type ReembedStatus string
+
+const (
+	ReembedStatusRunning   ReembedStatus = "running"
+	ReembedStatusCompleted ReembedStatus = "completed"
+)
+
+// ErrReembedInProgress is returned when a re-embedding job is already running for the user
+var ErrReembedInProgress = errors.New("re-embedding is already in progress")
+
+// ReembedJob tracks the progress of re-generating embeddings with the active model
+type ReembedJob struct {
+	Status      ReembedStatus
+	Model       string
+	Dimensions  int
+	Total       int
+	Processed   int
+	Failed      int
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// ReembedService re-generates file embeddings after the embedding model changes
+type ReembedService interface {
+	// StartReembed starts re-embedding all completed files whose embedding was not
+	// produced by the active model. The work runs in the background.
+	StartReembed(userID string) (*ReembedJob, error)
+
+	// GetReembedStatus returns the latest re-embedding job for the user, or nil if none has run
+	GetReembedStatus(userID string) *ReembedJob
+}
+
+type reembedService struct {
+	db               *gorm.DB
+	fileService      FileService
+	embeddingService EmbeddingService
+
+	mu   sync.Mutex
+	jobs map[string]*ReembedJob
+}
+
+// NewReembedService creates a new ReembedService
+func NewReembedService(db *gorm.DB, fileService FileService, embeddingService EmbeddingService) ReembedService {
+	return &reembedService{
+		db:               db,
+		fileService:      fileService,
+		embeddingService: embeddingService,
+		jobs:             make(map[string]*ReembedJob),
+	}
+}
+
+// StartReembed starts a background re-embedding job for the user
+func (s *reembedService) StartReembed(userID string) (*ReembedJob, error) {
+	model, dimensions := s.embeddingService.ActiveModel()
+
+	// Files whose stored vector was not produced by the active model
+	upToDate := s.db.Model(&models.FileEmbedding{}).
+		Select("file_id").
+		Where("user_id = ? AND model = ?", userID, model)
+	if dimensions > 0 {
+		upToDate = upToDate.Where("dimensions = ?", dimensions)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if job, ok := s.jobs[userID]; ok && job.Status == ReembedStatusRunning {
+		return nil, ErrReembedInProgress
+	}
+
+	var fileIDs []uint
+	err := s.db.Model(&models.File{}).
+		Where("user_id = ? AND processing_status = ? AND content <> ''", userID, models.FileStatusCompleted).
+		Where("id NOT IN (?)", upToDate).
+		Order("id ASC").
+		Pluck("id", &fileIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	job := &ReembedJob{
+		Status:     ReembedStatusRunning,
+		Model:      model,
+		Dimensions: dimensions,
+		Total:      len(fileIDs),
+		StartedAt:  time.Now(),
+	}
+	s.jobs[userID] = job
+
+	go s.run(userID, job, fileIDs)
+
+	snapshot := *job
+	return &snapshot, nil
+}
+
+// GetReembedStatus returns a snapshot of the user's latest re-embedding job
+func (s *reembedService) GetReembedStatus(userID string) *ReembedJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[userID]
+	if !ok {
+		return nil
+	}
+	snapshot := *job
+	return &snapshot
+}
+
+// run re-embeds each file and records progress on the job
+func (s *reembedService) run(userID string, job *ReembedJob, fileIDs []uint) {
+	ctx := context.Background()
+
+	for _, fileID := range fileIDs {
+		err := s.reembedFile(ctx, userID, fileID)
+		if err != nil {
+			log.Printf("[Reembed] Failed to re-embed file %d for user %s: %v", fileID, userID, err)
+		}
+
+		s.mu.Lock()
+		job.Processed++
+		if err != nil {
+			job.Failed++
+		}
+		s.mu.Unlock()
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	job.Status = ReembedStatusCompleted
+	job.CompletedAt = &now
+	s.mu.Unlock()
+
+	log.Printf("[Reembed] Finished re-embedding %d files for user %s (%d failed)", job.Total, userID, job.Failed)
+}
+
+// reembedFile regenerates and stores the embedding for a single file
+func (s *reembedService) reembedFile(ctx context.Context, userID string, fileID uint) error {
+	var file models.File
+	if err := s.db.Select("id", "content").Where("id = ? AND user_id = ?", fileID, userID).First(&file).Error; err != nil {
+		return err
+	}
+
+	embedding, err := s.embeddingService.GenerateEmbedding(ctx, file.Content)
+	if err != nil {
+		return err
+	}
+
+	if err := s.embeddingService.StoreFileEmbedding(userID, fileID, embedding, file.Content); err != nil {
+		return err
+	}
+
+	return s.fileService.SetFileHasEmbedding(userID, fileID, true)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const reembedTestUserID = "reembed-test-user"
+
+func newTestEmbeddingGateway(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [{"embedding": [1, 0, 0], "index": 0}]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func createCompletedTestFile(t *testing.T, db *gorm.DB, title string) *models.File {
+	file := &models.File{
+		UserID:           reembedTestUserID,
+		Title:            title,
+		Content:          "content of " + title,
+		S3Key:            "files/" + title,
+		OriginalFilename: title + ".pdf",
+		ProcessingStatus: models.FileStatusCompleted,
+	}
+	require.NoError(t, db.Create(file).Error)
+	return file
+}
+
+func waitForReembed(t *testing.T, service ReembedService) *ReembedJob {
+	var job *ReembedJob
+	require.Eventually(t, func() bool {
+		job = service.GetReembedStatus(reembedTestUserID)
+		return job != nil && job.Status == ReembedStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func TestStartReembed_StampsActiveModel(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+
+	oldEmbedding := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "old-model"})
+	file := createCompletedTestFile(t, db, "invoice")
+	require.NoError(t, oldEmbedding.StoreFileEmbedding(reembedTestUserID, file.ID, []float32{1, 0, 0}, ""))
+
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "new-model", Dimensions: 3})
+	service := NewReembedService(db, NewFileService(db, FileConfig{}), embeddingService)
+
+	started, err := service.StartReembed(reembedTestUserID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-model", started.Model)
+	assert.Equal(t, 1, started.Total)
+
+	job := waitForReembed(t, service)
+	assert.Equal(t, 1, job.Processed)
+	assert.Equal(t, 0, job.Failed)
+
+	var stored models.FileEmbedding
+	require.NoError(t, db.Where("file_id = ?", file.ID).First(&stored).Error)
+	assert.Equal(t, "new-model", stored.Model)
+	assert.Equal(t, 3, stored.Dimensions)
+
+	// Files already embedded with the active model are skipped
+	again, err := service.StartReembed(reembedTestUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, again.Total)
+}
+
+func TestVectorSearch_IgnoresOtherModels(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+
+	oldEmbedding := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "old-model"})
+	newEmbedding := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "new-model"})
+
+	oldFile := createCompletedTestFile(t, db, "old")
+	newFile := createCompletedTestFile(t, db, "new")
+	require.NoError(t, oldEmbedding.StoreFileEmbedding(reembedTestUserID, oldFile.ID, []float32{1, 0, 0}, ""))
+	require.NoError(t, newEmbedding.StoreFileEmbedding(reembedTestUserID, newFile.ID, []float32{1, 0, 0}, ""))
+
+	results, err := NewSearchService(db, newEmbedding, nil).VectorSearch(context.Background(), reembedTestUserID, "query", SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, newFile.ID, results[0].File.ID)
+}
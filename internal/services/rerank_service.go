@@ -0,0 +1,137 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
+)
+
+// DefaultRerankTopK is how many hybrid search candidates are reranked when
+// RerankConfig.TopK is unset
+const DefaultRerankTopK = 50
+
+// ErrRerankNotConfigured is returned when a search asks for reranking but no
+// reranking model is configured
+var ErrRerankNotConfigured = errors.New("reranking is not configured")
+
+// RerankConfig holds configuration for the reranking model
+type RerankConfig struct {
+	URL    string // Cohere-compatible rerank endpoint, e.g. https://api.cohere.com/v2/rerank
+	APIKey string
+	Model  string // e.g., rerank-v3.5
+	TopK   int    // Candidates sent to the model (0 = DefaultRerankTopK)
+}
+
+// RerankService scores documents against a query with a cross-encoder model
+type RerankService interface {
+	// Rerank returns the model's relevance score for each document, in
+	// document order
+	Rerank(ctx context.Context, query string, documents []string) ([]float64, error)
+	// TopK returns how many of the best candidates should be reranked
+	TopK() int
+}
+
+type rerankService struct {
+	config RerankConfig
+	client *http.Client
+}
+
+// NewRerankService creates a new RerankService, or nil when no endpoint is
+// configured
+func NewRerankService(config RerankConfig) RerankService {
+	if config.URL == "" {
+		return nil
+	}
+	if config.TopK <= 0 {
+		config.TopK = DefaultRerankTopK
+	}
+	return &rerankService{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// rerankRequest is the request body for the rerank API
+type rerankRequest struct {
+	Model     string   `json:"model,omitempty"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	TopN      int      `json:"top_n"`
+}
+
+// rerankResponse is the response from the rerank API
+type rerankResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float64 `json:"relevance_score"`
+	} `json:"results"`
+}
+
+func (s *rerankService) TopK() int {
+	return s.config.TopK
+}
+
+func (s *rerankService) Rerank(ctx context.Context, query string, documents []string) (scores []float64, err error) {
+	if len(documents) == 0 {
+		return []float64{}, nil
+	}
+
+	start := time.Now()
+	defer func() { metrics.ObserveAIRequest(metrics.AIServiceRerank, start, err) }()
+
+	jsonBody, err := json.Marshal(rerankRequest{
+		Model:     s.config.Model,
+		Query:     query,
+		Documents: documents,
+		TopN:      len(documents),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.config.APIKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rerank API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var rerankResp rerankResponse
+	if err := json.Unmarshal(body, &rerankResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Results come back sorted by relevance; documents the model left out
+	// score zero
+	scores = make([]float64, len(documents))
+	for _, result := range rerankResp.Results {
+		if result.Index < 0 || result.Index >= len(documents) {
+			return nil, fmt.Errorf("rerank API returned unknown document index %d", result.Index)
+		}
+		scores[result.Index] = result.RelevanceScore
+	}
+	return scores, nil
+}
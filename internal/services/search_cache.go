@@ -0,0 +1,161 @@
+package services
+
+import (
+	"container/list"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultSearchCacheSize is the number of search results kept in memory
+	DefaultSearchCacheSize = 256
+	// DefaultSearchCacheTTL is how long a cached search result stays valid
+	DefaultSearchCacheTTL = 30 * time.Second
+)
+
+// fileDataVersion is bumped whenever file data that search results depend on
+// changes. Cached searches from an older version are discarded.
+var fileDataVersion atomic.Uint64
+
+// markFilesChanged invalidates all cached search results
+func markFilesChanged() {
+	fileDataVersion.Add(1)
+}
+
+// CachedSearch is a search result stored in the SearchCache
+type CachedSearch struct {
+	Results           []SearchResult
+	Total             int64
+	VectorUnavailable bool // Hybrid search fell back to full-text results
+	RerankUnavailable bool // Hybrid search kept the blended ranking
+}
+
+type searchCacheEntry struct {
+	key       string
+	value     CachedSearch
+	version   uint64
+	expiresAt time.Time
+}
+
+// SearchCache is an in-memory LRU cache of search results with a TTL
+type SearchCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+// NewSearchCache creates a new SearchCache
+func NewSearchCache(capacity int, ttl time.Duration) *SearchCache {
+	return &SearchCache{
+		capacity: capacity,
+		ttl:      ttl,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// SearchCacheKey builds the cache key for a search
+func SearchCacheKey(userID, query, searchType string, opts SearchOptions) string {
+	folderID := ""
+	if opts.FolderID != nil {
+		folderID = fmt.Sprint(*opts.FolderID)
+	}
+	rootFolderID := ""
+	if opts.RootFolderID != nil {
+		rootFolderID = fmt.Sprint(*opts.RootFolderID)
+	}
+
+	tagIDs := make([]string, len(opts.TagIDs))
+	for i, id := range opts.TagIDs {
+		tagIDs[i] = fmt.Sprint(id)
+	}
+	sort.Strings(tagIDs)
+
+	fileTypes := make([]string, len(opts.FileTypes))
+	for i, ft := range opts.FileTypes {
+		fileTypes[i] = string(ft)
+	}
+	sort.Strings(fileTypes)
+
+	boosts := make([]string, 0, len(opts.BoostTagIDs))
+	for id, weight := range opts.BoostTagIDs {
+		boosts = append(boosts, fmt.Sprintf("%d:%g", id, weight))
+	}
+	sort.Strings(boosts)
+
+	return strings.Join([]string{
+		userID,
+		query,
+		searchType,
+		folderID,
+		rootFolderID,
+		strings.Join(tagIDs, ","),
+		strings.Join(fileTypes, ","),
+		fmt.Sprint(opts.TitleOnly),
+		fmt.Sprint(opts.IncludeFolderName),
+		strings.Join(boosts, ","),
+		fmt.Sprint(opts.snippetLength()),
+		opts.RecencyHalfLife.String(),
+		fmt.Sprint(opts.Rerank),
+		fmt.Sprint(opts.IncludeRawScores),
+		fmt.Sprint(opts.Limit),
+		fmt.Sprint(opts.Offset),
+	}, "\x00")
+}
+
+// Get returns the cached search for the key if it is still fresh
+func (c *SearchCache) Get(key string) (CachedSearch, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return CachedSearch{}, false
+	}
+
+	entry := elem.Value.(*searchCacheEntry)
+	if time.Now().After(entry.expiresAt) || entry.version != fileDataVersion.Load() {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return CachedSearch{}, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set stores a search result, evicting the least recently used entry when full
+func (c *SearchCache) Set(key string, value CachedSearch) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &searchCacheEntry{
+		key:       key,
+		value:     value,
+		version:   fileDataVersion.Load(),
+		expiresAt: time.Now().Add(c.ttl),
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*searchCacheEntry).key)
+	}
+}
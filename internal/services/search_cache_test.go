@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewSearchCache(2, time.Minute)
+	cache.Set("a", CachedSearch{Total: 1})
+	cache.Set("b", CachedSearch{Total: 2})
+
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+
+	cache.Set("c", CachedSearch{Total: 3})
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+}
+
+func TestSearchCache_ExpiresAfterTTL(t *testing.T) {
+	cache := NewSearchCache(2, time.Millisecond)
+	cache.Set("a", CachedSearch{Total: 1})
+
+	time.Sleep(5 * time.Millisecond)
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestSearchCache_InvalidatedByFileChanges(t *testing.T) {
+	cache := NewSearchCache(2, time.Minute)
+	cache.Set("a", CachedSearch{Total: 1})
+
+	markFilesChanged()
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestSearchCacheKey_IgnoresFilterOrder(t *testing.T) {
+	a := SearchCacheKey("user", "invoice", "hybrid", SearchOptions{TagIDs: []uint{1, 2}})
+	b := SearchCacheKey("user", "invoice", "hybrid", SearchOptions{TagIDs: []uint{2, 1}})
+	c := SearchCacheKey("other", "invoice", "hybrid", SearchOptions{TagIDs: []uint{1, 2}})
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
@@ -3,9 +3,14 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"math"
 	"sort"
+	"time"
 
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
 )
@@ -15,17 +20,77 @@ type SearchResult struct {
 	File    models.File `json:"file"`
 	Score   float64     `json:"score"`
 	Snippet string      `json:"snippet,omitempty"`
+	// Components holds the raw scores hybrid search blended into Score, keyed
+	// by ScoreComponentFullText and ScoreComponentVector. Set only with
+	// SearchOptions.IncludeRawScores; a missing key means that search didn't
+	// match the file.
+	Components map[string]float64 `json:"components,omitempty"`
+	// MatchedBy lists the searches that found a hybrid result, in the order
+	// ScoreComponentFullText, ScoreComponentVector. A result matched only by
+	// ScoreComponentVector is a semantic hit without the query text in it.
+	MatchedBy []string `json:"matched_by,omitempty"`
 }
 
+const (
+	// ScoreComponentFullText is the raw full-text relevance score
+	ScoreComponentFullText = "fulltext"
+	// ScoreComponentVector is the raw cosine similarity to the query
+	ScoreComponentVector = "vector"
+)
+
 // SearchOptions contains options for search operations
 type SearchOptions struct {
-	FolderID  *uint
-	TagIDs    []uint
-	FileTypes []models.FileType
-	Limit     int
-	Offset    int
+	FolderID *uint
+	// RootFolderID restricts results to files in this folder and its subfolders
+	RootFolderID *uint
+	TagIDs       []uint
+	FileTypes    []models.FileType
+	TitleOnly    bool // When true, full-text search matches titles only and skips loading content
+	// IncludeFolderName makes full-text search also match the name of the
+	// folder a file is in
+	IncludeFolderName bool
+	// BoostTagIDs multiplies the score of files carrying these tags by the given weight
+	BoostTagIDs map[uint]float64
+	// SnippetLength is the snippet size in characters, bounded by
+	// MinSnippetLength and MaxSnippetLength (0 = DefaultSnippetLength)
+	SnippetLength int
+	// RecencyHalfLife, when set, decays hybrid search scores by file age so a
+	// file's score halves every half-life (0 = no recency boost)
+	RecencyHalfLife time.Duration
+	// Rerank reorders the best hybrid search candidates by the reranking
+	// model's relevance scores, which replace the blended scores
+	Rerank bool
+	// IncludeRawScores attaches the unnormalized full-text and vector scores
+	// to hybrid search results as SearchResult.Components
+	IncludeRawScores bool
+	Limit            int
+	Offset           int
+}
+
+const (
+	// DefaultSnippetLength is the snippet size used when none is requested
+	DefaultSnippetLength = 200
+	// MinSnippetLength is the smallest snippet size a request can ask for
+	MinSnippetLength = 20
+	// MaxSnippetLength is the largest snippet size a request can ask for
+	MaxSnippetLength = 2000
+)
+
+// snippetLength returns the bounded snippet size for the search
+func (opts SearchOptions) snippetLength() int {
+	if opts.SnippetLength <= 0 {
+		return DefaultSnippetLength
+	}
+	return min(max(opts.SnippetLength, MinSnippetLength), MaxSnippetLength)
 }
 
+// ErrEmbeddingUnavailable is returned when the query embedding can't be
+// generated, e.g. because the embedding gateway is down or unset
+var ErrEmbeddingUnavailable = errors.New("failed to generate query embedding")
+
+// DefaultTagBoost is the score multiplier used when a boost tag has no explicit weight
+const DefaultTagBoost = 2.0
+
 // SearchService handles search operations including full-text and vector search
 type SearchService interface {
 	// FullTextSearch searches files using SQL LIKE on title and content
@@ -34,25 +99,64 @@ type SearchService interface {
 	// VectorSearch performs semantic search using embeddings
 	VectorSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error)
 
-	// HybridSearch combines full-text and vector search
-	HybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error)
+	// SimilarFiles ranks the user's other files by similarity to the stored
+	// embedding of fileID. Returns no results when the file has no embedding.
+	SimilarFiles(userID string, fileID uint, opts SearchOptions) ([]SearchResult, error)
+
+	// HybridSearch combines full-text and vector search. When the query
+	// embedding can't be generated or the reranking model fails, it falls back
+	// to the ranking it has and reports which part was unavailable.
+	HybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) (*HybridSearchResult, error)
+}
+
+// HybridSearchResult holds hybrid search results and the parts of the ranking
+// that fell back
+type HybridSearchResult struct {
+	Results           []SearchResult
+	VectorUnavailable bool // The query embedding failed; results are full-text matches only
+	RerankUnavailable bool // The reranking model failed; results keep their blended order
 }
 
 type searchService struct {
 	db               *gorm.DB
 	embeddingService EmbeddingService
+	rerankService    RerankService
 }
 
-// NewSearchService creates a new SearchService
-func NewSearchService(db *gorm.DB, embeddingService EmbeddingService) SearchService {
+// NewSearchService creates a new SearchService. rerankService may be nil when
+// no reranking model is configured.
+func NewSearchService(db *gorm.DB, embeddingService EmbeddingService, rerankService RerankService) SearchService {
 	return &searchService{
 		db:               db,
 		embeddingService: embeddingService,
+		rerankService:    rerankService,
 	}
 }
 
 // FullTextSearch performs a full-text search on files
 func (s *searchService) FullTextSearch(userID string, query string, opts SearchOptions) ([]SearchResult, int64, error) {
+	defer observeSearch("fulltext", time.Now())
+	return s.fullTextSearch(userID, query, opts)
+}
+
+// VectorSearch performs semantic search using embeddings
+func (s *searchService) VectorSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error) {
+	defer observeSearch("semantic", time.Now())
+	return s.vectorSearch(ctx, userID, query, opts)
+}
+
+// HybridSearch combines full-text and vector search
+func (s *searchService) HybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) (*HybridSearchResult, error) {
+	defer observeSearch("hybrid", time.Now())
+	return s.hybridSearch(ctx, userID, query, opts)
+}
+
+// observeSearch records the latency of a search started at start
+func observeSearch(mode string, start time.Time) {
+	metrics.SearchDuration.WithLabelValues(mode).Observe(time.Since(start).Seconds())
+}
+
+func (s *searchService) fullTextSearch(userID string, query string, opts SearchOptions) ([]SearchResult, int64, error) {
 	var files []models.File
 	var total int64
 
@@ -60,16 +164,26 @@ func (s *searchService) FullTextSearch(userID string, query string, opts SearchO
 		Where("user_id = ?", userID).
 		Where("processing_status = ?", models.FileStatusCompleted)
 
-	// Search in title, summary, and content
+	// Search in title, summary, and content (or only title on the fast path)
 	searchPattern := "%" + query + "%"
-	dbQuery = dbQuery.Where(
-		"title LIKE ? OR summary LIKE ? OR content LIKE ?",
-		searchPattern, searchPattern, searchPattern,
-	)
+	var match *gorm.DB
+	if opts.TitleOnly {
+		match = s.db.Where("title LIKE ?", searchPattern)
+	} else {
+		match = s.db.Where(
+			"title LIKE ? OR summary LIKE ? OR content LIKE ? OR "+entityMatchCondition,
+			searchPattern, searchPattern, searchPattern, "$", searchPattern,
+		)
+	}
+	if opts.IncludeFolderName {
+		match = match.Or("folder_id IN (?)", matchingFolderIDs(s.db, userID, searchPattern))
+	}
+	dbQuery = dbQuery.Where(match)
 
 	// Apply filters
-	if opts.FolderID != nil {
-		dbQuery = dbQuery.Where("folder_id = ?", *opts.FolderID)
+	dbQuery, err := s.applyFolderFilters(dbQuery, userID, opts)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	if len(opts.FileTypes) > 0 {
@@ -93,6 +207,10 @@ func (s *searchService) FullTextSearch(userID string, query string, opts SearchO
 		limit = 20
 	}
 
+	if opts.TitleOnly {
+		dbQuery = dbQuery.Omit("content")
+	}
+
 	if err := dbQuery.Preload("Tags").Preload("Folder").
 		Limit(limit).Offset(opts.Offset).
 		Order("updated_at DESC").
@@ -104,13 +222,17 @@ func (s *searchService) FullTextSearch(userID string, query string, opts SearchO
 	results := make([]SearchResult, len(files))
 	for i, file := range files {
 		score := s.calculateFullTextScore(file, query)
-		snippet := s.generateSnippet(file.Content, query, 200)
+		if opts.IncludeFolderName && file.Folder != nil && containsIgnoreCase(file.Folder.Name, query) {
+			score += folderNameMatchScore
+		}
+		snippet := s.generateSnippet(file.Content, query, opts.snippetLength())
 		results[i] = SearchResult{
 			File:    file,
 			Score:   score,
 			Snippet: snippet,
 		}
 	}
+	applyTagBoosts(results, opts.BoostTagIDs)
 
 	// Sort by score descending
 	sort.Slice(results, func(i, j int) bool {
@@ -120,17 +242,53 @@ func (s *searchService) FullTextSearch(userID string, query string, opts SearchO
 	return results, total, nil
 }
 
-// VectorSearch performs semantic search using embeddings
-func (s *searchService) VectorSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error) {
+func (s *searchService) vectorSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error) {
 	// Generate embedding for the query
+	if !s.embeddingService.IsConfigured() {
+		return nil, fmt.Errorf("%w: embedding service is not configured", ErrEmbeddingUnavailable)
+	}
 	queryEmbedding, err := s.embeddingService.GenerateEmbedding(ctx, query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate query embedding: %w", err)
+		return nil, fmt.Errorf("%w: %w", ErrEmbeddingUnavailable, err)
 	}
+	return s.searchByEmbedding(userID, queryEmbedding, 0, opts)
+}
 
-	// Get files with embeddings
+// SimilarFiles ranks the user's other files by similarity to the stored
+// embedding of a file
+func (s *searchService) SimilarFiles(userID string, fileID uint, opts SearchOptions) ([]SearchResult, error) {
+	embedding, err := s.embeddingService.GetFileEmbedding(userID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if embedding == nil {
+		return []SearchResult{}, nil
+	}
+	return s.searchByEmbedding(userID, embedding, fileID, opts)
+}
+
+// searchByEmbedding ranks the user's files by cosine similarity to
+// queryEmbedding, leaving out excludeFileID when it is set
+func (s *searchService) searchByEmbedding(userID string, queryEmbedding []float32, excludeFileID uint, opts SearchOptions) ([]SearchResult, error) {
+	// Only compare against vectors produced by the active model. Legacy rows
+	// without a model stamp are kept if their dimensions still match.
+	activeModel, _ := s.embeddingService.ActiveModel()
 	var fileEmbeddings []models.FileEmbedding
-	embQuery := s.db.Where("user_id = ?", userID)
+	embQuery := s.db.Where("user_id = ? AND (model = ? OR model = '' OR model IS NULL)", userID, activeModel)
+	if excludeFileID != 0 {
+		embQuery = embQuery.Where("file_id <> ?", excludeFileID)
+	}
+
+	// Filter before ranking so the limit only counts files in scope. Embeddings
+	// outlive files in a deleted folder, so this also leaves those out.
+	scopedFiles := s.db.Model(&models.File{}).Select("id").Where("user_id = ?", userID)
+	if opts.FolderID != nil || opts.RootFolderID != nil {
+		var err error
+		if scopedFiles, err = s.applyFolderFilters(scopedFiles, userID, opts); err != nil {
+			return nil, err
+		}
+	}
+	embQuery = embQuery.Where("file_id IN (?)", scopedFiles)
 	if err := embQuery.Find(&fileEmbeddings).Error; err != nil {
 		return nil, err
 	}
@@ -148,7 +306,7 @@ func (s *searchService) VectorSearch(ctx context.Context, userID string, query s
 
 	for _, fe := range fileEmbeddings {
 		embedding, err := parseEmbedding(fe.Embedding)
-		if err != nil {
+		if err != nil || len(embedding) != len(queryEmbedding) {
 			continue
 		}
 
@@ -159,6 +317,23 @@ func (s *searchService) VectorSearch(ctx context.Context, userID string, query s
 		})
 	}
 
+	// Apply tag boosts before ranking so boosted files can move into the limit
+	if len(opts.BoostTagIDs) > 0 {
+		candidateIDs := make([]uint, len(scoredFiles))
+		for i, sf := range scoredFiles {
+			candidateIDs[i] = sf.FileID
+		}
+		multipliers, err := s.tagBoostMultipliers(candidateIDs, opts.BoostTagIDs)
+		if err != nil {
+			return nil, err
+		}
+		for i, sf := range scoredFiles {
+			if m, ok := multipliers[sf.FileID]; ok {
+				scoredFiles[i].Score *= m
+			}
+		}
+	}
+
 	// Sort by score descending
 	sort.Slice(scoredFiles, func(i, j int) bool {
 		return scoredFiles[i].Score > scoredFiles[j].Score
@@ -186,10 +361,6 @@ func (s *searchService) VectorSearch(ctx context.Context, userID string, query s
 		Where("processing_status = ?", models.FileStatusCompleted)
 
 	// Apply filters
-	if opts.FolderID != nil {
-		dbQuery = dbQuery.Where("folder_id = ?", *opts.FolderID)
-	}
-
 	if len(opts.FileTypes) > 0 {
 		dbQuery = dbQuery.Where("file_type IN ?", opts.FileTypes)
 	}
@@ -210,7 +381,7 @@ func (s *searchService) VectorSearch(ctx context.Context, userID string, query s
 		results = append(results, SearchResult{
 			File:    file,
 			Score:   scoreMap[file.ID],
-			Snippet: s.generateSnippet(file.Summary, "", 200),
+			Snippet: s.generateSnippet(file.Summary, "", opts.snippetLength()),
 		})
 	}
 
@@ -222,25 +393,57 @@ func (s *searchService) VectorSearch(ctx context.Context, userID string, query s
 	return results, nil
 }
 
-// HybridSearch combines full-text and vector search
-func (s *searchService) HybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error) {
+// applyFolderFilters restricts a files query to opts.FolderID and to the
+// subtree under opts.RootFolderID
+func (s *searchService) applyFolderFilters(dbQuery *gorm.DB, userID string, opts SearchOptions) (*gorm.DB, error) {
+	if opts.FolderID != nil {
+		dbQuery = dbQuery.Where("folder_id = ?", *opts.FolderID)
+	}
+	if opts.RootFolderID != nil {
+		folderIDs, err := subtreeFolderIDs(s.db, userID, *opts.RootFolderID)
+		if err != nil {
+			return nil, err
+		}
+		dbQuery = dbQuery.Where("folder_id IN ?", folderIDs)
+	}
+	return dbQuery, nil
+}
+
+func (s *searchService) hybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) (*HybridSearchResult, error) {
+	if opts.Rerank && s.rerankService == nil {
+		return nil, ErrRerankNotConfigured
+	}
+
 	// Perform both searches
-	fullTextResults, _, err := s.FullTextSearch(userID, query, SearchOptions{
-		FolderID:  opts.FolderID,
-		TagIDs:    opts.TagIDs,
-		FileTypes: opts.FileTypes,
-		Limit:     50, // Get more for merging
+	fullTextResults, _, err := s.fullTextSearch(userID, query, SearchOptions{
+		FolderID:          opts.FolderID,
+		RootFolderID:      opts.RootFolderID,
+		TagIDs:            opts.TagIDs,
+		FileTypes:         opts.FileTypes,
+		IncludeFolderName: opts.IncludeFolderName,
+		SnippetLength:     opts.SnippetLength,
+		Limit:             50, // Get more for merging
 	})
 	if err != nil {
 		return nil, fmt.Errorf("full-text search failed: %w", err)
 	}
 
-	vectorResults, err := s.VectorSearch(ctx, userID, query, SearchOptions{
-		FolderID:  opts.FolderID,
-		TagIDs:    opts.TagIDs,
-		FileTypes: opts.FileTypes,
-		Limit:     50,
+	vectorResults, err := s.vectorSearch(ctx, userID, query, SearchOptions{
+		FolderID:      opts.FolderID,
+		RootFolderID:  opts.RootFolderID,
+		TagIDs:        opts.TagIDs,
+		FileTypes:     opts.FileTypes,
+		SnippetLength: opts.SnippetLength,
+		Limit:         50,
 	})
+	// Full-text matching still works without embeddings, so search degrades
+	// to it rather than failing
+	vectorUnavailable := false
+	if errors.Is(err, ErrEmbeddingUnavailable) {
+		log.Printf("[Search] Vector search unavailable, using full-text results only: %v", err)
+		vectorUnavailable = true
+		vectorResults, err = nil, nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("vector search failed: %w", err)
 	}
@@ -249,10 +452,24 @@ func (s *searchService) HybridSearch(ctx context.Context, userID string, query s
 	scoreMap := make(map[uint]float64)
 	fileMap := make(map[uint]models.File)
 	snippetMap := make(map[uint]string)
+	componentMap := make(map[uint]map[string]float64)
+	matchedByMap := make(map[uint][]string)
+	addComponent := func(fileID uint, name string, score float64) {
+		matchedByMap[fileID] = append(matchedByMap[fileID], name)
+		if !opts.IncludeRawScores {
+			return
+		}
+		if componentMap[fileID] == nil {
+			componentMap[fileID] = make(map[string]float64)
+		}
+		componentMap[fileID][name] = score
+	}
 
 	// Weight: 40% full-text, 60% semantic
-	const fullTextWeight = 0.4
-	const vectorWeight = 0.6
+	fullTextWeight, vectorWeight := 0.4, 0.6
+	if vectorUnavailable {
+		fullTextWeight = 1.0
+	}
 
 	// Normalize and add full-text scores
 	maxFTScore := 0.0
@@ -269,6 +486,7 @@ func (s *searchService) HybridSearch(ctx context.Context, userID string, query s
 		scoreMap[r.File.ID] = normalizedScore * fullTextWeight
 		fileMap[r.File.ID] = r.File
 		snippetMap[r.File.ID] = r.Snippet
+		addComponent(r.File.ID, ScoreComponentFullText, r.Score)
 	}
 
 	// Add vector scores
@@ -284,6 +502,7 @@ func (s *searchService) HybridSearch(ctx context.Context, userID string, query s
 			normalizedScore = r.Score / maxVectorScore
 		}
 		scoreMap[r.File.ID] += normalizedScore * vectorWeight
+		addComponent(r.File.ID, ScoreComponentVector, r.Score)
 		if _, exists := fileMap[r.File.ID]; !exists {
 			fileMap[r.File.ID] = r.File
 			snippetMap[r.File.ID] = r.Snippet
@@ -294,17 +513,32 @@ func (s *searchService) HybridSearch(ctx context.Context, userID string, query s
 	var results []SearchResult
 	for fileID, score := range scoreMap {
 		results = append(results, SearchResult{
-			File:    fileMap[fileID],
-			Score:   score,
-			Snippet: snippetMap[fileID],
+			File:       fileMap[fileID],
+			Score:      score,
+			Snippet:    snippetMap[fileID],
+			Components: componentMap[fileID],
+			MatchedBy:  matchedByMap[fileID],
 		})
 	}
+	applyTagBoosts(results, opts.BoostTagIDs)
+	applyRecencyBoost(results, opts.RecencyHalfLife, time.Now())
 
 	// Sort by combined score descending
 	sort.Slice(results, func(i, j int) bool {
 		return results[i].Score > results[j].Score
 	})
 
+	// The blended ranking is still useful when the reranking model is down
+	rerankUnavailable := false
+	if opts.Rerank {
+		if reranked, err := s.rerank(ctx, query, results); err != nil {
+			log.Printf("[Search] Rerank failed, using blended ranking: %v", err)
+			rerankUnavailable = true
+		} else {
+			results = reranked
+		}
+	}
+
 	// Apply limit
 	limit := opts.Limit
 	if limit <= 0 {
@@ -314,9 +548,114 @@ func (s *searchService) HybridSearch(ctx context.Context, userID string, query s
 		results = results[:limit]
 	}
 
+	return &HybridSearchResult{
+		Results:           results,
+		VectorUnavailable: vectorUnavailable,
+		RerankUnavailable: rerankUnavailable,
+	}, nil
+}
+
+// rerank scores the best ranked results with the reranking model and returns
+// them ordered by its scores. Results beyond the model's top K are dropped
+// since their blended scores aren't comparable.
+func (s *searchService) rerank(ctx context.Context, query string, results []SearchResult) ([]SearchResult, error) {
+	results = results[:min(len(results), s.rerankService.TopK())]
+
+	documents := make([]string, len(results))
+	for i, r := range results {
+		snippet := r.Snippet
+		if snippet == "" {
+			snippet = r.File.Summary
+		}
+		documents[i] = r.File.Title + "\n\n" + snippet
+	}
+
+	scores, err := s.rerankService.Rerank(ctx, query, documents)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Score = scores[i]
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
 	return results, nil
 }
 
+// tagBoost returns the score multiplier for a file carrying the given tags
+func tagBoost(tags []models.Tag, boosts map[uint]float64) float64 {
+	multiplier := 1.0
+	for _, tag := range tags {
+		if weight, ok := boosts[tag.ID]; ok {
+			multiplier *= weight
+		}
+	}
+	return multiplier
+}
+
+// applyTagBoosts multiplies result scores by the boosts of the tags each file carries
+func applyTagBoosts(results []SearchResult, boosts map[uint]float64) {
+	if len(boosts) == 0 {
+		return
+	}
+	for i := range results {
+		results[i].Score *= tagBoost(results[i].File.Tags, boosts)
+	}
+}
+
+// applyRecencyBoost multiplies result scores by exp(-ln2 * age / halfLife),
+// so newer files rank above equally relevant older ones
+func applyRecencyBoost(results []SearchResult, halfLife time.Duration, now time.Time) {
+	if halfLife <= 0 {
+		return
+	}
+	for i := range results {
+		age := max(now.Sub(results[i].File.CreatedAt), 0)
+		results[i].Score *= math.Exp(-math.Ln2 * float64(age) / float64(halfLife))
+	}
+}
+
+// tagBoostMultipliers looks up boost tags for files that are not loaded yet and
+// returns the score multiplier per file ID (files without boost tags are omitted)
+func (s *searchService) tagBoostMultipliers(fileIDs []uint, boosts map[uint]float64) (map[uint]float64, error) {
+	tagIDs := make([]uint, 0, len(boosts))
+	for id := range boosts {
+		tagIDs = append(tagIDs, id)
+	}
+
+	var rows []struct {
+		FileID uint
+		TagID  uint
+	}
+	if err := s.db.Table("file_tags").
+		Select("file_id, tag_id").
+		Where("file_id IN ? AND tag_id IN ?", fileIDs, tagIDs).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	multipliers := make(map[uint]float64)
+	for _, row := range rows {
+		if _, ok := multipliers[row.FileID]; !ok {
+			multipliers[row.FileID] = 1.0
+		}
+		multipliers[row.FileID] *= boosts[row.TagID]
+	}
+	return multipliers, nil
+}
+
+// folderNameMatchScore is the full-text score added when the file's folder
+// name matches, weighted between a summary and a content match
+const folderNameMatchScore = 3.0
+
+// matchingFolderIDs selects the IDs of the user's folders whose name matches
+// the LIKE pattern
+func matchingFolderIDs(db *gorm.DB, userID, pattern string) *gorm.DB {
+	return db.Model(&models.Folder{}).Select("id").Where("user_id = ? AND name LIKE ?", userID, pattern)
+}
+
 // calculateFullTextScore calculates a simple relevance score for full-text search
 func (s *searchService) calculateFullTextScore(file models.File, query string) float64 {
 	score := 0.0
@@ -489,7 +828,14 @@ func (m *MockSearchService) VectorSearch(ctx context.Context, userID string, que
 	return results, err
 }
 
-func (m *MockSearchService) HybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) ([]SearchResult, error) {
+func (m *MockSearchService) SimilarFiles(userID string, fileID uint, opts SearchOptions) ([]SearchResult, error) {
+	return []SearchResult{}, nil
+}
+
+func (m *MockSearchService) HybridSearch(ctx context.Context, userID string, query string, opts SearchOptions) (*HybridSearchResult, error) {
 	results, _, err := m.FullTextSearch(userID, query, opts)
-	return results, err
+	if err != nil {
+		return nil, err
+	}
+	return &HybridSearchResult{Results: results}, nil
 }
@@ -0,0 +1,374 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorSearch_TagBoostReordersResults(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	plain := createCompletedTestFile(t, db, "plain")
+	boosted := createCompletedTestFile(t, db, "boosted")
+	for _, id := range []uint{plain.ID, boosted.ID} {
+		require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, id, []float32{1, 0, 0}, ""))
+	}
+	require.NoError(t, db.Exec("INSERT INTO file_tags (file_id, tag_id) VALUES (?, ?)", boosted.ID, 42).Error)
+
+	results, err := NewSearchService(db, embeddingService, nil).VectorSearch(context.Background(), reembedTestUserID, "query", SearchOptions{
+		Limit:       1,
+		BoostTagIDs: map[uint]float64{42: 2},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, boosted.ID, results[0].File.ID)
+	assert.InDelta(t, 2.0, results[0].Score, 0.0001)
+}
+
+func TestVectorSearch_SkipsDeletedFiles(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	live := createCompletedTestFile(t, db, "live")
+	deleted := createCompletedTestFile(t, db, "deleted")
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, live.ID, []float32{0.5, 0.5, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, deleted.ID, []float32{1, 0, 0}, ""))
+	// Files in a deleted folder keep their embeddings so a restore brings them back
+	require.NoError(t, db.Model(&models.File{}).Where("id = ?", deleted.ID).Update("deleted_at", time.Now()).Error)
+
+	results, err := NewSearchService(db, embeddingService, nil).VectorSearch(context.Background(), reembedTestUserID, "query", SearchOptions{Limit: 1})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, live.ID, results[0].File.ID)
+}
+
+func TestSimilarFiles_ExcludesFileAndRanksBySimilarity(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	source := createCompletedTestFile(t, db, "source")
+	near := createCompletedTestFile(t, db, "near")
+	far := createCompletedTestFile(t, db, "far")
+	unembedded := createCompletedTestFile(t, db, "unembedded")
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, source.ID, []float32{1, 0, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, near.ID, []float32{0.9, 0.1, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, far.ID, []float32{0.1, 0.9, 0}, ""))
+
+	searchService := NewSearchService(db, embeddingService, nil)
+	results, err := searchService.SimilarFiles(reembedTestUserID, source.ID, SearchOptions{Limit: 5})
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, near.ID, results[0].File.ID)
+	assert.Equal(t, far.ID, results[1].File.ID)
+
+	// Without an embedding there is nothing to compare against
+	results, err = searchService.SimilarFiles(reembedTestUserID, unembedded.ID, SearchOptions{Limit: 5})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestHybridSearch_RecencyBoostFavorsNewerFiles(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	older := createCompletedTestFile(t, db, "older")
+	newer := createCompletedTestFile(t, db, "newer")
+	for _, id := range []uint{older.ID, newer.ID} {
+		require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, id, []float32{1, 0, 0}, ""))
+	}
+	require.NoError(t, db.Model(older).UpdateColumn("created_at", time.Now().Add(-60*24*time.Hour)).Error)
+
+	hybrid, err := NewSearchService(db, embeddingService, nil).HybridSearch(context.Background(), reembedTestUserID, "content", SearchOptions{
+		RecencyHalfLife: 30 * 24 * time.Hour,
+	})
+
+	require.NoError(t, err)
+	results := hybrid.Results
+	require.Len(t, results, 2)
+	assert.Equal(t, newer.ID, results[0].File.ID)
+	// Two half-lives old: a quarter of the score of an equally relevant new file
+	assert.InDelta(t, results[0].Score/4, results[1].Score, 0.001)
+}
+
+func TestHybridSearch_IncludeRawScores(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	alpha := createCompletedTestFile(t, db, "alpha")
+	beta := createCompletedTestFile(t, db, "beta")
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, alpha.ID, []float32{1, 0, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, beta.ID, []float32{0.6, 0.8, 0}, ""))
+	searchService := NewSearchService(db, embeddingService, nil)
+
+	hybrid, err := searchService.HybridSearch(context.Background(), reembedTestUserID, "alpha", SearchOptions{IncludeRawScores: true})
+
+	require.NoError(t, err)
+	results := hybrid.Results
+	require.Len(t, results, 2)
+	assert.Equal(t, alpha.ID, results[0].File.ID)
+	assert.Greater(t, results[0].Components[ScoreComponentFullText], 0.0)
+	assert.InDelta(t, 1.0, results[0].Components[ScoreComponentVector], 0.0001)
+	// Only the vector search matched beta
+	assert.NotContains(t, results[1].Components, ScoreComponentFullText)
+	assert.InDelta(t, 0.6, results[1].Components[ScoreComponentVector], 0.0001)
+
+	hybrid, err = searchService.HybridSearch(context.Background(), reembedTestUserID, "alpha", SearchOptions{})
+	require.NoError(t, err)
+	for _, r := range hybrid.Results {
+		assert.Nil(t, r.Components)
+	}
+}
+
+func TestHybridSearch_MatchedBy(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	alpha := createCompletedTestFile(t, db, "alpha")
+	beta := createCompletedTestFile(t, db, "beta")
+	alphabet := createCompletedTestFile(t, db, "alphabet")
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, alpha.ID, []float32{1, 0, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, beta.ID, []float32{0.6, 0.8, 0}, ""))
+
+	hybrid, err := NewSearchService(db, embeddingService, nil).HybridSearch(context.Background(), reembedTestUserID, "alpha", SearchOptions{})
+
+	require.NoError(t, err)
+	matchedBy := map[uint][]string{}
+	for _, r := range hybrid.Results {
+		matchedBy[r.File.ID] = r.MatchedBy
+	}
+	assert.Equal(t, map[uint][]string{
+		alpha.ID:    {ScoreComponentFullText, ScoreComponentVector},
+		beta.ID:     {ScoreComponentVector},
+		alphabet.ID: {ScoreComponentFullText},
+	}, matchedBy)
+}
+
+func TestHybridSearch_RerankUsesModelScores(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	first := createCompletedTestFile(t, db, "first")
+	second := createCompletedTestFile(t, db, "second")
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, first.ID, []float32{1, 0, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, second.ID, []float32{0, 1, 0}, ""))
+
+	var request rerankRequest
+	reranker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		// Score by title so the order comes from the model alone
+		results := []map[string]any{}
+		for i, doc := range request.Documents {
+			score := 0.1
+			if strings.HasPrefix(doc, "second") {
+				score = 0.9
+			}
+			results = append(results, map[string]any{"index": i, "relevance_score": score})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"results": results})
+	}))
+	defer reranker.Close()
+
+	service := NewSearchService(db, embeddingService, NewRerankService(RerankConfig{URL: reranker.URL, Model: "rerank"}))
+	hybrid, err := service.HybridSearch(context.Background(), reembedTestUserID, "content", SearchOptions{Rerank: true})
+
+	require.NoError(t, err)
+	assert.False(t, hybrid.RerankUnavailable)
+	results := hybrid.Results
+	require.Len(t, results, 2)
+	assert.Equal(t, "content", request.Query)
+	assert.Equal(t, "rerank", request.Model)
+	assert.Len(t, request.Documents, 2)
+	assert.Equal(t, second.ID, results[0].File.ID)
+	assert.Equal(t, 0.9, results[0].Score)
+	assert.Equal(t, 0.1, results[1].Score)
+}
+
+func TestHybridSearch_RerankFailureKeepsBlendedRanking(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+
+	first := createCompletedTestFile(t, db, "first")
+	second := createCompletedTestFile(t, db, "second")
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, first.ID, []float32{1, 0, 0}, ""))
+	require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, second.ID, []float32{0, 1, 0}, ""))
+
+	reranker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer reranker.Close()
+
+	service := NewSearchService(db, embeddingService, NewRerankService(RerankConfig{URL: reranker.URL, Model: "rerank"}))
+	hybrid, err := service.HybridSearch(context.Background(), reembedTestUserID, "content", SearchOptions{Rerank: true})
+
+	require.NoError(t, err)
+	assert.True(t, hybrid.RerankUnavailable)
+	assert.Len(t, hybrid.Results, 2)
+}
+
+func TestHybridSearch_RerankNotConfigured(t *testing.T) {
+	service := NewSearchService(newTestDB(t), NewMockEmbeddingService(), nil)
+
+	_, err := service.HybridSearch(context.Background(), reembedTestUserID, "content", SearchOptions{Rerank: true})
+	assert.ErrorIs(t, err, ErrRerankNotConfigured)
+}
+
+func TestHybridSearch_FallsBackToFullTextWhenEmbeddingUnconfigured(t *testing.T) {
+	db := newTestDB(t)
+	file := createCompletedTestFile(t, db, "report")
+	service := NewSearchService(db, NewEmbeddingService(db, EmbeddingConfig{Model: "model"}), nil)
+
+	hybrid, err := service.HybridSearch(context.Background(), reembedTestUserID, "report", SearchOptions{})
+
+	require.NoError(t, err)
+	assert.True(t, hybrid.VectorUnavailable)
+	results := hybrid.Results
+	require.Len(t, results, 1)
+	assert.Equal(t, file.ID, results[0].File.ID)
+	assert.InDelta(t, 1.0, results[0].Score, 0.0001)
+
+	_, err = service.VectorSearch(context.Background(), reembedTestUserID, "report", SearchOptions{})
+	assert.ErrorIs(t, err, ErrEmbeddingUnavailable)
+}
+
+func TestHybridSearch_FallsBackToFullTextWhenGatewayFails(t *testing.T) {
+	db := newTestDB(t)
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer gateway.Close()
+	createCompletedTestFile(t, db, "report")
+	service := NewSearchService(db, NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"}), nil)
+
+	hybrid, err := service.HybridSearch(context.Background(), reembedTestUserID, "report", SearchOptions{})
+
+	require.NoError(t, err)
+	assert.True(t, hybrid.VectorUnavailable)
+	assert.Len(t, hybrid.Results, 1)
+}
+
+func TestFullTextSearch_SnippetLength(t *testing.T) {
+	db := newTestDB(t)
+	file := createCompletedTestFile(t, db, "report")
+	content := strings.Repeat("lorem ipsum ", 300) + "quarterly revenue" + strings.Repeat(" dolor sit", 300)
+	require.NoError(t, db.Model(file).Update("content", content).Error)
+	service := NewSearchService(db, NewMockEmbeddingService(), nil)
+
+	tests := []struct {
+		name          string
+		snippetLength int
+		wantLength    int
+	}{
+		{"default", 0, DefaultSnippetLength},
+		{"custom", 80, 80},
+		{"clamped to minimum", 5, MinSnippetLength},
+		{"clamped to maximum", 100000, MaxSnippetLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, _, err := service.FullTextSearch(reembedTestUserID, "revenue", SearchOptions{SnippetLength: tt.snippetLength})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+
+			snippet := strings.Trim(results[0].Snippet, ".")
+			assert.Len(t, snippet, tt.wantLength)
+			assert.Contains(t, snippet, "revenue")
+		})
+	}
+}
+
+func TestFullTextSearch_IncludeFolderName(t *testing.T) {
+	db := newTestDB(t)
+	service := NewSearchService(db, NewMockEmbeddingService(), nil)
+
+	contracts := &models.Folder{UserID: reembedTestUserID, Name: "Contracts"}
+	require.NoError(t, db.Create(contracts).Error)
+	misc := &models.Folder{UserID: reembedTestUserID, Name: "misc"}
+	require.NoError(t, db.Create(misc).Error)
+
+	lease := createCompletedTestFile(t, db, "lease")
+	require.NoError(t, db.Model(lease).Update("folder_id", contracts.ID).Error)
+	notes := createCompletedTestFile(t, db, "notes")
+	require.NoError(t, db.Model(notes).Update("folder_id", misc.ID).Error)
+
+	results, _, err := service.FullTextSearch(reembedTestUserID, "contracts", SearchOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, total, err := service.FullTextSearch(reembedTestUserID, "contracts", SearchOptions{IncludeFolderName: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, results, 1)
+	assert.Equal(t, lease.ID, results[0].File.ID)
+	assert.Equal(t, folderNameMatchScore, results[0].Score)
+
+	// Folder filters still apply to folder name matches
+	results, _, err = service.FullTextSearch(reembedTestUserID, "contracts", SearchOptions{IncludeFolderName: true, FolderID: &misc.ID})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearch_RootFolderIncludesSubfolders(t *testing.T) {
+	db := newTestDB(t)
+	gateway := newTestEmbeddingGateway(t)
+	embeddingService := NewEmbeddingService(db, EmbeddingConfig{GatewayURL: gateway.URL, Model: "model"})
+	service := NewSearchService(db, embeddingService, nil)
+
+	project := &models.Folder{UserID: reembedTestUserID, Name: "project"}
+	require.NoError(t, db.Create(project).Error)
+	drafts := &models.Folder{UserID: reembedTestUserID, Name: "drafts", ParentID: &project.ID}
+	require.NoError(t, db.Create(drafts).Error)
+	other := &models.Folder{UserID: reembedTestUserID, Name: "other"}
+	require.NoError(t, db.Create(other).Error)
+
+	inProject := createCompletedTestFile(t, db, "plan")
+	inDrafts := createCompletedTestFile(t, db, "draft plan")
+	outside := createCompletedTestFile(t, db, "other plan")
+	for file, folder := range map[*models.File]*models.Folder{inProject: project, inDrafts: drafts, outside: other} {
+		require.NoError(t, db.Model(file).Update("folder_id", folder.ID).Error)
+		require.NoError(t, embeddingService.StoreFileEmbedding(reembedTestUserID, file.ID, []float32{1, 0, 0}, ""))
+	}
+
+	resultIDs := func(results []SearchResult) []uint {
+		ids := make([]uint, len(results))
+		for i, r := range results {
+			ids[i] = r.File.ID
+		}
+		return ids
+	}
+	opts := SearchOptions{RootFolderID: &project.ID}
+
+	results, total, err := service.FullTextSearch(reembedTestUserID, "plan", opts)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.ElementsMatch(t, []uint{inProject.ID, inDrafts.ID}, resultIDs(results))
+
+	// The scope applies before the limit, so nested matches aren't crowded out
+	results, err = service.VectorSearch(context.Background(), reembedTestUserID, "plan", SearchOptions{RootFolderID: &drafts.ID, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []uint{inDrafts.ID}, resultIDs(results))
+
+	hybrid, err := service.HybridSearch(context.Background(), reembedTestUserID, "plan", opts)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint{inProject.ID, inDrafts.ID}, resultIDs(hybrid.Results))
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// DefaultProcessingStatsWindow is how far back throughput and the average
+// processing duration look
+const DefaultProcessingStatsWindow = time.Hour
+
+// ProcessingStats is a snapshot of the processing pipeline across all users
+type ProcessingStats struct {
+	Counts          map[models.FileProcessingStatus]int64 // Files per processing status
+	Processing      int64                                 // Files currently being processed
+	Window          time.Duration
+	CompletedRecent int64          // Files that finished processing successfully within the window
+	FailedRecent    int64          // Files that failed within the window
+	AverageDuration *time.Duration // Mean processing time of files completed within the window, nil when there are none
+}
+
+// StatsService reports operational statistics
+type StatsService interface {
+	// ProcessingStats returns file counts by processing status and the
+	// throughput over the last window
+	ProcessingStats(window time.Duration) (*ProcessingStats, error)
+}
+
+type statsService struct {
+	db *gorm.DB
+}
+
+// NewStatsService creates a new StatsService
+func NewStatsService(db *gorm.DB) StatsService {
+	return &statsService{db: db}
+}
+
+func (s *statsService) ProcessingStats(window time.Duration) (*ProcessingStats, error) {
+	if window <= 0 {
+		window = DefaultProcessingStatsWindow
+	}
+
+	var rows []struct {
+		ProcessingStatus models.FileProcessingStatus
+		Count            int64
+	}
+	if err := s.db.Model(&models.File{}).
+		Select("processing_status, COUNT(*) AS count").
+		Group("processing_status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	stats := &ProcessingStats{
+		Counts: map[models.FileProcessingStatus]int64{
+			models.FileStatusPending:     0,
+			models.FileStatusProcessing:  0,
+			models.FileStatusCompleted:   0,
+			models.FileStatusFailed:      0,
+			models.FileStatusNeedsReview: 0,
+		},
+		Window: window,
+	}
+	for _, row := range rows {
+		stats.Counts[row.ProcessingStatus] = row.Count
+	}
+	stats.Processing = stats.Counts[models.FileStatusProcessing]
+
+	// Durations are computed here rather than in SQL, where date arithmetic
+	// differs between databases
+	var finished []models.File
+	if err := s.db.Select("processing_status", "processing_started_at", "processing_ended_at").
+		Where("processing_ended_at >= ?", time.Now().Add(-window)).
+		Find(&finished).Error; err != nil {
+		return nil, err
+	}
+
+	var total time.Duration
+	var timed int64
+	for _, file := range finished {
+		if file.ProcessingStatus == models.FileStatusFailed {
+			stats.FailedRecent++
+			continue
+		}
+		if file.ProcessingStatus != models.FileStatusCompleted {
+			continue
+		}
+		stats.CompletedRecent++
+		if file.ProcessingStartedAt != nil && file.ProcessingEndedAt.After(*file.ProcessingStartedAt) {
+			total += file.ProcessingEndedAt.Sub(*file.ProcessingStartedAt)
+			timed++
+		}
+	}
+	if timed > 0 {
+		average := total / time.Duration(timed)
+		stats.AverageDuration = &average
+	}
+	return stats, nil
+}
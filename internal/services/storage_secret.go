@@ -0,0 +1,112 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// storageSecretPrefix marks a StorageConfig secret encrypted by EncryptStorageSecret
+const storageSecretPrefix = "enc:v1:"
+
+var (
+	// ErrStorageSecretKeyMissing is returned when a storage config's secret
+	// can't be read because no encryption key is configured
+	ErrStorageSecretKeyMissing = errors.New("storage secret encryption key is not configured")
+	// ErrStorageSecretNotEncrypted is returned for a storage config whose
+	// secret is still stored in plaintext
+	ErrStorageSecretNotEncrypted = errors.New("storage secret is not encrypted")
+)
+
+// StorageSecretKey derives the AES-256 key for storage secrets from a passphrase.
+// An empty passphrase returns nil, which leaves secrets unreadable.
+func StorageSecretKey(passphrase string) []byte {
+	if passphrase == "" {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(passphrase))
+	return sum[:]
+}
+
+// EncryptStorageSecret encrypts a bucket secret for storing in StorageConfig
+func EncryptStorageSecret(key []byte, plaintext string) (string, error) {
+	gcm, err := storageSecretCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return storageSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptStorageSecret reverses EncryptStorageSecret. Empty secrets, used by
+// buckets that authenticate some other way, are returned as is.
+func decryptStorageSecret(key []byte, stored string) (string, error) {
+	if stored == "" {
+		return "", nil
+	}
+	encoded, ok := strings.CutPrefix(stored, storageSecretPrefix)
+	if !ok {
+		return "", ErrStorageSecretNotEncrypted
+	}
+	gcm, err := storageSecretCipher(key)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", errors.New("storage secret is corrupt")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt storage secret: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func storageSecretCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) == 0 {
+		return nil, ErrStorageSecretKeyMissing
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptStorageSecrets encrypts the secrets of storage configs that were
+// saved in plaintext and returns how many it updated
+func EncryptStorageSecrets(db *gorm.DB, key []byte) (int, error) {
+	var configs []models.StorageConfig
+	if err := db.Unscoped().
+		Where("secret_access_key <> '' AND secret_access_key NOT LIKE ?", storageSecretPrefix+"%").
+		Find(&configs).Error; err != nil {
+		return 0, err
+	}
+
+	for _, cfg := range configs {
+		encrypted, err := EncryptStorageSecret(key, cfg.SecretAccessKey)
+		if err != nil {
+			return 0, err
+		}
+		// The secret itself is unchanged, so keep updated_at as it was
+		if err := db.Unscoped().Model(&models.StorageConfig{}).
+			Where("id = ?", cfg.ID).
+			UpdateColumn("secret_access_key", encrypted).Error; err != nil {
+			return 0, err
+		}
+	}
+	return len(configs), nil
+}
@@ -7,19 +7,39 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
+	"github.com/rxtech-lab/invoice-management/internal/models"
 )
 
+// DefaultSummaryFallbackLength is the length of the excerpt used in place of
+// the AI summary when SummaryConfig.FallbackLength is unset
+const DefaultSummaryFallbackLength = 500
+
 // SummaryConfig holds configuration for the summary service
 type SummaryConfig struct {
-	GatewayURL string // e.g., https://ai-gateway.vercel.sh/v1
-	APIKey     string // AI Gateway API key
-	Model      string // e.g., openai/gpt-4o-mini
+	GatewayURL     string // e.g., https://ai-gateway.vercel.sh/v1
+	APIKey         string // AI Gateway API key
+	Model          string // e.g., openai/gpt-4o-mini
+	FallbackLength int    // Excerpt length when the AI summary fails (0 = DefaultSummaryFallbackLength)
+	// ExtractEntities enables ExtractEntities; when false it returns nil
+	// without calling the model
+	ExtractEntities bool
 }
 
 // SummaryService handles AI-powered summary generation
 type SummaryService interface {
-	GenerateSummary(ctx context.Context, content string, maxLength int) (string, error)
+	// GenerateSummary summarizes content; model overrides the configured model when non-empty
+	GenerateSummary(ctx context.Context, content string, maxLength int, model string) (string, error)
+	// FallbackSummary returns an excerpt of content to use when GenerateSummary fails
+	FallbackSummary(content string) string
+	// ExtractEntities returns the people, organizations, dates and amounts in
+	// content, or nil when entity extraction is disabled
+	ExtractEntities(ctx context.Context, content string, model string) (*models.FileEntities, error)
 }
 
 type summaryService struct {
@@ -29,6 +49,9 @@ type summaryService struct {
 
 // NewSummaryService creates a new SummaryService
 func NewSummaryService(config SummaryConfig) SummaryService {
+	if config.FallbackLength <= 0 {
+		config.FallbackLength = DefaultSummaryFallbackLength
+	}
 	return &summaryService{
 		config: config,
 		client: &http.Client{},
@@ -37,8 +60,20 @@ func NewSummaryService(config SummaryConfig) SummaryService {
 
 // chatRequest is the request body for the chat completions API
 type chatRequest struct {
-	Model    string        `json:"model"`
-	Messages []chatMessage `json:"messages"`
+	Model      string        `json:"model"`
+	Messages   []chatMessage `json:"messages"`
+	Tools      []chatTool    `json:"tools,omitempty"`
+	ToolChoice interface{}   `json:"tool_choice,omitempty"`
+}
+
+// chatTool declares a function the model can call
+type chatTool struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name        string                 `json:"name"`
+		Description string                 `json:"description"`
+		Parameters  map[string]interface{} `json:"parameters"`
+	} `json:"function"`
 }
 
 type chatMessage struct {
@@ -50,7 +85,13 @@ type chatMessage struct {
 type chatResponse struct {
 	Choices []struct {
 		Message struct {
-			Content string `json:"content"`
+			Content   string `json:"content"`
+			ToolCalls []struct {
+				Function struct {
+					Name      string `json:"name"`
+					Arguments string `json:"arguments"`
+				} `json:"function"`
+			} `json:"tool_calls"`
 		} `json:"message"`
 		FinishReason string `json:"finish_reason"`
 	} `json:"choices"`
@@ -60,11 +101,19 @@ type chatResponse struct {
 	} `json:"error"`
 }
 
+// FallbackSummary truncates content to the configured fallback length
+func (s *summaryService) FallbackSummary(content string) string {
+	return GenerateSummary(content, s.config.FallbackLength)
+}
+
 // GenerateSummary generates a summary using AI
-func (s *summaryService) GenerateSummary(ctx context.Context, content string, maxLength int) (string, error) {
+func (s *summaryService) GenerateSummary(ctx context.Context, content string, maxLength int, model string) (string, error) {
 	if content == "" {
 		return "", nil
 	}
+	if model == "" {
+		model = s.config.Model
+	}
 
 	// Truncate content if too long (to fit in context window)
 	if len(content) > 15000 {
@@ -78,25 +127,52 @@ Do not include any preamble like "Here is a summary" - just provide the summary
 Document content:
 %s`, maxLength, content)
 
-	reqBody := chatRequest{
-		Model: s.config.Model,
+	start := time.Now()
+	reply, err := s.complete(ctx, model, prompt)
+	metrics.ObserveAIRequest(metrics.AIServiceSummary, start, err)
+	if err != nil {
+		return "", err
+	}
+
+	summary := strings.TrimSpace(reply)
+
+	// Truncate if still too long
+	if len(summary) > maxLength {
+		summary = summary[:maxLength-3] + "..."
+	}
+
+	return summary, nil
+}
+
+// complete sends a single-message chat completion and returns the reply
+func (s *summaryService) complete(ctx context.Context, model, prompt string) (string, error) {
+	chatResp, err := s.send(ctx, chatRequest{
+		Model: model,
 		Messages: []chatMessage{
 			{
 				Role:    "user",
 				Content: prompt,
 			},
 		},
+	})
+	if err != nil {
+		return "", err
 	}
+	return chatResp.Choices[0].Message.Content, nil
+}
 
+// send posts a chat completion request and returns the response, which has
+// at least one choice
+func (s *summaryService) send(ctx context.Context, reqBody chatRequest) (*chatResponse, error) {
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal request: %w", err)
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
 	url := fmt.Sprintf("%s/chat/completions", strings.TrimSuffix(s.config.GatewayURL, "/"))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -104,40 +180,33 @@ Document content:
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("summary API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("summary API error (status %d): %s", resp.StatusCode, string(body))
 	}
 
 	var chatResp chatResponse
 	if err := json.Unmarshal(body, &chatResp); err != nil {
-		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
 	if chatResp.Error != nil {
-		return "", fmt.Errorf("summary API error: %s", chatResp.Error.Message)
+		return nil, fmt.Errorf("summary API error: %s", chatResp.Error.Message)
 	}
 
 	if len(chatResp.Choices) == 0 {
-		return "", fmt.Errorf("no response from summary API")
-	}
-
-	summary := strings.TrimSpace(chatResp.Choices[0].Message.Content)
-
-	// Truncate if still too long
-	if len(summary) > maxLength {
-		summary = summary[:maxLength-3] + "..."
+		return nil, fmt.Errorf("no response from summary API")
 	}
 
-	return summary, nil
+	return &chatResp, nil
 }
 
 // MockSummaryService is a mock implementation for TESTING ONLY.
@@ -150,7 +219,33 @@ func NewMockSummaryService() SummaryService {
 	return &MockSummaryService{}
 }
 
-func (m *MockSummaryService) GenerateSummary(ctx context.Context, content string, maxLength int) (string, error) {
+func (m *MockSummaryService) GenerateSummary(ctx context.Context, content string, maxLength int, model string) (string, error) {
 	// Fall back to simple truncation for testing
 	return GenerateSummary(content, maxLength), nil
 }
+
+func (m *MockSummaryService) FallbackSummary(content string) string {
+	return GenerateSummary(content, DefaultSummaryFallbackLength)
+}
+
+var (
+	mockEntityDatePattern   = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+	mockEntityAmountPattern = regexp.MustCompile(`\$(\d[\d,]*(?:\.\d+)?)`)
+	mockEntityOrgPattern    = regexp.MustCompile(`\b[A-Z]{3,}\b`)
+)
+
+// ExtractEntities picks ISO dates, dollar amounts and all-caps words as
+// organizations from the content for testing
+func (m *MockSummaryService) ExtractEntities(ctx context.Context, content string, model string) (*models.FileEntities, error) {
+	var amounts []models.EntityAmount
+	for _, match := range mockEntityAmountPattern.FindAllStringSubmatch(content, -1) {
+		if value, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64); err == nil {
+			amounts = append(amounts, models.EntityAmount{Value: value, Currency: "USD"})
+		}
+	}
+	return normalizeEntities(entityArgs{
+		Organizations: mockEntityOrgPattern.FindAllString(content, -1),
+		Dates:         mockEntityDatePattern.FindAllString(content, -1),
+		Amounts:       amounts,
+	}), nil
+}
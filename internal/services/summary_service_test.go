@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackSummary_UsesConfiguredLength(t *testing.T) {
+	content := strings.Repeat("word ", 200)
+
+	service := NewSummaryService(SummaryConfig{FallbackLength: 100})
+	summary := service.FallbackSummary(content)
+	assert.LessOrEqual(t, len(summary), 100+len("..."))
+	assert.True(t, strings.HasSuffix(summary, "..."))
+
+	service = NewSummaryService(SummaryConfig{})
+	summary = service.FallbackSummary(content)
+	assert.Greater(t, len(summary), 100)
+	assert.LessOrEqual(t, len(summary), DefaultSummaryFallbackLength+len("..."))
+}
+
+func TestExtractEntities(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		arguments, _ := json.Marshal(map[string]interface{}{
+			"people":        []string{"Jane Doe", " jane doe ", ""},
+			"organizations": []string{"ACME Corp"},
+			"dates":         []string{"2024-03-01", "March 15, 2024", "sometime in spring"},
+			"amounts":       []map[string]interface{}{{"value": 1200.5, "currency": "usd"}, {"value": 0}},
+		})
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{{
+				"finish_reason": "tool_calls",
+				"message": map[string]interface{}{
+					"tool_calls": []map[string]interface{}{{
+						"function": map[string]interface{}{"name": "record_entities", "arguments": string(arguments)},
+					}},
+				},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	service := NewSummaryService(SummaryConfig{GatewayURL: server.URL, Model: "test-model", ExtractEntities: true})
+	entities, err := service.ExtractEntities(context.Background(), "Invoice from ACME Corp to Jane Doe", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "record_entities", request["tool_choice"].(map[string]interface{})["function"].(map[string]interface{})["name"])
+	assert.Len(t, request["tools"], 1)
+
+	assert.Equal(t, []string{"Jane Doe"}, entities.People)
+	assert.Equal(t, []string{"ACME Corp"}, entities.Organizations)
+	assert.Equal(t, []string{"2024-03-01", "2024-03-15"}, entities.Dates)
+	assert.Equal(t, []models.EntityAmount{{Value: 1200.5, Currency: "USD"}}, entities.Amounts)
+}
+
+func TestExtractEntities_Disabled(t *testing.T) {
+	service := NewSummaryService(SummaryConfig{GatewayURL: "http://127.0.0.1:0"})
+	entities, err := service.ExtractEntities(context.Background(), "Invoice from ACME Corp", "")
+	require.NoError(t, err)
+	assert.Nil(t, entities)
+}
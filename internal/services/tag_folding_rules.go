@@ -0,0 +1,132 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrFoldingRuleExists is returned when creating a rule for a tag that
+	// already has one
+	ErrFoldingRuleExists = errors.New("a folding rule for this tag already exists")
+	// ErrFoldingRuleTagNotFound is returned when a rule names an unknown tag
+	ErrFoldingRuleTagNotFound = errors.New("tag not found")
+	// ErrFoldingRuleFolderNotFound is returned when a rule names an unknown
+	// target folder
+	ErrFoldingRuleFolderNotFound = errors.New("target folder not found")
+)
+
+// ListFoldingRules returns the user's folding rules, oldest first
+func (s *tagService) ListFoldingRules(userID string) ([]models.FoldingRule, error) {
+	rules := []models.FoldingRule{}
+	err := s.db.Where("user_id = ?", userID).Order("id ASC").Find(&rules).Error
+	return rules, err
+}
+
+// CreateFoldingRule adds a rule moving files tagged with tagID into the
+// target folder
+func (s *tagService) CreateFoldingRule(userID string, tagID, targetFolderID uint) (*models.FoldingRule, error) {
+	if err := s.checkFoldingRuleTargets(userID, &tagID, targetFolderID); err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := s.db.Model(&models.FoldingRule{}).Where("user_id = ? AND tag_id = ?", userID, tagID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrFoldingRuleExists
+	}
+
+	rule := &models.FoldingRule{UserID: userID, TagID: tagID, TargetFolderID: targetFolderID}
+	if err := s.db.Create(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// UpdateFoldingRule changes a rule's target folder. Returns nil when the rule
+// doesn't exist.
+func (s *tagService) UpdateFoldingRule(userID string, id, targetFolderID uint) (*models.FoldingRule, error) {
+	var rule models.FoldingRule
+	if err := s.db.Where("id = ? AND user_id = ?", id, userID).First(&rule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := s.checkFoldingRuleTargets(userID, nil, targetFolderID); err != nil {
+		return nil, err
+	}
+
+	rule.TargetFolderID = targetFolderID
+	if err := s.db.Save(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// DeleteFoldingRule removes a rule and reports whether it existed
+func (s *tagService) DeleteFoldingRule(userID string, id uint) (bool, error) {
+	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.FoldingRule{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// checkFoldingRuleTargets verifies the tag, when given, and the target folder
+// belong to the user
+func (s *tagService) checkFoldingRuleTargets(userID string, tagID *uint, targetFolderID uint) error {
+	if tagID != nil {
+		var count int64
+		if err := s.db.Model(&models.Tag{}).Where("id = ? AND user_id = ?", *tagID, userID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrFoldingRuleTagNotFound
+		}
+	}
+
+	var count int64
+	if err := s.db.Model(&models.Folder{}).Where("id = ? AND user_id = ?", targetFolderID, userID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrFoldingRuleFolderNotFound
+	}
+	return nil
+}
+
+// applyFoldingRules moves the file into the target folder of the oldest rule
+// matching one of the newly added tags, the same way MoveFiles does. Rules
+// whose folder has since been deleted are skipped. Returns the folder the file
+// was moved to, or nil; callers place the file's object under the folder's
+// key prefix.
+func (s *fileService) applyFoldingRules(userID string, file *models.File, addedTagIDs []uint) (*uint, error) {
+	if len(addedTagIDs) == 0 {
+		return nil, nil
+	}
+
+	liveFolders := s.db.Model(&models.Folder{}).Select("id").Where("user_id = ?", userID)
+	var rule models.FoldingRule
+	err := s.db.Where("user_id = ? AND tag_id IN ? AND target_folder_id IN (?)", userID, addedTagIDs, liveFolders).
+		Order("id ASC").
+		Limit(1).
+		Find(&rule).Error
+	if err != nil || rule.ID == 0 {
+		return nil, err
+	}
+
+	// A full target folder leaves the file where it is; the tag still applies
+	moved, err := s.MoveFiles(userID, []uint{file.ID}, &rule.TargetFolderID)
+	if errors.Is(err, ErrFolderFull) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if len(moved.Moved) == 0 {
+		return nil, nil
+	}
+	return &rule.TargetFolderID, nil
+}
@@ -2,6 +2,10 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
@@ -10,13 +14,86 @@ import (
 // TagService handles tag-related operations
 type TagService interface {
 	CreateTag(userID string, tag *models.Tag) error
+	// CreateTags creates several tags in one transaction, skipping names the
+	// user already has
+	CreateTags(userID string, tags []models.Tag) (*BulkTagResult, error)
 	GetTagByID(userID string, id uint) (*models.Tag, error)
 	ListTags(userID string, keyword string, limit, offset int) ([]models.Tag, int64, error)
 	UpdateTag(userID string, tag *models.Tag) error
 	DeleteTag(userID string, id uint) error
+	// DeleteTags deletes several tags in one transaction. Tags attached to
+	// files or folders are skipped unless force is set.
+	DeleteTags(userID string, ids []uint, force bool) ([]TagDeleteResult, error)
 	GetTagsByIDs(userID string, ids []uint) ([]models.Tag, error)
+	AddTagAlias(userID string, tagID uint, alias string) (*models.TagAlias, error)
+	RemoveTagAlias(userID string, tagID uint, aliasID uint) error
+	FindSimilarTags(userID string, name string, excludeID uint) ([]models.Tag, error)
+	ListFolderTags(userID string, folderID uint, recursive bool) ([]TagFileCount, error)
+
+	// Folding rule operations
+	ListFoldingRules(userID string) ([]models.FoldingRule, error)
+	CreateFoldingRule(userID string, tagID, targetFolderID uint) (*models.FoldingRule, error)
+	UpdateFoldingRule(userID string, id, targetFolderID uint) (*models.FoldingRule, error)
+	DeleteFoldingRule(userID string, id uint) (bool, error)
+}
+
+// TagFileCount is a tag and the number of files in a folder that carry it
+type TagFileCount struct {
+	Tag       models.Tag
+	FileCount int64
+}
+
+// MaxBulkTags is the most tags one CreateTags or DeleteTags call should handle
+const MaxBulkTags = 100
+
+// ErrInvalidTagIDs is returned by ParseTagIDs for a token that isn't a tag ID
+var ErrInvalidTagIDs = errors.New("tag_ids must be a comma-separated list of positive integer IDs")
+
+// ParseTagIDs parses a comma-separated tag_ids filter. Blank entries are
+// skipped, but any other token that isn't a positive integer is an error
+// rather than being dropped, which would silently widen the filter.
+func ParseTagIDs(value string) ([]uint, error) {
+	var ids []uint
+	for _, token := range strings.Split(value, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(token, 10, 32)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("%w: invalid ID %q", ErrInvalidTagIDs, token)
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, nil
 }
 
+// BulkTagResult reports the outcome of creating several tags at once
+type BulkTagResult struct {
+	Created []models.Tag // Newly created tags, in request order
+	Skipped []models.Tag // Existing tags matching a requested name, in request order
+}
+
+// TagDeleteStatus is the outcome of deleting one tag in a bulk delete
+type TagDeleteStatus string
+
+const (
+	TagDeleted        TagDeleteStatus = "deleted"
+	TagSkippedInUse   TagDeleteStatus = "skipped_in_use"
+	TagDeleteNotFound TagDeleteStatus = "not_found"
+)
+
+// TagDeleteResult reports what a bulk delete did with one tag
+type TagDeleteResult struct {
+	TagID       uint
+	Status      TagDeleteStatus
+	FileCount   int64 // Live files the tag was attached to
+	FolderCount int64 // Live folders the tag was attached to
+}
+
+// maxSimilarTags is the number of similar tags FindSimilarTags returns
+const maxSimilarTags = 5
+
 type tagService struct {
 	db *gorm.DB
 }
@@ -32,10 +109,55 @@ func (s *tagService) CreateTag(userID string, tag *models.Tag) error {
 	return s.db.Create(tag).Error
 }
 
+// CreateTags creates the tags in one transaction. A tag whose name matches,
+// ignoring case, one the user already has or one earlier in the list is
+// skipped and reported by its existing tag.
+func (s *tagService) CreateTags(userID string, tags []models.Tag) (*BulkTagResult, error) {
+	result := &BulkTagResult{Created: []models.Tag{}, Skipped: []models.Tag{}}
+	if len(tags) == 0 {
+		return result, nil
+	}
+
+	names := make([]string, len(tags))
+	for i := range tags {
+		names[i] = strings.ToLower(tags[i].Name)
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var existing []models.Tag
+		if err := tx.Where("user_id = ? AND LOWER(name) IN ?", userID, names).Find(&existing).Error; err != nil {
+			return err
+		}
+		byName := make(map[string]models.Tag, len(existing)+len(tags))
+		for _, tag := range existing {
+			byName[strings.ToLower(tag.Name)] = tag
+		}
+
+		for i, tag := range tags {
+			if match, ok := byName[names[i]]; ok {
+				result.Skipped = append(result.Skipped, match)
+				continue
+			}
+			tag.ID = 0
+			tag.UserID = userID
+			if err := tx.Create(&tag).Error; err != nil {
+				return err
+			}
+			byName[names[i]] = tag
+			result.Created = append(result.Created, tag)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetTagByID retrieves a tag by ID for a specific user
 func (s *tagService) GetTagByID(userID string, id uint) (*models.Tag, error) {
 	var tag models.Tag
-	err := s.db.Where("id = ? AND user_id = ?", id, userID).First(&tag).Error
+	err := s.db.Preload("Aliases").Where("id = ? AND user_id = ?", id, userID).First(&tag).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -45,7 +167,8 @@ func (s *tagService) GetTagByID(userID string, id uint) (*models.Tag, error) {
 	return &tag, nil
 }
 
-// ListTags lists all tags for a user with optional keyword search
+// ListTags lists all tags for a user with optional keyword search.
+// The keyword matches tag names, descriptions and aliases.
 func (s *tagService) ListTags(userID string, keyword string, limit, offset int) ([]models.Tag, int64, error) {
 	var tags []models.Tag
 	var total int64
@@ -54,7 +177,8 @@ func (s *tagService) ListTags(userID string, keyword string, limit, offset int)
 
 	if keyword != "" {
 		searchPattern := "%" + keyword + "%"
-		query = query.Where("name LIKE ? OR description LIKE ?", searchPattern, searchPattern)
+		aliasQuery := s.db.Model(&models.TagAlias{}).Select("tag_id").Where("user_id = ? AND alias LIKE ?", userID, searchPattern)
+		query = query.Where("name LIKE ? OR description LIKE ? OR id IN (?)", searchPattern, searchPattern, aliasQuery)
 	}
 
 	// Count total before pagination
@@ -70,7 +194,7 @@ func (s *tagService) ListTags(userID string, keyword string, limit, offset int)
 		query = query.Offset(offset)
 	}
 
-	if err := query.Order("created_at DESC").Find(&tags).Error; err != nil {
+	if err := query.Preload("Aliases").Order("created_at DESC").Find(&tags).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -79,6 +203,8 @@ func (s *tagService) ListTags(userID string, keyword string, limit, offset int)
 
 // UpdateTag updates a tag
 func (s *tagService) UpdateTag(userID string, tag *models.Tag) error {
+	defer markFilesChanged()
+
 	// Verify ownership
 	existing, err := s.GetTagByID(userID, tag.ID)
 	if err != nil {
@@ -95,11 +221,28 @@ func (s *tagService) UpdateTag(userID string, tag *models.Tag) error {
 		"description": tag.Description,
 	}
 
-	return s.db.Model(&models.Tag{}).Where("id = ? AND user_id = ?", tag.ID, userID).Updates(updates).Error
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Tag{}).Where("id = ? AND user_id = ?", tag.ID, userID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if tag.Name == existing.Name && tag.Description == existing.Description {
+			return nil
+		}
+		return deleteTagEmbeddings(tx, tag.ID)
+	})
+}
+
+// deleteTagEmbeddings drops the stored embeddings of the tags after their text
+// changed or they were deleted. AutoTagService embeds a tag again the next
+// time it matches files, so a renamed tag is never scored by its old name.
+func deleteTagEmbeddings(tx *gorm.DB, tagIDs ...uint) error {
+	return tx.Where("tag_id IN ?", tagIDs).Delete(&models.TagEmbedding{}).Error
 }
 
-// DeleteTag deletes a tag
+// DeleteTag deletes a tag, its aliases and its folding rule
 func (s *tagService) DeleteTag(userID string, id uint) error {
+	defer markFilesChanged()
+
 	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Tag{})
 	if result.Error != nil {
 		return result.Error
@@ -107,7 +250,118 @@ func (s *tagService) DeleteTag(userID string, id uint) error {
 	if result.RowsAffected == 0 {
 		return errors.New("tag not found")
 	}
-	return nil
+	if err := s.db.Where("tag_id = ? AND user_id = ?", id, userID).Delete(&models.TagAlias{}).Error; err != nil {
+		return err
+	}
+	if err := s.db.Where("tag_id = ? AND user_id = ?", id, userID).Delete(&models.FoldingRule{}).Error; err != nil {
+		return err
+	}
+	return deleteTagEmbeddings(s.db, id)
+}
+
+// DeleteTags deletes the tags in one transaction and reports each requested
+// ID once, in request order. Without force, tags attached to live files or
+// folders are skipped; with force they are deleted and detached.
+func (s *tagService) DeleteTags(userID string, ids []uint, force bool) ([]TagDeleteResult, error) {
+	defer markFilesChanged()
+
+	results := []TagDeleteResult{}
+	if len(ids) == 0 {
+		return results, nil
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var existing []uint
+		if err := tx.Model(&models.Tag{}).Where("id IN ? AND user_id = ?", ids, userID).Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+		found := make(map[uint]bool, len(existing))
+		for _, id := range existing {
+			found[id] = true
+		}
+
+		fileCounts, err := tagUsageCounts(tx, "file_tags", "file_id", "files", userID, existing)
+		if err != nil {
+			return err
+		}
+		folderCounts, err := tagUsageCounts(tx, "folder_tags", "folder_id", "folders", userID, existing)
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[uint]bool, len(ids))
+		var deleteIDs []uint
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			result := TagDeleteResult{TagID: id, Status: TagDeleteNotFound}
+			if found[id] {
+				result.FileCount = fileCounts[id]
+				result.FolderCount = folderCounts[id]
+				if !force && (result.FileCount > 0 || result.FolderCount > 0) {
+					result.Status = TagSkippedInUse
+				} else {
+					result.Status = TagDeleted
+					deleteIDs = append(deleteIDs, id)
+				}
+			}
+			results = append(results, result)
+		}
+
+		if len(deleteIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("id IN ? AND user_id = ?", deleteIDs, userID).Delete(&models.Tag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id IN ? AND user_id = ?", deleteIDs, userID).Delete(&models.TagAlias{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id IN ? AND user_id = ?", deleteIDs, userID).Delete(&models.FoldingRule{}).Error; err != nil {
+			return err
+		}
+		if err := deleteTagEmbeddings(tx, deleteIDs...); err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM file_tags WHERE tag_id IN ?", deleteIDs).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM folder_tags WHERE tag_id IN ?", deleteIDs).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// tagUsageCounts counts, per tag, the user's live rows of table attached to
+// the tags through joinTable
+func tagUsageCounts(tx *gorm.DB, joinTable, joinColumn, table, userID string, tagIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(tagIDs))
+	if len(tagIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		TagID uint
+		Count int64
+	}
+	err := tx.Table(joinTable).
+		Select(joinTable+".tag_id AS tag_id, COUNT(*) AS count").
+		Joins("JOIN "+table+" ON "+table+".id = "+joinTable+"."+joinColumn).
+		Where(joinTable+".tag_id IN ? AND "+table+".user_id = ? AND "+table+".deleted_at IS NULL", tagIDs, userID).
+		Group(joinTable + ".tag_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+	return counts, nil
 }
 
 // GetTagsByIDs retrieves multiple tags by their IDs
@@ -119,3 +373,196 @@ func (s *tagService) GetTagsByIDs(userID string, ids []uint) ([]models.Tag, erro
 	err := s.db.Where("id IN ? AND user_id = ?", ids, userID).Find(&tags).Error
 	return tags, err
 }
+
+// ListFolderTags returns the tags attached to files in a folder, most used
+// first. With recursive, files in subfolders are counted too.
+func (s *tagService) ListFolderTags(userID string, folderID uint, recursive bool) ([]TagFileCount, error) {
+	folderIDs := []uint{folderID}
+	if recursive {
+		var err error
+		if folderIDs, err = subtreeFolderIDs(s.db, userID, folderID); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []struct {
+		TagID     uint
+		FileCount int64
+	}
+	err := s.db.Table("file_tags").
+		Select("file_tags.tag_id, COUNT(DISTINCT file_tags.file_id) AS file_count").
+		Joins("JOIN files ON files.id = file_tags.file_id").
+		Joins("JOIN tags ON tags.id = file_tags.tag_id").
+		Where("files.user_id = ? AND files.folder_id IN ? AND files.deleted_at IS NULL", userID, folderIDs).
+		Where("tags.user_id = ? AND tags.deleted_at IS NULL", userID).
+		Group("file_tags.tag_id, tags.name").
+		Order("file_count DESC, tags.name ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make([]uint, len(rows))
+	for i, row := range rows {
+		tagIDs[i] = row.TagID
+	}
+	tags, err := s.GetTagsByIDs(userID, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	tagsByID := make(map[uint]models.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByID[tag.ID] = tag
+	}
+
+	counts := make([]TagFileCount, 0, len(rows))
+	for _, row := range rows {
+		if tag, ok := tagsByID[row.TagID]; ok {
+			counts = append(counts, TagFileCount{Tag: tag, FileCount: row.FileCount})
+		}
+	}
+	return counts, nil
+}
+
+// AddTagAlias adds an alternate name to a tag. Aliases are unique per user
+// (case-insensitive) and may not shadow the name of another tag.
+func (s *tagService) AddTagAlias(userID string, tagID uint, alias string) (*models.TagAlias, error) {
+	alias = strings.TrimSpace(alias)
+	if alias == "" {
+		return nil, errors.New("alias is required")
+	}
+
+	tag, err := s.GetTagByID(userID, tagID)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, errors.New("tag not found")
+	}
+
+	var count int64
+	if err := s.db.Model(&models.TagAlias{}).
+		Where("user_id = ? AND LOWER(alias) = LOWER(?)", userID, alias).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errors.New("alias already exists")
+	}
+
+	if err := s.db.Model(&models.Tag{}).
+		Where("user_id = ? AND id <> ? AND LOWER(name) = LOWER(?)", userID, tagID, alias).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errors.New("alias conflicts with an existing tag name")
+	}
+
+	tagAlias := &models.TagAlias{
+		TagID:  tagID,
+		UserID: userID,
+		Alias:  alias,
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(tagAlias).Error; err != nil {
+			return err
+		}
+		return deleteTagEmbeddings(tx, tagID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tagAlias, nil
+}
+
+// RemoveTagAlias removes an alias from a tag
+func (s *tagService) RemoveTagAlias(userID string, tagID uint, aliasID uint) error {
+	result := s.db.Where("id = ? AND tag_id = ? AND user_id = ?", aliasID, tagID, userID).Delete(&models.TagAlias{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("alias not found")
+	}
+	return deleteTagEmbeddings(s.db, tagID)
+}
+
+// FindSimilarTags returns the user's tags whose names are close to name:
+// a small edit distance or a shared prefix. The closest matches come first.
+func (s *tagService) FindSimilarTags(userID string, name string, excludeID uint) ([]models.Tag, error) {
+	target := strings.ToLower(strings.TrimSpace(name))
+	if target == "" {
+		return nil, nil
+	}
+
+	var tags []models.Tag
+	if err := s.db.Where("user_id = ? AND id <> ?", userID, excludeID).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	type scoredTag struct {
+		tag      models.Tag
+		distance int
+	}
+	var similar []scoredTag
+	for _, tag := range tags {
+		candidate := strings.ToLower(strings.TrimSpace(tag.Name))
+		distance := editDistance(target, candidate)
+		if distance <= similarNameThreshold(target, candidate) || sharesNamePrefix(target, candidate) {
+			similar = append(similar, scoredTag{tag: tag, distance: distance})
+		}
+	}
+
+	sort.SliceStable(similar, func(i, j int) bool {
+		return similar[i].distance < similar[j].distance
+	})
+	if len(similar) > maxSimilarTags {
+		similar = similar[:maxSimilarTags]
+	}
+
+	result := make([]models.Tag, len(similar))
+	for i, st := range similar {
+		result[i] = st.tag
+	}
+	return result, nil
+}
+
+// similarNameThreshold is the edit distance under which two names count as
+// similar: roughly one typo per four characters of the shorter name
+func similarNameThreshold(a, b string) int {
+	shorter := min(len([]rune(a)), len([]rune(b)))
+	return max(1, shorter/4)
+}
+
+// sharesNamePrefix reports whether one name starts with the other, ignoring
+// prefixes too short to be meaningful (e.g. "tax" and "taxes")
+func sharesNamePrefix(a, b string) bool {
+	if len([]rune(a)) < 3 || len([]rune(b)) < 3 {
+		return false
+	}
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
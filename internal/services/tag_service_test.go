@@ -0,0 +1,163 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tagTestUserID = "tag-test-user"
+
+func newTestTagService(t *testing.T) TagService {
+	return NewTagService(newTestDB(t))
+}
+
+func TestFindSimilarTags(t *testing.T) {
+	service := newTestTagService(t)
+	for _, name := range []string{"Receipts", "Reciepts 2024", "Tax", "Taxes", "Travel", "Contracts"} {
+		require.NoError(t, service.CreateTag(tagTestUserID, &models.Tag{Name: name}))
+	}
+
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{name: "receipt", expected: []string{"Receipts"}},
+		{name: "Recipts", expected: []string{"Receipts"}},
+		{name: "taxs", expected: []string{"Tax", "Taxes"}},
+		{name: "contract", expected: []string{"Contracts"}},
+		{name: "Music", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			similar, err := service.FindSimilarTags(tagTestUserID, tt.name, 0)
+			require.NoError(t, err)
+
+			var names []string
+			for _, tag := range similar {
+				names = append(names, tag.Name)
+			}
+			assert.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
+func TestFindSimilarTags_ExcludesTagAndOtherUsers(t *testing.T) {
+	service := newTestTagService(t)
+	created := &models.Tag{Name: "Invoice"}
+	require.NoError(t, service.CreateTag(tagTestUserID, created))
+	require.NoError(t, service.CreateTag("other-user", &models.Tag{Name: "Invoices"}))
+
+	similar, err := service.FindSimilarTags(tagTestUserID, created.Name, created.ID)
+	require.NoError(t, err)
+	assert.Empty(t, similar)
+}
+
+func TestFoldingRules_OldestMatchingRuleWins(t *testing.T) {
+	db := newTestDB(t)
+	tagService := NewTagService(db)
+	fileService := NewFileService(db, FileConfig{})
+
+	invoices := &models.Tag{Name: "Invoices"}
+	urgent := &models.Tag{Name: "Urgent"}
+	require.NoError(t, tagService.CreateTag(tagTestUserID, invoices))
+	require.NoError(t, tagService.CreateTag(tagTestUserID, urgent))
+
+	invoiceFolder := &models.Folder{UserID: tagTestUserID, Name: "Invoices"}
+	urgentFolder := &models.Folder{UserID: tagTestUserID, Name: "Urgent"}
+	require.NoError(t, db.Create(invoiceFolder).Error)
+	require.NoError(t, db.Create(urgentFolder).Error)
+
+	_, err := tagService.CreateFoldingRule(tagTestUserID, urgent.ID, urgentFolder.ID)
+	require.NoError(t, err)
+	_, err = tagService.CreateFoldingRule(tagTestUserID, invoices.ID, invoiceFolder.ID)
+	require.NoError(t, err)
+
+	_, err = tagService.CreateFoldingRule(tagTestUserID, invoices.ID, urgentFolder.ID)
+	assert.ErrorIs(t, err, ErrFoldingRuleExists)
+
+	file := &models.File{Title: "Bill", S3Key: "bill.pdf", OriginalFilename: "bill.pdf"}
+	require.NoError(t, fileService.CreateFile(tagTestUserID, file))
+
+	result, err := fileService.AddTagsToFile(tagTestUserID, file.ID, []uint{invoices.ID, urgent.ID})
+	require.NoError(t, err)
+	require.NotNil(t, result.MovedToFolder)
+	assert.Equal(t, urgentFolder.ID, *result.MovedToFolder)
+
+	// Rules pointing at deleted folders are skipped
+	other := &models.File{Title: "Receipt", S3Key: "receipt.pdf", OriginalFilename: "receipt.pdf"}
+	require.NoError(t, fileService.CreateFile(tagTestUserID, other))
+	require.NoError(t, db.Delete(urgentFolder).Error)
+
+	result, err = fileService.AddTagsToFile(tagTestUserID, other.ID, []uint{urgent.ID})
+	require.NoError(t, err)
+	assert.Nil(t, result.MovedToFolder)
+}
+
+func TestFoldingRules_FullFolderKeepsFile(t *testing.T) {
+	db := newTestDB(t)
+	tagService := NewTagService(db)
+	fileService := NewFileService(db, FileConfig{MaxFilesPerFolder: 1})
+
+	tag := &models.Tag{Name: "Invoices"}
+	require.NoError(t, tagService.CreateTag(tagTestUserID, tag))
+	folder := &models.Folder{UserID: tagTestUserID, Name: "Invoices"}
+	require.NoError(t, db.Create(folder).Error)
+	_, err := tagService.CreateFoldingRule(tagTestUserID, tag.ID, folder.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, fileService.CreateFile(tagTestUserID, &models.File{Title: "Filed", S3Key: "filed.pdf", OriginalFilename: "filed.pdf", FolderID: &folder.ID}))
+	file := &models.File{Title: "Bill", S3Key: "bill.pdf", OriginalFilename: "bill.pdf"}
+	require.NoError(t, fileService.CreateFile(tagTestUserID, file))
+
+	result, err := fileService.AddTagsToFile(tagTestUserID, file.ID, []uint{tag.ID})
+	require.NoError(t, err)
+	assert.Nil(t, result.MovedToFolder)
+	assert.Equal(t, []uint{tag.ID}, result.Added)
+
+	stored, err := fileService.GetFileByID(tagTestUserID, file.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.FolderID)
+}
+
+func TestDeleteTagRemovesFoldingRules(t *testing.T) {
+	db := newTestDB(t)
+	tagService := NewTagService(db)
+
+	folder := &models.Folder{UserID: tagTestUserID, Name: "Archive"}
+	require.NoError(t, db.Create(folder).Error)
+	single := &models.Tag{Name: "Old"}
+	batch := &models.Tag{Name: "Older"}
+	require.NoError(t, tagService.CreateTag(tagTestUserID, single))
+	require.NoError(t, tagService.CreateTag(tagTestUserID, batch))
+	for _, tag := range []*models.Tag{single, batch} {
+		_, err := tagService.CreateFoldingRule(tagTestUserID, tag.ID, folder.ID)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tagService.DeleteTag(tagTestUserID, single.ID))
+	_, err := tagService.DeleteTags(tagTestUserID, []uint{batch.ID}, false)
+	require.NoError(t, err)
+
+	var count int64
+	require.NoError(t, db.Model(&models.FoldingRule{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestParseTagIDs(t *testing.T) {
+	ids, err := ParseTagIDs(" 3, 1 ,,2,")
+	require.NoError(t, err)
+	assert.Equal(t, []uint{3, 1, 2}, ids)
+
+	ids, err = ParseTagIDs("")
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	for _, value := range []string{"abc", "1,abc", "0", "-1", "1.5", "99999999999"} {
+		_, err := ParseTagIDs(value)
+		assert.ErrorIs(t, err, ErrInvalidTagIDs, value)
+	}
+}
@@ -3,9 +3,16 @@ package services
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"path"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -14,12 +21,169 @@ import (
 	"github.com/google/uuid"
 )
 
-// UploadService handles file uploads to S3-compatible storage
+// maxS3PrefixLength bounds folder key prefixes, leaving room in the key for
+// the user scope and object name
+const maxS3PrefixLength = 200
+
+// s3PrefixSegmentPattern matches one "/"-separated segment of a key prefix.
+// Segments can't start with a dot, which rules out "." and "..".
+var s3PrefixSegmentPattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// ErrInvalidS3Prefix is returned for a folder key prefix that isn't a
+// relative path of letters, digits, ".", "_" and "-"
+var ErrInvalidS3Prefix = errors.New("s3_prefix must be a relative path of letters, digits, '.', '_' and '-' segments")
+
+// NormalizeS3Prefix trims surrounding slashes and spaces from a folder key
+// prefix and validates it. An empty prefix is valid and means none.
+func NormalizeS3Prefix(prefix string) (string, error) {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	if prefix == "" {
+		return "", nil
+	}
+	if len(prefix) > maxS3PrefixLength {
+		return "", fmt.Errorf("s3_prefix must be at most %d characters", maxS3PrefixLength)
+	}
+	for _, segment := range strings.Split(prefix, "/") {
+		if !s3PrefixSegmentPattern.MatchString(segment) {
+			return "", ErrInvalidS3Prefix
+		}
+	}
+	return prefix, nil
+}
+
+// userKeyScope is the part of every object key identifying the owner
+func userKeyScope(userID string) string {
+	return "files/" + userID + "/"
+}
+
+// maxObjectKeyLength is the longest key S3 accepts, in bytes
+const maxObjectKeyLength = 1024
+
+// maxObjectKeyExtLength bounds the filename extension kept in object keys
+const maxObjectKeyExtLength = 16
+
+// objectKeyExtPattern matches the extensions kept in object keys
+var objectKeyExtPattern = regexp.MustCompile(`^\.[A-Za-z0-9]+$`)
+
+// ErrObjectKeyTooLong is returned when an upload's object key would exceed
+// the S3 key length limit
+var ErrObjectKeyTooLong = fmt.Errorf("object key must be at most %d bytes", maxObjectKeyLength)
+
+// NewObjectKey generates a unique key for an upload or a copied object,
+// placed under the folder's prefix inside the user's scope when prefix is set.
+// Only a short alphanumeric extension of filename is kept, so pathological
+// names can't make the key invalid.
+func NewObjectKey(userID, prefix, filename string) (string, error) {
+	name := uuid.New().String() + objectKeyExt(filename)
+	key := userKeyScope(userID) + name
+	if prefix != "" {
+		key = userKeyScope(userID) + prefix + "/" + name
+	}
+	if len(key) > maxObjectKeyLength {
+		return "", ErrObjectKeyTooLong
+	}
+	return key, nil
+}
+
+// objectKeyExt returns filename's extension, or "" when it is too long or
+// has characters other than letters and digits
+func objectKeyExt(filename string) string {
+	ext := filepath.Ext(filename)
+	if len(ext) > maxObjectKeyExtLength || !objectKeyExtPattern.MatchString(ext) {
+		return ""
+	}
+	return ext
+}
+
+// TruncateFilename shortens name to at most maxBytes bytes, keeping its
+// extension and whole UTF-8 characters. The same name always truncates the
+// same way. Extensions too long to keep are cut along with the rest.
+func TruncateFilename(name string, maxBytes int) string {
+	if len(name) <= maxBytes {
+		return name
+	}
+	ext := path.Ext(name)
+	if len(ext) > maxBytes/2 {
+		ext = ""
+	}
+	base := strings.TrimSuffix(name, ext)
+	limit := maxBytes - len(ext)
+	for limit > 0 && !utf8.RuneStart(base[limit]) {
+		limit--
+	}
+	return base[:limit] + ext
+}
+
+// PrefixedObjectKey returns where an existing object belongs under a folder
+// prefix, keeping its object name. ok is false when the key is already under
+// the prefix or isn't scoped to the user, e.g. an imported object.
+func PrefixedObjectKey(key, userID, prefix string) (string, bool) {
+	scope := userKeyScope(userID)
+	if prefix == "" || !strings.HasPrefix(key, scope) || strings.HasPrefix(key, scope+prefix+"/") {
+		return "", false
+	}
+	return scope + prefix + "/" + path.Base(key), true
+}
+
+// UploadService handles file uploads to S3-compatible storage. prefix places
+// new objects under a folder's key prefix; pass "" for the default layout.
 type UploadService interface {
-	UploadFile(ctx context.Context, userID string, filename string, content []byte, contentType string) (string, error)
-	GetPresignedUploadURL(ctx context.Context, userID string, filename string, contentType string) (string, string, error)
+	UploadFile(ctx context.Context, userID, prefix string, filename string, content []byte, contentType string) (string, error)
+	GetPresignedUploadURL(ctx context.Context, userID, prefix string, filename string, contentType string) (string, string, error)
 	GetPresignedDownloadURL(ctx context.Context, key string) (string, error)
 	DeleteFile(ctx context.Context, key string) error
+	CopyFile(ctx context.Context, srcKey, dstKey string) error
+}
+
+// MaxPresignBatch is the most upload URLs one PresignUploads call should create
+const MaxPresignBatch = 100
+
+// presignConcurrency bounds how many URLs PresignUploads signs at once
+const presignConcurrency = 8
+
+// PresignRequest describes one file to get an upload URL for
+type PresignRequest struct {
+	Filename    string
+	ContentType string
+}
+
+// PresignedUpload is a presigned upload URL and the key it uploads to
+type PresignedUpload struct {
+	Filename    string
+	ContentType string
+	UploadURL   string
+	Key         string
+}
+
+// PresignUploads generates an upload URL for each request concurrently and
+// returns them in request order. It fails if any URL can't be generated.
+func PresignUploads(ctx context.Context, service UploadService, userID, prefix string, requests []PresignRequest) ([]PresignedUpload, error) {
+	uploads := make([]PresignedUpload, len(requests))
+	errs := make([]error, len(requests))
+	sem := make(chan struct{}, presignConcurrency)
+	var wg sync.WaitGroup
+
+	for i, req := range requests {
+		wg.Add(1)
+		go func(i int, req PresignRequest) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			uploadURL, key, err := service.GetPresignedUploadURL(ctx, userID, prefix, req.Filename, req.ContentType)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", req.Filename, err)
+				return
+			}
+			uploads[i] = PresignedUpload{Filename: req.Filename, ContentType: req.ContentType, UploadURL: uploadURL, Key: key}
+		}(i, req)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return uploads, nil
 }
 
 // S3Config holds S3 configuration
@@ -80,13 +244,15 @@ func NewUploadService(cfg S3Config) (UploadService, error) {
 }
 
 // UploadFile uploads a file to S3 and returns the object key
-func (s *uploadService) UploadFile(ctx context.Context, userID string, filename string, content []byte, contentType string) (string, error) {
+func (s *uploadService) UploadFile(ctx context.Context, userID, prefix string, filename string, content []byte, contentType string) (string, error) {
 	// Generate unique key with user ID prefix
-	ext := filepath.Ext(filename)
-	key := fmt.Sprintf("files/%s/%s%s", userID, uuid.New().String(), ext)
+	key, err := NewObjectKey(userID, prefix, filename)
+	if err != nil {
+		return "", err
+	}
 
 	// Upload to S3
-	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(s.bucket),
 		Key:         aws.String(key),
 		Body:        bytes.NewReader(content),
@@ -101,10 +267,12 @@ func (s *uploadService) UploadFile(ctx context.Context, userID string, filename
 
 // GetPresignedUploadURL generates a presigned URL for direct upload
 // Returns the presigned URL and the object key
-func (s *uploadService) GetPresignedUploadURL(ctx context.Context, userID string, filename string, contentType string) (string, string, error) {
+func (s *uploadService) GetPresignedUploadURL(ctx context.Context, userID, prefix string, filename string, contentType string) (string, string, error) {
 	// Generate unique key with user ID prefix
-	ext := filepath.Ext(filename)
-	key := fmt.Sprintf("files/%s/%s%s", userID, uuid.New().String(), ext)
+	key, err := NewObjectKey(userID, prefix, filename)
+	if err != nil {
+		return "", "", err
+	}
 
 	// Generate presigned PUT URL
 	presignResult, err := s.presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
@@ -148,6 +316,19 @@ func (s *uploadService) DeleteFile(ctx context.Context, key string) error {
 	return nil
 }
 
+// CopyFile copies an object to a new key within the bucket
+func (s *uploadService) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	_, err := s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(s.bucket),
+		CopySource: aws.String(url.PathEscape(s.bucket + "/" + srcKey)),
+		Key:        aws.String(dstKey),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy file: %w", err)
+	}
+	return nil
+}
+
 // MockUploadService is a mock implementation for testing
 type MockUploadService struct {
 	files map[string][]byte
@@ -160,16 +341,20 @@ func NewMockUploadService() UploadService {
 	}
 }
 
-func (m *MockUploadService) UploadFile(ctx context.Context, userID string, filename string, content []byte, contentType string) (string, error) {
-	ext := filepath.Ext(filename)
-	key := fmt.Sprintf("files/%s/%s%s", userID, uuid.New().String(), ext)
+func (m *MockUploadService) UploadFile(ctx context.Context, userID, prefix string, filename string, content []byte, contentType string) (string, error) {
+	key, err := NewObjectKey(userID, prefix, filename)
+	if err != nil {
+		return "", err
+	}
 	m.files[key] = content
 	return key, nil
 }
 
-func (m *MockUploadService) GetPresignedUploadURL(ctx context.Context, userID string, filename string, contentType string) (string, string, error) {
-	ext := filepath.Ext(filename)
-	key := fmt.Sprintf("files/%s/%s%s", userID, uuid.New().String(), ext)
+func (m *MockUploadService) GetPresignedUploadURL(ctx context.Context, userID, prefix string, filename string, contentType string) (string, string, error) {
+	key, err := NewObjectKey(userID, prefix, filename)
+	if err != nil {
+		return "", "", err
+	}
 	// Return a mock presigned URL
 	return fmt.Sprintf("https://mock-s3.example.com/%s?presigned=true", key), key, nil
 }
@@ -182,3 +367,10 @@ func (m *MockUploadService) DeleteFile(ctx context.Context, key string) error {
 	delete(m.files, key)
 	return nil
 }
+
+func (m *MockUploadService) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	if content, ok := m.files[srcKey]; ok {
+		m.files[dstKey] = content
+	}
+	return nil
+}
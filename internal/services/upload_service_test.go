@@ -0,0 +1,52 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateFilename(t *testing.T) {
+	assert.Equal(t, "report.pdf", TruncateFilename("report.pdf", 20))
+
+	long := strings.Repeat("a", 300) + ".pdf"
+	got := TruncateFilename(long, 240)
+	assert.Len(t, got, 240)
+	assert.True(t, strings.HasSuffix(got, ".pdf"))
+	assert.Equal(t, got, TruncateFilename(long, 240))
+
+	// Multi-byte characters are never split
+	got = TruncateFilename(strings.Repeat("é", 200)+".txt", 101)
+	assert.True(t, utf8.ValidString(got))
+	assert.LessOrEqual(t, len(got), 101)
+	assert.True(t, strings.HasSuffix(got, ".txt"))
+
+	// An extension longer than half the limit is cut with the rest
+	got = TruncateFilename("a."+strings.Repeat("x", 300), 240)
+	assert.Len(t, got, 240)
+	assert.True(t, strings.HasPrefix(got, "a.x"))
+}
+
+func TestNewObjectKey(t *testing.T) {
+	key, err := NewObjectKey("user-1", "", "invoice.pdf")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "files/user-1/"))
+	assert.True(t, strings.HasSuffix(key, ".pdf"))
+
+	key, err = NewObjectKey("user-1", "clients/acme", "invoice.pdf")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "files/user-1/clients/acme/"))
+
+	// Long or unusual extensions are dropped
+	for _, filename := range []string{"a." + strings.Repeat("x", 500), "notes.p df", "archive.", "README"} {
+		key, err = NewObjectKey("user-1", "", filename)
+		require.NoError(t, err)
+		assert.NotContains(t, key, ".", filename)
+	}
+
+	_, err = NewObjectKey(strings.Repeat("u", maxObjectKeyLength), "", "invoice.pdf")
+	assert.ErrorIs(t, err, ErrObjectKeyTooLong)
+}
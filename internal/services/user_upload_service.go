@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrStorageNotConfigured is returned when a user has no storage config and
+// no global bucket is configured
+var ErrStorageNotConfigured = errors.New("file storage is not configured")
+
+const (
+	// storageConfigTTL is how long a bucket client is used before its config is
+	// checked again for rotated credentials
+	storageConfigTTL = time.Minute
+	// maxCachedObjectKeys bounds the object key to storage config cache
+	maxCachedObjectKeys = 10000
+)
+
+// userUploadService resolves the storage backend of each object from the
+// StorageConfig its File was uploaded with, falling back to the global
+// upload service. New uploads go to the user's newest StorageConfig.
+type userUploadService struct {
+	db       *gorm.DB
+	fallback UploadService
+	// secretKey decrypts StorageConfig.SecretAccessKey
+	secretKey []byte
+	// newBackend builds the service for a user's bucket; replaced in tests
+	newBackend func(cfg S3Config) (UploadService, error)
+
+	mu       sync.Mutex
+	backends map[uint]userBackend
+	// objectConfigs remembers which storage config (nil for the global bucket)
+	// each object key lives in
+	objectConfigs map[string]*uint
+}
+
+// userBackend is a cached bucket client and the config version it was built from
+type userBackend struct {
+	service   UploadService
+	updatedAt time.Time
+	checkedAt time.Time
+}
+
+// NewUserUploadService creates an UploadService that stores each user's files
+// in the bucket from their StorageConfig. Users without a config use fallback,
+// which may be nil when no global bucket is configured. secretKey decrypts
+// the configs' secrets; see EncryptStorageSecret.
+func NewUserUploadService(db *gorm.DB, fallback UploadService, secretKey []byte) UploadService {
+	return &userUploadService{
+		db:            db,
+		fallback:      fallback,
+		secretKey:     secretKey,
+		newBackend:    NewUploadService,
+		backends:      make(map[uint]userBackend),
+		objectConfigs: make(map[string]*uint),
+	}
+}
+
+// CurrentStorageConfigID returns the ID of the storage config new uploads of
+// a user go to, or nil when they use the global bucket
+func CurrentStorageConfigID(db *gorm.DB, userID string) (*uint, error) {
+	var ids []uint
+	if err := db.Model(&models.StorageConfig{}).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Limit(1).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return &ids[0], nil
+}
+
+// forConfig returns the storage backend for a storage config, or the global
+// bucket when configID is nil. Deleted configs still serve the files stored
+// with them.
+func (s *userUploadService) forConfig(configID *uint) (UploadService, error) {
+	if configID == nil {
+		if s.fallback == nil {
+			return nil, ErrStorageNotConfigured
+		}
+		return s.fallback, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	backend, cached := s.backends[*configID]
+	if cached && time.Since(backend.checkedAt) < storageConfigTTL {
+		return backend.service, nil
+	}
+
+	var cfg models.StorageConfig
+	if err := s.db.Unscoped().First(&cfg, *configID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStorageNotConfigured
+		}
+		return nil, err
+	}
+
+	// Reuse the client until the config changes
+	if cached && backend.updatedAt.Equal(cfg.UpdatedAt) {
+		backend.checkedAt = time.Now()
+		s.backends[*configID] = backend
+		return backend.service, nil
+	}
+
+	secret, err := decryptStorageSecret(s.secretKey, cfg.SecretAccessKey)
+	if err != nil {
+		return nil, fmt.Errorf("storage config %d: %w", cfg.ID, err)
+	}
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	service, err := s.newBackend(S3Config{
+		Endpoint:        cfg.Endpoint,
+		Bucket:          cfg.Bucket,
+		AccessKeyID:     cfg.AccessKeyID,
+		SecretAccessKey: secret,
+		Region:          region,
+		UsePathStyle:    cfg.UsePathStyle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.backends[*configID] = userBackend{service: service, updatedAt: cfg.UpdatedAt, checkedAt: time.Now()}
+	return service, nil
+}
+
+// forUser returns the backend and storage config ID new uploads of a user go to
+func (s *userUploadService) forUser(userID string) (UploadService, *uint, error) {
+	configID, err := CurrentStorageConfigID(s.db, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	backend, err := s.forConfig(configID)
+	return backend, configID, err
+}
+
+// forKey returns the storage backend and config ID of an object. The config
+// comes from the File stored under key; keys without one yet are objects
+// still being uploaded, which belong to their owner's current config.
+func (s *userUploadService) forKey(key string) (UploadService, *uint, error) {
+	s.mu.Lock()
+	configID, known := s.objectConfigs[key]
+	s.mu.Unlock()
+
+	if !known {
+		var files []models.File
+		if err := s.db.Unscoped().Select("storage_config_id").Where("s3_key = ?", key).Limit(1).Find(&files).Error; err != nil {
+			return nil, nil, err
+		}
+		switch userID, scoped := storageKeyUserID(key); {
+		case len(files) > 0:
+			configID = files[0].StorageConfigID
+			s.rememberObject(key, configID)
+		case scoped:
+			return s.forUser(userID)
+		}
+	}
+
+	backend, err := s.forConfig(configID)
+	return backend, configID, err
+}
+
+// rememberObject caches the storage config an object key lives in
+func (s *userUploadService) rememberObject(key string, configID *uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.objectConfigs) >= maxCachedObjectKeys {
+		clear(s.objectConfigs)
+	}
+	s.objectConfigs[key] = configID
+}
+
+func (s *userUploadService) UploadFile(ctx context.Context, userID, prefix string, filename string, content []byte, contentType string) (string, error) {
+	backend, configID, err := s.forUser(userID)
+	if err != nil {
+		return "", err
+	}
+	key, err := backend.UploadFile(ctx, userID, prefix, filename, content, contentType)
+	if err != nil {
+		return "", err
+	}
+	s.rememberObject(key, configID)
+	return key, nil
+}
+
+func (s *userUploadService) GetPresignedUploadURL(ctx context.Context, userID, prefix string, filename string, contentType string) (string, string, error) {
+	backend, configID, err := s.forUser(userID)
+	if err != nil {
+		return "", "", err
+	}
+	url, key, err := backend.GetPresignedUploadURL(ctx, userID, prefix, filename, contentType)
+	if err != nil {
+		return "", "", err
+	}
+	s.rememberObject(key, configID)
+	return url, key, nil
+}
+
+func (s *userUploadService) GetPresignedDownloadURL(ctx context.Context, key string) (string, error) {
+	backend, _, err := s.forKey(key)
+	if err != nil {
+		return "", err
+	}
+	return backend.GetPresignedDownloadURL(ctx, key)
+}
+
+func (s *userUploadService) DeleteFile(ctx context.Context, key string) error {
+	backend, _, err := s.forKey(key)
+	if err != nil {
+		return err
+	}
+	return backend.DeleteFile(ctx, key)
+}
+
+// CopyFile copies an object within the bucket it is stored in. Copies keep
+// the source's StorageConfigID, so dstKey stays resolvable after the copy is
+// saved even if its owner has since moved to another bucket.
+func (s *userUploadService) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	backend, configID, err := s.forKey(srcKey)
+	if err != nil {
+		return err
+	}
+	if err := backend.CopyFile(ctx, srcKey, dstKey); err != nil {
+		return err
+	}
+	s.rememberObject(dstKey, configID)
+	return nil
+}
+
+// storageKeyUserID extracts the owner from a "files/<user>/..." key
+func storageKeyUserID(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, "files/")
+	if !ok {
+		return "", false
+	}
+	userID, _, ok := strings.Cut(rest, "/")
+	return userID, ok && userID != ""
+}
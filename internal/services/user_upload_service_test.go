@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bucketUploadService is a mock upload service whose download URLs name its bucket
+type bucketUploadService struct {
+	UploadService
+	bucket string
+}
+
+func (s *bucketUploadService) GetPresignedDownloadURL(ctx context.Context, key string) (string, error) {
+	return "https://" + s.bucket + "/" + key, nil
+}
+
+var testStorageSecretKey = StorageSecretKey("test-passphrase")
+
+func newTestUserUploadService(t *testing.T, fallback UploadService) (*userUploadService, *[]S3Config) {
+	service := NewUserUploadService(newTestDB(t), fallback, testStorageSecretKey).(*userUploadService)
+	var built []S3Config
+	service.newBackend = func(cfg S3Config) (UploadService, error) {
+		built = append(built, cfg)
+		return &bucketUploadService{UploadService: NewMockUploadService(), bucket: cfg.Bucket}, nil
+	}
+	return service, &built
+}
+
+func createTestStorageConfig(t *testing.T, service *userUploadService, userID, bucket string) *models.StorageConfig {
+	secret, err := EncryptStorageSecret(testStorageSecretKey, bucket+"-secret")
+	require.NoError(t, err)
+	cfg := &models.StorageConfig{UserID: userID, Bucket: bucket, SecretAccessKey: secret}
+	require.NoError(t, service.db.Create(cfg).Error)
+	return cfg
+}
+
+func TestUserUploadService_ResolvesBucketPerFile(t *testing.T) {
+	ctx := context.Background()
+	service, built := newTestUserUploadService(t, &bucketUploadService{UploadService: NewMockUploadService(), bucket: "global"})
+
+	cfg := createTestStorageConfig(t, service, "byo-user", "customer-bucket")
+	_, key, err := service.GetPresignedUploadURL(ctx, "byo-user", "", "report.pdf", "application/pdf")
+	require.NoError(t, err)
+	fileService := NewFileService(service.db, FileConfig{})
+	require.NoError(t, fileService.CreateFile("byo-user", &models.File{Title: "Report", S3Key: key, OriginalFilename: "report.pdf"}))
+
+	url, err := service.GetPresignedDownloadURL(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "https://customer-bucket/"+key, url)
+	require.Len(t, *built, 1)
+	assert.Equal(t, "customer-bucket-secret", (*built)[0].SecretAccessKey)
+
+	url, err = service.GetPresignedDownloadURL(ctx, "files/other-user/report.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "https://global/files/other-user/report.pdf", url)
+
+	// Moving the user to another bucket only affects new uploads
+	require.NoError(t, service.db.Delete(cfg).Error)
+	createTestStorageConfig(t, service, "byo-user", "new-bucket")
+	service.objectConfigs = make(map[string]*uint)
+
+	url, err = service.GetPresignedDownloadURL(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "https://customer-bucket/"+key, url)
+
+	_, newKey, err := service.GetPresignedUploadURL(ctx, "byo-user", "", "other.pdf", "application/pdf")
+	require.NoError(t, err)
+	url, err = service.GetPresignedDownloadURL(ctx, newKey)
+	require.NoError(t, err)
+	assert.Equal(t, "https://new-bucket/"+newKey, url)
+	assert.Len(t, *built, 2)
+
+	// Copies stay in the source's bucket
+	copyKey := "files/byo-user/copy.pdf"
+	require.NoError(t, service.CopyFile(ctx, key, copyKey))
+	url, err = service.GetPresignedDownloadURL(ctx, copyKey)
+	require.NoError(t, err)
+	assert.Equal(t, "https://customer-bucket/"+copyKey, url)
+}
+
+func TestUserUploadService_RebuildsClientWhenConfigChanges(t *testing.T) {
+	ctx := context.Background()
+	service, built := newTestUserUploadService(t, nil)
+
+	cfg := createTestStorageConfig(t, service, "byo-user", "customer-bucket")
+	_, err := service.GetPresignedDownloadURL(ctx, "files/byo-user/report.pdf")
+	require.NoError(t, err)
+	_, err = service.GetPresignedDownloadURL(ctx, "files/byo-user/other.pdf")
+	require.NoError(t, err)
+	assert.Len(t, *built, 1)
+
+	// Rotated credentials are picked up once the cached client is due for a check
+	secret, err := EncryptStorageSecret(testStorageSecretKey, "rotated-secret")
+	require.NoError(t, err)
+	require.NoError(t, service.db.Model(cfg).Updates(map[string]any{
+		"secret_access_key": secret,
+		"updated_at":        time.Now().Add(time.Minute),
+	}).Error)
+	backend := service.backends[cfg.ID]
+	backend.checkedAt = time.Now().Add(-storageConfigTTL)
+	service.backends[cfg.ID] = backend
+
+	_, err = service.GetPresignedDownloadURL(ctx, "files/byo-user/report.pdf")
+	require.NoError(t, err)
+	require.Len(t, *built, 2)
+	assert.Equal(t, "rotated-secret", (*built)[1].SecretAccessKey)
+}
+
+func TestUserUploadService_RejectsPlaintextSecret(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestUserUploadService(t, nil)
+
+	require.NoError(t, service.db.Create(&models.StorageConfig{UserID: "byo-user", Bucket: "customer-bucket", SecretAccessKey: "plain"}).Error)
+	_, err := service.GetPresignedDownloadURL(ctx, "files/byo-user/report.pdf")
+	assert.ErrorIs(t, err, ErrStorageSecretNotEncrypted)
+
+	encrypted, err := EncryptStorageSecrets(service.db, testStorageSecretKey)
+	require.NoError(t, err)
+	assert.Equal(t, 1, encrypted)
+	_, err = service.GetPresignedDownloadURL(ctx, "files/byo-user/report.pdf")
+	assert.NoError(t, err)
+}
+
+func TestUserUploadService_WithoutGlobalBucket(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestUserUploadService(t, nil)
+
+	_, _, err := service.GetPresignedUploadURL(ctx, "user-1", "", "report.pdf", "application/pdf")
+	assert.ErrorIs(t, err, ErrStorageNotConfigured)
+
+	createTestStorageConfig(t, service, "user-1", "customer-bucket")
+	_, key, err := service.GetPresignedUploadURL(ctx, "user-1", "", "report.pdf", "application/pdf")
+	require.NoError(t, err)
+	assert.Contains(t, key, "files/user-1/")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -14,11 +16,13 @@ import (
 
 // CreateFileTool handles creating a new file record
 type CreateFileTool struct {
-	service services.FileService
+	service          services.FileService
+	embeddingService services.EmbeddingService
+	placement        *services.FilePlacement
 }
 
-func NewCreateFileTool(service services.FileService) *CreateFileTool {
-	return &CreateFileTool{service: service}
+func NewCreateFileTool(service services.FileService, embeddingService services.EmbeddingService, placement *services.FilePlacement) *CreateFileTool {
+	return &CreateFileTool{service: service, embeddingService: embeddingService, placement: placement}
 }
 
 func (t *CreateFileTool) GetTool() mcp.Tool {
@@ -31,6 +35,10 @@ func (t *CreateFileTool) GetTool() mcp.Tool {
 		mcp.WithNumber("folder_id", mcp.Description("Folder ID to place the file in")),
 		mcp.WithString("mime_type", mcp.Description("MIME type of the file")),
 		mcp.WithNumber("size", mcp.Description("File size in bytes")),
+		mcp.WithString("content", mcp.Description("Already-extracted text. When set, the file is created as completed and processing is skipped")),
+		mcp.WithString("summary", mcp.Description("Already-generated summary, stored with content")),
+		mcp.WithBoolean("generate_embedding", mcp.Description("When content is set, generate the embedding for semantic search (default: true)")),
+		mcp.WithString("processing_hint", mcp.Description("Instructions the agent follows when organizing this file")),
 	)
 }
 
@@ -49,12 +57,17 @@ func (t *CreateFileTool) GetHandler() server.ToolHandlerFunc {
 		if title == "" || s3Key == "" || originalFilename == "" {
 			return mcp.NewToolResultError("title, s3_key, and original_filename are required"), nil
 		}
+		hint := getStringArg(args, "processing_hint")
+		if utf8.RuneCountInString(hint) > services.MaxProcessingHintLength {
+			return mcp.NewToolResultError(fmt.Sprintf("processing_hint must be at most %d characters", services.MaxProcessingHintLength)), nil
+		}
 
 		file := &models.File{
 			Title:            title,
 			S3Key:            s3Key,
 			OriginalFilename: originalFilename,
 			MimeType:         getStringArg(args, "mime_type"),
+			ProcessingHint:   strings.TrimSpace(hint),
 			ProcessingStatus: models.FileStatusPending,
 		}
 
@@ -68,17 +81,37 @@ func (t *CreateFileTool) GetHandler() server.ToolHandlerFunc {
 
 		if fileType := getStringArg(args, "file_type"); fileType != "" {
 			file.FileType = models.FileType(fileType)
+			file.FileTypeSetByUser = true
 		} else {
 			file.FileType = models.DetectFileTypeFromMimeType(file.MimeType)
 		}
 
+		// Imported files with extracted text skip the processing pipeline
+		if content := getStringArg(args, "content"); content != "" {
+			file.Content = content
+			file.Summary = getStringArg(args, "summary")
+			file.ProcessingStatus = models.FileStatusCompleted
+		}
+
 		if err := t.service.CreateFile(userID, file); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to create file: %v", err)), nil
 		}
+		t.placement.PlaceFiles(ctx, userID, []uint{file.ID}, file.FolderID)
+
+		if file.ProcessingStatus == models.FileStatusCompleted && t.embeddingService != nil && getBoolArg(args, "generate_embedding", true) {
+			embedding, err := t.embeddingService.GenerateEmbedding(ctx, file.Content)
+			if err != nil {
+				t.service.UpdateFileProcessingStatus(userID, file.ID, models.FileStatusCompleted, "Embedding generation failed: "+err.Error())
+			} else if err := t.embeddingService.StoreFileEmbedding(userID, file.ID, embedding, file.Content); err != nil {
+				t.service.UpdateFileProcessingStatus(userID, file.ID, models.FileStatusCompleted, "Embedding storage failed: "+err.Error())
+			} else {
+				t.service.SetFileHasEmbedding(userID, file.ID, true)
+			}
+		}
 
 		// Fetch created file with relations
 		created, _ := t.service.GetFileByID(userID, file.ID)
-		result, _ := json.Marshal(fileToMap(created))
+		result, _ := json.Marshal(fileToMap(ctx, created))
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -99,8 +132,17 @@ func (t *ListFilesTool) GetTool() mcp.Tool {
 		mcp.WithNumber("folder_id", mcp.Description("Filter by folder ID")),
 		mcp.WithString("file_type", mcp.Description("Filter by file type: music, photo, video, document, invoice")),
 		mcp.WithString("tag_ids", mcp.Description("Comma-separated tag IDs to filter by")),
-		mcp.WithString("status", mcp.Description("Filter by processing status: pending, processing, completed, failed")),
-		mcp.WithString("sort_by", mcp.Description("Sort by: created_at, title, size, updated_at")),
+		mcp.WithString("status", mcp.Description("Filter by processing status: pending, processing, completed, failed, needs_review")),
+		mcp.WithString("error_contains", mcp.Description("Only files whose processing error contains this text")),
+		mcp.WithNumber("min_word_count", mcp.Description("Only files with at least this many words")),
+		mcp.WithNumber("max_word_count", mcp.Description("Only files with at most this many words")),
+		mcp.WithNumber("min_size", mcp.Description("Only files of at least this many bytes")),
+		mcp.WithNumber("max_size", mcp.Description("Only files of at most this many bytes")),
+		mcp.WithString("entity", mcp.Description("Only files with an extracted entity (person, organization, date) containing this text")),
+		mcp.WithString("entity_type", mcp.Description("Restrict entity to one type: people, organizations, dates, amounts")),
+		mcp.WithString("entity_date_from", mcp.Description("Only files with an extracted date on or after this date (YYYY-MM-DD)")),
+		mcp.WithString("entity_date_to", mcp.Description("Only files with an extracted date on or before this date (YYYY-MM-DD)")),
+		mcp.WithString("sort_by", mcp.Description("Sort by: created_at, title, size, updated_at, word_count, char_count")),
 		mcp.WithString("sort_order", mcp.Description("Sort order: asc, desc")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of files to return (default: 100)")),
 		mcp.WithNumber("offset", mcp.Description("Number of files to skip for pagination")),
@@ -116,13 +158,30 @@ func (t *ListFilesTool) GetHandler() server.ToolHandlerFunc {
 
 		args := getArgsMap(request.Params.Arguments)
 
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		opts := services.FileListOptions{
-			Keyword:   getStringArg(args, "keyword"),
-			Limit:     getIntArg(args, "limit", 100),
-			Offset:    getIntArg(args, "offset", 0),
-			TagIDs:    parseTagIDs(getStringArg(args, "tag_ids")),
-			SortBy:    getStringArg(args, "sort_by"),
-			SortOrder: getStringArg(args, "sort_order"),
+			Keyword:        getStringArg(args, "keyword"),
+			ErrorContains:  getStringArg(args, "error_contains"),
+			Limit:          getIntArg(args, "limit", 100),
+			Offset:         getIntArg(args, "offset", 0),
+			TagIDs:         tagIDs,
+			SortBy:         getStringArg(args, "sort_by"),
+			SortOrder:      getStringArg(args, "sort_order"),
+			Entity:         getStringArg(args, "entity"),
+			EntityType:     getStringArg(args, "entity_type"),
+			EntityDateFrom: getStringArg(args, "entity_date_from"),
+			EntityDateTo:   getStringArg(args, "entity_date_to"),
+		}
+
+		if err := opts.ValidateSort(); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if err := opts.ValidateEntityFilters(); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		if folderID := getUintArg(args, "folder_id"); folderID > 0 {
@@ -138,6 +197,26 @@ func (t *ListFilesTool) GetHandler() server.ToolHandlerFunc {
 			opts.Status = &s
 		}
 
+		if _, ok := args["min_word_count"]; ok {
+			minWords := getIntArg(args, "min_word_count", 0)
+			opts.MinWordCount = &minWords
+		}
+		if _, ok := args["max_word_count"]; ok {
+			maxWords := getIntArg(args, "max_word_count", 0)
+			opts.MaxWordCount = &maxWords
+		}
+		if value, ok := args["min_size"].(float64); ok {
+			minSize := int64(value)
+			opts.MinSize = &minSize
+		}
+		if value, ok := args["max_size"].(float64); ok {
+			maxSize := int64(value)
+			opts.MaxSize = &maxSize
+		}
+		if err := opts.ValidateSizeRange(); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		files, total, err := t.service.ListFiles(userID, opts)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to list files: %v", err)), nil
@@ -145,7 +224,7 @@ func (t *ListFilesTool) GetHandler() server.ToolHandlerFunc {
 
 		fileList := make([]map[string]any, len(files))
 		for i, file := range files {
-			fileList[i] = fileToMap(&file)
+			fileList[i] = fileToMap(ctx, &file)
 		}
 
 		result, _ := json.Marshal(map[string]any{
@@ -195,18 +274,19 @@ func (t *GetFileTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("File not found"), nil
 		}
 
-		result, _ := json.Marshal(fileToMap(file))
+		result, _ := json.Marshal(fileToMap(ctx, file))
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
 
 // UpdateFileTool handles updating a file
 type UpdateFileTool struct {
-	service services.FileService
+	service   services.FileService
+	placement *services.FilePlacement
 }
 
-func NewUpdateFileTool(service services.FileService) *UpdateFileTool {
-	return &UpdateFileTool{service: service}
+func NewUpdateFileTool(service services.FileService, placement *services.FilePlacement) *UpdateFileTool {
+	return &UpdateFileTool{service: service, placement: placement}
 }
 
 func (t *UpdateFileTool) GetTool() mcp.Tool {
@@ -215,6 +295,7 @@ func (t *UpdateFileTool) GetTool() mcp.Tool {
 		mcp.WithNumber("file_id", mcp.Required(), mcp.Description("File ID")),
 		mcp.WithString("title", mcp.Description("New file title")),
 		mcp.WithString("summary", mcp.Description("New file summary")),
+		mcp.WithString("processing_hint", mcp.Description("Instructions for the agent; empty string clears them")),
 		mcp.WithString("file_type", mcp.Description("New file type")),
 		mcp.WithNumber("folder_id", mcp.Description("New folder ID")),
 	)
@@ -249,20 +330,31 @@ func (t *UpdateFileTool) GetHandler() server.ToolHandlerFunc {
 		if summary, ok := args["summary"].(string); ok {
 			existing.Summary = summary
 		}
+		if hint, ok := args["processing_hint"].(string); ok {
+			if utf8.RuneCountInString(hint) > services.MaxProcessingHintLength {
+				return mcp.NewToolResultError(fmt.Sprintf("processing_hint must be at most %d characters", services.MaxProcessingHintLength)), nil
+			}
+			existing.ProcessingHint = strings.TrimSpace(hint)
+		}
 		if fileType, ok := args["file_type"].(string); ok && fileType != "" {
 			existing.FileType = models.FileType(fileType)
+			existing.FileTypeSetByUser = true
 		}
-		if folderID := getUintArg(args, "folder_id"); folderID > 0 {
+		folderID := getUintArg(args, "folder_id")
+		if folderID > 0 {
 			existing.FolderID = &folderID
 		}
 
 		if err := t.service.UpdateFile(userID, existing); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update file: %v", err)), nil
 		}
+		if folderID > 0 {
+			t.placement.PlaceFiles(ctx, userID, []uint{fileID}, existing.FolderID)
+		}
 
 		// Fetch updated file
 		updated, _ := t.service.GetFileByID(userID, fileID)
-		result, _ := json.Marshal(fileToMap(updated))
+		result, _ := json.Marshal(fileToMap(ctx, updated))
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -318,9 +410,12 @@ func (t *DeleteFileTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete file: %v", err)), nil
 		}
 
-		// Delete from S3 (best effort - don't fail if S3 delete fails)
+		// Delete from S3 (best effort - don't fail if S3 delete fails), unless
+		// another file still references the same object
 		if file.S3Key != "" && t.uploadService != nil {
-			_ = t.uploadService.DeleteFile(ctx, file.S3Key)
+			if referenced, err := t.service.IsS3KeyReferenced(file.S3Key); err == nil && !referenced {
+				_ = t.uploadService.DeleteFile(ctx, file.S3Key)
+			}
 		}
 
 		// Delete embedding if exists (best effort)
@@ -350,11 +445,11 @@ func (t *DeleteFileTool) GetHandler() server.ToolHandlerFunc {
 
 // MoveFilesTool handles moving files to a different folder
 type MoveFilesTool struct {
-	service services.FileService
+	placement *services.FilePlacement
 }
 
-func NewMoveFilesTool(service services.FileService) *MoveFilesTool {
-	return &MoveFilesTool{service: service}
+func NewMoveFilesTool(placement *services.FilePlacement) *MoveFilesTool {
+	return &MoveFilesTool{placement: placement}
 }
 
 func (t *MoveFilesTool) GetTool() mcp.Tool {
@@ -384,13 +479,16 @@ func (t *MoveFilesTool) GetHandler() server.ToolHandlerFunc {
 			targetFolderID = &folderID
 		}
 
-		if err := t.service.MoveFiles(userID, fileIDs, targetFolderID); err != nil {
+		moved, err := t.placement.MoveFiles(ctx, userID, fileIDs, targetFolderID)
+		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to move files: %v", err)), nil
 		}
 
 		result, _ := json.Marshal(map[string]any{
-			"message":     "Files moved successfully",
-			"moved_count": len(fileIDs),
+			"message":         "Files moved successfully",
+			"moved_count":     len(moved.Moved),
+			"unchanged_count": len(moved.Unchanged),
+			"unchanged_ids":   moved.Unchanged,
 		})
 		return mcp.NewToolResultText(string(result)), nil
 	}
@@ -398,11 +496,12 @@ func (t *MoveFilesTool) GetHandler() server.ToolHandlerFunc {
 
 // AddTagsToFileTool handles adding tags to a file
 type AddTagsToFileTool struct {
-	service services.FileService
+	service   services.FileService
+	placement *services.FilePlacement
 }
 
-func NewAddTagsToFileTool(service services.FileService) *AddTagsToFileTool {
-	return &AddTagsToFileTool{service: service}
+func NewAddTagsToFileTool(service services.FileService, placement *services.FilePlacement) *AddTagsToFileTool {
+	return &AddTagsToFileTool{service: service, placement: placement}
 }
 
 func (t *AddTagsToFileTool) GetTool() mcp.Tool {
@@ -426,18 +525,30 @@ func (t *AddTagsToFileTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("file_id is required"), nil
 		}
 
-		tagIDs := parseTagIDs(getStringArg(args, "tag_ids"))
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if len(tagIDs) == 0 {
 			return mcp.NewToolResultError("tag_ids is required"), nil
 		}
 
-		if err := t.service.AddTagsToFile(userID, fileID, tagIDs); err != nil {
+		added, err := t.placement.AddTagsToFile(ctx, userID, fileID, tagIDs)
+		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to add tags: %v", err)), nil
 		}
 
 		// Fetch updated file
 		updated, _ := t.service.GetFileByID(userID, fileID)
-		result, _ := json.Marshal(fileToMap(updated))
+		response := map[string]interface{}{
+			"file":                    fileToMap(ctx, updated),
+			"added_tag_ids":           added.Added,
+			"already_present_tag_ids": added.AlreadyPresent,
+		}
+		if added.MovedToFolder != nil {
+			response["moved_to_folder_id"] = *added.MovedToFolder
+		}
+		result, _ := json.Marshal(response)
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -472,7 +583,10 @@ func (t *RemoveTagsFromFileTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("file_id is required"), nil
 		}
 
-		tagIDs := parseTagIDs(getStringArg(args, "tag_ids"))
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if len(tagIDs) == 0 {
 			return mcp.NewToolResultError("tag_ids is required"), nil
 		}
@@ -483,7 +597,7 @@ func (t *RemoveTagsFromFileTool) GetHandler() server.ToolHandlerFunc {
 
 		// Fetch updated file
 		updated, _ := t.service.GetFileByID(userID, fileID)
-		result, _ := json.Marshal(fileToMap(updated))
+		result, _ := json.Marshal(fileToMap(ctx, updated))
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -515,6 +629,11 @@ func (t *GetFileDownloadURLTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
 
+		// The document itself is the content the token may not see
+		if utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+			return mcp.NewToolResultError("File content is not available to this token"), nil
+		}
+
 		args := getArgsMap(request.Params.Arguments)
 		fileID := getUintArg(args, "file_id")
 		if fileID == 0 {
@@ -544,22 +663,42 @@ func (t *GetFileDownloadURLTool) GetHandler() server.ToolHandlerFunc {
 }
 
 // Helper functions
-func fileToMap(file *models.File) map[string]any {
+
+// fileToMap converts a file for a tool result, leaving out the summary when
+// the caller's token masks it
+func fileToMap(ctx context.Context, file *models.File) map[string]any {
 	m := map[string]any{
-		"id":                file.ID,
-		"title":             file.Title,
-		"summary":           file.Summary,
-		"file_type":         file.FileType,
-		"s3_key":            file.S3Key,
-		"original_filename": file.OriginalFilename,
-		"mime_type":         file.MimeType,
-		"size":              file.Size,
-		"processing_status": file.ProcessingStatus,
-		"processing_error":  file.ProcessingError,
-		"has_embedding":     file.HasEmbedding,
-		"folder_id":         file.FolderID,
-		"created_at":        file.CreatedAt,
-		"updated_at":        file.UpdatedAt,
+		"id":                  file.ID,
+		"public_id":           file.PublicID,
+		"title":               file.Title,
+		"file_type":           file.FileType,
+		"s3_key":              file.S3Key,
+		"original_filename":   file.OriginalFilename,
+		"mime_type":           file.MimeType,
+		"size":                file.Size,
+		"word_count":          file.WordCount,
+		"char_count":          file.CharCount,
+		"processing_status":   file.ProcessingStatus,
+		"processing_error":    file.ProcessingError,
+		"processing_hint":     file.ProcessingHint,
+		"has_embedding":       file.HasEmbedding,
+		"summary_is_fallback": file.SummaryIsFallback,
+		"folder_id":           file.FolderID,
+		"created_at":          file.CreatedAt,
+		"updated_at":          file.UpdatedAt,
+	}
+
+	if !utils.IsFieldMasked(ctx, utils.MaskFieldSummary) {
+		m["summary"] = file.Summary
+	}
+
+	if file.DetectedMimeType != "" {
+		m["declared_mime_type"] = file.DeclaredMimeType
+		m["detected_mime_type"] = file.DetectedMimeType
+	}
+
+	if file.Entities != nil {
+		m["entities"] = file.Entities
 	}
 
 	if file.Folder != nil {
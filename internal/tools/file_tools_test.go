@@ -0,0 +1,68 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newTestDB returns a migrated in-memory database that is closed when the test ends
+func newTestDB(t *testing.T) *gorm.DB {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	return dbService.GetDB()
+}
+
+func TestGetFileDownloadURLTool_MaskedContent(t *testing.T) {
+	fileService := services.NewFileService(newTestDB(t), services.FileConfig{})
+	file := &models.File{Title: "record", S3Key: "record.pdf", OriginalFilename: "record.pdf"}
+	require.NoError(t, fileService.CreateFile("user-1", file))
+	handler := NewGetFileDownloadURLTool(fileService, services.NewMockUploadService()).GetHandler()
+
+	call := func(masked ...string) *mcp.CallToolResult {
+		ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1", MaskedFields: masked})
+		var request mcp.CallToolRequest
+		request.Params.Arguments = map[string]any{"file_id": float64(file.ID)}
+		result, err := handler(ctx, request)
+		require.NoError(t, err)
+		return result
+	}
+
+	assert.True(t, call(utils.MaskFieldContent).IsError)
+	assert.False(t, call(utils.MaskFieldSummary).IsError)
+}
+
+func TestFileTools_ProcessingHintLimit(t *testing.T) {
+	fileService := services.NewFileService(newTestDB(t), services.FileConfig{})
+	file := &models.File{Title: "record", S3Key: "record.pdf", OriginalFilename: "record.pdf"}
+	require.NoError(t, fileService.CreateFile("user-1", file))
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+	tooLong := strings.Repeat("é", services.MaxProcessingHintLength+1)
+
+	var request mcp.CallToolRequest
+	request.Params.Arguments = map[string]any{"title": "new", "s3_key": "new.pdf", "original_filename": "new.pdf", "processing_hint": tooLong}
+	result, err := NewCreateFileTool(fileService, nil, nil).GetHandler()(ctx, request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	update := NewUpdateFileTool(fileService, nil).GetHandler()
+	request.Params.Arguments = map[string]any{"file_id": float64(file.ID), "processing_hint": tooLong}
+	result, err = update(ctx, request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	// The limit counts characters, not bytes
+	request.Params.Arguments = map[string]any{"file_id": float64(file.ID), "processing_hint": tooLong[2:]}
+	result, err = update(ctx, request)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+}
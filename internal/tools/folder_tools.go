@@ -92,11 +92,16 @@ func (t *ListFoldersTool) GetHandler() server.ToolHandlerFunc {
 
 		args := getArgsMap(request.Params.Arguments)
 
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		opts := services.FolderListOptions{
 			Keyword: getStringArg(args, "keyword"),
 			Limit:   getIntArg(args, "limit", 100),
 			Offset:  getIntArg(args, "offset", 0),
-			TagIDs:  parseTagIDs(getStringArg(args, "tag_ids")),
+			TagIDs:  tagIDs,
 		}
 
 		if parentID := getUintArg(args, "parent_id"); parentID > 0 {
@@ -226,25 +231,18 @@ func (t *UpdateFolderTool) GetHandler() server.ToolHandlerFunc {
 
 // DeleteFolderTool handles deleting a folder
 type DeleteFolderTool struct {
-	service          services.FolderService
-	fileService      services.FileService
-	uploadService    services.UploadService
-	embeddingService services.EmbeddingService
-}
-
-func NewDeleteFolderTool(service services.FolderService, fileService services.FileService, uploadService services.UploadService, embeddingService services.EmbeddingService) *DeleteFolderTool {
-	return &DeleteFolderTool{
-		service:          service,
-		fileService:      fileService,
-		uploadService:    uploadService,
-		embeddingService: embeddingService,
-	}
+	service services.FolderService
+}
+
+func NewDeleteFolderTool(service services.FolderService) *DeleteFolderTool {
+	return &DeleteFolderTool{service: service}
 }
 
 func (t *DeleteFolderTool) GetTool() mcp.Tool {
 	return mcp.NewTool("delete_folder",
-		mcp.WithDescription("Delete a folder and all its contents"),
+		mcp.WithDescription("Delete a folder and all its contents. Deleted folders can be restored with restore_folder"),
 		mcp.WithNumber("folder_id", mcp.Required(), mcp.Description("Folder ID")),
+		mcp.WithString("exclude_folder_ids", mcp.Description("Comma-separated subfolder IDs to keep; they are moved up to the deleted folder's parent")),
 	)
 }
 
@@ -261,39 +259,63 @@ func (t *DeleteFolderTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("folder_id is required"), nil
 		}
 
-		// Get all files in folder recursively before deletion (for S3 cleanup)
-		var filesToCleanup []models.File
-		if t.fileService != nil {
-			files, err := t.fileService.GetFilesInFolderRecursive(userID, folderID)
-			if err == nil {
-				filesToCleanup = files
-			}
-		}
+		excludeFolderIDs := parseIDs(getStringArg(args, "exclude_folder_ids"))
 
-		// Delete folder from database (cascade deletes files in DB)
-		if err := t.service.DeleteFolder(userID, folderID); err != nil {
+		// Soft-delete the folder tree; stored files are kept for restore
+		if err := t.service.DeleteFolder(userID, folderID, excludeFolderIDs); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete folder: %v", err)), nil
 		}
 
-		// Clean up S3 objects and embeddings for all files (best effort)
-		for _, file := range filesToCleanup {
-			if file.S3Key != "" && t.uploadService != nil {
-				_ = t.uploadService.DeleteFile(ctx, file.S3Key)
-			}
-			if file.HasEmbedding && t.embeddingService != nil {
-				_ = t.embeddingService.DeleteFileEmbedding(userID, file.ID)
-			}
-		}
-
 		result, _ := json.Marshal(map[string]interface{}{
-			"message":       "Folder deleted successfully",
-			"folder_id":     folderID,
-			"files_cleaned": len(filesToCleanup),
+			"message":   "Folder deleted successfully",
+			"folder_id": folderID,
 		})
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
 
+// RestoreFolderTool handles restoring a deleted folder
+type RestoreFolderTool struct {
+	service services.FolderService
+}
+
+func NewRestoreFolderTool(service services.FolderService) *RestoreFolderTool {
+	return &RestoreFolderTool{service: service}
+}
+
+func (t *RestoreFolderTool) GetTool() mcp.Tool {
+	return mcp.NewTool("restore_folder",
+		mcp.WithDescription("Restore a deleted folder together with the subfolders and files deleted with it"),
+		mcp.WithNumber("folder_id", mcp.Required(), mcp.Description("Folder ID")),
+	)
+}
+
+func (t *RestoreFolderTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := utils.GetUserID(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		folderID := getUintArg(args, "folder_id")
+		if folderID == 0 {
+			return mcp.NewToolResultError("folder_id is required"), nil
+		}
+
+		folder, err := t.service.RestoreFolder(userID, folderID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to restore folder: %v", err)), nil
+		}
+		if folder == nil {
+			return mcp.NewToolResultError("Folder not found"), nil
+		}
+
+		result, _ := json.Marshal(folderToMap(folder))
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
 // MoveFolderTool handles moving a folder to a new parent
 type MoveFolderTool struct {
 	service services.FolderService
@@ -329,7 +351,7 @@ func (t *MoveFolderTool) GetHandler() server.ToolHandlerFunc {
 			newParentID = &parentID
 		}
 
-		if err := t.service.MoveFolder(userID, folderID, newParentID); err != nil {
+		if _, err := t.service.MoveFolder(userID, folderID, newParentID); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to move folder: %v", err)), nil
 		}
 
@@ -409,7 +431,10 @@ func (t *AddTagsToFolderTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("folder_id is required"), nil
 		}
 
-		tagIDs := parseTagIDs(getStringArg(args, "tag_ids"))
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if len(tagIDs) == 0 {
 			return mcp.NewToolResultError("tag_ids is required"), nil
 		}
@@ -455,7 +480,10 @@ func (t *RemoveTagsFromFolderTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("folder_id is required"), nil
 		}
 
-		tagIDs := parseTagIDs(getStringArg(args, "tag_ids"))
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if len(tagIDs) == 0 {
 			return mcp.NewToolResultError("tag_ids is required"), nil
 		}
@@ -505,18 +533,18 @@ func foldersToTreeMap(folders []models.Folder) []map[string]interface{} {
 	return result
 }
 
-func parseTagIDs(tagIDsStr string) []uint {
-	if tagIDsStr == "" {
+func parseIDs(idsStr string) []uint {
+	if idsStr == "" {
 		return nil
 	}
 
-	var tagIDs []uint
-	for _, s := range splitAndTrim(tagIDsStr, ",") {
+	var ids []uint
+	for _, s := range splitAndTrim(idsStr, ",") {
 		if id := parseUint(s); id > 0 {
-			tagIDs = append(tagIDs, id)
+			ids = append(ids, id)
 		}
 	}
-	return tagIDs
+	return ids
 }
 
 func splitAndTrim(s, sep string) []string {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -27,8 +28,15 @@ func (t *SearchFilesTool) GetTool() mcp.Tool {
 		mcp.WithString("query", mcp.Required(), mcp.Description("Search query")),
 		mcp.WithString("type", mcp.Description("Search type: fulltext, semantic, or hybrid (default: hybrid)")),
 		mcp.WithNumber("folder_id", mcp.Description("Filter results to a specific folder")),
+		mcp.WithNumber("scope_folder_id", mcp.Description("Filter results to a folder and all of its subfolders")),
 		mcp.WithString("file_type", mcp.Description("Filter by file type: music, photo, video, document, invoice")),
 		mcp.WithString("tag_ids", mcp.Description("Comma-separated tag IDs to filter by")),
+		mcp.WithBoolean("title_only", mcp.Description("Only match file titles (fast lookup by document name; always uses fulltext search)")),
+		mcp.WithBoolean("include_folder_name", mcp.Description("Also match the name of the folder a file is in (fulltext and hybrid search)")),
+		mcp.WithNumber("snippet_length", mcp.Description("Snippet size in characters, 20-2000 (default: 200)")),
+		mcp.WithNumber("recency_half_life_days", mcp.Description("Hybrid search only: halve a file's score for every this many days of age, favoring recent files")),
+		mcp.WithBoolean("rerank", mcp.Description("Hybrid search only: reorder the best results with the reranking model for more precise top results (slower)")),
+		mcp.WithBoolean("include_raw_scores", mcp.Description("Hybrid search only: add the raw fulltext score and vector similarity behind each result's score as components")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results (default: 20)")),
 		mcp.WithNumber("offset", mcp.Description("Number of results to skip for pagination")),
 	)
@@ -52,23 +60,46 @@ func (t *SearchFilesTool) GetHandler() server.ToolHandlerFunc {
 			searchType = "hybrid"
 		}
 
+		tagIDs, err := services.ParseTagIDs(getStringArg(args, "tag_ids"))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		opts := services.SearchOptions{
-			Limit:  getIntArg(args, "limit", 20),
-			Offset: getIntArg(args, "offset", 0),
-			TagIDs: parseTagIDs(getStringArg(args, "tag_ids")),
+			Limit:             getIntArg(args, "limit", 20),
+			Offset:            getIntArg(args, "offset", 0),
+			TagIDs:            tagIDs,
+			IncludeFolderName: getBoolArg(args, "include_folder_name", false),
+			SnippetLength:     getIntArg(args, "snippet_length", 0),
 		}
 
 		if folderID := getUintArg(args, "folder_id"); folderID > 0 {
 			opts.FolderID = &folderID
 		}
 
+		if scopeFolderID := getUintArg(args, "scope_folder_id"); scopeFolderID > 0 {
+			opts.RootFolderID = &scopeFolderID
+		}
+
 		if fileType := getStringArg(args, "file_type"); fileType != "" {
 			opts.FileTypes = []models.FileType{models.FileType(fileType)}
 		}
 
+		if days, ok := args["recency_half_life_days"].(float64); ok && days > 0 {
+			opts.RecencyHalfLife = time.Duration(days * float64(24*time.Hour))
+		}
+
+		opts.Rerank = searchType == "hybrid" && getBoolArg(args, "rerank", false)
+		opts.IncludeRawScores = searchType == "hybrid" && getBoolArg(args, "include_raw_scores", false)
+
+		if getBoolArg(args, "title_only", false) {
+			opts.TitleOnly = true
+			searchType = "fulltext"
+		}
+
 		var results []services.SearchResult
 		var total int64
-		var err error
+		var hybrid *services.HybridSearchResult
 
 		switch searchType {
 		case "fulltext":
@@ -77,8 +108,10 @@ func (t *SearchFilesTool) GetHandler() server.ToolHandlerFunc {
 			results, err = t.service.VectorSearch(ctx, userID, query, opts)
 			total = int64(len(results))
 		case "hybrid":
-			results, err = t.service.HybridSearch(ctx, userID, query, opts)
-			total = int64(len(results))
+			if hybrid, err = t.service.HybridSearch(ctx, userID, query, opts); err == nil {
+				results = hybrid.Results
+				total = int64(len(results))
+			}
 		default:
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid search type: %s. Use fulltext, semantic, or hybrid", searchType)), nil
 		}
@@ -89,24 +122,41 @@ func (t *SearchFilesTool) GetHandler() server.ToolHandlerFunc {
 
 		resultList := make([]map[string]any, len(results))
 		for i, r := range results {
-			resultList[i] = searchResultToMap(r)
+			resultList[i] = searchResultToMap(ctx, r)
 		}
 
-		result, _ := json.Marshal(map[string]any{
+		response := map[string]any{
 			"data":        resultList,
 			"total":       total,
 			"query":       query,
 			"search_type": searchType,
-		})
+		}
+		if hybrid != nil && hybrid.VectorUnavailable {
+			response["vector_search_unavailable"] = true
+		}
+		if hybrid != nil && hybrid.RerankUnavailable {
+			response["rerank_unavailable"] = true
+		}
+		result, _ := json.Marshal(response)
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
 
 // Helper function
-func searchResultToMap(r services.SearchResult) map[string]any {
-	return map[string]any{
-		"file":    fileToMap(&r.File),
-		"score":   r.Score,
-		"snippet": r.Snippet,
+func searchResultToMap(ctx context.Context, r services.SearchResult) map[string]any {
+	result := map[string]any{
+		"file":  fileToMap(ctx, &r.File),
+		"score": r.Score,
+	}
+	// Snippets are excerpts of the content, so they are masked with it
+	if !utils.IsFieldMasked(ctx, utils.MaskFieldContent) {
+		result["snippet"] = r.Snippet
+	}
+	if r.Components != nil {
+		result["components"] = r.Components
+	}
+	if r.MatchedBy != nil {
+		result["matched_by"] = r.MatchedBy
 	}
+	return result
 }
@@ -53,7 +53,87 @@ func (t *CreateTagTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to create tag: %v", err)), nil
 		}
 
-		result, _ := json.Marshal(tagToMap(tag))
+		tagMap := tagToMap(tag)
+		// Nudge callers to reuse an existing tag instead of creating near-duplicates
+		if similar, err := t.service.FindSimilarTags(userID, tag.Name, tag.ID); err == nil && len(similar) > 0 {
+			similarMaps := make([]map[string]interface{}, len(similar))
+			for i := range similar {
+				similarMaps[i] = tagToMap(&similar[i])
+			}
+			tagMap["similar_existing_tags"] = similarMaps
+		}
+
+		result, _ := json.Marshal(tagMap)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CreateTagsTool handles creating several tags at once
+type CreateTagsTool struct {
+	service services.TagService
+}
+
+func NewCreateTagsTool(service services.TagService) *CreateTagsTool {
+	return &CreateTagsTool{service: service}
+}
+
+func (t *CreateTagsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("create_tags",
+		mcp.WithDescription("Create several tags in one call. Names matching an existing tag (ignoring case) are skipped and the existing tag is returned instead."),
+		mcp.WithArray("tags", mcp.Required(),
+			mcp.Description(fmt.Sprintf("Tags to create (at most %d)", services.MaxBulkTags)),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":        map[string]any{"type": "string", "description": "Tag name"},
+					"description": map[string]any{"type": "string", "description": "Tag description"},
+					"color":       map[string]any{"type": "string", "description": "Hex color code (e.g., #FF5733)"},
+				},
+				"required": []string{"name"},
+			}),
+		),
+	)
+}
+
+func (t *CreateTagsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := utils.GetUserID(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		items, _ := args["tags"].([]interface{})
+		if len(items) == 0 {
+			return mcp.NewToolResultError("tags is required"), nil
+		}
+		if len(items) > services.MaxBulkTags {
+			return mcp.NewToolResultError(fmt.Sprintf("tags must contain at most %d tags", services.MaxBulkTags)), nil
+		}
+
+		tags := make([]models.Tag, len(items))
+		for i, item := range items {
+			tagArgs, _ := item.(map[string]interface{})
+			name := getStringArg(tagArgs, "name")
+			if name == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("tags[%d].name is required", i)), nil
+			}
+			tags[i] = models.Tag{
+				Name:        name,
+				Description: getStringArg(tagArgs, "description"),
+				Color:       getStringArg(tagArgs, "color"),
+			}
+		}
+
+		created, err := t.service.CreateTags(userID, tags)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create tags: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"created": tagsToMaps(created.Created),
+			"skipped": tagsToMaps(created.Skipped),
+		})
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -69,7 +149,7 @@ func NewListTagsTool(service services.TagService) *ListTagsTool {
 
 func (t *ListTagsTool) GetTool() mcp.Tool {
 	return mcp.NewTool("list_tags",
-		mcp.WithDescription("List all tags with optional keyword search"),
+		mcp.WithDescription("List all tags with optional keyword search (matches names, descriptions and aliases)"),
 		mcp.WithString("keyword", mcp.Description("Search keyword to filter tags")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of tags to return (default: 100)")),
 		mcp.WithNumber("offset", mcp.Description("Number of tags to skip for pagination")),
@@ -256,7 +336,7 @@ func (t *DeleteTagTool) GetHandler() server.ToolHandlerFunc {
 
 // Helper functions
 func tagToMap(tag *models.Tag) map[string]interface{} {
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"id":          tag.ID,
 		"name":        tag.Name,
 		"description": tag.Description,
@@ -264,6 +344,22 @@ func tagToMap(tag *models.Tag) map[string]interface{} {
 		"created_at":  tag.CreatedAt,
 		"updated_at":  tag.UpdatedAt,
 	}
+	if len(tag.Aliases) > 0 {
+		aliases := make([]string, len(tag.Aliases))
+		for i, a := range tag.Aliases {
+			aliases[i] = a.Alias
+		}
+		result["aliases"] = aliases
+	}
+	return result
+}
+
+func tagsToMaps(tags []models.Tag) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(tags))
+	for i := range tags {
+		result[i] = tagToMap(&tags[i])
+	}
+	return result
 }
 
 func getStringArg(args map[string]interface{}, key string) string {
@@ -273,6 +369,13 @@ func getStringArg(args map[string]interface{}, key string) string {
 	return ""
 }
 
+func getBoolArg(args map[string]interface{}, key string, defaultVal bool) bool {
+	if val, ok := args[key].(bool); ok {
+		return val
+	}
+	return defaultVal
+}
+
 func getIntArg(args map[string]interface{}, key string, defaultVal int) int {
 	if val, ok := args[key].(float64); ok {
 		return int(val)
@@ -54,7 +54,7 @@ func (t *GetPresignedURLTool) GetHandler() server.ToolHandlerFunc {
 			contentType = "application/octet-stream"
 		}
 
-		uploadURL, key, err := t.service.GetPresignedUploadURL(ctx, userID, filename, contentType)
+		uploadURL, key, err := t.service.GetPresignedUploadURL(ctx, userID, "", filename, contentType)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to get presigned URL: %v", err)), nil
 		}
@@ -68,3 +68,82 @@ func (t *GetPresignedURLTool) GetHandler() server.ToolHandlerFunc {
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
+
+// GetPresignedURLsTool handles getting presigned URLs for several uploads at once
+type GetPresignedURLsTool struct {
+	service services.UploadService
+}
+
+func NewGetPresignedURLsTool(service services.UploadService) *GetPresignedURLsTool {
+	return &GetPresignedURLsTool{service: service}
+}
+
+func (t *GetPresignedURLsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("get_presigned_urls",
+		mcp.WithDescription("Get presigned URLs for uploading several files to S3 in one call, e.g. when uploading a whole folder"),
+		mcp.WithArray("files", mcp.Required(),
+			mcp.Description(fmt.Sprintf("Files to upload (at most %d)", services.MaxPresignBatch)),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"filename":     map[string]any{"type": "string", "description": "Name of the file to upload"},
+					"content_type": map[string]any{"type": "string", "description": "MIME type of the file (default: application/octet-stream)"},
+				},
+				"required": []string{"filename"},
+			}),
+		),
+	)
+}
+
+func (t *GetPresignedURLsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := utils.GetUserID(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		items, _ := args["files"].([]interface{})
+		if len(items) == 0 {
+			return mcp.NewToolResultError("files is required"), nil
+		}
+		if len(items) > services.MaxPresignBatch {
+			return mcp.NewToolResultError(fmt.Sprintf("files must contain at most %d files", services.MaxPresignBatch)), nil
+		}
+
+		requests := make([]services.PresignRequest, len(items))
+		for i, item := range items {
+			fileArgs, _ := item.(map[string]interface{})
+			filename := getStringArg(fileArgs, "filename")
+			if filename == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("files[%d].filename is required", i)), nil
+			}
+			contentType := getStringArg(fileArgs, "content_type")
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			requests[i] = services.PresignRequest{Filename: filename, ContentType: contentType}
+		}
+
+		uploads, err := services.PresignUploads(ctx, t.service, userID, "", requests)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get presigned URLs: %v", err)), nil
+		}
+
+		urls := make([]map[string]interface{}, len(uploads))
+		for i, upload := range uploads {
+			urls[i] = map[string]interface{}{
+				"filename":     upload.Filename,
+				"upload_url":   upload.UploadURL,
+				"key":          upload.Key,
+				"content_type": upload.ContentType,
+			}
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"uploads":      urls,
+			"instructions": "Use a PUT request with each upload_url to upload its file, then use the key as the s3_key when creating the file record.",
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
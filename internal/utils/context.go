@@ -9,8 +9,17 @@ type AuthenticatedUser struct {
 	Sub    string   `json:"sub"`
 	Roles  []string `json:"roles"`
 	Scopes []string `json:"scopes"`
+	// MaskedFields lists file fields (MaskFieldContent, MaskFieldSummary)
+	// left out of responses to this token, from its masked_fields claim
+	MaskedFields []string `json:"masked_fields,omitempty"`
 }
 
+// File fields a token can have masked
+const (
+	MaskFieldContent = "content"
+	MaskFieldSummary = "summary"
+)
+
 // MCPAuthenticatedUserContextKey is the context key for storing authenticated user in MCP contexts
 // This is separate from the Fiber middleware context key to avoid confusion
 const MCPAuthenticatedUserContextKey = "mcp_authenticated_user"
@@ -95,6 +104,20 @@ func HasScope(ctx context.Context, scope string) bool {
 	return false
 }
 
+// IsFieldMasked checks if responses to the authenticated user must leave out a file field
+func IsFieldMasked(ctx context.Context, field string) bool {
+	user, ok := GetAuthenticatedUser(ctx)
+	if !ok || user == nil {
+		return false
+	}
+	for _, masked := range user.MaskedFields {
+		if masked == field {
+			return true
+		}
+	}
+	return false
+}
+
 // WithRawAuthToken stores the raw auth token in the context
 func WithRawAuthToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, RawAuthTokenContextKey, token)